@@ -0,0 +1,306 @@
+// Package analytics computes DORA-style delivery metrics from deployment
+// history: deployment frequency, change failure rate, lead time, and time
+// to restore, per time bucket and per project, environment, or bundle.
+//
+//	report, err := analytics.FromIter(
+//	    c.Deployments.Iter(ctx, deployments.ListInput{Action: deployments.ActionProvision}),
+//	    analytics.Options{Bucket: analytics.BucketWeek, Dimension: analytics.DimensionEnvironment},
+//	)
+//	if err != nil {
+//	    return err
+//	}
+//	return report.WriteCSV(os.Stdout)
+//
+// # Definitions
+//
+// Only PROVISION deployments count; plans and decommissions are ignored.
+// A deployment belongs to the bucket its CreatedAt falls in.
+//
+//   - Deployments is the number of COMPLETED deployments, and
+//     FrequencyPerDay that count over the bucket's length in days.
+//   - ChangeFailureRate is FAILED / (COMPLETED + FAILED). ABORTED and
+//     REJECTED deployments are neither.
+//   - Lead time is a COMPLETED deployment's elapsed time from creation to
+//     its final transition — queueing plus provisioning. Massdriver doesn't
+//     see commits, so this is the platform's share of the classic metric.
+//   - Time to restore runs from an instance's first FAILED deployment to
+//     its next COMPLETED one, attributed to the failure's bucket. Failures
+//     still unresolved at the end of the history don't count.
+package analytics
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// Bucket is the time granularity of a [Report].
+type Bucket string
+
+const (
+	BucketDay   Bucket = "day"
+	BucketWeek  Bucket = "week" // ISO weeks, starting Monday
+	BucketMonth Bucket = "month"
+)
+
+// Dimension is what a [Report]'s rows are grouped by within each bucket.
+type Dimension string
+
+const (
+	// DimensionOrganization puts every deployment in one group per bucket.
+	DimensionOrganization Dimension = "organization"
+	DimensionProject      Dimension = "project"
+	DimensionEnvironment  Dimension = "environment"
+	// DimensionBundle groups by bundle name (e.g. "aws-rds"), across
+	// versions.
+	DimensionBundle Dimension = "bundle"
+)
+
+// Options configures a [Report]. The zero value buckets by week across the
+// whole organization, over all history given.
+type Options struct {
+	Bucket    Bucket
+	Dimension Dimension
+	// Since and Until, when set, restrict the report to deployments
+	// created in [Since, Until). Earlier history still feeds time to
+	// restore for failures inside the window.
+	Since time.Time
+	Until time.Time
+}
+
+// Row is the metrics for one (bucket, key) pair.
+type Row struct {
+	Start time.Time
+	End   time.Time
+	// Key is the dimension value — a project or environment ID, a bundle
+	// name, or the empty string for [DimensionOrganization].
+	Key string
+
+	Deployments       int
+	Failures          int
+	FrequencyPerDay   float64
+	ChangeFailureRate float64
+
+	LeadTimeMedian time.Duration
+	LeadTimeP90    time.Duration
+
+	Restores            int
+	TimeToRestoreMedian time.Duration
+	TimeToRestoreP90    time.Duration
+}
+
+// Report is the output of [Compute]: rows ordered by bucket start, then
+// key.
+type Report struct {
+	Bucket    Bucket
+	Dimension Dimension
+	Rows      []Row
+}
+
+// FromIter drains seq — typically [deployments.Service.Iter] — and
+// computes a report. It stops at the first error.
+func FromIter(seq iter.Seq2[types.Deployment, error], opts Options) (*Report, error) {
+	var deps []types.Deployment
+	for d, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		deps = append(deps, d)
+	}
+	return Compute(deps, opts), nil
+}
+
+// Compute builds a report from deployment history, in any order.
+// Deployments without an instance ref are skipped: failures are paired
+// with the success that restored them per instance, and there is no
+// instance to pair them on.
+func Compute(deps []types.Deployment, opts Options) *Report {
+	if opts.Bucket == "" {
+		opts.Bucket = BucketWeek
+	}
+	if opts.Dimension == "" {
+		opts.Dimension = DimensionOrganization
+	}
+
+	type groupKey struct {
+		start time.Time
+		key   string
+	}
+	type acc struct {
+		completed, failed int
+		leadTimes         []time.Duration
+		restores          []time.Duration
+	}
+	groups := make(map[groupKey]*acc)
+	at := func(d types.Deployment) *acc {
+		k := groupKey{truncate(d.CreatedAt, opts.Bucket), dimensionKey(d, opts.Dimension)}
+		a, ok := groups[k]
+		if !ok {
+			a = &acc{}
+			groups[k] = a
+		}
+		return a
+	}
+	inWindow := func(t time.Time) bool {
+		return (opts.Since.IsZero() || !t.Before(opts.Since)) && (opts.Until.IsZero() || t.Before(opts.Until))
+	}
+
+	// Walk each instance's history in order so failures can be paired with
+	// the success that restored them.
+	byInstance := make(map[string][]types.Deployment)
+	for _, d := range deps {
+		if d.Action != "PROVISION" || d.CreatedAt.IsZero() || d.Instance == nil || d.Instance.ID == "" {
+			continue
+		}
+		byInstance[d.Instance.ID] = append(byInstance[d.Instance.ID], d)
+	}
+	for _, history := range byInstance {
+		slices.SortFunc(history, func(a, b types.Deployment) int { return a.CreatedAt.Compare(b.CreatedAt) })
+		var open *types.Deployment
+		for i := range history {
+			d := history[i]
+			switch d.Status {
+			case "COMPLETED":
+				if inWindow(d.CreatedAt) {
+					a := at(d)
+					a.completed++
+					a.leadTimes = append(a.leadTimes, leadTime(d))
+				}
+				if open != nil {
+					if inWindow(open.CreatedAt) {
+						a := at(*open)
+						a.restores = append(a.restores, finishedAt(d).Sub(finishedAt(*open)))
+					}
+					open = nil
+				}
+			case "FAILED":
+				if inWindow(d.CreatedAt) {
+					at(d).failed++
+				}
+				if open == nil {
+					open = &history[i]
+				}
+			}
+		}
+	}
+
+	r := &Report{Bucket: opts.Bucket, Dimension: opts.Dimension}
+	for k, a := range groups {
+		end := next(k.start, opts.Bucket)
+		row := Row{
+			Start:       k.start,
+			End:         end,
+			Key:         k.key,
+			Deployments: a.completed,
+			Failures:    a.failed,
+			Restores:    len(a.restores),
+		}
+		row.FrequencyPerDay = float64(a.completed) / end.Sub(k.start).Hours() * 24
+		if n := a.completed + a.failed; n > 0 {
+			row.ChangeFailureRate = float64(a.failed) / float64(n)
+		}
+		row.LeadTimeMedian, row.LeadTimeP90 = percentile(a.leadTimes, 50), percentile(a.leadTimes, 90)
+		row.TimeToRestoreMedian, row.TimeToRestoreP90 = percentile(a.restores, 50), percentile(a.restores, 90)
+		r.Rows = append(r.Rows, row)
+	}
+	slices.SortFunc(r.Rows, func(a, b Row) int {
+		return cmp.Or(a.Start.Compare(b.Start), strings.Compare(a.Key, b.Key))
+	})
+	return r
+}
+
+// dimensionKey returns d's group under dim. Refs missing from the
+// deployment fall back to the instance ID's slug segments
+// (project-environment-component).
+func dimensionKey(d types.Deployment, dim Dimension) string {
+	inst := d.Instance
+	if inst == nil {
+		inst = &types.Instance{}
+	}
+	slug := strings.Split(inst.ID, "-")
+	switch dim {
+	case DimensionProject:
+		if inst.Environment != nil && inst.Environment.Project != nil && inst.Environment.Project.ID != "" {
+			return inst.Environment.Project.ID
+		}
+		return slug[0]
+	case DimensionEnvironment:
+		if inst.Environment != nil && inst.Environment.ID != "" {
+			return inst.Environment.ID
+		}
+		if len(slug) >= 2 {
+			return slug[0] + "-" + slug[1]
+		}
+		return inst.ID
+	case DimensionBundle:
+		if inst.Bundle != nil {
+			if inst.Bundle.Name != "" {
+				return inst.Bundle.Name
+			}
+			name, _, _ := strings.Cut(inst.Bundle.ID, "@")
+			return name
+		}
+		return ""
+	case DimensionOrganization:
+	}
+	return ""
+}
+
+func leadTime(d types.Deployment) time.Duration {
+	if d.ElapsedTime > 0 {
+		return time.Duration(d.ElapsedTime) * time.Second
+	}
+	if !d.LastTransitionedAt.IsZero() {
+		return d.LastTransitionedAt.Sub(d.CreatedAt)
+	}
+	return 0
+}
+
+// finishedAt is when d reached its final status, as best the record says.
+func finishedAt(d types.Deployment) time.Time {
+	if !d.LastTransitionedAt.IsZero() {
+		return d.LastTransitionedAt
+	}
+	return d.CreatedAt.Add(time.Duration(d.ElapsedTime) * time.Second)
+}
+
+func truncate(t time.Time, b Bucket) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch b {
+	case BucketDay:
+		return day
+	case BucketMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case BucketWeek:
+	}
+	// Monday-based: Sunday is 6 days after the week's start.
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
+
+func next(start time.Time, b Bucket) time.Time {
+	switch b {
+	case BucketDay:
+		return start.AddDate(0, 0, 1)
+	case BucketMonth:
+		return start.AddDate(0, 1, 0)
+	case BucketWeek:
+	}
+	return start.AddDate(0, 0, 7)
+}
+
+// percentile returns the p-th percentile of xs by nearest rank, or zero
+// for no samples.
+func percentile(xs []time.Duration, p int) time.Duration {
+	if len(xs) == 0 {
+		return 0
+	}
+	s := slices.Clone(xs)
+	slices.Sort(s)
+	rank := (p*len(s) + 99) / 100 // ceil(p/100 * n)
+	return s[max(rank, 1)-1]
+}
@@ -0,0 +1,145 @@
+package analytics_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/analytics"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+func at(day, hour, minute int) time.Time {
+	return time.Date(2026, 1, day, hour, minute, 0, 0, time.UTC)
+}
+
+// history spans two ISO weeks (Mon 5 Jan and Mon 12 Jan 2026). The prod
+// instance carries full refs; the staging one only its ID, so its keys
+// come from the slug.
+func history() []types.Deployment {
+	prod := &types.Instance{
+		ID:          "ecomm-prod-db",
+		Environment: &types.Environment{ID: "ecomm-prod", Project: &types.Project{ID: "ecomm"}},
+		Bundle:      &types.Bundle{ID: "aws-rds@1.2.0", Name: "aws-rds"},
+	}
+	staging := &types.Instance{ID: "ecomm-staging-app"}
+	return []types.Deployment{
+		// Out of order on purpose: Compute sorts per instance.
+		{ID: "d3", Status: "COMPLETED", Action: "PROVISION", Instance: prod, CreatedAt: at(6, 11, 0), LastTransitionedAt: at(6, 11, 5), ElapsedTime: 300},
+		{ID: "d1", Status: "COMPLETED", Action: "PROVISION", Instance: prod, CreatedAt: at(5, 10, 0), ElapsedTime: 120},
+		{ID: "d2", Status: "FAILED", Action: "PROVISION", Instance: prod, CreatedAt: at(6, 10, 0), LastTransitionedAt: at(6, 10, 5)},
+		{ID: "d4", Status: "COMPLETED", Action: "PLAN", Instance: prod, CreatedAt: at(6, 12, 0)},
+		{ID: "d5", Status: "COMPLETED", Action: "PROVISION", Instance: staging, CreatedAt: at(12, 9, 0), ElapsedTime: 60},
+		{ID: "d6", Status: "ABORTED", Action: "PROVISION", Instance: staging, CreatedAt: at(13, 9, 0)},
+	}
+}
+
+func TestCompute_Organization(t *testing.T) {
+	r := analytics.Compute(history(), analytics.Options{})
+	if r.Bucket != analytics.BucketWeek || r.Dimension != analytics.DimensionOrganization {
+		t.Errorf("defaults = %s/%s, want week/organization", r.Bucket, r.Dimension)
+	}
+	if len(r.Rows) != 2 {
+		t.Fatalf("got %d rows, want 2: %+v", len(r.Rows), r.Rows)
+	}
+
+	w1 := r.Rows[0]
+	if !w1.Start.Equal(at(5, 0, 0)) || !w1.End.Equal(at(12, 0, 0)) {
+		t.Errorf("week 1 = [%s, %s), want Mon 5 Jan – Mon 12 Jan", w1.Start, w1.End)
+	}
+	if w1.Deployments != 2 || w1.Failures != 1 {
+		t.Errorf("week 1 deployments/failures = %d/%d, want 2/1", w1.Deployments, w1.Failures)
+	}
+	if want := 1.0 / 3; w1.ChangeFailureRate != want {
+		t.Errorf("ChangeFailureRate = %v, want %v", w1.ChangeFailureRate, want)
+	}
+	if want := 2.0 / 7; w1.FrequencyPerDay != want {
+		t.Errorf("FrequencyPerDay = %v, want %v", w1.FrequencyPerDay, want)
+	}
+	if w1.LeadTimeMedian != 2*time.Minute || w1.LeadTimeP90 != 5*time.Minute {
+		t.Errorf("lead time p50/p90 = %s/%s, want 2m/5m", w1.LeadTimeMedian, w1.LeadTimeP90)
+	}
+	if w1.Restores != 1 || w1.TimeToRestoreMedian != time.Hour {
+		t.Errorf("restores = %d, median %s; want 1, 1h", w1.Restores, w1.TimeToRestoreMedian)
+	}
+
+	if w2 := r.Rows[1]; w2.Deployments != 1 || w2.Failures != 0 || w2.ChangeFailureRate != 0 {
+		t.Errorf("week 2 = %+v, want 1 deployment, ABORTED not counted as a failure", w2)
+	}
+}
+
+func TestCompute_ByEnvironmentFallsBackToSlug(t *testing.T) {
+	r := analytics.Compute(history(), analytics.Options{Bucket: analytics.BucketMonth, Dimension: analytics.DimensionEnvironment})
+	if len(r.Rows) != 2 {
+		t.Fatalf("got %d rows, want 2: %+v", len(r.Rows), r.Rows)
+	}
+	if r.Rows[0].Key != "ecomm-prod" || r.Rows[1].Key != "ecomm-staging" {
+		t.Errorf("keys = %q, %q; want ecomm-prod, ecomm-staging", r.Rows[0].Key, r.Rows[1].Key)
+	}
+	if !r.Rows[0].Start.Equal(at(1, 0, 0)) {
+		t.Errorf("month start = %s, want 1 Jan", r.Rows[0].Start)
+	}
+}
+
+func TestCompute_WindowKeepsEarlierFailuresOut(t *testing.T) {
+	r := analytics.Compute(history(), analytics.Options{Since: at(6, 10, 30), Bucket: analytics.BucketDay})
+	for _, row := range r.Rows {
+		if row.Failures != 0 || row.Restores != 0 {
+			t.Errorf("row %s has failures/restores %d/%d from before Since", row.Start, row.Failures, row.Restores)
+		}
+	}
+}
+
+func TestReport_Export(t *testing.T) {
+	r := analytics.Compute(history(), analytics.Options{Dimension: analytics.DimensionBundle})
+
+	var csvBuf bytes.Buffer
+	if err := r.WriteCSV(&csvBuf); err != nil {
+		t.Fatalf("WriteCSV: %v", err)
+	}
+	recs, err := csv.NewReader(&csvBuf).ReadAll()
+	if err != nil {
+		t.Fatalf("read CSV: %v", err)
+	}
+	if len(recs) != 1+len(r.Rows) {
+		t.Fatalf("got %d CSV records, want header + %d", len(recs), len(r.Rows))
+	}
+	// Row order is (start, key): week 1 is aws-rds; week 2's staging
+	// instance has no bundle ref.
+	if recs[1][2] != "bundle" || recs[1][3] != "aws-rds" || recs[1][8] != "120" {
+		t.Errorf("CSV row = %v, want bundle/aws-rds with 120s median lead time", recs[1])
+	}
+
+	var jsonBuf bytes.Buffer
+	if err := r.WriteJSON(&jsonBuf); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+	var got struct {
+		Dimension string `json:"dimension"`
+		Rows      []struct {
+			Key                   string  `json:"key"`
+			LeadTimeMedianSeconds float64 `json:"leadTimeMedianSeconds"`
+		} `json:"rows"`
+	}
+	if err := json.Unmarshal(jsonBuf.Bytes(), &got); err != nil {
+		t.Fatalf("decode JSON: %v", err)
+	}
+	if got.Dimension != "bundle" || len(got.Rows) != 2 || got.Rows[0].LeadTimeMedianSeconds != 120 {
+		t.Errorf("JSON = %+v", got)
+	}
+}
+
+// TestCompute_SkipsDeploymentsWithoutInstance confirms deployments with no
+// instance ref aren't pooled into one history, where a failure on one
+// instance would be "restored" by a success on another.
+func TestCompute_SkipsDeploymentsWithoutInstance(t *testing.T) {
+	r := analytics.Compute([]types.Deployment{
+		{ID: "d1", Status: "FAILED", Action: "PROVISION", CreatedAt: at(5, 10, 0), LastTransitionedAt: at(5, 10, 5)},
+		{ID: "d2", Status: "COMPLETED", Action: "PROVISION", CreatedAt: at(5, 11, 0), LastTransitionedAt: at(5, 11, 5)},
+	}, analytics.Options{})
+	if len(r.Rows) != 0 {
+		t.Errorf("rows = %+v, want none", r.Rows)
+	}
+}
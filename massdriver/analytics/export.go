@@ -0,0 +1,97 @@
+package analytics
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+)
+
+// csvHeader names the columns [Report.WriteCSV] writes. Durations are in
+// seconds.
+var csvHeader = []string{
+	"bucket_start", "bucket_end", "dimension", "key",
+	"deployments", "failures", "frequency_per_day", "change_failure_rate",
+	"lead_time_median_s", "lead_time_p90_s",
+	"restores", "time_to_restore_median_s", "time_to_restore_p90_s",
+}
+
+// WriteCSV writes the report as CSV with a header row. Times are RFC 3339
+// and durations are in seconds.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, row := range r.Rows {
+		rec := []string{
+			row.Start.Format(time.RFC3339),
+			row.End.Format(time.RFC3339),
+			string(r.Dimension),
+			row.Key,
+			strconv.Itoa(row.Deployments),
+			strconv.Itoa(row.Failures),
+			strconv.FormatFloat(row.FrequencyPerDay, 'f', 4, 64),
+			strconv.FormatFloat(row.ChangeFailureRate, 'f', 4, 64),
+			seconds(row.LeadTimeMedian),
+			seconds(row.LeadTimeP90),
+			strconv.Itoa(row.Restores),
+			seconds(row.TimeToRestoreMedian),
+			seconds(row.TimeToRestoreP90),
+		}
+		if err := cw.Write(rec); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes the report as one indented JSON object. Durations are
+// in seconds, matching the CSV columns.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// MarshalJSON encodes durations as seconds rather than nanoseconds.
+func (row Row) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Start               time.Time `json:"bucketStart"`
+		End                 time.Time `json:"bucketEnd"`
+		Key                 string    `json:"key"`
+		Deployments         int       `json:"deployments"`
+		Failures            int       `json:"failures"`
+		FrequencyPerDay     float64   `json:"frequencyPerDay"`
+		ChangeFailureRate   float64   `json:"changeFailureRate"`
+		LeadTimeMedian      float64   `json:"leadTimeMedianSeconds"`
+		LeadTimeP90         float64   `json:"leadTimeP90Seconds"`
+		Restores            int       `json:"restores"`
+		TimeToRestoreMedian float64   `json:"timeToRestoreMedianSeconds"`
+		TimeToRestoreP90    float64   `json:"timeToRestoreP90Seconds"`
+	}{
+		row.Start, row.End, row.Key, row.Deployments, row.Failures, row.FrequencyPerDay, row.ChangeFailureRate,
+		row.LeadTimeMedian.Seconds(), row.LeadTimeP90.Seconds(),
+		row.Restores, row.TimeToRestoreMedian.Seconds(), row.TimeToRestoreP90.Seconds(),
+	})
+}
+
+// MarshalJSON gives the report lower-camel field names like the rest of
+// the SDK's JSON.
+func (r *Report) MarshalJSON() ([]byte, error) {
+	rows := r.Rows
+	if rows == nil {
+		rows = []Row{}
+	}
+	return json.Marshal(struct {
+		Bucket    Bucket    `json:"bucket"`
+		Dimension Dimension `json:"dimension"`
+		Rows      []Row     `json:"rows"`
+	}{r.Bucket, r.Dimension, rows})
+}
+
+func seconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64)
+}
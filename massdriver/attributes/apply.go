@@ -0,0 +1,49 @@
+package attributes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/bulk"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/components"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/environments"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/organizations"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/projects"
+)
+
+// Apply updates each drifted entity in plan with its Drift.Want attributes
+// through Projects.Update, Environments.Update, or Components.Update,
+// keeping its name and description. It runs the updates one at a time
+// with [bulk.Run]: a failed update is recorded in the report and Apply
+// moves on.
+//
+// It returns an error only when ctx ends — per-entity failures are in the
+// report, which is returned (as far as it got) in every case. Plan again
+// to see what's left.
+func (r *Reconciler) Apply(ctx context.Context, plan *Plan) (*bulk.Report[Drift], error) {
+	return bulk.Run(ctx, func(yield func(Drift, error) bool) {
+		for _, d := range plan.Drift {
+			if !yield(d, nil) {
+				return
+			}
+		}
+	}, r.update, bulk.Options{})
+}
+
+func (r *Reconciler) update(ctx context.Context, d Drift) error {
+	var err error
+	switch d.Scope {
+	case organizations.AttributeScopeProject:
+		_, err = r.c.Projects.Update(ctx, d.ID, projects.UpdateInput{Name: d.name, Description: d.description, Attributes: d.Want})
+	case organizations.AttributeScopeEnvironment:
+		_, err = r.c.Environments.Update(ctx, d.ID, environments.UpdateInput{Name: d.name, Description: d.description, Attributes: d.Want})
+	case organizations.AttributeScopeComponent:
+		_, err = r.c.Components.Update(ctx, d.ID, components.UpdateInput{Name: d.name, Description: d.description, Attributes: d.Want})
+	default:
+		err = fmt.Errorf("unsupported scope %s", d.Scope)
+	}
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", d.Scope, d.ID, err)
+	}
+	return nil
+}
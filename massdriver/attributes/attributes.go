@@ -0,0 +1,343 @@
+// Package attributes applies a desired set of custom attributes across the
+// projects, environments, and components that match a filter, and reports
+// where live attributes have drifted from it.
+//
+//	r := attributes.New(c)
+//	plan, err := r.Plan(ctx, &attributes.Spec{
+//	    Projects:     map[string]string{"cost_center": "cc-100"},
+//	    Environments: map[string]string{"data_class": "internal"},
+//	}, attributes.Filter{Attributes: []types.AttributeFilter{{Key: "team", Eq: "data"}}})
+//	if err != nil {
+//	    return err // a listing failed, or the spec breaks the attribute schema
+//	}
+//	fmt.Print(plan) // the drift
+//	report, err := r.Apply(ctx, plan)
+//	if err != nil {
+//	    return err
+//	}
+//	return report.Err()
+//
+// Plans are validated before anything is written: every key must be one
+// the organization's custom-attribute schema allows at that scope, and
+// every value one of its permitted values (Policies.CustomAttributeSchema,
+// which narrows to what the caller's policies allow). Apply runs the
+// updates through [bulk.Run] and returns its report.
+//
+// # Relation to declarative
+//
+// The declarative package also sets environment attributes, as one part
+// of reconciling a single environment's whole configuration. This package
+// is the cross-cutting counterpart: one spec fanned out across every
+// project, environment, and component a filter selects, validated against
+// the custom-attribute schema. Its plan is per entity rather than per
+// field, and it never touches defaults, instances, or deployments, so it
+// doesn't reuse declarative's environment-scoped Plan. Use declarative
+// for one environment end to end, attributes for a policy across many.
+package attributes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/components"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/environments"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/organizations"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/projects"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// Spec is the desired attributes per scope. Everything is an overlay:
+// attributes the spec doesn't mention are left as they are, and a scope
+// with no attributes isn't touched. Keys are case-insensitive, as in the
+// API: "Team" in a spec matches a live or schema "team".
+type Spec struct {
+	Projects     map[string]string `yaml:"projects,omitempty" json:"projects,omitempty"`
+	Environments map[string]string `yaml:"environments,omitempty" json:"environments,omitempty"`
+	Components   map[string]string `yaml:"components,omitempty" json:"components,omitempty"`
+}
+
+// Filter selects the entities a [Spec] applies to. The zero value selects
+// every project, environment, and component in the organization.
+type Filter struct {
+	// ProjectIDs limits the plan to these projects and the environments and
+	// components within them.
+	ProjectIDs []string
+	// Attributes filters projects and environments by effective attributes,
+	// as in projects.ListInput. Components are selected with the projects
+	// that match.
+	Attributes []types.AttributeFilter
+}
+
+// Drift is one entity whose attributes differ from the [Spec].
+type Drift struct {
+	Scope organizations.AttributeScope
+	ID    string
+	// Keys maps each drifted key to its live value; a key that isn't set
+	// maps to nil.
+	Keys map[string]any
+	// Want is the entity's full attribute set after [Reconciler.Apply]:
+	// its live attributes with the spec overlaid.
+	Want map[string]any
+
+	name, description string
+}
+
+// Plan is the drift between a [Spec] and live state, produced by
+// [Reconciler.Plan] and consumed by [Reconciler.Apply].
+type Plan struct {
+	// Drift lists drifted entities, projects first, then environments,
+	// then components, each in ID order.
+	Drift []Drift
+	// InSync is the number of selected entities that already match.
+	InSync int
+}
+
+// HasDrift reports whether applying the plan would change anything.
+func (p *Plan) HasDrift() bool { return len(p.Drift) > 0 }
+
+// String renders the plan for people: one block per drifted entity, one
+// line per drifted key, and a closing tally.
+//
+//	project ecomm
+//	  ~ cost_center: "cc-200" => "cc-100"
+//	environment ecomm-prod
+//	  + data_class: "internal"
+//
+//	Plan: 2 to update, 5 in sync.
+func (p *Plan) String() string {
+	var sb strings.Builder
+	for _, d := range p.Drift {
+		fmt.Fprintf(&sb, "%s %s\n", strings.ToLower(string(d.Scope)), d.ID)
+		for _, key := range slices.Sorted(maps.Keys(d.Keys)) {
+			want := render(d.Want[key])
+			if have := d.Keys[key]; have == nil {
+				fmt.Fprintf(&sb, "  + %s: %s\n", key, want)
+			} else {
+				fmt.Fprintf(&sb, "  ~ %s: %s => %s\n", key, render(have), want)
+			}
+		}
+	}
+	if sb.Len() > 0 {
+		sb.WriteByte('\n')
+	}
+	fmt.Fprintf(&sb, "Plan: %d to update, %d in sync.\n", len(p.Drift), p.InSync)
+	return sb.String()
+}
+
+// render formats a value as compact JSON.
+func render(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// ValidationError is returned by [Reconciler.Plan] when the spec sets
+// attributes the schema doesn't allow. Nothing has been written.
+type ValidationError struct {
+	// Problems holds one line per bad key ("environment data_class:
+	// "secret" is not one of ["internal","public"]"), sorted.
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "attributes: invalid spec:\n  " + strings.Join(e.Problems, "\n  ")
+}
+
+// Reconciler plans and applies attribute specs. Construct with [New].
+type Reconciler struct {
+	c *massdriver.Client
+}
+
+// New returns a [*Reconciler] using c's services.
+func New(c *massdriver.Client) *Reconciler { return &Reconciler{c: c} }
+
+// updateActions are the policy actions whose attribute schema governs
+// each scope.
+var updateActions = map[organizations.AttributeScope]string{
+	organizations.AttributeScopeProject:     "project:update",
+	organizations.AttributeScopeEnvironment: "environment:update",
+	organizations.AttributeScopeComponent:   "component:update",
+}
+
+// Plan validates spec against the attribute schema for each scope it sets,
+// then diffs it against every entity filter selects. Reads go through
+// Policies.CustomAttributeSchema, Projects.Iter, Environments.Iter, and
+// Components.List. Nothing is written.
+func (r *Reconciler) Plan(ctx context.Context, spec *Spec, filter Filter) (*Plan, error) {
+	if err := r.validate(ctx, spec); err != nil {
+		return nil, err
+	}
+	plan := &Plan{}
+	inScope := func(projectID string) bool {
+		return len(filter.ProjectIDs) == 0 || slices.Contains(filter.ProjectIDs, projectID)
+	}
+
+	var projectIDs []string
+	if len(spec.Projects) > 0 || len(spec.Components) > 0 {
+		for p, err := range r.c.Projects.Iter(ctx, projects.ListInput{Attributes: filter.Attributes}) {
+			if err != nil {
+				return nil, err
+			}
+			if !inScope(p.ID) {
+				continue
+			}
+			projectIDs = append(projectIDs, p.ID)
+			plan.diff(organizations.AttributeScopeProject, p.ID, p.Name, p.Description, p.Attributes, spec.Projects)
+		}
+	}
+
+	if len(spec.Environments) > 0 {
+		for e, err := range r.c.Environments.Iter(ctx, environments.ListInput{Attributes: filter.Attributes}) {
+			if err != nil {
+				return nil, err
+			}
+			if e.Project == nil || !inScope(e.Project.ID) {
+				continue
+			}
+			plan.diff(organizations.AttributeScopeEnvironment, e.ID, e.Name, e.Description, e.Attributes, spec.Environments)
+		}
+	}
+
+	if len(spec.Components) > 0 {
+		for _, projectID := range projectIDs {
+			comps, err := r.c.Components.List(ctx, components.ListInput{ProjectID: projectID})
+			if err != nil {
+				return nil, err
+			}
+			for _, comp := range comps {
+				plan.diff(organizations.AttributeScopeComponent, comp.ID, comp.Name, comp.Description, comp.Attributes, spec.Components)
+			}
+		}
+	}
+
+	slices.SortStableFunc(plan.Drift, func(a, b Drift) int {
+		if c := scopeOrder(a.Scope) - scopeOrder(b.Scope); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return plan, nil
+}
+
+// scopes are the scopes a [Spec] covers, in plan order.
+var scopes = []organizations.AttributeScope{
+	organizations.AttributeScopeProject,
+	organizations.AttributeScopeEnvironment,
+	organizations.AttributeScopeComponent,
+}
+
+func scopeOrder(s organizations.AttributeScope) int { return slices.Index(scopes, s) }
+
+// scope returns the spec's attributes for s.
+func (s *Spec) scope(scope organizations.AttributeScope) map[string]string {
+	switch scope {
+	case organizations.AttributeScopeProject:
+		return s.Projects
+	case organizations.AttributeScopeEnvironment:
+		return s.Environments
+	case organizations.AttributeScopeComponent:
+		return s.Components
+	}
+	return nil
+}
+
+// diff records a Drift for the entity when want isn't already a subset of
+// have, and counts it in sync otherwise. Keys match case-insensitively, as
+// the API treats them.
+func (p *Plan) diff(scope organizations.AttributeScope, id, name, description string, have map[string]any, want map[string]string) {
+	if len(want) == 0 {
+		return
+	}
+	d := Drift{Scope: scope, ID: id, name: name, description: description}
+	for key, value := range want {
+		_, current, ok := lookupFold(have, key)
+		if ok && fmt.Sprint(current) == value {
+			continue
+		}
+		if d.Keys == nil {
+			d.Keys = map[string]any{}
+		}
+		d.Keys[key] = current
+	}
+	if d.Keys == nil {
+		p.InSync++
+		return
+	}
+	d.Want = maps.Clone(have)
+	if d.Want == nil {
+		d.Want = map[string]any{}
+	}
+	for key, value := range want {
+		// Replace the live key however it's cased rather than add a twin.
+		if live, _, ok := lookupFold(d.Want, key); ok {
+			delete(d.Want, live)
+		}
+		d.Want[key] = value
+	}
+	p.Drift = append(p.Drift, d)
+}
+
+// lookupFold finds key in m ignoring case, preferring an exact match, and
+// returns the key as m spells it.
+func lookupFold[V any](m map[string]V, key string) (string, V, bool) {
+	if v, ok := m[key]; ok {
+		return key, v, true
+	}
+	for k, v := range m {
+		if strings.EqualFold(k, key) {
+			return k, v, true
+		}
+	}
+	var zero V
+	return "", zero, false
+}
+
+// attributeSchema is the part of a custom-attribute JSON Schema that
+// validation reads.
+type attributeSchema struct {
+	Properties map[string]struct {
+		Enum []any `json:"enum"`
+	} `json:"properties"`
+	AdditionalProperties json.RawMessage `json:"additionalProperties"`
+}
+
+func (r *Reconciler) validate(ctx context.Context, spec *Spec) error {
+	var problems []string
+	for _, scope := range scopes {
+		want := spec.scope(scope)
+		if len(want) == 0 {
+			continue
+		}
+		raw, err := r.c.Policies.CustomAttributeSchema(ctx, updateActions[scope])
+		if err != nil {
+			return err
+		}
+		var schema attributeSchema
+		if err := json.Unmarshal(raw, &schema); err != nil {
+			return fmt.Errorf("decode %s attribute schema: %w", strings.ToLower(string(scope)), err)
+		}
+		closed := strings.TrimSpace(string(schema.AdditionalProperties)) == "false"
+		for key, value := range want {
+			label := strings.ToLower(string(scope)) + " " + key
+			_, prop, ok := lookupFold(schema.Properties, key)
+			switch {
+			case !ok && closed:
+				problems = append(problems, label+": not an allowed attribute")
+			case ok && len(prop.Enum) > 0 && !slices.ContainsFunc(prop.Enum, func(v any) bool { return fmt.Sprint(v) == value }):
+				problems = append(problems, fmt.Sprintf("%s: %q is not one of %s", label, value, render(prop.Enum)))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		slices.Sort(problems)
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
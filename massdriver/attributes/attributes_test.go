@@ -0,0 +1,151 @@
+package attributes_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/attributes"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
+)
+
+func newReconciler(t *testing.T, gqlClient *gqltest.Client) *attributes.Reconciler {
+	t.Helper()
+	c := gqltest.NewSDKClient(t, gqlClient)
+	return attributes.New(c)
+}
+
+func schema(key string, values ...string) gqltest.Response {
+	return gqltest.RespondWithData(map[string]any{
+		"customAttributeSchema": map[string]any{
+			"type":                 "object",
+			"properties":           map[string]any{key: map[string]any{"type": "string", "enum": values}},
+			"additionalProperties": false,
+		},
+	})
+}
+
+func TestPlanAndApply(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		schema("cost_center", "cc-100", "cc-200"),
+		schema("data_class", "internal", "public"),
+		gqltest.RespondWithData(map[string]any{
+			"projects": map[string]any{"cursor": map[string]any{}, "items": []map[string]any{
+				{"id": "billing", "name": "Billing", "attributes": map[string]any{"cost_center": "cc-100"}},
+				{"id": "ecomm", "name": "E-commerce", "description": "Storefront", "attributes": map[string]any{"cost_center": "cc-200", "team": "data"}},
+			}},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"environments": map[string]any{"cursor": map[string]any{}, "items": []map[string]any{
+				{"id": "billing-prod", "name": "Production", "project": map[string]any{"id": "billing"}, "attributes": map[string]any{"data_class": "internal"}},
+				{"id": "ecomm-prod", "name": "Production", "project": map[string]any{"id": "ecomm"}},
+			}},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"updateProject": map[string]any{"result": map[string]any{"id": "ecomm"}, "successful": true},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"updateEnvironment": map[string]any{"successful": false, "messages": []map[string]any{
+				{"field": "attributes", "message": "data_class is locked"},
+			}},
+		}),
+	)
+	r := newReconciler(t, gqlClient)
+
+	plan, err := r.Plan(t.Context(), &attributes.Spec{
+		Projects:     map[string]string{"cost_center": "cc-100"},
+		Environments: map[string]string{"data_class": "internal"},
+	}, attributes.Filter{})
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	want := `project ecomm
+  ~ cost_center: "cc-200" => "cc-100"
+environment ecomm-prod
+  + data_class: "internal"
+
+Plan: 2 to update, 2 in sync.
+`
+	if got := plan.String(); got != want {
+		t.Errorf("plan =\n%s\nwant\n%s", got, want)
+	}
+
+	report, err := r.Apply(t.Context(), plan)
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if len(report.Succeeded) != 1 || report.Succeeded[0].ID != "ecomm" {
+		t.Errorf("Succeeded = %+v, want ecomm", report.Succeeded)
+	}
+	if len(report.Failed) != 1 || report.Failed[0].Item.ID != "ecomm-prod" {
+		t.Errorf("Failed = %+v, want ecomm-prod", report.Failed)
+	}
+	if _, ok := gql.AsMutationFailedError(report.Err()); !ok {
+		t.Errorf("Err() = %v, want it to wrap the MutationFailedError", report.Err())
+	}
+
+	reqs := gqlClient.Requests()
+	if reqs[0].Variables["action"] != "project:update" || reqs[1].Variables["action"] != "environment:update" {
+		t.Errorf("schema actions = %v, %v", reqs[0].Variables["action"], reqs[1].Variables["action"])
+	}
+	input, _ := reqs[4].Variables["input"].(map[string]any)
+	// The Map scalar travels as an encoded JSON string.
+	if input["name"] != "E-commerce" || input["description"] != "Storefront" || input["attributes"] != `{"cost_center":"cc-100","team":"data"}` {
+		t.Errorf("updateProject input = %v, want name, description, and team kept", input)
+	}
+	if gqlClient.Pending() != 0 {
+		t.Errorf("%d responses unused", gqlClient.Pending())
+	}
+}
+
+func TestPlan_RejectsValuesOutsideSchema(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		schema("data_class", "internal", "public"),
+	)
+
+	_, err := newReconciler(t, gqlClient).Plan(t.Context(), &attributes.Spec{
+		Environments: map[string]string{"data_class": "secret", "owner": "me"},
+	}, attributes.Filter{})
+	var verr *attributes.ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("err = %v, want *ValidationError", err)
+	}
+	want := []string{
+		`environment data_class: "secret" is not one of ["internal","public"]`,
+		`environment owner: not an allowed attribute`,
+	}
+	if len(verr.Problems) != 2 || verr.Problems[0] != want[0] || verr.Problems[1] != want[1] {
+		t.Errorf("Problems = %q, want %q", verr.Problems, want)
+	}
+	if len(gqlClient.Requests()) != 1 {
+		t.Errorf("made %d requests, want just the schema", len(gqlClient.Requests()))
+	}
+}
+
+func TestPlan_KeysIgnoreCase(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		schema("cost_center", "cc-100", "cc-200"),
+		gqltest.RespondWithData(map[string]any{
+			"projects": map[string]any{"cursor": map[string]any{}, "items": []map[string]any{
+				{"id": "billing", "name": "Billing", "attributes": map[string]any{"cost_center": "cc-100"}},
+				{"id": "ecomm", "name": "E-commerce", "attributes": map[string]any{"COST_CENTER": "cc-200", "team": "data"}},
+			}},
+		}),
+	)
+
+	plan, err := newReconciler(t, gqlClient).Plan(t.Context(), &attributes.Spec{
+		Projects: map[string]string{"Cost_Center": "cc-100"},
+	}, attributes.Filter{})
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	if plan.InSync != 1 || len(plan.Drift) != 1 || plan.Drift[0].ID != "ecomm" {
+		t.Fatalf("plan = %+v, want billing in sync and ecomm drifted", plan)
+	}
+	if got := plan.Drift[0].Keys["Cost_Center"]; got != "cc-200" {
+		t.Errorf("Keys = %v, want Cost_Center's live value cc-200", plan.Drift[0].Keys)
+	}
+	if want := plan.Drift[0].Want; len(want) != 2 || want["Cost_Center"] != "cc-100" || want["team"] != "data" {
+		t.Errorf("Want = %v, want COST_CENTER replaced and team kept", want)
+	}
+}
@@ -0,0 +1,295 @@
+// Package bulk runs one action over many platform records — "set an
+// attribute on every production environment", "abort every RUNNING
+// deployment in a project" — with bounded concurrency, an optional rate
+// limit, and a report of what happened to each item.
+//
+// The source is any lazy iterator, typically a service's Iter method:
+//
+//	report, err := bulk.Run(ctx,
+//	    c.Deployments.Iter(ctx, deployments.ListInput{Status: deployments.StatusRunning}),
+//	    func(ctx context.Context, d deployments.Deployment) error {
+//	        _, err := c.Deployments.Abort(ctx, d.ID)
+//	        return err
+//	    },
+//	    bulk.Options{Concurrency: 8},
+//	)
+//	if err != nil {
+//	    return err // the source failed or ctx ended
+//	}
+//	return report.Err() // nil unless some actions failed
+//
+// Run with [Options.DryRun] first to see the targets without touching
+// them.
+package bulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql"
+	"golang.org/x/time/rate"
+)
+
+// ErrSkip, returned by an action, records the item as skipped rather
+// than failed — for items already in the desired state.
+var ErrSkip = errors.New("skip")
+
+// Options configures [Run]. The zero value runs actions one at a time,
+// unthrottled, continuing past failures.
+type Options struct {
+	// Concurrency is the number of actions run at once. Zero or
+	// negative means 1.
+	Concurrency int
+	// RateLimit caps actions started per second, allowing bursts of up
+	// to Burst (minimum 1). Zero means unlimited. This is on top of any
+	// client-wide [massdriver.WithRateLimit].
+	RateLimit float64
+	Burst     int
+	// StopOnError stops starting new actions after the first failure.
+	// Actions already running finish; items pulled from the source but
+	// not started are reported as skipped, and the source is not read
+	// further.
+	StopOnError bool
+	// DryRun reads the whole source into [Report.Targets] and runs no
+	// actions.
+	DryRun bool
+}
+
+// Reason classifies a failed action.
+type Reason string
+
+const (
+	// ReasonNotFound means the record no longer exists ([gql.ErrNotFound]).
+	ReasonNotFound Reason = "not_found"
+	// ReasonForbidden means policy denied the action ([gql.ErrForbidden]).
+	ReasonForbidden Reason = "forbidden"
+	// ReasonUnauthenticated means the credentials were rejected
+	// ([gql.ErrUnauthenticated]).
+	ReasonUnauthenticated Reason = "unauthenticated"
+	// ReasonValidation means the server rejected the mutation's input
+	// ([gql.MutationFailedError]).
+	ReasonValidation Reason = "validation"
+	// ReasonCanceled means the action's context ended.
+	ReasonCanceled Reason = "canceled"
+	// ReasonOther is any other failure.
+	ReasonOther Reason = "other"
+)
+
+// Failure is one failed action.
+type Failure[T any] struct {
+	Item   T
+	Err    error
+	Reason Reason
+}
+
+// Failures lists failed items. Besides [Report], packages that track
+// per-item failures outside [Run] (previews, attributes) report them with
+// it.
+type Failures[T any] []Failure[T]
+
+// Add records item as failed with err, classifying err's [Reason].
+func (f *Failures[T]) Add(item T, err error) {
+	*f = append(*f, Failure[T]{Item: item, Err: err, Reason: classify(err)})
+}
+
+// Err summarizes the failures as one error, "<what>: n of total failed",
+// or returns nil when there are none. It wraps each item's error, so
+// [errors.Is] against the gql sentinels still works.
+func (f Failures[T]) Err(what string, total int) error {
+	if len(f) == 0 {
+		return nil
+	}
+	errs := make([]error, 0, len(f))
+	for _, x := range f {
+		errs = append(errs, x.Err)
+	}
+	return fmt.Errorf("%s: %d of %d failed: %w", what, len(f), total, errors.Join(errs...))
+}
+
+// Report is the outcome of [Run]. Each list is in source order.
+type Report[T any] struct {
+	// Succeeded holds items whose action returned nil.
+	Succeeded []T
+	// Failed holds items whose action returned an error.
+	Failed Failures[T]
+	// Skipped holds items whose action returned [ErrSkip], or that were
+	// never started because of StopOnError or cancellation.
+	Skipped []T
+	// Targets holds every item the source yielded. Populated only for
+	// [Options.DryRun].
+	Targets []T
+}
+
+// Err summarizes the failures as one error, or returns nil when there
+// are none. It wraps each action's error, so [errors.Is] against the gql
+// sentinels still works.
+func (r *Report[T]) Err() error {
+	return r.Failed.Err("bulk", len(r.Succeeded)+len(r.Failed)+len(r.Skipped))
+}
+
+// Run pulls items from source and applies action to each as described
+// by opts. It returns an error only when the source yields one or ctx
+// ends — per-item failures are in the report, which is returned (as far
+// as it got) in every case.
+func Run[T any](ctx context.Context, source iter.Seq2[T, error], action func(context.Context, T) error, opts Options) (*Report[T], error) {
+	report := &Report[T]{}
+	if opts.DryRun {
+		for item, err := range source {
+			if err != nil {
+				return report, err
+			}
+			report.Targets = append(report.Targets, item)
+		}
+		return report, nil
+	}
+
+	var limiter *rate.Limiter
+	if opts.RateLimit > 0 {
+		limiter = rate.NewLimiter(rate.Limit(opts.RateLimit), max(opts.Burst, 1))
+	}
+	slots := make(chan struct{}, max(opts.Concurrency, 1))
+
+	var (
+		wg      sync.WaitGroup
+		out     outcomes[T]
+		srcErr  error
+		nextIdx int
+	)
+	stopping := func() bool { return opts.StopOnError && out.anyFailed() }
+
+	for item, err := range source {
+		if err != nil {
+			srcErr = err
+			break
+		}
+		idx := nextIdx
+		nextIdx++
+		if stopping() {
+			out.skip(idx, item)
+			break
+		}
+		if err := acquire(ctx, slots, limiter); err != nil {
+			out.skip(idx, item)
+			srcErr = err
+			break
+		}
+		// A failure may have landed while this item waited for a slot.
+		if stopping() {
+			<-slots
+			out.skip(idx, item)
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			switch err := action(ctx, item); {
+			case err == nil:
+				out.succeed(idx, item)
+			case errors.Is(err, ErrSkip):
+				out.skip(idx, item)
+			default:
+				out.fail(idx, Failure[T]{Item: item, Err: err, Reason: classify(err)})
+			}
+		}()
+	}
+	wg.Wait()
+
+	out.into(report)
+	return report, srcErr
+}
+
+// acquire waits for a concurrency slot and then a rate-limit token,
+// giving up when ctx ends. On error no slot is held.
+func acquire(ctx context.Context, slots chan struct{}, limiter *rate.Limiter) error {
+	select {
+	case slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if limiter != nil {
+		if err := limiter.Wait(ctx); err != nil {
+			<-slots
+			return err
+		}
+	}
+	return nil
+}
+
+// classify maps an action error onto a [Reason].
+func classify(err error) Reason {
+	switch {
+	case errors.Is(err, gql.ErrNotFound):
+		return ReasonNotFound
+	case errors.Is(err, gql.ErrForbidden):
+		return ReasonForbidden
+	case errors.Is(err, gql.ErrUnauthenticated):
+		return ReasonUnauthenticated
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return ReasonCanceled
+	}
+	if _, ok := gql.AsMutationFailedError(err); ok {
+		return ReasonValidation
+	}
+	return ReasonOther
+}
+
+// outcomes collects results tagged with their source index, so the
+// report can be put back in source order however actions interleave.
+// Safe for concurrent use.
+type outcomes[T any] struct {
+	mu        sync.Mutex
+	succeeded []indexed[T]
+	failed    []indexed[Failure[T]]
+	skipped   []indexed[T]
+}
+
+type indexed[T any] struct {
+	idx int
+	v   T
+}
+
+func (o *outcomes[T]) succeed(idx int, item T) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.succeeded = append(o.succeeded, indexed[T]{idx, item})
+}
+
+func (o *outcomes[T]) fail(idx int, f Failure[T]) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.failed = append(o.failed, indexed[Failure[T]]{idx, f})
+}
+
+func (o *outcomes[T]) skip(idx int, item T) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.skipped = append(o.skipped, indexed[T]{idx, item})
+}
+
+func (o *outcomes[T]) anyFailed() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.failed) > 0
+}
+
+// into sorts the outcomes into r; call once every action has finished.
+func (o *outcomes[T]) into(r *Report[T]) {
+	r.Succeeded = inOrder(o.succeeded)
+	r.Failed = inOrder(o.failed)
+	r.Skipped = inOrder(o.skipped)
+}
+
+func inOrder[T any](xs []indexed[T]) []T {
+	slices.SortFunc(xs, func(a, b indexed[T]) int { return a.idx - b.idx })
+	out := make([]T, 0, len(xs))
+	for _, x := range xs {
+		out = append(out, x.v)
+	}
+	return out
+}
@@ -0,0 +1,164 @@
+package bulk_test
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/bulk"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql"
+)
+
+// items yields xs, then err if non-nil.
+func items(xs []string, err error) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		for _, x := range xs {
+			if !yield(x, nil) {
+				return
+			}
+		}
+		if err != nil {
+			yield("", err)
+		}
+	}
+}
+
+func TestRun_ReportsEachOutcome(t *testing.T) {
+	action := func(_ context.Context, id string) error {
+		switch id {
+		case "gone":
+			return gql.ErrNotFound
+		case "denied":
+			return gql.ErrForbidden
+		case "done":
+			return bulk.ErrSkip
+		}
+		return nil
+	}
+
+	report, err := bulk.Run(context.Background(),
+		items([]string{"a", "gone", "b", "denied", "done", "c"}, nil),
+		action, bulk.Options{Concurrency: 4})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if want := []string{"a", "b", "c"}; !slices.Equal(report.Succeeded, want) {
+		t.Errorf("Succeeded = %v, want %v", report.Succeeded, want)
+	}
+	if want := []string{"done"}; !slices.Equal(report.Skipped, want) {
+		t.Errorf("Skipped = %v, want %v", report.Skipped, want)
+	}
+	if len(report.Failed) != 2 {
+		t.Fatalf("Failed = %v, want 2 entries", report.Failed)
+	}
+	if f := report.Failed[0]; f.Item != "gone" || f.Reason != bulk.ReasonNotFound {
+		t.Errorf("Failed[0] = %+v, want gone/not_found", f)
+	}
+	if f := report.Failed[1]; f.Item != "denied" || f.Reason != bulk.ReasonForbidden {
+		t.Errorf("Failed[1] = %+v, want denied/forbidden", f)
+	}
+	if err := report.Err(); !errors.Is(err, gql.ErrForbidden) {
+		t.Errorf("Err() = %v, want it to wrap ErrForbidden", err)
+	}
+}
+
+func TestRun_StopOnError(t *testing.T) {
+	var attempted []string
+	action := func(_ context.Context, id string) error {
+		attempted = append(attempted, id)
+		if id == "b" {
+			return errors.New("boom")
+		}
+		return nil
+	}
+
+	report, err := bulk.Run(context.Background(),
+		items([]string{"a", "b", "c", "d"}, nil),
+		action, bulk.Options{StopOnError: true})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if want := []string{"a", "b"}; !slices.Equal(attempted, want) {
+		t.Errorf("attempted = %v, want %v", attempted, want)
+	}
+	if want := []string{"c"}; !slices.Equal(report.Skipped, want) {
+		t.Errorf("Skipped = %v, want %v", report.Skipped, want)
+	}
+	if len(report.Failed) != 1 || report.Failed[0].Reason != bulk.ReasonOther {
+		t.Errorf("Failed = %+v, want one other failure", report.Failed)
+	}
+}
+
+func TestRun_DryRun(t *testing.T) {
+	report, err := bulk.Run(context.Background(),
+		items([]string{"a", "b"}, nil),
+		func(context.Context, string) error {
+			t.Fatal("action called during dry run")
+			return nil
+		},
+		bulk.Options{DryRun: true})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if want := []string{"a", "b"}; !slices.Equal(report.Targets, want) {
+		t.Errorf("Targets = %v, want %v", report.Targets, want)
+	}
+	if len(report.Succeeded) != 0 {
+		t.Errorf("Succeeded = %v, want none", report.Succeeded)
+	}
+}
+
+func TestRun_SourceError(t *testing.T) {
+	srcErr := errors.New("page fetch failed")
+	report, err := bulk.Run(context.Background(),
+		items([]string{"a"}, srcErr),
+		func(context.Context, string) error { return nil },
+		bulk.Options{})
+	if !errors.Is(err, srcErr) {
+		t.Fatalf("Run err = %v, want %v", err, srcErr)
+	}
+	if want := []string{"a"}; !slices.Equal(report.Succeeded, want) {
+		t.Errorf("Succeeded = %v, want %v", report.Succeeded, want)
+	}
+}
+
+func TestRun_BoundsConcurrency(t *testing.T) {
+	const limit = 3
+	var (
+		mu            sync.Mutex
+		running, peak int
+		calls         atomic.Int32
+	)
+	action := func(context.Context, string) error {
+		calls.Add(1)
+		mu.Lock()
+		running++
+		peak = max(peak, running)
+		mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return nil
+	}
+
+	ids := make([]string, 20)
+	for i := range ids {
+		ids[i] = string(rune('a' + i))
+	}
+	if _, err := bulk.Run(context.Background(), items(ids, nil), action, bulk.Options{Concurrency: limit}); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if calls.Load() != int32(len(ids)) {
+		t.Errorf("calls = %d, want %d", calls.Load(), len(ids))
+	}
+	if peak > limit {
+		t.Errorf("peak concurrency = %d, want <= %d", peak, limit)
+	}
+}
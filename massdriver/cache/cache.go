@@ -0,0 +1,492 @@
+// Package cache is an opt-in read-through cache for the platform SDK's
+// read operations. Enable it with [massdriver.WithCache]:
+//
+//	c, err := massdriver.NewClient(
+//	    massdriver.WithCache(cache.Options{TTL: 5 * time.Minute, MaxEntries: 5000}),
+//	)
+//	defer c.Close()
+//
+// Only the read-mostly lookups are cached: Get and ListPage (and each
+// page an Iter fetches) of projects, environments and bundles, keyed by
+// the GraphQL operation and its variables. Everything else — deployments,
+// instances, logs, secrets — always goes to the server, so status polls
+// never see a stale answer. Mutations are never cached.
+//
+// A read that must not come from the cache even though its operation is
+// cacheable can say so with [Bypass]; the fresh answer still refills the
+// cache.
+//
+// # Invalidation
+//
+// Entries leave the cache in four ways:
+//
+//   - An event arrives ([Cache.Invalidate]). With PAT credentials the
+//     client subscribes to the organization event stream automatically
+//     (projects, bundles, OCI repositories), and to the event stream of
+//     every project and environment it holds a cached Get for
+//     ([Cache.Follow]), so EnvironmentEvent, InstanceEvent and the rest
+//     evict too. Other streams can be fed in through [Cache.Watch].
+//   - A mutation is sent through the same client (read-your-writes).
+//     A read that was in flight when the mutation's invalidation ran is
+//     not cached, so it can't put the pre-mutation answer back.
+//   - The entry's TTL lapses — the only mechanism when no stream covers
+//     it, e.g. with basic-auth credentials.
+//   - The cache is full and the entry is the least recently used.
+//
+// An event or mutation about an ID evicts every entry whose variables
+// mention that ID or a slug ancestor/descendant of it (Massdriver IDs
+// nest as project-environment-component, so an update to instance
+// "ecomm-prod-db" evicts a cached Get of environment "ecomm-prod"), plus
+// every cached list, whose membership may have changed. Environment
+// default events count as being about the default's environment, which
+// is where defaults are read.
+package cache
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// Defaults applied to a zero [Options].
+const (
+	DefaultTTL        = time.Minute
+	DefaultMaxEntries = 1000
+	DefaultMaxStreams = 20
+)
+
+// Options configures a [Cache].
+type Options struct {
+	// TTL bounds how long an entry is served without revalidation. Zero
+	// selects [DefaultTTL].
+	TTL time.Duration
+	// MaxEntries caps the number of cached responses; the least recently
+	// used entry is evicted beyond it. Zero selects [DefaultMaxEntries].
+	MaxEntries int
+	// MaxStreams caps the project and environment subscriptions
+	// [Cache.Follow] keeps open; entries beyond it rely on the TTL. Zero
+	// selects [DefaultMaxStreams].
+	MaxStreams int
+}
+
+// Stats is a point-in-time snapshot of cache effectiveness.
+type Stats struct {
+	// Hits and Misses count cacheable reads served from and past the
+	// cache.
+	Hits   uint64
+	Misses uint64
+	// Invalidations counts entries removed by events and mutations.
+	Invalidations uint64
+	// Evictions counts entries removed to stay within MaxEntries.
+	Evictions uint64
+	// Entries is the number of responses currently cached.
+	Entries int
+}
+
+// Cache is a read-through response cache that sits in front of a
+// GraphQL client. Construct with [New]; safe for concurrent use.
+type Cache struct {
+	ttl        time.Duration
+	maxEntries int
+	maxStreams int
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*list.Element // key → element holding *entry
+	lru     *list.List               // front = most recently used
+	stats   Stats
+	// generation counts invalidations. A miss records it before going to
+	// the server and is only stored if it hasn't moved since.
+	generation uint64
+	// follow opens a scope's stream once [Cache.Follow] is running;
+	// following holds the scopes with a stream open.
+	follow    func(scope)
+	following map[scope]bool
+}
+
+// scope is a project or environment whose event stream can invalidate
+// cached entries.
+type scope struct {
+	kind string // "project" or "environment"
+	id   string
+}
+
+type entry struct {
+	key     string
+	op      string
+	ids     []string
+	body    []byte
+	expires time.Time
+}
+
+// New returns an empty [*Cache].
+func New(opts Options) *Cache {
+	if opts.TTL <= 0 {
+		opts.TTL = DefaultTTL
+	}
+	if opts.MaxEntries <= 0 {
+		opts.MaxEntries = DefaultMaxEntries
+	}
+	if opts.MaxStreams <= 0 {
+		opts.MaxStreams = DefaultMaxStreams
+	}
+	return &Cache{
+		ttl:        opts.TTL,
+		maxEntries: opts.MaxEntries,
+		maxStreams: opts.MaxStreams,
+		now:        time.Now,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+		following:  make(map[scope]bool),
+	}
+}
+
+// Stats returns a snapshot of hit/miss counters and the current size.
+func (c *Cache) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.stats
+	s.Entries = c.lru.Len()
+	return s
+}
+
+// Purge drops every entry. Counters are kept.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]*list.Element)
+	c.lru.Init()
+	c.generation++
+}
+
+// Wrap returns a [graphql.Client] that serves cacheable operations from
+// c and forwards everything else to next. Used by [massdriver.WithCache];
+// call it directly only when assembling a client by hand.
+func (c *Cache) Wrap(next graphql.Client) graphql.Client {
+	return &cachingClient{cache: c, next: next}
+}
+
+// Invalidate evicts the entries ev makes stale — see the package docs
+// for the matching rules.
+func (c *Cache) Invalidate(ev types.Event) {
+	c.invalidate(eventID(ev))
+}
+
+// Watch invalidates from events until the channel closes or ctx ends.
+// It blocks; run it in a goroutine:
+//
+//	events, err := c.Environments.StreamEvents(ctx, "ecomm-prod")
+//	if err == nil {
+//	    go c.Cache.Watch(ctx, events)
+//	}
+func (c *Cache) Watch(ctx context.Context, events <-chan types.Event) {
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			c.Invalidate(ev)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Streams opens the event subscriptions [Cache.Follow] keeps for cached
+// projects and environments — [projects.Service.StreamEvents] and
+// [environments.Service.StreamEvents].
+type Streams struct {
+	Project     func(ctx context.Context, id string) (<-chan types.Event, error)
+	Environment func(ctx context.Context, id string) (<-chan types.Event, error)
+}
+
+// Follow subscribes to the event stream of each project and environment
+// the cache stores a Get for, from now until ctx ends, and invalidates
+// from it. [massdriver.WithCache] starts it for PAT credentials; it
+// returns immediately.
+//
+// At most Options.MaxStreams streams are open at once. When one closes
+// early, entries related to its scope are evicted — events may have
+// been missed — and the next Get of that scope subscribes again. A
+// stream that fails to open leaves its entries to the TTL.
+func (c *Cache) Follow(ctx context.Context, open Streams) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.follow = func(sc scope) {
+		fn := open.Project
+		if sc.kind == "environment" {
+			fn = open.Environment
+		}
+		go func() {
+			dropped := false
+			if fn != nil {
+				if events, err := fn(ctx, sc.id); err == nil {
+					c.Watch(ctx, events)
+					dropped = ctx.Err() == nil
+				}
+			}
+			c.mu.Lock()
+			delete(c.following, sc)
+			c.mu.Unlock()
+			// A stream that dropped may have missed events; one that never
+			// opened covered nothing, and the TTL applies.
+			if dropped {
+				c.invalidate(sc.id)
+			}
+		}()
+	}
+}
+
+// bypassKey marks a context whose reads skip the cache.
+type bypassKey struct{}
+
+// Bypass returns a context whose reads go to the server even when their
+// operation is cacheable. The fresh answer still refills the cache. Use
+// it for reads that decide something — a poll for a status change, a
+// re-read before a write:
+//
+//	env, err := c.Environments.Get(cache.Bypass(ctx), "ecomm-prod")
+func Bypass(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassKey{}, true)
+}
+
+func bypassed(ctx context.Context) bool {
+	b, _ := ctx.Value(bypassKey{}).(bool)
+	return b
+}
+
+// invalidate evicts every list entry and every entry related to id.
+// Empty id evicts lists only.
+func (c *Cache) invalidate(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	for e := c.lru.Front(); e != nil; {
+		next := e.Next()
+		ent := e.Value.(*entry)
+		if isList(ent.op) || related(ent.ids, id) {
+			c.remove(e)
+			c.stats.Invalidations++
+		}
+		e = next
+	}
+}
+
+// get returns the cached body for key, or, on a miss, the current
+// generation to hand back to put. bypass forces a miss.
+func (c *Cache) get(key string, bypass bool) ([]byte, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok && !bypass {
+		ent := e.Value.(*entry)
+		if !c.now().After(ent.expires) {
+			c.lru.MoveToFront(e)
+			c.stats.Hits++
+			return ent.body, 0, true
+		}
+		c.remove(e)
+	}
+	c.stats.Misses++
+	return nil, c.generation, false
+}
+
+// put stores ent unless an invalidation has run since generation was
+// read — the response may predate it.
+func (c *Cache) put(ent *entry, generation uint64) {
+	ent.expires = c.now().Add(c.ttl)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	if sc, ok := scopeOf(ent); ok && c.follow != nil && !c.following[sc] && len(c.following) < c.maxStreams {
+		c.following[sc] = true
+		c.follow(sc)
+	}
+	if e, ok := c.entries[ent.key]; ok {
+		e.Value = ent
+		c.lru.MoveToFront(e)
+		return
+	}
+	c.entries[ent.key] = c.lru.PushFront(ent)
+	for c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+		c.stats.Evictions++
+	}
+}
+
+// remove drops e; caller holds c.mu.
+func (c *Cache) remove(e *list.Element) {
+	delete(c.entries, e.Value.(*entry).key)
+	c.lru.Remove(e)
+}
+
+// cachingClient is the [graphql.Client] returned by [Cache.Wrap].
+type cachingClient struct {
+	cache *Cache
+	next  graphql.Client
+}
+
+func (cc *cachingClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	if !cacheable(req.OpName) {
+		err := cc.next.MakeRequest(ctx, req, resp)
+		if isMutation(req.Query) {
+			ids := variableIDs(req.Variables)
+			if len(ids) == 0 {
+				cc.cache.invalidate("")
+			}
+			for _, id := range ids {
+				cc.cache.invalidate(id)
+			}
+		}
+		return err
+	}
+
+	vars, err := json.Marshal(req.Variables)
+	if err != nil {
+		return cc.next.MakeRequest(ctx, req, resp)
+	}
+	key := req.OpName + "\x00" + string(vars)
+	body, generation, ok := cc.cache.get(key, bypassed(ctx))
+	if ok {
+		if json.Unmarshal(body, resp.Data) == nil {
+			return nil
+		}
+	}
+
+	if err := cc.next.MakeRequest(ctx, req, resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		return nil
+	}
+	// A response that won't marshal just isn't cached.
+	if body, merr := json.Marshal(resp.Data); merr == nil {
+		cc.cache.put(&entry{key: key, op: req.OpName, ids: variableIDs(req.Variables), body: body}, generation)
+	}
+	return nil
+}
+
+// cacheableOps are the reads the cache serves: lookups of records that
+// change rarely and are read often.
+var cacheableOps = map[string]bool{
+	"GetProject":       true,
+	"ListProjects":     true,
+	"GetEnvironment":   true,
+	"ListEnvironments": true,
+	"GetBundle":        true,
+	"ListBundles":      true,
+}
+
+func cacheable(op string) bool { return cacheableOps[op] }
+
+// scopeOf returns the project or environment whose stream covers a
+// cached Get.
+func scopeOf(ent *entry) (scope, bool) {
+	if len(ent.ids) != 1 {
+		return scope{}, false
+	}
+	switch ent.op {
+	case "GetProject":
+		return scope{kind: "project", id: ent.ids[0]}, true
+	case "GetEnvironment":
+		return scope{kind: "environment", id: ent.ids[0]}, true
+	}
+	return scope{}, false
+}
+
+func isList(op string) bool { return strings.HasPrefix(op, "List") }
+
+func isMutation(query string) bool {
+	return strings.HasPrefix(strings.TrimSpace(query), "mutation")
+}
+
+// variableIDs returns every string value in vars — the IDs, slugs and
+// names an entry can be invalidated by. organizationId is skipped: every
+// operation carries it, so it would relate everything to everything.
+func variableIDs(vars any) []string {
+	body, err := json.Marshal(vars)
+	if err != nil {
+		return nil
+	}
+	var v any
+	if json.Unmarshal(body, &v) != nil {
+		return nil
+	}
+	var ids []string
+	var walk func(any)
+	walk = func(v any) {
+		switch t := v.(type) {
+		case string:
+			if t != "" {
+				ids = append(ids, t)
+			}
+		case map[string]any:
+			for k, child := range t {
+				if k != "organizationId" {
+					walk(child)
+				}
+			}
+		case []any:
+			for _, child := range t {
+				walk(child)
+			}
+		}
+	}
+	walk(v)
+	return ids
+}
+
+// related reports whether any of ids is id or a slug ancestor or
+// descendant of it.
+func related(ids []string, id string) bool {
+	if id == "" {
+		return false
+	}
+	for _, v := range ids {
+		if v == id || strings.HasPrefix(id, v+"-") || strings.HasPrefix(v, id+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+// eventID returns the ID of the resource ev is about.
+func eventID(ev types.Event) string {
+	switch e := ev.(type) {
+	case *types.ProjectEvent:
+		return e.Project.ID
+	case *types.EnvironmentEvent:
+		return e.Environment.ID
+	case *types.EnvironmentDefaultEvent:
+		// A default is read through its environment, so evict that.
+		if env := e.EnvironmentDefault.Environment; env != nil {
+			return env.ID
+		}
+		return e.EnvironmentDefault.ID
+	case *types.InstanceEvent:
+		return e.Instance.ID
+	case *types.ComponentEvent:
+		return e.Component.ID
+	case *types.LinkEvent:
+		return e.Link.ID
+	case *types.ConnectionEvent:
+		return e.Connection.ID
+	case *types.AlarmEvent:
+		return e.Alarm.ID
+	case *types.OciRepoEvent:
+		return e.OciRepo.ID
+	case *types.BundleEvent:
+		return e.Bundle.ID
+	case *types.DeploymentEvent:
+		return e.Deployment.ID
+	}
+	return ""
+}
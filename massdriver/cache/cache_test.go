@@ -0,0 +1,269 @@
+package cache_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/cache"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/projects"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+func project(id, name string) gqltest.Response {
+	return gqltest.RespondWithData(map[string]any{
+		"project": map[string]any{
+			"id":   id,
+			"name": name,
+			"environments": map[string]any{
+				"items": []map[string]any{{"id": id + "-prod", "name": "Production"}},
+			},
+		},
+	})
+}
+
+func newClient(t *testing.T, mock *gqltest.Client) *massdriver.Client {
+	t.Helper()
+	return gqltest.NewSDKClient(t, mock,
+		massdriver.WithOrganizationID("test-org"),
+		massdriver.WithCache(cache.Options{TTL: time.Hour}),
+	)
+}
+
+func TestCache_ServesRepeatGets(t *testing.T) {
+	mock := gqltest.NewClient(project("ecomm", "E-Commerce"))
+	c := newClient(t, mock)
+
+	for range 3 {
+		p, err := c.Projects.Get(t.Context(), "ecomm")
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		// The cached copy decodes to the same shape, nested lists included.
+		if p.Name != "E-Commerce" || len(p.Environments) != 1 {
+			t.Fatalf("Get = %+v, want E-Commerce with one environment", p)
+		}
+	}
+	if n := len(mock.Requests()); n != 1 {
+		t.Errorf("requests = %d, want 1", n)
+	}
+	if s := c.Cache.Stats(); s.Hits != 2 || s.Misses != 1 || s.Entries != 1 {
+		t.Errorf("Stats = %+v, want 2 hits, 1 miss, 1 entry", s)
+	}
+}
+
+// TestCache_EventInvalidates confirms an event evicts entries for the
+// same ID and its slug descendants but leaves unrelated entries alone.
+func TestCache_EventInvalidates(t *testing.T) {
+	mock := gqltest.NewClient(
+		project("ecomm", "E-Commerce"),
+		project("billing", "Billing"),
+		project("ecomm", "Renamed"),
+	)
+	c := newClient(t, mock)
+	for _, id := range []string{"ecomm", "billing"} {
+		if _, err := c.Projects.Get(t.Context(), id); err != nil {
+			t.Fatalf("Get %s: %v", id, err)
+		}
+	}
+
+	c.Cache.Invalidate(&types.EnvironmentEvent{
+		EventCommon: types.EventCommon{Action: types.EventUpdated},
+		Environment: types.Environment{ID: "ecomm-prod"},
+	})
+
+	p, err := c.Projects.Get(t.Context(), "ecomm")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if p.Name != "Renamed" {
+		t.Errorf("Name = %q, want the refetched Renamed", p.Name)
+	}
+	if _, err := c.Projects.Get(t.Context(), "billing"); err != nil {
+		t.Fatalf("Get billing: %v", err)
+	}
+	if n := len(mock.Requests()); n != 3 {
+		t.Errorf("requests = %d, want 3 (billing still cached)", n)
+	}
+}
+
+// TestCache_MutationInvalidates confirms a write through the client is
+// visible to the next read.
+func TestCache_MutationInvalidates(t *testing.T) {
+	mock := gqltest.NewClient(
+		project("ecomm", "E-Commerce"),
+		gqltest.RespondWithData(map[string]any{
+			"updateProject": map[string]any{
+				"result":     map[string]any{"id": "ecomm", "name": "Renamed"},
+				"successful": true,
+			},
+		}),
+		project("ecomm", "Renamed"),
+	)
+	c := newClient(t, mock)
+	if _, err := c.Projects.Get(t.Context(), "ecomm"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if _, err := c.Projects.Update(t.Context(), "ecomm", projects.UpdateInput{Name: "Renamed"}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	p, err := c.Projects.Get(t.Context(), "ecomm")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if p.Name != "Renamed" {
+		t.Errorf("Name = %q, want Renamed", p.Name)
+	}
+	if s := c.Cache.Stats(); s.Invalidations != 1 {
+		t.Errorf("Invalidations = %d, want 1", s.Invalidations)
+	}
+}
+
+// TestCache_PollsReachServer confirms reads outside the read-mostly set
+// are never cached, and a bypassed read refetches and refreshes.
+func TestCache_PollsReachServer(t *testing.T) {
+	deployment := gqltest.RespondWithData(map[string]any{
+		"deployment": map[string]any{"id": "dep-1", "status": "RUNNING"},
+	})
+	mock := gqltest.NewClient(
+		deployment,
+		deployment,
+		project("ecomm", "E-Commerce"),
+		project("ecomm", "Renamed"),
+	)
+	c := newClient(t, mock)
+
+	for range 2 {
+		if _, err := c.Deployments.Get(t.Context(), "dep-1"); err != nil {
+			t.Fatalf("Deployments.Get: %v", err)
+		}
+	}
+	if _, err := c.Projects.Get(t.Context(), "ecomm"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if _, err := c.Projects.Get(cache.Bypass(t.Context()), "ecomm"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	p, err := c.Projects.Get(t.Context(), "ecomm")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if p.Name != "Renamed" {
+		t.Errorf("Name = %q, want the bypassed read's Renamed", p.Name)
+	}
+	if n := len(mock.Requests()); n != 4 {
+		t.Errorf("requests = %d, want 4 (both deployment reads and the bypass)", n)
+	}
+}
+
+// fakeGQL answers every request with data after calling during.
+type fakeGQL struct {
+	data   map[string]any
+	during func()
+}
+
+func (f *fakeGQL) MakeRequest(_ context.Context, _ *graphql.Request, resp *graphql.Response) error {
+	if f.during != nil {
+		f.during()
+	}
+	b, _ := json.Marshal(f.data)
+	return json.Unmarshal(b, resp.Data)
+}
+
+func getEnvironment(t *testing.T, gql graphql.Client, id string) {
+	t.Helper()
+	var data map[string]any
+	req := &graphql.Request{OpName: "GetEnvironment", Query: "query GetEnvironment", Variables: map[string]any{"organizationId": "test-org", "id": id}}
+	if err := gql.MakeRequest(t.Context(), req, &graphql.Response{Data: &data}); err != nil {
+		t.Fatalf("GetEnvironment: %v", err)
+	}
+}
+
+// TestCache_InvalidationDuringMiss confirms a response fetched across an
+// invalidation isn't stored: it may predate the change.
+func TestCache_InvalidationDuringMiss(t *testing.T) {
+	ch := cache.New(cache.Options{TTL: time.Hour})
+	fake := &fakeGQL{data: map[string]any{"environment": map[string]any{"id": "ecomm-prod"}}}
+	fake.during = func() {
+		ch.Invalidate(&types.EnvironmentEvent{Environment: types.Environment{ID: "ecomm-prod"}})
+	}
+	gql := ch.Wrap(fake)
+
+	getEnvironment(t, gql, "ecomm-prod")
+	if n := ch.Stats().Entries; n != 0 {
+		t.Errorf("Entries = %d, want the raced response dropped", n)
+	}
+	fake.during = nil
+	getEnvironment(t, gql, "ecomm-prod")
+	if n := ch.Stats().Entries; n != 1 {
+		t.Errorf("Entries = %d, want the next read cached", n)
+	}
+}
+
+// TestCache_Follow confirms a cached environment Get subscribes to that
+// environment's stream, and an InstanceEvent in it evicts the entry.
+func TestCache_Follow(t *testing.T) {
+	ch := cache.New(cache.Options{TTL: time.Hour})
+	events := make(chan types.Event)
+	opened := make(chan string, 1)
+	ch.Follow(t.Context(), cache.Streams{
+		Environment: func(_ context.Context, id string) (<-chan types.Event, error) {
+			opened <- id
+			return events, nil
+		},
+	})
+	gql := ch.Wrap(&fakeGQL{data: map[string]any{"environment": map[string]any{"id": "ecomm-prod"}}})
+
+	getEnvironment(t, gql, "ecomm-prod")
+	getEnvironment(t, gql, "ecomm-prod") // cached; no second subscription
+	if id := <-opened; id != "ecomm-prod" {
+		t.Fatalf("subscribed to %q, want ecomm-prod", id)
+	}
+	events <- &types.InstanceEvent{Instance: types.Instance{ID: "ecomm-prod-db"}}
+	// The send returns once Watch has the event; the unbuffered channel's
+	// next send can't complete until Invalidate has run.
+	events <- &types.InstanceEvent{Instance: types.Instance{ID: "billing-prod-db"}}
+	if s := ch.Stats(); s.Entries != 0 || s.Invalidations != 1 {
+		t.Errorf("Stats = %+v, want the entry evicted once", s)
+	}
+	select {
+	case id := <-opened:
+		t.Errorf("opened a second stream for %s", id)
+	default:
+	}
+}
+
+// TestCache_DefaultEventEvictsEnvironment confirms a new default evicts
+// its environment, whose cached copy doesn't yet mention the default.
+func TestCache_DefaultEventEvictsEnvironment(t *testing.T) {
+	env := func(defaults ...map[string]any) gqltest.Response {
+		return gqltest.RespondWithData(map[string]any{"environment": map[string]any{
+			"id": "ecomm-prod", "name": "Production", "defaults": map[string]any{"items": defaults},
+		}})
+	}
+	mock := gqltest.NewClient(
+		env(),
+		env(map[string]any{"id": "def-9", "resource": map[string]any{"id": "ecomm-prod-network-vpc"}}),
+	)
+	c := newClient(t, mock)
+	if _, err := c.Environments.Get(t.Context(), "ecomm-prod"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	c.Cache.Invalidate(&types.EnvironmentDefaultEvent{
+		EventCommon:        types.EventCommon{Action: types.EventCreated},
+		EnvironmentDefault: types.EnvironmentDefault{ID: "def-9", Environment: &types.Environment{ID: "ecomm-prod"}},
+	})
+
+	got, err := c.Environments.Get(t.Context(), "ecomm-prod")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if len(got.Defaults) != 1 || got.Defaults[0].ID != "def-9" {
+		t.Errorf("Defaults = %+v, want the refetched def-9", got.Defaults)
+	}
+}
@@ -1,6 +1,11 @@
 package massdriver
 
 import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/cache"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/config"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/client"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/accesstokens"
@@ -20,8 +25,13 @@ import (
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/serviceaccounts"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/urls"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/viewer"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/streaming"
 )
 
+// cacheReconnectDelay spaces out attempts to re-open the organization
+// event stream that keeps a [WithCache] cache fresh.
+const cacheReconnectDelay = 30 * time.Second
+
 // Client is the top-level SDK client. Each domain service is a field;
 // access is just `c.<Service>.<Method>(ctx, ...)`. Construct with
 // [NewClient]. Read the resolved configuration via [Client.Config].
@@ -33,6 +43,14 @@ type Client struct {
 	// transport client at construction time).
 	config config.Config
 
+	// stop cancels background work started by options (the cache's
+	// event subscription). Nil when there is none.
+	stop context.CancelFunc
+
+	// Cache is the read-through response cache installed by
+	// [WithCache]; nil without it.
+	Cache *cache.Cache
+
 	// AccessTokens manages personal access tokens (PATs) for the
 	// authenticated identity.
 	AccessTokens *accesstokens.Service
@@ -83,6 +101,16 @@ type Client struct {
 // subsequent service calls.
 func (c *Client) Config() config.Config { return c.config }
 
+// Close stops background work the client started — today only the
+// event subscription that [WithCache] keeps open. Safe to call more
+// than once and on clients that started none.
+func (c *Client) Close() error {
+	if c.stop != nil {
+		c.stop()
+	}
+	return nil
+}
+
 // NewClient constructs the SDK client.
 //
 // Without options, configuration is resolved from environment variables
@@ -115,13 +143,14 @@ func NewClient(opts ...Option) (*Client, error) {
 	}
 
 	if o.gqlClient != nil {
-		return wrap(&client.Client{
+		return build(&client.Client{
 			Config: config.Config{
 				OrganizationID: o.organizationID,
 				URL:            o.baseURL,
 			},
-			GQLv2: o.gqlClient,
-		}), nil
+			GQLv2:  client.LogGQL(o.gqlClient, o.logger),
+			Logger: o.logger,
+		}, o.cache), nil
 	}
 
 	cfg, err := config.Load(config.Overrides{
@@ -137,27 +166,85 @@ func NewClient(opts ...Option) (*Client, error) {
 	if o.timeoutSet {
 		timeout = o.timeout
 	}
-	return wrap(client.NewWithConfig(cfg, timeout)), nil
+	return build(client.NewWithOptions(cfg, client.Options{
+		Timeout:        timeout,
+		Logger:         o.logger,
+		RateLimit:      o.rateLimit,
+		Burst:          o.burst,
+		MaxConcurrency: o.maxConcurrency,
+	}), o.cache), nil
+}
+
+// build wires the services around c, first putting a read-through cache
+// in front of its GraphQL client when cacheOpts is set. For PAT
+// credentials it also starts the event subscriptions that invalidate
+// the cache: the organization stream, and the streams of the projects
+// and environments the cache holds.
+func build(c *client.Client, cacheOpts *cache.Options) *Client {
+	if cacheOpts == nil {
+		return wrap(c)
+	}
+	ch := cache.New(*cacheOpts)
+	c.GQLv2 = ch.Wrap(c.GQLv2)
+	out := wrap(c)
+	out.Cache = ch
+
+	if c.Config.Credentials.Method == config.AuthPAT {
+		ctx, cancel := context.WithCancel(context.Background())
+		out.stop = cancel
+		go out.watchOrganization(ctx)
+		ch.Follow(ctx, cache.Streams{
+			Project:     out.Projects.StreamEvents,
+			Environment: out.Environments.StreamEvents,
+		})
+	}
+	return out
+}
+
+// watchOrganization feeds organization events into c.Cache until ctx
+// ends, reconnecting after failures. Events missed while disconnected
+// can't be replayed, so the cache is purged on every reconnect.
+func (c *Client) watchOrganization(ctx context.Context) {
+	for first := true; ; first = false {
+		events, err := c.Organizations.StreamEvents(ctx)
+		if errors.Is(err, streaming.ErrRequiresPAT) {
+			return
+		}
+		if err == nil {
+			if !first {
+				c.Cache.Purge()
+			}
+			c.Cache.Watch(ctx, events)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(cacheReconnectDelay):
+		}
+	}
 }
 
 // wrap returns a [*Client] with every domain service pre-wired around
 // the supplied transport client, and Config populated from it.
 // Internal — used by [NewClient] and by internal tests.
 func wrap(c *client.Client) *Client {
+	bun := bundles.New(c)
+	inst := instances.New(c)
+	comps := components.New(c, bun, inst)
 	return &Client{
 		config:          c.Config,
 		AccessTokens:    accesstokens.New(c),
 		AuditLogs:       auditlogs.New(c),
-		Bundles:         bundles.New(c),
-		Components:      components.New(c),
+		Bundles:         bun,
+		Components:      comps,
 		Deployments:     deployments.New(c),
 		Environments:    environments.New(c),
 		Groups:          groups.New(c),
-		Instances:       instances.New(c),
+		Instances:       inst,
 		OciRepos:        ocirepos.New(c),
 		Organizations:   organizations.New(c),
 		Policies:        policies.New(c),
-		Projects:        projects.New(c),
+		Projects:        projects.New(c, comps),
 		Resources:       resources.New(c),
 		Server:          server.New(c),
 		ServiceAccounts: serviceaccounts.New(c),
@@ -1,8 +1,15 @@
 package massdriver_test
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -194,3 +201,138 @@ func TestNewClient_NoCredentialsErrors(t *testing.T) {
 		t.Error("errors.Is should not match a fresh unrelated error")
 	}
 }
+
+// TestNewClient_WithLoggerRedacts confirms GraphQL operations are logged
+// by name and that neither the API key nor a secret value reaches the
+// log output.
+func TestNewClient_WithLoggerRedacts(t *testing.T) {
+	isolateEnv(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"setInstanceSecret":{"successful":true,"result":{"name":"DB_PASSWORD"}}}}`))
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	c, err := massdriver.NewClient(
+		massdriver.WithAPIKey("mds_do-not-log-me"),
+		massdriver.WithOrganizationID("ecomm"),
+		massdriver.WithBaseURL(srv.URL),
+		massdriver.WithLogger(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if _, err := c.Instances.SetSecret(t.Context(), "ecomm-prod-db", "DB_PASSWORD", "hunter2"); err != nil {
+		t.Fatalf("SetSecret: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, `"operation":"SetInstanceSecret"`) {
+		t.Errorf("log is missing the operation name:\n%s", out)
+	}
+	for _, leaked := range []string{"hunter2", "do-not-log-me"} {
+		if strings.Contains(out, leaked) {
+			t.Errorf("log leaked %q:\n%s", leaked, out)
+		}
+	}
+	if !strings.Contains(out, "DB_PASSWORD") {
+		t.Errorf("secret name should still be logged:\n%s", out)
+	}
+}
+
+// serverHandler answers GetServer so tests can drive traffic through
+// the real HTTP transport.
+func serverHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"data":{"server":{"appUrl":"https://app.example.com","mode":"self_hosted","version":"1.0.0"}}}`))
+}
+
+// TestNewClient_WithMaxConcurrency confirms no more than n requests are
+// in flight at once, however many goroutines share the client.
+func TestNewClient_WithMaxConcurrency(t *testing.T) {
+	isolateEnv(t)
+	var inFlight, peak atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		serverHandler(w, r)
+	}))
+	defer srv.Close()
+
+	c, err := massdriver.NewClient(
+		massdriver.WithAPIKey("mds_test"),
+		massdriver.WithOrganizationID("ecomm"),
+		massdriver.WithBaseURL(srv.URL),
+		massdriver.WithMaxConcurrency(2),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Server.Get(t.Context()); err != nil {
+				t.Errorf("Server.Get: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := peak.Load(); got > 2 {
+		t.Errorf("peak in-flight = %d, want <= 2", got)
+	}
+}
+
+// TestNewClient_WithRateLimitRespectsContext confirms a request waiting
+// on the limiter returns as soon as its context ends, and that a 429
+// pauses subsequent requests for the Retry-After interval.
+func TestNewClient_WithRateLimitRespectsContext(t *testing.T) {
+	isolateEnv(t)
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		serverHandler(w, r)
+	}))
+	defer srv.Close()
+
+	c, err := massdriver.NewClient(
+		massdriver.WithAPIKey("mds_test"),
+		massdriver.WithOrganizationID("ecomm"),
+		massdriver.WithBaseURL(srv.URL),
+		massdriver.WithRateLimit(100, 1),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if _, err := c.Server.Get(t.Context()); err == nil {
+		t.Fatal("first call: want the 429 surfaced as an error")
+	}
+
+	// The server asked for 30s of quiet; a short deadline must win.
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = c.Server.Get(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("waited %s; want return at the context deadline", elapsed)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("server saw %d calls, want 1 (second held by the pause)", n)
+	}
+}
@@ -12,7 +12,10 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-const defaultURL = "https://api.massdriver.cloud"
+// DefaultURL is the Massdriver API base URL used when neither
+// MASSDRIVER_URL nor the active profile supplies one.
+const DefaultURL = "https://api.massdriver.cloud"
+
 const configPathFromConfigDir = "massdriver/config.yaml"
 
 type configFileProfile struct {
@@ -125,7 +128,7 @@ func initializeConfig(o Overrides) (Config, error) {
 	}
 
 	cfg.OrganizationID = cmp.Or(configEnvs.OrganizationID, configEnvs.OrgId, profile.OrganizationID)
-	cfg.URL = cmp.Or(configEnvs.URL, profile.URL, defaultURL)
+	cfg.URL = cmp.Or(configEnvs.URL, profile.URL, DefaultURL)
 	cfg.TemplatesPath = cmp.Or(configEnvs.TemplatesPath, profile.TemplatesPath)
 
 	credentials, credErr := resolveCredentials(configEnvs, &profile, apiKeyOrigin)
@@ -137,18 +140,25 @@ func initializeConfig(o Overrides) (Config, error) {
 	return cfg, nil
 }
 
-func getConfigFile() (*configFile, error) {
-	var configFilePath string
-
+// FilePath returns the location of the config file: under
+// $XDG_CONFIG_HOME when set, otherwise ~/.config. The file need not
+// exist.
+func FilePath() (string, error) {
 	xdgConfigHome := os.Getenv("XDG_CONFIG_HOME")
 	if xdgConfigHome != "" {
-		configFilePath = filepath.Join(xdgConfigHome, configPathFromConfigDir)
-	} else {
-		homeDir, homeDirErr := os.UserHomeDir()
-		if homeDirErr != nil {
-			return nil, fmt.Errorf("could not determine home directory: %w", homeDirErr)
-		}
-		configFilePath = filepath.Join(homeDir, ".config", configPathFromConfigDir)
+		return filepath.Join(xdgConfigHome, configPathFromConfigDir), nil
+	}
+	homeDir, homeDirErr := os.UserHomeDir()
+	if homeDirErr != nil {
+		return "", fmt.Errorf("could not determine home directory: %w", homeDirErr)
+	}
+	return filepath.Join(homeDir, ".config", configPathFromConfigDir), nil
+}
+
+func getConfigFile() (*configFile, error) {
+	configFilePath, pathErr := FilePath()
+	if pathErr != nil {
+		return nil, pathErr
 	}
 
 	file, readErr := os.ReadFile(configFilePath)
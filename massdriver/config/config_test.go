@@ -366,3 +366,59 @@ func TestLoad_PATSourceTracking(t *testing.T) {
 		require.Equal(t, config.SourceEnv, cfg.Credentials.Source)
 	})
 }
+
+// TestSaveProfile confirms a saved profile round-trips through Load and
+// that everything else in the file — other profiles, comments, keys the
+// SDK doesn't model — survives the rewrite, which also tightens the
+// file's permissions.
+func TestSaveProfile(t *testing.T) {
+	for _, k := range []string{"MASSDRIVER_ORGANIZATION_ID", "MASSDRIVER_ORG_ID", "MASSDRIVER_API_KEY", "MASSDRIVER_URL", "MASSDRIVER_DEPLOYMENT_ID", "MASSDRIVER_TOKEN"} {
+		t.Setenv(k, "")
+	}
+	homeDir := t.TempDir()
+	t.Setenv("HOME", homeDir)
+	t.Setenv("XDG_CONFIG_HOME", "")
+	path := writeTempConfigFileAt(t, homeDir, ".config/massdriver/config.yaml", `
+version: 1
+# Managed by hand; keep this note.
+editor: vim
+profiles:
+  default:
+    organization_id: "keep-org"
+    api_key: "keep-key"
+  work:
+    organization_id: "old-org"
+    api_key: "old-key"
+    templates_path: /tmp/templates
+    color: blue
+`)
+	require.NoError(t, os.Chmod(path, 0o644))
+
+	require.NoError(t, config.SaveProfile("work", config.Profile{
+		OrganizationID: "work-org",
+		APIKey:         "mds_work",
+		URL:            "https://massdriver.internal.example.com",
+	}))
+
+	cfg, err := config.Load(config.Overrides{Profile: "work"})
+	require.NoError(t, err)
+	require.Equal(t, "work-org", cfg.OrganizationID)
+	require.Equal(t, "https://massdriver.internal.example.com", cfg.URL)
+	require.Equal(t, config.AuthPAT, cfg.Credentials.Method)
+	require.Equal(t, config.SourceProfile, cfg.Credentials.Source)
+
+	cfg, err = config.Load(config.Overrides{})
+	require.NoError(t, err)
+	require.Equal(t, "keep-org", cfg.OrganizationID, "existing profile must be preserved")
+
+	body, err := os.ReadFile(path)
+	require.NoError(t, err)
+	for _, want := range []string{"# Managed by hand; keep this note.", "editor: vim", "color: blue"} {
+		require.Contains(t, string(body), want)
+	}
+	require.NotContains(t, string(body), "templates_path", "an empty field removes its key")
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
@@ -0,0 +1,187 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Profile is one named entry in ~/.config/massdriver/config.yaml — the
+// values [Load] falls back to when neither options nor environment
+// variables supply them.
+type Profile struct {
+	OrganizationID string
+	APIKey         string
+	URL            string
+	TemplatesPath  string
+}
+
+// SaveProfile writes p to the config file under name, creating the file
+// (and its parent directory) when it doesn't exist yet. The file is
+// shared with the CLI, so it is edited in place: other profiles, keys the
+// SDK doesn't model, and comments are preserved. Within the named profile
+// each field of p replaces its key, and an empty field removes it.
+//
+// The file is replaced atomically — written to a temporary file with 0600
+// permissions, because profiles carry API keys, and renamed over the
+// original. Empty name selects "default".
+func SaveProfile(name string, p Profile) error {
+	if name == "" {
+		name = "default"
+	}
+	path, err := FilePath()
+	if err != nil {
+		return err
+	}
+	// Validates the version of an existing file.
+	if _, err := getConfigFile(); err != nil {
+		return fmt.Errorf("error reading config file: %w", err)
+	}
+
+	var doc yaml.Node
+	body, readErr := os.ReadFile(path)
+	switch {
+	case readErr == nil:
+		if err := yaml.Unmarshal(body, &doc); err != nil {
+			return fmt.Errorf("could not unmarshal config file %s: %w", path, err)
+		}
+	case !os.IsNotExist(readErr):
+		return fmt.Errorf("could not read config file %s: %w", path, readErr)
+	}
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+		setScalar(doc.Content[0], "version", "1", "!!int")
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("config file %s is not a mapping", path)
+	}
+	profile := mappingAt(mappingAt(root, "profiles"), name)
+	for _, f := range []struct{ key, value string }{
+		{"organization_id", p.OrganizationID},
+		{"api_key", p.APIKey},
+		{"url", p.URL},
+		{"templates_path", p.TemplatesPath},
+	} {
+		if f.value == "" {
+			removeKey(profile, f.key)
+		} else {
+			setScalar(profile, f.key, f.value, "!!str")
+		}
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return fmt.Errorf("could not marshal config file: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("could not marshal config file: %w", err)
+	}
+	if mkErr := os.MkdirAll(filepath.Dir(path), 0o700); mkErr != nil {
+		return fmt.Errorf("could not create config directory: %w", mkErr)
+	}
+	if writeErr := writeFileAtomic(path, buf.Bytes()); writeErr != nil {
+		return fmt.Errorf("could not write config file %s: %w", path, writeErr)
+	}
+	return nil
+}
+
+// writeFileAtomic writes body to a 0600 temporary file beside path and
+// renames it over path, so readers never see a partial file and the
+// result has 0600 permissions whatever the original had.
+func writeFileAtomic(path string, body []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(body); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// mappingAt returns the mapping under key in m, creating it (or replacing
+// a null value) when missing.
+func mappingAt(m *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			v := m.Content[i+1]
+			if v.Kind != yaml.MappingNode {
+				*v = yaml.Node{Kind: yaml.MappingNode, HeadComment: v.HeadComment, LineComment: v.LineComment}
+			}
+			return v
+		}
+	}
+	v := &yaml.Node{Kind: yaml.MappingNode}
+	m.Content = append(m.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, v)
+	return v
+}
+
+// setScalar sets key in m to value, keeping the existing node's comments.
+func setScalar(m *yaml.Node, key, value, tag string) {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			v := m.Content[i+1]
+			v.Kind, v.Tag, v.Value, v.Content = yaml.ScalarNode, tag, value, nil
+			return
+		}
+	}
+	m.Content = append(m.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value},
+	)
+}
+
+// removeKey deletes key and its value from m.
+func removeKey(m *yaml.Node, key string) {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			m.Content = append(m.Content[:i], m.Content[i+2:]...)
+			return
+		}
+	}
+}
+
+// ReadProfile returns the named profile from the config file. ok is
+// false when the file or the profile doesn't exist. Empty name selects
+// "default".
+func ReadProfile(name string) (p Profile, ok bool, err error) {
+	if name == "" {
+		name = "default"
+	}
+	cfg, err := getConfigFile()
+	if err != nil {
+		return Profile{}, false, fmt.Errorf("error reading config file: %w", err)
+	}
+	if cfg == nil {
+		return Profile{}, false, nil
+	}
+	fp, ok := cfg.Profiles[name]
+	if !ok {
+		return Profile{}, false, nil
+	}
+	return Profile{
+		OrganizationID: fp.OrganizationID,
+		APIKey:         fp.APIKey,
+		URL:            fp.URL,
+		TemplatesPath:  fp.TemplatesPath,
+	}, true, nil
+}
@@ -0,0 +1,132 @@
+package declarative
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/deployments"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/environments"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/instances"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// ApplyOptions configures [Reconciler.Apply].
+type ApplyOptions struct {
+	// Action is the deployment created for each changed instance. Empty =
+	// PROVISION. With [deployments.ActionPlan] the merged params are only
+	// previewed.
+	Action deployments.Action
+	// Message is recorded on each deployment.
+	Message string
+}
+
+// Apply makes the changes in plan, in order: environment attributes
+// (Environments.Update), defaults (Environments.SetDefault, after
+// RemoveDefault for one being replaced), then per instance its version
+// (Instances.Update) and secrets (Instances.SetSecret), and finally one
+// deployment (Deployments.Create) carrying the merged params. Instances
+// with only no-op changes get no deployment.
+//
+// On error Apply returns the deployments created so far and leaves the
+// changes already made in place. A fresh Plan shows only what's left.
+func (r *Reconciler) Apply(ctx context.Context, plan *Plan, opts ApplyOptions) ([]types.Deployment, error) {
+	if opts.Action == "" {
+		opts.Action = deployments.ActionProvision
+	}
+	env := plan.env
+
+	attrs := maps.Clone(env.Attributes)
+	attrsChanged := false
+	for _, c := range plan.Changes {
+		if c.Target == plan.EnvironmentID && c.Kind == KindAttribute && c.Op != OpNoop {
+			if attrs == nil {
+				attrs = map[string]any{}
+			}
+			attrs[c.Path] = c.After
+			attrsChanged = true
+		}
+	}
+	if attrsChanged {
+		if _, err := r.environments.Update(ctx, env.ID, environments.UpdateInput{
+			Name:        env.Name,
+			Description: env.Description,
+			Attributes:  attrs,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, c := range plan.Changes {
+		if c.Target != plan.EnvironmentID || c.Kind != KindDefault || c.Op == OpNoop {
+			continue
+		}
+		if c.Op == OpUpdate {
+			for _, d := range env.Defaults {
+				if d.Resource.ID == c.Before {
+					if _, err := r.environments.RemoveDefault(ctx, d.ID); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+		resourceID, _ := c.After.(string)
+		if _, err := r.environments.SetDefault(ctx, env.ID, resourceID); err != nil {
+			return nil, err
+		}
+	}
+
+	var deps []types.Deployment
+	target := ""
+	changed := false
+	flush := func() error {
+		if target == "" || !changed {
+			return nil
+		}
+		ip := plan.instances[target]
+		dep, err := r.deployments.Create(ctx, target, deployments.CreateInput{
+			Action:  opts.Action,
+			Params:  ip.params,
+			Message: opts.Message,
+		})
+		if err != nil {
+			return err
+		}
+		deps = append(deps, *dep)
+		return nil
+	}
+	for _, c := range plan.Changes {
+		if c.Target == plan.EnvironmentID {
+			continue
+		}
+		if c.Target != target {
+			if err := flush(); err != nil {
+				return deps, err
+			}
+			target, changed = c.Target, false
+		}
+		if c.Op == OpNoop {
+			continue
+		}
+		changed = true
+		ip := plan.instances[c.Target]
+		switch c.Kind {
+		case KindVersion:
+			if _, err := r.instances.Update(ctx, c.Target, instances.UpdateInput{Version: ip.version}); err != nil {
+				return deps, err
+			}
+		case KindSecret:
+			if _, err := r.instances.SetSecret(ctx, c.Target, c.Path, ip.secrets[c.Path]); err != nil {
+				return deps, err
+			}
+		case KindParam, KindAttribute, KindDefault:
+			// Params ride on the deployment.
+		default:
+			return deps, fmt.Errorf("apply %s: unknown change kind %q", c.Target, c.Kind)
+		}
+	}
+	if err := flush(); err != nil {
+		return deps, err
+	}
+	return deps, nil
+}
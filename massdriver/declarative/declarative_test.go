@@ -0,0 +1,163 @@
+package declarative_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/declarative"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/fingerprint"
+)
+
+const specYAML = `
+environment: ecomm-prod
+attributes:
+  team: platform
+defaults:
+  - net-new
+instances:
+  database:
+    version: "~1.2"
+    params:
+      instance_type: db.r6g.large
+      backup: {retention_days: 7}
+      replicas: null
+    secrets:
+      DB_PASSWORD: env:DECLARATIVE_TEST_DB_PASSWORD
+`
+
+func newReconciler(t *testing.T, gqlClient *gqltest.Client) *declarative.Reconciler {
+	t.Helper()
+	c := gqltest.NewSDKClient(t, gqlClient)
+	return declarative.New(c.Environments, c.Instances, c.Deployments, c.Resources)
+}
+
+// liveState is what the plan reads, in order.
+func liveState() []gqltest.Response {
+	return []gqltest.Response{
+		gqltest.RespondWithData(map[string]any{
+			"environment": map[string]any{
+				"id": "ecomm-prod", "name": "Production", "attributes": map[string]any{"team": "data", "tier": "1"},
+				"defaults": map[string]any{"items": []map[string]any{
+					{"id": "def-1", "resource": map[string]any{"id": "net-old", "resourceType": map[string]any{"id": "network"}}},
+				}},
+			},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"resource": map[string]any{"id": "net-new", "resourceType": map[string]any{"id": "network"}},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"instances": map[string]any{"cursor": map[string]any{}, "items": []map[string]any{
+				{"id": "ecomm-prod-database", "component": map[string]any{"id": "database"}},
+				{"id": "ecomm-prod-app", "component": map[string]any{"id": "app"}},
+			}},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"instance": map[string]any{
+				"id": "ecomm-prod-database", "version": "~1.1",
+				"params": map[string]any{"instance_type": "db.t3.medium", "backup": map[string]any{"retention_days": 7}, "replicas": 2},
+			},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"instance": map[string]any{"id": "ecomm-prod-database", "secretFields": []map[string]any{
+				{"name": "DB_PASSWORD", "required": true, "sha256": fingerprint.Of("old")},
+			}},
+		}),
+	}
+}
+
+func TestPlanAndApply(t *testing.T) {
+	t.Setenv("DECLARATIVE_TEST_DB_PASSWORD", "hunter2")
+	spec, err := declarative.ParseSpec(strings.NewReader(specYAML))
+	if err != nil {
+		t.Fatalf("ParseSpec: %v", err)
+	}
+
+	responses := append(liveState(),
+		gqltest.RespondWithSuccess("updateEnvironment", "x"),
+		gqltest.RespondWithSuccess("removeEnvironmentDefault", "x"),
+		gqltest.RespondWithSuccess("setEnvironmentDefault", "x"),
+		gqltest.RespondWithSuccess("updateInstance", "x"),
+		gqltest.RespondWithData(map[string]any{
+			"setInstanceSecret": map[string]any{"result": map[string]any{"name": "DB_PASSWORD"}, "successful": true},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"createDeployment": map[string]any{"result": map[string]any{"id": "dep-1", "status": "PENDING"}, "successful": true},
+		}),
+	)
+	gqlClient := gqltest.NewClient(responses...)
+	r := newReconciler(t, gqlClient)
+
+	plan, err := r.Plan(t.Context(), spec, declarative.PlanOptions{})
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	want := `environment ecomm-prod
+  ~ attribute team: "data" => "platform"
+  ~ default network: "net-old" => "net-new"
+instance ecomm-prod-database
+  ~ version: "~1.1" => "~1.2"
+  ~ param /instance_type: "db.t3.medium" => "db.r6g.large"
+  - param /replicas: 2
+  ~ secret DB_PASSWORD: (sensitive)
+
+Plan: 0 to create, 5 to update, 1 to delete, 1 unchanged.
+`
+	if got := plan.String(); got != want {
+		t.Errorf("plan =\n%s\nwant\n%s", got, want)
+	}
+	if strings.Contains(plan.String(), "hunter2") {
+		t.Error("plan leaks a secret value")
+	}
+
+	deps, err := r.Apply(t.Context(), plan, declarative.ApplyOptions{Message: "sync"})
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if len(deps) != 1 || deps[0].ID != "dep-1" {
+		t.Errorf("deployments = %+v, want dep-1", deps)
+	}
+
+	reqs := gqlClient.Requests()
+	envInput, _ := reqs[5].Variables["input"].(map[string]any)
+	if envInput["name"] != "Production" {
+		t.Errorf("updateEnvironment input = %v, want the name preserved", envInput)
+	}
+	if reqs[6].Variables["id"] != "def-1" || reqs[7].Variables["resourceId"] != "net-new" {
+		t.Errorf("default swap = remove %v, set %v", reqs[6].Variables["id"], reqs[7].Variables["resourceId"])
+	}
+	secret, _ := reqs[9].Variables["input"].(map[string]any)
+	if secret["value"] != "hunter2" {
+		t.Errorf("setInstanceSecret sent the wrong value for %v", secret["name"])
+	}
+	create, _ := reqs[10].Variables["input"].(map[string]any)
+	if create["action"] != "PROVISION" || create["message"] != "sync" {
+		t.Errorf("createDeployment input = %v", create)
+	}
+	if gqlClient.Pending() != 0 {
+		t.Errorf("%d responses unused", gqlClient.Pending())
+	}
+}
+
+func TestPlan_UnknownComponent(t *testing.T) {
+	spec := &declarative.Spec{Environment: "ecomm-prod", Instances: map[string]declarative.InstanceSpec{"cache": {Version: "~2"}}}
+	gqlClient := gqltest.NewClient(
+		gqltest.RespondWithData(map[string]any{"environment": map[string]any{"id": "ecomm-prod"}}),
+		gqltest.RespondWithData(map[string]any{"instances": map[string]any{"cursor": map[string]any{}, "items": []map[string]any{}}}),
+	)
+	_, err := newReconciler(t, gqlClient).Plan(t.Context(), spec, declarative.PlanOptions{})
+	if err == nil || !strings.Contains(err.Error(), `"cache"`) {
+		t.Errorf("err = %v, want an unknown-component error", err)
+	}
+}
+
+func TestParseSpec_RejectsUnknownKeys(t *testing.T) {
+	_, err := declarative.ParseSpec(strings.NewReader("environment: ecomm-prod\ninstance: {}\n"))
+	if err == nil {
+		t.Error("ParseSpec accepted a misspelled key")
+	}
+	spec, err := declarative.ParseSpec(strings.NewReader(`{"environment":"ecomm-prod","attributes":{"team":"data"}}`))
+	if err != nil || spec.Attributes["team"] != "data" {
+		t.Errorf("JSON spec = %+v, %v", spec, err)
+	}
+}
@@ -0,0 +1,432 @@
+// Package declarative reconciles an environment against a checked-in
+// [Spec] — GitOps for Massdriver. [Reconciler.Plan] diffs the spec against
+// live state field by field; [Reconciler.Apply] makes the changes.
+//
+//	spec, err := declarative.LoadSpec("envs/ecomm-prod.yaml")
+//	if err != nil {
+//	    return err
+//	}
+//	r := declarative.New(c.Environments, c.Instances, c.Deployments, c.Resources)
+//	plan, err := r.Plan(ctx, spec, declarative.PlanOptions{})
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Print(plan)
+//	if !plan.HasChanges() {
+//	    return nil
+//	}
+//	_, err = r.Apply(ctx, plan, declarative.ApplyOptions{Message: "sync from git"})
+//	return err
+//
+// Plans are deterministic: the same spec against the same live state
+// always renders the same text, so a plan can be posted to a pull request
+// and compared later. Secret values are resolved at plan time but never
+// rendered; only whether each would be created or changed.
+package declarative
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/fingerprint"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/jsonutil"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/deployments"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/environments"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/instances"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/resources"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// Op is what applying a [Change] does.
+type Op string
+
+const (
+	OpCreate Op = "create"
+	OpUpdate Op = "update"
+	OpDelete Op = "delete"
+	OpNoop   Op = "no-op"
+)
+
+// Kind is the sort of field a [Change] touches.
+type Kind string
+
+const (
+	KindAttribute Kind = "attribute"
+	KindDefault   Kind = "default"
+	KindVersion   Kind = "version"
+	KindParam     Kind = "param"
+	KindSecret    Kind = "secret"
+)
+
+// Change is one field of a [Plan].
+type Change struct {
+	// Target is the environment ID for attributes and defaults, else the
+	// instance ID.
+	Target string
+	Kind   Kind
+	// Path identifies the field: the attribute key, the resource type ID
+	// of a default, the JSON Pointer of a param ("/backup/retention_days"),
+	// or the secret name. Empty for a version.
+	Path string
+	Op   Op
+	// Before and After are the live and desired values. A default's are
+	// resource IDs. Both are nil for secrets.
+	Before any
+	After  any
+}
+
+// Plan is the diff between a [Spec] and live state, produced by
+// [Reconciler.Plan] and consumed by [Reconciler.Apply].
+type Plan struct {
+	EnvironmentID string
+	// Changes is every field the spec mentions, no-ops included, ordered
+	// environment first, then by instance, kind, and path.
+	Changes []Change
+
+	env       *types.Environment
+	instances map[string]*instancePlan
+}
+
+// instancePlan carries what Apply needs beyond the rendered changes.
+type instancePlan struct {
+	params  map[string]any
+	secrets map[string]string
+	version string
+}
+
+// HasChanges reports whether applying the plan would change anything.
+func (p *Plan) HasChanges() bool {
+	return slices.ContainsFunc(p.Changes, func(c Change) bool { return c.Op != OpNoop })
+}
+
+// Count returns the number of changes with op o.
+func (p *Plan) Count(o Op) int {
+	n := 0
+	for _, c := range p.Changes {
+		if c.Op == o {
+			n++
+		}
+	}
+	return n
+}
+
+// String renders the plan for people: one block per target, one line per
+// change ("+" create, "~" update, "-" delete), no-ops omitted, and a
+// closing tally.
+//
+//	environment ecomm-prod
+//	  ~ attribute team: "data" => "platform"
+//	instance ecomm-prod-database
+//	  ~ param /instance_type: "db.t3.medium" => "db.r6g.large"
+//	  + secret DB_PASSWORD: (sensitive)
+//
+//	Plan: 1 to create, 2 to update, 0 to delete, 4 unchanged.
+func (p *Plan) String() string {
+	var sb strings.Builder
+	target := ""
+	for _, c := range p.Changes {
+		if c.Op == OpNoop {
+			continue
+		}
+		if c.Target != target {
+			target = c.Target
+			what := "instance"
+			if target == p.EnvironmentID {
+				what = "environment"
+			}
+			fmt.Fprintf(&sb, "%s %s\n", what, target)
+		}
+		mark := map[Op]string{OpCreate: "+", OpUpdate: "~", OpDelete: "-"}[c.Op]
+		label := string(c.Kind)
+		if c.Path != "" {
+			label += " " + c.Path
+		}
+		switch {
+		case c.Kind == KindSecret:
+			fmt.Fprintf(&sb, "  %s %s: (sensitive)\n", mark, label)
+		case c.Op == OpCreate:
+			fmt.Fprintf(&sb, "  %s %s: %s\n", mark, label, render(c.After))
+		case c.Op == OpDelete:
+			fmt.Fprintf(&sb, "  %s %s: %s\n", mark, label, render(c.Before))
+		default:
+			fmt.Fprintf(&sb, "  %s %s: %s => %s\n", mark, label, render(c.Before), render(c.After))
+		}
+	}
+	if sb.Len() > 0 {
+		sb.WriteByte('\n')
+	}
+	fmt.Fprintf(&sb, "Plan: %d to create, %d to update, %d to delete, %d unchanged.\n",
+		p.Count(OpCreate), p.Count(OpUpdate), p.Count(OpDelete), p.Count(OpNoop))
+	return sb.String()
+}
+
+// render formats a value as compact JSON, which is stable for maps.
+func render(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// Reconciler plans and applies specs. Construct with [New].
+type Reconciler struct {
+	environments *environments.Service
+	instances    *instances.Service
+	deployments  *deployments.Service
+	resources    *resources.Service
+}
+
+// New returns a [*Reconciler] using the given services — typically
+// c.Environments, c.Instances, c.Deployments and c.Resources from the
+// top-level client.
+func New(envs *environments.Service, inst *instances.Service, deps *deployments.Service, res *resources.Service) *Reconciler {
+	return &Reconciler{environments: envs, instances: inst, deployments: deps, resources: res}
+}
+
+// PlanOptions configures [Reconciler.Plan].
+type PlanOptions struct {
+	// Resolve turns secret references into values. Nil uses
+	// [ResolveSecret].
+	Resolve SecretResolver
+}
+
+// Plan diffs spec against the live environment. Reads go through
+// Environments.Get, Instances.Iter and Get, Instances.SecretFields, and
+// Resources.Get (for the type of a new default). Nothing is written.
+//
+// A component in the spec without an instance in the environment, a
+// secret its bundle doesn't declare, or an unresolvable secret reference
+// is an error: the plan couldn't be applied as written.
+func (r *Reconciler) Plan(ctx context.Context, spec *Spec, opts PlanOptions) (*Plan, error) {
+	resolve := opts.Resolve
+	if resolve == nil {
+		resolve = ResolveSecret
+	}
+	env, err := r.environments.Get(ctx, spec.Environment)
+	if err != nil {
+		return nil, err
+	}
+	plan := &Plan{EnvironmentID: env.ID, env: env, instances: map[string]*instancePlan{}}
+
+	for _, key := range slices.Sorted(maps.Keys(spec.Attributes)) {
+		want := jsonutil.Normalize(spec.Attributes[key])
+		have, ok := env.Attributes[key]
+		plan.Changes = append(plan.Changes, fieldChange(env.ID, KindAttribute, key, have, ok, want))
+	}
+
+	if err := r.planDefaults(ctx, plan, spec.Defaults); err != nil {
+		return nil, err
+	}
+
+	live := map[string]types.Instance{}
+	for inst, err := range r.instances.Iter(ctx, instances.ListInput{EnvironmentID: env.ID}) {
+		if err != nil {
+			return nil, err
+		}
+		live[inst.ID] = inst
+		if inst.Component != nil && inst.Component.ID != "" {
+			live[env.ID+"-"+inst.Component.ID] = inst
+		}
+	}
+	for _, component := range slices.Sorted(maps.Keys(spec.Instances)) {
+		ref, ok := live[env.ID+"-"+component]
+		if !ok {
+			return nil, fmt.Errorf("plan %s: component %q has no instance in the environment", env.ID, component)
+		}
+		if err := r.planInstance(ctx, plan, ref.ID, spec.Instances[component], resolve); err != nil {
+			return nil, err
+		}
+	}
+
+	slices.SortStableFunc(plan.Changes, func(a, b Change) int {
+		envFirst := func(c Change) int {
+			if c.Target == plan.EnvironmentID {
+				return 0
+			}
+			return 1
+		}
+		return cmp.Or(
+			cmp.Compare(envFirst(a), envFirst(b)),
+			strings.Compare(a.Target, b.Target),
+			cmp.Compare(kindOrder(a.Kind), kindOrder(b.Kind)),
+			strings.Compare(a.Path, b.Path),
+		)
+	})
+	return plan, nil
+}
+
+func kindOrder(k Kind) int {
+	return slices.Index([]Kind{KindAttribute, KindDefault, KindVersion, KindParam, KindSecret}, k)
+}
+
+func (r *Reconciler) planDefaults(ctx context.Context, plan *Plan, resourceIDs []string) error {
+	// Live defaults, by resource type and by resource.
+	byType := map[string]string{}
+	typeOf := map[string]string{}
+	for _, d := range plan.env.Defaults {
+		if d.Resource.ResourceType != nil {
+			byType[d.Resource.ResourceType.ID] = d.Resource.ID
+			typeOf[d.Resource.ID] = d.Resource.ResourceType.ID
+		}
+	}
+	for _, id := range resourceIDs {
+		if typ, ok := typeOf[id]; ok {
+			plan.Changes = append(plan.Changes, Change{Target: plan.EnvironmentID, Kind: KindDefault, Path: typ, Op: OpNoop, Before: id, After: id})
+			continue
+		}
+		res, err := r.resources.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		typ := ""
+		if res.ResourceType != nil {
+			typ = res.ResourceType.ID
+		}
+		c := Change{Target: plan.EnvironmentID, Kind: KindDefault, Path: typ, Op: OpCreate, After: id}
+		if cur, ok := byType[typ]; ok {
+			c.Op, c.Before = OpUpdate, cur
+		}
+		plan.Changes = append(plan.Changes, c)
+	}
+	return nil
+}
+
+func (r *Reconciler) planInstance(ctx context.Context, plan *Plan, id string, spec InstanceSpec, resolve SecretResolver) error {
+	inst, err := r.instances.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	ip := &instancePlan{version: inst.Version, secrets: map[string]string{}}
+	plan.instances[id] = ip
+
+	if spec.Version != "" {
+		plan.Changes = append(plan.Changes, fieldChange(id, KindVersion, "", inst.Version, true, spec.Version))
+		ip.version = spec.Version
+	}
+
+	current, _ := jsonutil.Normalize(inst.Params).(map[string]any)
+	if current == nil {
+		current = map[string]any{}
+	}
+	desired := jsonutil.Normalize(spec.Params)
+	merged, err := mergeParams(current, spec.Params)
+	if err != nil {
+		return fmt.Errorf("plan %s: %w", id, err)
+	}
+	diffParams(&plan.Changes, id, "", current, desired, merged)
+	ip.params = merged
+
+	if len(spec.Secrets) > 0 {
+		fields, err := r.instances.SecretFields(ctx, id)
+		if err != nil {
+			return err
+		}
+		stored := map[string]string{}
+		for _, f := range fields {
+			stored[f.Name] = f.SHA256
+		}
+		for _, name := range slices.Sorted(maps.Keys(spec.Secrets)) {
+			sum, declared := stored[name]
+			if !declared {
+				return fmt.Errorf("plan %s: secret %s is not declared by the bundle", id, name)
+			}
+			value, err := resolve(spec.Secrets[name])
+			if err != nil {
+				return fmt.Errorf("plan %s: secret %s: %w", id, name, err)
+			}
+			c := Change{Target: id, Kind: KindSecret, Path: name, Op: OpNoop}
+			switch {
+			case sum == "":
+				c.Op = OpCreate
+			case !fingerprint.Matches(sum, value):
+				c.Op = OpUpdate
+			}
+			if c.Op != OpNoop {
+				ip.secrets[name] = value
+			}
+			plan.Changes = append(plan.Changes, c)
+		}
+	}
+	return nil
+}
+
+// fieldChange compares one scalar-ish field.
+func fieldChange(target string, kind Kind, path string, have any, present bool, want any) Change {
+	c := Change{Target: target, Kind: kind, Path: path, Before: have, After: want}
+	switch {
+	case !present || have == nil || have == "":
+		c.Op, c.Before = OpCreate, nil
+	case jsonutil.Equal(have, want):
+		c.Op = OpNoop
+	default:
+		c.Op = OpUpdate
+	}
+	return c
+}
+
+// diffParams records a change for every leaf the patch mentions, comparing
+// current with the merged result.
+func diffParams(out *[]Change, id, prefix string, current map[string]any, patch any, merged map[string]any) {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return
+	}
+	for _, key := range slices.Sorted(maps.Keys(p)) {
+		path := prefix + "/" + strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+		have, had := current[key]
+		want, has := merged[key]
+		hm, hIsMap := have.(map[string]any)
+		wm, wIsMap := want.(map[string]any)
+		if _, patchIsMap := p[key].(map[string]any); patchIsMap && hIsMap && wIsMap {
+			diffParams(out, id, path, hm, p[key], wm)
+			continue
+		}
+		c := Change{Target: id, Kind: KindParam, Path: path, Before: have, After: want}
+		switch {
+		case !has && !had:
+			continue
+		case !has:
+			c.Op, c.After = OpDelete, nil
+		case !had:
+			c.Op = OpCreate
+		case reflect.DeepEqual(have, want):
+			c.Op = OpNoop
+		default:
+			c.Op = OpUpdate
+		}
+		*out = append(*out, c)
+	}
+}
+
+// mergeParams applies spec params to current as an RFC 7396 merge patch.
+func mergeParams(current, patch map[string]any) (map[string]any, error) {
+	if patch == nil {
+		return maps.Clone(current), nil
+	}
+	doc, err := json.Marshal(current)
+	if err != nil {
+		return nil, fmt.Errorf("encode params: %w", err)
+	}
+	raw, err := json.Marshal(patch)
+	if err != nil {
+		return nil, fmt.Errorf("encode spec params: %w", err)
+	}
+	out, err := jsonpatch.MergePatch(doc, raw)
+	if err != nil {
+		return nil, fmt.Errorf("merge params: %w", err)
+	}
+	var merged map[string]any
+	if err := json.Unmarshal(out, &merged); err != nil {
+		return nil, fmt.Errorf("decode merged params: %w", err)
+	}
+	return merged, nil
+}
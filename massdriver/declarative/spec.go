@@ -0,0 +1,117 @@
+package declarative
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Spec is the desired state of one environment. It is written as YAML or
+// JSON:
+//
+//	environment: ecomm-prod
+//	attributes:
+//	  team: platform
+//	defaults:
+//	  - ecomm-prod-network-vpc   # resource IDs
+//	instances:
+//	  database:                  # component ID
+//	    version: "~1.2"
+//	    params:
+//	      instance_type: db.r6g.large
+//	      backup: {retention_days: 14}
+//	    secrets:
+//	      DB_PASSWORD: env:PROD_DB_PASSWORD
+//
+// Everything is an overlay: attributes, params, and secrets the spec
+// doesn't mention are left as they are. A null param removes it, as in an
+// RFC 7396 merge patch.
+type Spec struct {
+	// Environment is the environment ID ("ecomm-prod").
+	Environment string `yaml:"environment" json:"environment"`
+	// Attributes are environment attributes to set.
+	Attributes map[string]any `yaml:"attributes,omitempty" json:"attributes,omitempty"`
+	// Defaults are resource IDs to make the environment's default of
+	// their type, replacing any current default of that type.
+	Defaults []string `yaml:"defaults,omitempty" json:"defaults,omitempty"`
+	// Instances is keyed by component ID; the instance is
+	// "<environment>-<component>".
+	Instances map[string]InstanceSpec `yaml:"instances,omitempty" json:"instances,omitempty"`
+}
+
+// InstanceSpec is the desired state of one instance.
+type InstanceSpec struct {
+	// Version is the bundle version constraint ("~1.2", "1.2.3",
+	// "latest"). Empty leaves it unchanged.
+	Version string `yaml:"version,omitempty" json:"version,omitempty"`
+	// Params are merged onto the instance's current params.
+	Params map[string]any `yaml:"params,omitempty" json:"params,omitempty"`
+	// Secrets maps secret names to references resolved at plan time —
+	// "env:NAME" or "file:PATH" by default; see [PlanOptions].Resolve.
+	// Values never appear in the spec or the plan.
+	Secrets map[string]string `yaml:"secrets,omitempty" json:"secrets,omitempty"`
+}
+
+// ParseSpec reads a YAML or JSON spec. Unknown keys are an error, so a
+// typo doesn't silently drop part of the desired state.
+func ParseSpec(r io.Reader) (*Spec, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	var spec Spec
+	if err := dec.Decode(&spec); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("parse spec: empty document")
+		}
+		return nil, fmt.Errorf("parse spec: %w", err)
+	}
+	if spec.Environment == "" {
+		return nil, errors.New("parse spec: environment is required")
+	}
+	return &spec, nil
+}
+
+// LoadSpec reads a spec file.
+func LoadSpec(path string) (*Spec, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := ParseSpec(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return spec, nil
+}
+
+// SecretResolver turns a secret reference from a spec into its value.
+type SecretResolver func(ref string) (string, error)
+
+// ResolveSecret is the default [SecretResolver]. It understands
+// "env:NAME", read from the environment (unset is an error), and
+// "file:PATH", the file's contents with one trailing newline trimmed.
+func ResolveSecret(ref string) (string, error) {
+	scheme, arg, ok := strings.Cut(ref, ":")
+	if !ok || arg == "" {
+		return "", fmt.Errorf("secret reference %q: want env:NAME or file:PATH", ref)
+	}
+	switch scheme {
+	case "env":
+		v, ok := os.LookupEnv(arg)
+		if !ok {
+			return "", fmt.Errorf("secret reference %q: %s is not set", ref, arg)
+		}
+		return v, nil
+	case "file":
+		b, err := os.ReadFile(arg)
+		if err != nil {
+			return "", fmt.Errorf("secret reference %q: %w", ref, err)
+		}
+		return strings.TrimSuffix(string(b), "\n"), nil
+	}
+	return "", fmt.Errorf("secret reference %q: unknown scheme %q", ref, scheme)
+}
@@ -0,0 +1,214 @@
+// Package diagram renders a project's blueprint (components and links) or
+// an environment's runtime graph (instances and connections) as Graphviz
+// DOT or Mermaid, for architecture docs that stay in sync with what's
+// deployed.
+//
+//	env, err := c.Environments.Graph(ctx, "ecomm-prod")
+//	if err != nil {
+//	    return err
+//	}
+//	g := diagram.FromEnvironment(env)
+//	return g.WriteMermaid(os.Stdout, diagram.Options{Cost: diagram.CostMonthlyAverage})
+//
+// Nodes are labeled with their name, bundle and version, and status; edges
+// with "fromField → toField". Instance nodes are colored by status. Output
+// is sorted, so rendering the same graph twice gives identical text.
+package diagram
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// Graph is the renderer-neutral form of a blueprint or environment. Build
+// one with [FromProject] or [FromEnvironment], or assemble it by hand.
+type Graph struct {
+	// Name titles the diagram.
+	Name  string
+	Nodes []Node
+	Edges []Edge
+	// Cost is the total shown in the title when [Options].Cost is set.
+	Cost types.CostSummary
+}
+
+// Node is one component or instance.
+type Node struct {
+	ID   string
+	Name string
+	// Bundle is the bundle (or OCI repo) name; Version the version to
+	// show. Either may be empty.
+	Bundle  string
+	Version string
+	// Status is an instance status (PROVISIONED, FAILED, …); empty for
+	// components, which render uncolored.
+	Status string
+	Cost   types.CostSummary
+}
+
+// Edge is one link or connection, from the producing node to the
+// consuming one.
+type Edge struct {
+	From      string
+	FromField string
+	To        string
+	ToField   string
+}
+
+// FromProject builds a [Graph] from a project's blueprint, as returned by
+// projects.Get. Components are labeled with their OCI repo name; they
+// have no status, version, or cost of their own.
+func FromProject(p *types.Project) *Graph {
+	g := &Graph{Name: cmp.Or(p.Name, p.ID), Cost: p.Cost}
+	for _, c := range p.Components {
+		n := Node{ID: c.ID, Name: cmp.Or(c.Name, c.ID)}
+		if c.OciRepo != nil {
+			n.Bundle = c.OciRepo.Name
+		}
+		g.Nodes = append(g.Nodes, n)
+	}
+	for _, l := range p.Links {
+		if l.FromComponent == nil || l.ToComponent == nil {
+			continue
+		}
+		g.Edges = append(g.Edges, Edge{From: l.FromComponent.ID, FromField: l.FromField, To: l.ToComponent.ID, ToField: l.ToField})
+	}
+	g.sort()
+	return g
+}
+
+// FromEnvironment builds a [Graph] from an environment's instances and
+// connections, as returned by environments.Graph. An instance's version is
+// the one deployed, falling back to the one resolved, then its
+// constraint.
+func FromEnvironment(e *types.Environment) *Graph {
+	g := &Graph{Name: cmp.Or(e.Name, e.ID), Cost: e.Cost}
+	for _, i := range e.Instances {
+		n := Node{
+			ID:      i.ID,
+			Name:    cmp.Or(i.Name, i.ID),
+			Version: cmp.Or(i.DeployedVersion, i.ResolvedVersion, i.Version),
+			Status:  i.Status,
+			Cost:    i.Cost,
+		}
+		if i.Bundle != nil {
+			n.Bundle = i.Bundle.Name
+		}
+		g.Nodes = append(g.Nodes, n)
+	}
+	for _, c := range e.Connections {
+		if c.FromInstance == nil || c.ToInstance == nil {
+			continue
+		}
+		g.Edges = append(g.Edges, Edge{From: c.FromInstance.ID, FromField: c.FromField, To: c.ToInstance.ID, ToField: c.ToField})
+	}
+	g.sort()
+	return g
+}
+
+func (g *Graph) sort() {
+	slices.SortFunc(g.Nodes, func(a, b Node) int { return strings.Compare(a.ID, b.ID) })
+	slices.SortFunc(g.Edges, func(a, b Edge) int {
+		return cmp.Or(
+			strings.Compare(a.From, b.From),
+			strings.Compare(a.To, b.To),
+			strings.Compare(a.FromField, b.FromField),
+			strings.Compare(a.ToField, b.ToField),
+		)
+	})
+}
+
+// CostPeriod selects which [types.CostSummary] sample [Options].Cost
+// annotates nodes with.
+type CostPeriod string
+
+const (
+	CostNone           CostPeriod = ""
+	CostLastMonth      CostPeriod = "last month"
+	CostMonthlyAverage CostPeriod = "monthly average"
+	CostLastDay        CostPeriod = "last day"
+	CostDailyAverage   CostPeriod = "daily average"
+)
+
+// Options controls rendering.
+type Options struct {
+	// Cost adds the chosen period's cost to each node and the graph's
+	// total to the title. Nodes without billing data get no annotation.
+	// Empty = no cost.
+	Cost CostPeriod
+}
+
+// cost formats the selected sample, or "" when there is none.
+func (o Options) cost(s types.CostSummary) string {
+	var sample types.CostSample
+	switch o.Cost {
+	case CostLastMonth:
+		sample = s.LastMonth
+	case CostMonthlyAverage:
+		sample = s.MonthlyAverage
+	case CostLastDay:
+		sample = s.LastDay
+	case CostDailyAverage:
+		sample = s.DailyAverage
+	default:
+		return ""
+	}
+	if sample.Amount == nil {
+		return ""
+	}
+	currency := "USD"
+	if sample.Currency != nil {
+		currency = *sample.Currency
+	}
+	return fmt.Sprintf("%.2f %s (%s)", *sample.Amount, currency, o.Cost)
+}
+
+// lines is a node's label, one entry per line.
+func (n Node) lines(opts Options) []string {
+	out := []string{n.Name}
+	switch {
+	case n.Bundle != "" && n.Version != "":
+		out = append(out, n.Bundle+"@"+n.Version)
+	case n.Bundle != "":
+		out = append(out, n.Bundle)
+	case n.Version != "":
+		out = append(out, n.Version)
+	}
+	if n.Status != "" {
+		out = append(out, n.Status)
+	}
+	if c := opts.cost(n.Cost); c != "" {
+		out = append(out, c)
+	}
+	return out
+}
+
+func (e Edge) label() string {
+	return e.FromField + " → " + e.ToField
+}
+
+func (g *Graph) title(opts Options) string {
+	if c := opts.cost(g.Cost); c != "" {
+		return g.Name + " — " + c
+	}
+	return g.Name
+}
+
+// statusColor is the fill and border for an instance status; components
+// and unknown statuses get white.
+func statusColor(status string) (fill, stroke string) {
+	switch status {
+	case "PROVISIONED":
+		return "#d4edda", "#28a745"
+	case "FAILED":
+		return "#f8d7da", "#dc3545"
+	case "INITIALIZED":
+		return "#fff3cd", "#ffc107"
+	case "DECOMMISSIONED":
+		return "#e2e3e5", "#6c757d"
+	}
+	return "#ffffff", "#333333"
+}
@@ -0,0 +1,101 @@
+package diagram_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/diagram"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func environment() *types.Environment {
+	return &types.Environment{
+		ID:   "ecomm-prod",
+		Name: "Production",
+		Cost: types.CostSummary{MonthlyAverage: types.CostSample{Amount: ptr(1234.5), Currency: ptr("USD")}},
+		Instances: []types.Instance{
+			{
+				ID: "ecomm-prod-database", Name: "Database", Status: "PROVISIONED",
+				Version: "~1.2", ResolvedVersion: "1.2.4", DeployedVersion: "1.2.3",
+				Bundle: &types.Bundle{Name: "aws-aurora-postgres"},
+				Cost:   types.CostSummary{MonthlyAverage: types.CostSample{Amount: ptr(1000.0), Currency: ptr("USD")}},
+			},
+			{
+				ID: "ecomm-prod-app", Name: `Store "front"`, Status: "FAILED",
+				Version: "latest", ResolvedVersion: "2.0.0",
+				Bundle: &types.Bundle{Name: "aws-ecs-service"},
+			},
+		},
+		Connections: []types.Connection{
+			{
+				ID: "conn-1", FromField: "authentication", ToField: "database",
+				FromInstance: &types.Instance{ID: "ecomm-prod-database"}, ToInstance: &types.Instance{ID: "ecomm-prod-app"},
+			},
+		},
+	}
+}
+
+func TestWriteDOT(t *testing.T) {
+	var sb strings.Builder
+	if err := diagram.FromEnvironment(environment()).WriteDOT(&sb, diagram.Options{Cost: diagram.CostMonthlyAverage}); err != nil {
+		t.Fatalf("WriteDOT: %v", err)
+	}
+	want := `digraph "Production" {
+  label="Production — 1234.50 USD (monthly average)";
+  labelloc=t;
+  rankdir=LR;
+  node [shape=box, style="rounded,filled", fontname="Helvetica"];
+  edge [fontname="Helvetica", fontsize=10];
+  "ecomm-prod-app" [label="Store \"front\"\naws-ecs-service@2.0.0\nFAILED", fillcolor="#f8d7da", color="#dc3545"];
+  "ecomm-prod-database" [label="Database\naws-aurora-postgres@1.2.3\nPROVISIONED\n1000.00 USD (monthly average)", fillcolor="#d4edda", color="#28a745"];
+  "ecomm-prod-database" -> "ecomm-prod-app" [label="authentication → database"];
+}
+`
+	if got := sb.String(); got != want {
+		t.Errorf("DOT =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestWriteMermaid(t *testing.T) {
+	var sb strings.Builder
+	if err := diagram.FromEnvironment(environment()).WriteMermaid(&sb, diagram.Options{}); err != nil {
+		t.Fatalf("WriteMermaid: %v", err)
+	}
+	want := `---
+title: "Production"
+---
+flowchart LR
+  n0["Store #quot;front#quot;<br/>aws-ecs-service@2.0.0<br/>FAILED"]
+  class n0 failed
+  n1["Database<br/>aws-aurora-postgres@1.2.3<br/>PROVISIONED"]
+  class n1 provisioned
+  n1 -->|"authentication → database"| n0
+  classDef failed fill:#f8d7da,stroke:#dc3545
+  classDef provisioned fill:#d4edda,stroke:#28a745
+`
+	if got := sb.String(); got != want {
+		t.Errorf("Mermaid =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestFromProject(t *testing.T) {
+	g := diagram.FromProject(&types.Project{
+		ID: "ecomm",
+		Components: []types.Component{
+			{ID: "ecomm-database", Name: "Database", OciRepo: &types.OciRepo{Name: "aws-aurora-postgres"}},
+			{ID: "ecomm-app"},
+		},
+		Links: []types.Link{
+			{FromField: "authentication", ToField: "database", FromComponent: &types.Component{ID: "ecomm-database"}, ToComponent: &types.Component{ID: "ecomm-app"}},
+			{FromField: "dangling", ToField: "x", FromComponent: &types.Component{ID: "ecomm-database"}},
+		},
+	})
+	if g.Name != "ecomm" || len(g.Nodes) != 2 || len(g.Edges) != 1 {
+		t.Fatalf("graph = %+v", g)
+	}
+	if g.Nodes[0].Name != "ecomm-app" || g.Nodes[1].Bundle != "aws-aurora-postgres" || g.Nodes[1].Status != "" {
+		t.Errorf("nodes = %+v", g.Nodes)
+	}
+}
@@ -0,0 +1,85 @@
+package diagram
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteDOT writes g as a Graphviz digraph, laid out left to right:
+//
+//	dot -Tsvg -o ecomm-prod.svg < ecomm-prod.dot
+func (g *Graph) WriteDOT(w io.Writer, opts Options) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "digraph %s {\n", dotQuote(g.Name))
+	fmt.Fprintf(bw, "  label=%s;\n  labelloc=t;\n  rankdir=LR;\n", dotQuote(g.title(opts)))
+	bw.WriteString("  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\"];\n")
+	bw.WriteString("  edge [fontname=\"Helvetica\", fontsize=10];\n")
+	for _, n := range g.Nodes {
+		fill, stroke := statusColor(n.Status)
+		fmt.Fprintf(bw, "  %s [label=%s, fillcolor=%q, color=%q];\n",
+			dotQuote(n.ID), dotQuote(strings.Join(n.lines(opts), "\n")), fill, stroke)
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(bw, "  %s -> %s [label=%s];\n", dotQuote(e.From), dotQuote(e.To), dotQuote(e.label()))
+	}
+	bw.WriteString("}\n")
+	return bw.Flush()
+}
+
+// WriteMermaid writes g as a Mermaid flowchart, ready to paste into a
+// fenced ```mermaid block.
+func (g *Graph) WriteMermaid(w io.Writer, opts Options) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "---\ntitle: \"%s\"\n---\n", mermaidText(g.title(opts)))
+	bw.WriteString("flowchart LR\n")
+	ids := map[string]string{}
+	for i, n := range g.Nodes {
+		ids[n.ID] = fmt.Sprintf("n%d", i)
+	}
+	// Edges may name nodes the graph doesn't list; give them an ID too so
+	// the edge still renders.
+	for _, e := range g.Edges {
+		for _, id := range []string{e.From, e.To} {
+			if _, ok := ids[id]; !ok {
+				ids[id] = fmt.Sprintf("n%d", len(ids))
+			}
+		}
+	}
+	statuses := map[string]bool{}
+	for _, n := range g.Nodes {
+		lines := n.lines(opts)
+		for i := range lines {
+			lines[i] = mermaidText(lines[i])
+		}
+		fmt.Fprintf(bw, "  %s[\"%s\"]\n", ids[n.ID], strings.Join(lines, "<br/>"))
+		if n.Status != "" {
+			fmt.Fprintf(bw, "  class %s %s\n", ids[n.ID], strings.ToLower(n.Status))
+			statuses[n.Status] = true
+		}
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(bw, "  %s -->|\"%s\"| %s\n", ids[e.From], mermaidText(e.label()), ids[e.To])
+	}
+	for _, status := range []string{"DECOMMISSIONED", "FAILED", "INITIALIZED", "PROVISIONED"} {
+		if statuses[status] {
+			fill, stroke := statusColor(status)
+			fmt.Fprintf(bw, "  classDef %s fill:%s,stroke:%s\n", strings.ToLower(status), fill, stroke)
+		}
+	}
+	return bw.Flush()
+}
+
+// dotQuote returns s as a DOT double-quoted string; newlines become \n
+// line breaks.
+func dotQuote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
+
+// mermaidText escapes s for a quoted Mermaid label.
+func mermaidText(s string) string {
+	r := strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;", "\n", " ")
+	return r.Replace(s)
+}
@@ -33,11 +33,20 @@ func (r *roundTripperWithHeaders) RoundTrip(req *http.Request) (*http.Response,
 	return r.Base.RoundTrip(req)
 }
 
+// NewV2Client returns a genqlient client for the platform GraphQL API at
+// cfg.URL, authenticated with cfg's credentials.
 func NewV2Client(cfg config.Config) graphql.Client {
+	return NewV2ClientWithTransport(cfg, http.DefaultTransport)
+}
+
+// NewV2ClientWithTransport is [NewV2Client] with base as the underlying
+// HTTP transport instead of [http.DefaultTransport] — used to route
+// GraphQL traffic through the same rate limiter as REST calls.
+func NewV2ClientWithTransport(cfg config.Config, base http.RoundTripper) graphql.Client {
 	baseURL := cfg.URL + gqlV2Path
 
 	transport := &roundTripperWithHeaders{
-		Base: http.DefaultTransport,
+		Base: base,
 		Headers: map[string]string{
 			"Authorization": cfg.Credentials.AuthHeaderValue,
 			"Content-Type":  "application/json",
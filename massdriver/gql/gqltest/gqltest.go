@@ -118,6 +118,15 @@ func RespondWithData(data map[string]any) Response {
 	return Response{payload: map[string]any{"data": data}}
 }
 
+// RespondWithSuccess returns a Response for a successful mutation whose
+// payload is field, carrying a result with just id — enough for wrappers
+// that only check the mutation went through.
+func RespondWithSuccess(field, id string) Response {
+	return RespondWithData(map[string]any{
+		field: map[string]any{"result": map[string]any{"id": id}, "successful": true},
+	})
+}
+
 // RespondWithJSON returns a Response whose payload is the supplied envelope
 // verbatim. Use this when you need to send custom shapes — the full envelope
 // (including the outer "data" key) must be provided.
@@ -0,0 +1,22 @@
+package gqltest
+
+import (
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver"
+)
+
+// NewSDKClient returns a [*massdriver.Client] whose GraphQL operations go
+// to c, for testing packages built on the whole SDK rather than one
+// service. The organization is "my-org"; opts are applied after it, so
+// they can override it. The client is closed when the test ends.
+func NewSDKClient(t testing.TB, c *Client, opts ...massdriver.Option) *massdriver.Client {
+	t.Helper()
+	opts = append([]massdriver.Option{massdriver.WithGQLClient(c), massdriver.WithOrganizationID("my-org")}, opts...)
+	mc, err := massdriver.NewClient(opts...)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	t.Cleanup(func() { _ = mc.Close() })
+	return mc
+}
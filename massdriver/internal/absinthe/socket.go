@@ -17,6 +17,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/url"
 	"strconv"
 	"strings"
@@ -25,6 +26,7 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/redact"
 )
 
 const (
@@ -37,12 +39,18 @@ const (
 	phoenixVsn        = "2.0.0"
 )
 
+// errHeartbeatTimeout closes the socket when the server hasn't answered
+// a heartbeat by the time the next one is due — the same rule the
+// Phoenix JS client applies to detect half-open connections.
+var errHeartbeatTimeout = errors.New("absinthe heartbeat timeout")
+
 // Socket is an open Phoenix Channels connection that has joined Absinthe's
 // `__absinthe__:control` topic and can multiplex GraphQL subscriptions over
 // a single WebSocket.
 type Socket struct {
 	conn    *websocket.Conn
 	nextRef atomic.Uint64
+	log     *slog.Logger
 
 	joinRef string
 
@@ -53,6 +61,7 @@ type Socket struct {
 	subscribers map[string]chan json.RawMessage // subscriptionId (== Phoenix topic) → data
 	closed      bool
 	closeErr    error
+	heartbeat   string // ref of the unanswered heartbeat, "" when none
 
 	closeConnOne sync.Once     // gates conn.Close so user-Close is idempotent
 	connCloseErr error         //   captured once for the caller
@@ -75,19 +84,29 @@ type reply struct {
 // URL's query string). Switching to an Authorization header would
 // require the server-side UserSocket to opt into connect_info; until
 // that lands, query-string is the only auth path the server accepts.
-func Dial(ctx context.Context, baseURL, token string) (*Socket, error) {
+//
+// logger receives debug-level lifecycle events (dial, join, heartbeat
+// timeout, close, subscribe); the token is redacted from the logged URL.
+// Nil disables logging.
+func Dial(ctx context.Context, baseURL, token string, logger *slog.Logger) (*Socket, error) {
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
 	wsURL, err := buildWSURL(baseURL, token)
 	if err != nil {
 		return nil, err
 	}
 
+	logger.DebugContext(ctx, "absinthe dial", slog.String("url", redact.URL(wsURL)))
 	conn, _, err := websocket.DefaultDialer.DialContext(ctx, wsURL, nil)
 	if err != nil {
+		logger.DebugContext(ctx, "absinthe dial failed", slog.Any("error", err))
 		return nil, fmt.Errorf("absinthe dial: %w", err)
 	}
 
 	s := &Socket{
 		conn:        conn,
+		log:         logger,
 		pending:     make(map[string]chan reply),
 		subscribers: make(map[string]chan json.RawMessage),
 		done:        make(chan struct{}),
@@ -95,9 +114,11 @@ func Dial(ctx context.Context, baseURL, token string) (*Socket, error) {
 	go s.readLoop()
 
 	if joinErr := s.join(ctx); joinErr != nil {
+		logger.DebugContext(ctx, "absinthe join failed", slog.Any("error", joinErr))
 		_ = s.Close()
 		return nil, joinErr
 	}
+	logger.DebugContext(ctx, "absinthe joined", slog.String("topic", controlTopic))
 	go s.heartbeatLoop()
 	return s, nil
 }
@@ -234,6 +255,14 @@ func (s *Socket) dispatch(body []byte) {
 		if ref == nil {
 			return
 		}
+		if topic == heartbeatTopic {
+			s.mu.Lock()
+			if s.heartbeat == *ref {
+				s.heartbeat = ""
+			}
+			s.mu.Unlock()
+			return
+		}
 		var p struct {
 			Status   string          `json:"status"`
 			Response json.RawMessage `json:"response"`
@@ -273,7 +302,18 @@ func (s *Socket) heartbeatLoop() {
 			return
 		case <-t.C:
 			ref := s.refID()
-			// Heartbeats use a nil join_ref. Don't wait for the reply.
+			s.mu.Lock()
+			missed := s.heartbeat != ""
+			s.heartbeat = ref
+			s.mu.Unlock()
+			if missed {
+				s.log.Debug("absinthe heartbeat timeout", slog.Duration("interval", heartbeatInterval))
+				s.shutdown(errHeartbeatTimeout)
+				s.closeConnOne.Do(func() { s.connCloseErr = s.conn.Close() })
+				return
+			}
+			// Heartbeats use a nil join_ref. The reply is matched in
+			// dispatch; a missing one is caught on the next tick.
 			_ = s.writeFrame(nil, &ref, heartbeatTopic, heartbeatEvent, nil)
 		}
 	}
@@ -289,6 +329,7 @@ func (s *Socket) shutdown(cause error) {
 	if cause != nil && !isNormalClose(cause) {
 		s.closeErr = cause
 	}
+	s.log.Debug("absinthe socket closed", slog.Any("cause", s.closeErr))
 	pending := s.pending
 	s.pending = nil
 	subs := s.subscribers
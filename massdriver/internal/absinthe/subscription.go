@@ -4,9 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
+	"regexp"
 	"sync"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/redact"
 )
 
+// opNamePattern pulls the operation name out of a subscription document
+// for logging.
+var opNamePattern = regexp.MustCompile(`^\s*subscription\s+(\w+)`)
+
 // Subscription is an open Absinthe subscription. Iterate Data until it
 // closes, then check Err() for the failure cause (nil means clean
 // termination).
@@ -55,6 +63,16 @@ func (s *Socket) Subscribe(ctx context.Context, query string, variables map[stri
 		return nil, fmt.Errorf("absinthe subscribe: server returned no subscriptionId (response=%s)", string(resp.response))
 	}
 
+	op := ""
+	if m := opNamePattern.FindStringSubmatch(query); m != nil {
+		op = m[1]
+	}
+	s.log.DebugContext(ctx, "absinthe subscribe",
+		slog.String("operation", op),
+		slog.String("subscriptionId", subResp.SubscriptionID),
+		slog.Any("variables", redact.Variables(op, variables)),
+	)
+
 	raw := s.registerSubscription(subResp.SubscriptionID)
 	out := make(chan json.RawMessage, cap(raw))
 
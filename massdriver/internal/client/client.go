@@ -1,6 +1,8 @@
 package client
 
 import (
+	"log/slog"
+	"net/http"
 	"runtime"
 	"runtime/debug"
 	"time"
@@ -55,6 +57,34 @@ type Client struct {
 	Config config.Config
 	HTTP   *resty.Client
 	GQLv2  graphql.Client
+	// Logger receives debug-level transport logs. May be nil (no
+	// logging); use [Client.Log] rather than reading it directly.
+	Logger *slog.Logger
+
+	// timeout and transport are what [NewWithOptions] built c with, kept
+	// so [Client.WithBearer] can share them. Nil transport: c was built
+	// by hand.
+	timeout   time.Duration
+	transport http.RoundTripper
+}
+
+// Options holds the transport settings [NewWithOptions] applies on top
+// of a resolved [config.Config].
+type Options struct {
+	// Timeout is the per-request HTTP timeout. Zero disables it.
+	Timeout time.Duration
+	// Logger receives debug-level logs of every GraphQL operation, REST
+	// call, and streaming-socket lifecycle event, with credentials and
+	// secret values redacted. Nil disables logging.
+	Logger *slog.Logger
+	// RateLimit caps requests per second across GraphQL and REST,
+	// allowing bursts of up to Burst. Zero means unlimited. The rate
+	// backs off when the server answers 429 and recovers afterwards.
+	RateLimit float64
+	Burst     int
+	// MaxConcurrency caps in-flight requests across GraphQL and REST.
+	// Zero means unlimited.
+	MaxConcurrency int
 }
 
 // New constructs a [*Client] from environment variables and the
@@ -78,17 +108,60 @@ func New() (*Client, error) {
 // A timeout of 0 disables the per-request HTTP timeout — only do this
 // for callers who already enforce deadlines via [context.Context].
 func NewWithConfig(cfg config.Config, timeout time.Duration) *Client {
+	return NewWithOptions(cfg, Options{Timeout: timeout})
+}
+
+// NewWithOptions is [NewWithConfig] with the full set of transport
+// settings.
+func NewWithOptions(cfg config.Config, opts Options) *Client {
+	// One transport for both surfaces so limits hold across them.
+	transport := newThrottle(http.DefaultTransport, opts.RateLimit, opts.Burst, opts.MaxConcurrency)
+	return newWithTransport(cfg, opts.Timeout, opts.Logger, transport)
+}
+
+func newWithTransport(cfg config.Config, timeout time.Duration, logger *slog.Logger, transport http.RoundTripper) *Client {
 	rest := resty.New().
+		SetTransport(transport).
 		SetBaseURL(cfg.URL).
 		SetTimeout(timeout).
 		SetHeader("Authorization", cfg.Credentials.AuthHeaderValue).
 		SetHeader("Content-Type", "application/json").
 		SetHeader("Accept", "application/json").
 		SetHeader("User-Agent", UserAgent())
+	if logger != nil {
+		logREST(rest, logger)
+	}
 
 	return &Client{
-		Config: cfg,
-		HTTP:   rest,
-		GQLv2:  gql.NewV2Client(cfg),
+		Config:    cfg,
+		HTTP:      rest,
+		GQLv2:     LogGQL(gql.NewV2ClientWithTransport(cfg, transport), logger),
+		Logger:    logger,
+		timeout:   timeout,
+		transport: transport,
+	}
+}
+
+// WithBearer returns a client for the same server and organization as c,
+// authenticated with the raw access token instead of c's credentials.
+// Services use it to act as a different identity — a freshly minted
+// token, or a service account's key.
+//
+// The new client shares c's timeout, logger, and transport, so c's rate
+// limit and concurrency cap count its requests too. It doesn't share c's
+// cache: cached reads belong to c's identity, and reading them back as
+// another would defeat checks such as "which identity is this key".
+func (c *Client) WithBearer(token string) *Client {
+	cfg := c.Config
+	cfg.Credentials = config.Credentials{
+		Method:          config.AuthPAT,
+		Source:          config.SourceOption,
+		ID:              cfg.OrganizationID,
+		Secret:          token,
+		AuthHeaderValue: "Bearer " + token,
+	}
+	if c.transport == nil {
+		return NewWithOptions(cfg, Options{Timeout: DefaultTimeout, Logger: c.Logger})
 	}
+	return newWithTransport(cfg, c.timeout, c.Logger, c.transport)
 }
@@ -0,0 +1,68 @@
+package client_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/config"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/client"
+)
+
+// TestWithBearer_SharesThrottle confirms a client derived with WithBearer
+// authenticates as the new token but still counts against the parent's
+// concurrency cap.
+func TestWithBearer_SharesThrottle(t *testing.T) {
+	var (
+		inFlight, peak atomic.Int32
+		mu             sync.Mutex
+		auths          = map[string]int{}
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		mu.Lock()
+		auths[r.Header.Get("Authorization")]++
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+	}))
+	t.Cleanup(srv.Close)
+
+	parent := client.NewWithOptions(config.Config{
+		URL:         srv.URL,
+		Credentials: config.Credentials{Method: config.AuthPAT, AuthHeaderValue: "Bearer md_parent"},
+	}, client.Options{Timeout: time.Minute, MaxConcurrency: 1})
+	child := parent.WithBearer("md_child")
+
+	var wg sync.WaitGroup
+	for i := range 6 {
+		c := parent
+		if i%2 == 1 {
+			c = child
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.HTTP.R().SetContext(t.Context()).Get("/"); err != nil {
+				t.Errorf("GET: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got != 1 {
+		t.Errorf("peak in-flight = %d, want 1 across both clients", got)
+	}
+	if auths["Bearer md_parent"] != 3 || auths["Bearer md_child"] != 3 {
+		t.Errorf("Authorization headers = %v, want 3 of each", auths)
+	}
+}
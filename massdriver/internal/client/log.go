@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/go-resty/resty/v2"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/redact"
+)
+
+// discard is the logger handed out when none is configured.
+var discard = slog.New(slog.DiscardHandler)
+
+// Log returns the client's logger, or one that discards everything when
+// none was configured. Never nil.
+func (c *Client) Log() *slog.Logger {
+	if c == nil || c.Logger == nil {
+		return discard
+	}
+	return c.Logger
+}
+
+// LogGQL wraps next so each operation is logged at debug level once it
+// completes: operation name, redacted variables, duration, and error.
+// Returns next unchanged when logger is nil.
+func LogGQL(next graphql.Client, logger *slog.Logger) graphql.Client {
+	if logger == nil {
+		return next
+	}
+	return &loggingGQL{next: next, log: logger}
+}
+
+type loggingGQL struct {
+	next graphql.Client
+	log  *slog.Logger
+}
+
+func (l *loggingGQL) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	start := time.Now()
+	err := l.next.MakeRequest(ctx, req, resp)
+	attrs := []any{
+		slog.String("operation", req.OpName),
+		slog.Any("variables", redact.Variables(req.OpName, req.Variables)),
+		slog.Duration("duration", time.Since(start)),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+	}
+	l.log.DebugContext(ctx, "massdriver graphql", attrs...)
+	return err
+}
+
+// logREST hooks resty so each REST call is logged at debug level.
+// Headers and bodies are never logged, so Authorization and resource
+// payloads can't leak.
+func logREST(r *resty.Client, logger *slog.Logger) {
+	r.OnAfterResponse(func(_ *resty.Client, resp *resty.Response) error {
+		req := resp.Request
+		logger.DebugContext(req.Context(), "massdriver rest",
+			slog.String("method", req.Method),
+			slog.String("url", redact.URL(req.URL)),
+			slog.Int("status", resp.StatusCode()),
+			slog.Duration("duration", resp.Time()),
+		)
+		return nil
+	})
+	r.OnError(func(req *resty.Request, err error) {
+		logger.DebugContext(req.Context(), "massdriver rest",
+			slog.String("method", req.Method),
+			slog.String("url", redact.URL(req.URL)),
+			slog.Any("error", err),
+		)
+	})
+}
@@ -20,7 +20,7 @@ func (c *Client) OpenStreamSocket(ctx context.Context) (*absinthe.Socket, error)
 	if c.Config.Credentials.Method != config.AuthPAT {
 		return nil, streaming.ErrRequiresPAT
 	}
-	socket, err := absinthe.Dial(ctx, c.Config.URL, c.Config.Credentials.Secret)
+	socket, err := absinthe.Dial(ctx, c.Config.URL, c.Config.Credentials.Secret, c.Logger)
 	if err != nil {
 		return nil, fmt.Errorf("open absinthe socket: %w", err)
 	}
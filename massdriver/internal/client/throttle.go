@@ -0,0 +1,167 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Adaptive-limit tuning. A 429 halves the current rate (never below
+// minRateFraction of the configured rate); each successful response
+// then wins back recoverFraction of the configured rate until it's
+// restored.
+const (
+	minRateFraction = 1.0 / 16
+	recoverFraction = 1.0 / 20
+	// defaultRetryAfter pauses all requests after a 429 that carries no
+	// (parseable) Retry-After header.
+	defaultRetryAfter = time.Second
+)
+
+// throttle is the [http.RoundTripper] every GraphQL and REST request of
+// one [Client] flows through when a rate limit or concurrency cap is
+// configured, so the limits hold across all services and goroutines.
+type throttle struct {
+	base http.RoundTripper
+
+	// limiter is nil when no rate limit is configured; ceiling is the
+	// configured rate it recovers towards after a 429.
+	limiter *rate.Limiter
+	ceiling rate.Limit
+
+	// slots caps in-flight requests; nil when uncapped. A slot is held
+	// until the response body is closed.
+	slots chan struct{}
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+// newThrottle wraps base, or returns base unchanged when neither limit
+// is set.
+func newThrottle(base http.RoundTripper, rps float64, burst, maxConcurrency int) http.RoundTripper {
+	if rps <= 0 && maxConcurrency <= 0 {
+		return base
+	}
+	t := &throttle{base: base}
+	if rps > 0 {
+		if burst <= 0 {
+			burst = 1
+		}
+		t.ceiling = rate.Limit(rps)
+		t.limiter = rate.NewLimiter(t.ceiling, burst)
+	}
+	if maxConcurrency > 0 {
+		t.slots = make(chan struct{}, maxConcurrency)
+	}
+	return t
+}
+
+func (t *throttle) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if err := t.waitPause(ctx); err != nil {
+		return nil, err
+	}
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			t.release()
+			return nil, err
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		t.release()
+		return nil, err
+	}
+	t.observe(resp)
+	if t.slots != nil {
+		resp.Body = &releasingBody{ReadCloser: resp.Body, release: t.release}
+	}
+	return resp, nil
+}
+
+// waitPause blocks while a server-requested Retry-After is in effect.
+func (t *throttle) waitPause(ctx context.Context) error {
+	t.mu.Lock()
+	wait := time.Until(t.pausedUntil)
+	t.mu.Unlock()
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// observe adapts the limiter to the server's feedback: back off and
+// pause on 429, recover gradually otherwise.
+func (t *throttle) observe(resp *http.Response) {
+	if resp.StatusCode != http.StatusTooManyRequests {
+		if t.limiter != nil && t.limiter.Limit() < t.ceiling {
+			t.limiter.SetLimit(min(t.limiter.Limit()+t.ceiling*recoverFraction, t.ceiling))
+		}
+		return
+	}
+	if t.limiter != nil {
+		t.limiter.SetLimit(max(t.limiter.Limit()/2, t.ceiling*minRateFraction))
+	}
+	until := time.Now().Add(retryAfter(resp.Header.Get("Retry-After")))
+	t.mu.Lock()
+	if until.After(t.pausedUntil) {
+		t.pausedUntil = until
+	}
+	t.mu.Unlock()
+}
+
+func (t *throttle) release() {
+	if t.slots != nil {
+		<-t.slots
+	}
+}
+
+// retryAfter parses a Retry-After header in either delay-seconds or
+// HTTP-date form.
+func retryAfter(v string) time.Duration {
+	if v == "" {
+		return defaultRetryAfter
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if at, err := http.ParseTime(v); err == nil {
+		return max(time.Until(at), 0)
+	}
+	return defaultRetryAfter
+}
+
+// releasingBody frees a concurrency slot the first time the response
+// body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,23 @@
+// Package fingerprint computes the SHA-256 fingerprints the API reports
+// for stored secret values, so a local value can be compared with one
+// the server holds without reading the secret back.
+package fingerprint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// Of returns the lowercase hex SHA-256 of value, in the form the API
+// reports it.
+func Of(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// Matches reports whether stored, a fingerprint from the API, is the
+// fingerprint of value. Case is ignored.
+func Matches(stored, value string) bool {
+	return strings.EqualFold(stored, Of(value))
+}
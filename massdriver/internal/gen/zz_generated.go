@@ -1559,6 +1559,368 @@ var AllBundlesSortField = []BundlesSortField{
 	BundlesSortFieldCreatedAt,
 }
 
+// CompareDeploymentsCompareDeploymentsDeploymentComparison includes the requested fields of the GraphQL type DeploymentComparison.
+// The GraphQL type's documentation follows.
+//
+// Side-by-side comparison of two deployments.
+//
+// Returned by `compareDeployments`. Use this to audit what changed between two
+// points in an instance's history ("what did this deploy change?") or to
+// contrast two different deployments against each other.
+//
+// The comparison is limited to snapshotted configuration — bundle version and
+// params. Runtime state, logs, and produced artifacts are out of scope.
+type CompareDeploymentsCompareDeploymentsDeploymentComparison struct {
+	// The deployment on the source side of the comparison.
+	Source CompareDeploymentsCompareDeploymentsDeploymentComparisonSourceDeployment `json:"source"`
+	// The deployment on the target side of the comparison.
+	Target CompareDeploymentsCompareDeploymentsDeploymentComparisonTargetDeployment `json:"target"`
+	// Bundle version on each side, with an `equal` flag for quick check.
+	Version CompareDeploymentsCompareDeploymentsDeploymentComparisonVersionVersionComparison `json:"version"`
+	// Flat, leaf-level diff of the two deployments' snapshotted params. Empty when both snapshots have no values to compare.
+	Params []CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparison `json:"params"`
+}
+
+// GetSource returns CompareDeploymentsCompareDeploymentsDeploymentComparison.Source, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparison) GetSource() CompareDeploymentsCompareDeploymentsDeploymentComparisonSourceDeployment {
+	return v.Source
+}
+
+// GetTarget returns CompareDeploymentsCompareDeploymentsDeploymentComparison.Target, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparison) GetTarget() CompareDeploymentsCompareDeploymentsDeploymentComparisonTargetDeployment {
+	return v.Target
+}
+
+// GetVersion returns CompareDeploymentsCompareDeploymentsDeploymentComparison.Version, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparison) GetVersion() CompareDeploymentsCompareDeploymentsDeploymentComparisonVersionVersionComparison {
+	return v.Version
+}
+
+// GetParams returns CompareDeploymentsCompareDeploymentsDeploymentComparison.Params, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparison) GetParams() []CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparison {
+	return v.Params
+}
+
+// CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparison includes the requested fields of the GraphQL type ParamComparison.
+// The GraphQL type's documentation follows.
+//
+// A single leaf-level comparison between two params maps.
+//
+// The list of `ParamComparison` entries returned by a comparison query is flat:
+// every entry is a terminal leaf (maps and arrays are walked to the bottom).
+// Use `equal` to filter to only the entries that differ.
+type CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparison struct {
+	// jq-style path to this leaf value, e.g. `.database.port` or `.containers[0].image`. Paths are stable across both sides of the comparison.
+	Path string `json:"path"`
+	// The value (or absence) on the source side of the comparison.
+	Source CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparisonSourceParamValue `json:"source"`
+	// The value (or absence) on the target side of the comparison.
+	Target CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparisonTargetParamValue `json:"target"`
+	// `true` when both sides have the same presence and the same value. `false` when either the key is only on one side or the values differ.
+	Equal bool `json:"equal"`
+}
+
+// GetPath returns CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparison.Path, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparison) GetPath() string {
+	return v.Path
+}
+
+// GetSource returns CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparison.Source, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparison) GetSource() CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparisonSourceParamValue {
+	return v.Source
+}
+
+// GetTarget returns CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparison.Target, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparison) GetTarget() CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparisonTargetParamValue {
+	return v.Target
+}
+
+// GetEqual returns CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparison.Equal, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparison) GetEqual() bool {
+	return v.Equal
+}
+
+// CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparisonSourceParamValue includes the requested fields of the GraphQL type ParamValue.
+// The GraphQL type's documentation follows.
+//
+// One leaf value in a params comparison, captured for a single side.
+//
+// `present` indicates whether the key exists on this side:
+// - `present: false` means the key is missing entirely.
+// - `present: true, value: null` means the key exists with a JSON `null` value.
+// - `present: true, value: "..."` means the key exists with the given value.
+//
+// `value` is a display string — for non-string leaves (numbers, booleans,
+// arrays), the value is rendered as text (`"5432"`, `"true"`, `"[1,2,3]"`).
+// Use the corresponding `paramDimensions` entry or the bundle schema for
+// the original type.
+type CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparisonSourceParamValue struct {
+	// Whether a value exists at this path on this side of the comparison.
+	Present bool `json:"present"`
+	// Display-ready string form of the leaf value. `null` when the key is missing or its value is JSON `null` — disambiguate with `present`.
+	Value string `json:"value"`
+}
+
+// GetPresent returns CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparisonSourceParamValue.Present, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparisonSourceParamValue) GetPresent() bool {
+	return v.Present
+}
+
+// GetValue returns CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparisonSourceParamValue.Value, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparisonSourceParamValue) GetValue() string {
+	return v.Value
+}
+
+// CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparisonTargetParamValue includes the requested fields of the GraphQL type ParamValue.
+// The GraphQL type's documentation follows.
+//
+// One leaf value in a params comparison, captured for a single side.
+//
+// `present` indicates whether the key exists on this side:
+// - `present: false` means the key is missing entirely.
+// - `present: true, value: null` means the key exists with a JSON `null` value.
+// - `present: true, value: "..."` means the key exists with the given value.
+//
+// `value` is a display string — for non-string leaves (numbers, booleans,
+// arrays), the value is rendered as text (`"5432"`, `"true"`, `"[1,2,3]"`).
+// Use the corresponding `paramDimensions` entry or the bundle schema for
+// the original type.
+type CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparisonTargetParamValue struct {
+	// Whether a value exists at this path on this side of the comparison.
+	Present bool `json:"present"`
+	// Display-ready string form of the leaf value. `null` when the key is missing or its value is JSON `null` — disambiguate with `present`.
+	Value string `json:"value"`
+}
+
+// GetPresent returns CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparisonTargetParamValue.Present, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparisonTargetParamValue) GetPresent() bool {
+	return v.Present
+}
+
+// GetValue returns CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparisonTargetParamValue.Value, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonParamsParamComparisonTargetParamValue) GetValue() string {
+	return v.Value
+}
+
+// CompareDeploymentsCompareDeploymentsDeploymentComparisonSourceDeployment includes the requested fields of the GraphQL type Deployment.
+// The GraphQL type's documentation follows.
+//
+// A record of an infrastructure provisioning operation.
+//
+// Each deployment tracks a single action (`PROVISION`, `DECOMMISSION`, or `PLAN`) against
+// an instance. Deployments are immutable once created — you cannot modify a deployment,
+// only create new ones.
+//
+// Use the `status` field to monitor progress and `elapsed_time` to track duration.
+// The `deployed_by` field identifies the user or service account that initiated the operation.
+type CompareDeploymentsCompareDeploymentsDeploymentComparisonSourceDeployment struct {
+	// Unique identifier for this deployment.
+	Id string `json:"id"`
+	// Current lifecycle state of this deployment.
+	Status DeploymentStatus `json:"status"`
+	// The infrastructure operation this deployment performs.
+	Action DeploymentAction `json:"action"`
+	// The bundle version used for this deployment (e.g., `1.2.0`).
+	Version string `json:"version"`
+	// When this deployment was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetId returns CompareDeploymentsCompareDeploymentsDeploymentComparisonSourceDeployment.Id, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonSourceDeployment) GetId() string {
+	return v.Id
+}
+
+// GetStatus returns CompareDeploymentsCompareDeploymentsDeploymentComparisonSourceDeployment.Status, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonSourceDeployment) GetStatus() DeploymentStatus {
+	return v.Status
+}
+
+// GetAction returns CompareDeploymentsCompareDeploymentsDeploymentComparisonSourceDeployment.Action, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonSourceDeployment) GetAction() DeploymentAction {
+	return v.Action
+}
+
+// GetVersion returns CompareDeploymentsCompareDeploymentsDeploymentComparisonSourceDeployment.Version, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonSourceDeployment) GetVersion() string {
+	return v.Version
+}
+
+// GetCreatedAt returns CompareDeploymentsCompareDeploymentsDeploymentComparisonSourceDeployment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonSourceDeployment) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// CompareDeploymentsCompareDeploymentsDeploymentComparisonTargetDeployment includes the requested fields of the GraphQL type Deployment.
+// The GraphQL type's documentation follows.
+//
+// A record of an infrastructure provisioning operation.
+//
+// Each deployment tracks a single action (`PROVISION`, `DECOMMISSION`, or `PLAN`) against
+// an instance. Deployments are immutable once created — you cannot modify a deployment,
+// only create new ones.
+//
+// Use the `status` field to monitor progress and `elapsed_time` to track duration.
+// The `deployed_by` field identifies the user or service account that initiated the operation.
+type CompareDeploymentsCompareDeploymentsDeploymentComparisonTargetDeployment struct {
+	// Unique identifier for this deployment.
+	Id string `json:"id"`
+	// Current lifecycle state of this deployment.
+	Status DeploymentStatus `json:"status"`
+	// The infrastructure operation this deployment performs.
+	Action DeploymentAction `json:"action"`
+	// The bundle version used for this deployment (e.g., `1.2.0`).
+	Version string `json:"version"`
+	// When this deployment was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetId returns CompareDeploymentsCompareDeploymentsDeploymentComparisonTargetDeployment.Id, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonTargetDeployment) GetId() string {
+	return v.Id
+}
+
+// GetStatus returns CompareDeploymentsCompareDeploymentsDeploymentComparisonTargetDeployment.Status, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonTargetDeployment) GetStatus() DeploymentStatus {
+	return v.Status
+}
+
+// GetAction returns CompareDeploymentsCompareDeploymentsDeploymentComparisonTargetDeployment.Action, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonTargetDeployment) GetAction() DeploymentAction {
+	return v.Action
+}
+
+// GetVersion returns CompareDeploymentsCompareDeploymentsDeploymentComparisonTargetDeployment.Version, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonTargetDeployment) GetVersion() string {
+	return v.Version
+}
+
+// GetCreatedAt returns CompareDeploymentsCompareDeploymentsDeploymentComparisonTargetDeployment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonTargetDeployment) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// CompareDeploymentsCompareDeploymentsDeploymentComparisonVersionVersionComparison includes the requested fields of the GraphQL type VersionComparison.
+// The GraphQL type's documentation follows.
+//
+// A comparison of a single version string between two sides.
+//
+// `source` and `target` may each be `null` when the corresponding side has no
+// version to report (e.g., an instance that exists on one side of an
+// environment comparison but not the other).
+type CompareDeploymentsCompareDeploymentsDeploymentComparisonVersionVersionComparison struct {
+	// The version on the source side, or `null` if no version applies.
+	Source string `json:"source"`
+	// The version on the target side, or `null` if no version applies.
+	Target string `json:"target"`
+	// `true` when both sides have the same version string (including both being `null`).
+	Equal bool `json:"equal"`
+}
+
+// GetSource returns CompareDeploymentsCompareDeploymentsDeploymentComparisonVersionVersionComparison.Source, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonVersionVersionComparison) GetSource() string {
+	return v.Source
+}
+
+// GetTarget returns CompareDeploymentsCompareDeploymentsDeploymentComparisonVersionVersionComparison.Target, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonVersionVersionComparison) GetTarget() string {
+	return v.Target
+}
+
+// GetEqual returns CompareDeploymentsCompareDeploymentsDeploymentComparisonVersionVersionComparison.Equal, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsCompareDeploymentsDeploymentComparisonVersionVersionComparison) GetEqual() bool {
+	return v.Equal
+}
+
+// CompareDeploymentsResponse is returned by CompareDeployments on success.
+type CompareDeploymentsResponse struct {
+	// Compare two deployments side-by-side.
+	//
+	// Returns the bundle version on each side and a flat, leaf-level diff of
+	// the snapshotted params. Useful for auditing what a deploy changed, or
+	// for contrasting deploys from different points in time.
+	//
+	// Both deployments must belong to the requesting organization. There is no
+	// requirement that they target the same instance — callers can pass any
+	// two deployments they have access to, though comparisons across unrelated
+	// instances will naturally show every leaf as "only on one side".
+	//
+	// ```graphql
+	// query {
+	// compareDeployments(organizationId: "my-org", sourceId: "<uuid-a>", targetId: "<uuid-b>") {
+	// source { id status version }
+	// target { id status version }
+	// version { source target equal }
+	// params { path source { value } target { value } equal }
+	// }
+	// }
+	// ```
+	CompareDeployments CompareDeploymentsCompareDeploymentsDeploymentComparison `json:"compareDeployments"`
+}
+
+// GetCompareDeployments returns CompareDeploymentsResponse.CompareDeployments, and is useful for accessing the field via an interface.
+func (v *CompareDeploymentsResponse) GetCompareDeployments() CompareDeploymentsCompareDeploymentsDeploymentComparison {
+	return v.CompareDeployments
+}
+
+// Determines how instances receive a dependency of this resource type.
+//
+// When a bundle declares a dependency, the connection orientation of the
+// dependency's resource type controls how it gets satisfied at deploy time.
+type ConnectionOrientation string
+
+const (
+	// The dependency is wired explicitly by drawing a connection between two instances on the canvas. The user chooses which specific instance provides the resource.
+	ConnectionOrientationLink ConnectionOrientation = "LINK"
+	// The dependency is satisfied automatically by an environment-level default. The resource is shared across all instances in the environment without explicit wiring.
+	ConnectionOrientationEnvironmentDefault ConnectionOrientation = "ENVIRONMENT_DEFAULT"
+)
+
+var AllConnectionOrientation = []ConnectionOrientation{
+	ConnectionOrientationLink,
+	ConnectionOrientationEnvironmentDefault,
+}
+
+// Filter which connections to return.
+type ConnectionsFilter struct {
+	// Match by the source (from) instance's ID.
+	FromInstanceId *IdFilter `json:"fromInstanceId,omitempty"`
+	// Match by the destination (to) instance's ID.
+	ToInstanceId *IdFilter `json:"toInstanceId,omitempty"`
+}
+
+// GetFromInstanceId returns ConnectionsFilter.FromInstanceId, and is useful for accessing the field via an interface.
+func (v *ConnectionsFilter) GetFromInstanceId() *IdFilter { return v.FromInstanceId }
+
+// GetToInstanceId returns ConnectionsFilter.ToInstanceId, and is useful for accessing the field via an interface.
+func (v *ConnectionsFilter) GetToInstanceId() *IdFilter { return v.ToInstanceId }
+
+// Sorting options for the connections list.
+type ConnectionsSort struct {
+	// The field to sort by.
+	Field ConnectionsSortField `json:"field"`
+	// Ascending or descending.
+	Order SortOrder `json:"order"`
+}
+
+// GetField returns ConnectionsSort.Field, and is useful for accessing the field via an interface.
+func (v *ConnectionsSort) GetField() ConnectionsSortField { return v.Field }
+
+// GetOrder returns ConnectionsSort.Order, and is useful for accessing the field via an interface.
+func (v *ConnectionsSort) GetOrder() SortOrder { return v.Order }
+
+// Available fields for sorting connections.
+type ConnectionsSortField string
+
+const (
+	// Chronological by creation time (oldest or newest first).
+	ConnectionsSortFieldCreatedAt ConnectionsSortField = "CREATED_AT"
+)
+
+var AllConnectionsSortField = []ConnectionsSortField{
+	ConnectionsSortFieldCreatedAt,
+}
+
 // CopyInstanceCopyInstanceInstancePayload includes the requested fields of the GraphQL type InstancePayload.
 type CopyInstanceCopyInstanceInstancePayload struct {
 	// The object created/updated/deleted by the mutation. May be null if mutation failed.
@@ -5755,6 +6117,38 @@ func (v *CustomAttributeValuesResponse) GetCustomAttributeValues() []string {
 	return v.CustomAttributeValues
 }
 
+// Sorting options for the custom attributes list. Specify a field and direction.
+type CustomAttributesSort struct {
+	// The field to sort by.
+	Field CustomAttributesSortField `json:"field"`
+	// Sort direction (`ASC` or `DESC`).
+	Order SortOrder `json:"order"`
+}
+
+// GetField returns CustomAttributesSort.Field, and is useful for accessing the field via an interface.
+func (v *CustomAttributesSort) GetField() CustomAttributesSortField { return v.Field }
+
+// GetOrder returns CustomAttributesSort.Order, and is useful for accessing the field via an interface.
+func (v *CustomAttributesSort) GetOrder() SortOrder { return v.Order }
+
+// Fields available for sorting the custom attributes list.
+type CustomAttributesSortField string
+
+const (
+	// Sort alphabetically by attribute key name (A-Z or Z-A).
+	CustomAttributesSortFieldKey CustomAttributesSortField = "KEY"
+	// Sort by resource scope level.
+	CustomAttributesSortFieldScope CustomAttributesSortField = "SCOPE"
+	// Sort by creation date (oldest first or newest first).
+	CustomAttributesSortFieldCreatedAt CustomAttributesSortField = "CREATED_AT"
+)
+
+var AllCustomAttributesSortField = []CustomAttributesSortField{
+	CustomAttributesSortFieldKey,
+	CustomAttributesSortFieldScope,
+	CustomAttributesSortFieldCreatedAt,
+}
+
 // Filter by a datetime field.
 //
 // All operators within a single filter are combined with **AND**, which makes it
@@ -9575,6 +9969,8 @@ type GetBundleBundleDependenciesBundleDependencyResourceType struct {
 	Id string `json:"id"`
 	// Human-readable display name (e.g., "AWS IAM Role", "Kubernetes Cluster").
 	Name string `json:"name"`
+	// How instances receive a dependency of this resource type. Determines whether connections are explicit links on the canvas or automatic environment-level defaults.
+	ConnectionOrientation ConnectionOrientation `json:"connectionOrientation"`
 }
 
 // GetId returns GetBundleBundleDependenciesBundleDependencyResourceType.Id, and is useful for accessing the field via an interface.
@@ -9583,6 +9979,11 @@ func (v *GetBundleBundleDependenciesBundleDependencyResourceType) GetId() string
 // GetName returns GetBundleBundleDependenciesBundleDependencyResourceType.Name, and is useful for accessing the field via an interface.
 func (v *GetBundleBundleDependenciesBundleDependencyResourceType) GetName() string { return v.Name }
 
+// GetConnectionOrientation returns GetBundleBundleDependenciesBundleDependencyResourceType.ConnectionOrientation, and is useful for accessing the field via an interface.
+func (v *GetBundleBundleDependenciesBundleDependencyResourceType) GetConnectionOrientation() ConnectionOrientation {
+	return v.ConnectionOrientation
+}
+
 // GetBundleBundleResourcesBundleResource includes the requested fields of the GraphQL type BundleResource.
 // The GraphQL type's documentation follows.
 //
@@ -9631,6 +10032,8 @@ type GetBundleBundleResourcesBundleResourceResourceType struct {
 	Id string `json:"id"`
 	// Human-readable display name (e.g., "AWS IAM Role", "Kubernetes Cluster").
 	Name string `json:"name"`
+	// How instances receive a dependency of this resource type. Determines whether connections are explicit links on the canvas or automatic environment-level defaults.
+	ConnectionOrientation ConnectionOrientation `json:"connectionOrientation"`
 }
 
 // GetId returns GetBundleBundleResourcesBundleResourceResourceType.Id, and is useful for accessing the field via an interface.
@@ -9639,6 +10042,11 @@ func (v *GetBundleBundleResourcesBundleResourceResourceType) GetId() string { re
 // GetName returns GetBundleBundleResourcesBundleResourceResourceType.Name, and is useful for accessing the field via an interface.
 func (v *GetBundleBundleResourcesBundleResourceResourceType) GetName() string { return v.Name }
 
+// GetConnectionOrientation returns GetBundleBundleResourcesBundleResourceResourceType.ConnectionOrientation, and is useful for accessing the field via an interface.
+func (v *GetBundleBundleResourcesBundleResourceResourceType) GetConnectionOrientation() ConnectionOrientation {
+	return v.ConnectionOrientation
+}
+
 // GetBundleResponse is returned by GetBundle on success.
 type GetBundleResponse struct {
 	// Fetch a single bundle by its composite identifier.
@@ -9694,6 +10102,8 @@ type GetComponentComponent struct {
 	Description string `json:"description"`
 	// Key-value attributes assigned directly to this component.
 	Attributes map[string]any `json:"-"`
+	// Position on the visual canvas. Null if never placed.
+	Position *GetComponentComponentPosition `json:"position"`
 	// When this component was created (UTC).
 	CreatedAt time.Time `json:"createdAt"`
 	// When this component was last modified (UTC).
@@ -9716,6 +10126,9 @@ func (v *GetComponentComponent) GetDescription() string { return v.Description }
 // GetAttributes returns GetComponentComponent.Attributes, and is useful for accessing the field via an interface.
 func (v *GetComponentComponent) GetAttributes() map[string]any { return v.Attributes }
 
+// GetPosition returns GetComponentComponent.Position, and is useful for accessing the field via an interface.
+func (v *GetComponentComponent) GetPosition() *GetComponentComponentPosition { return v.Position }
+
 // GetCreatedAt returns GetComponentComponent.CreatedAt, and is useful for accessing the field via an interface.
 func (v *GetComponentComponent) GetCreatedAt() time.Time { return v.CreatedAt }
 
@@ -9770,6 +10183,8 @@ type __premarshalGetComponentComponent struct {
 
 	Attributes json.RawMessage `json:"attributes"`
 
+	Position *GetComponentComponentPosition `json:"position"`
+
 	CreatedAt time.Time `json:"createdAt"`
 
 	UpdatedAt time.Time `json:"updatedAt"`
@@ -9805,6 +10220,7 @@ func (v *GetComponentComponent) __premarshalJSON() (*__premarshalGetComponentCom
 				"unable to marshal GetComponentComponent.Attributes: %w", err)
 		}
 	}
+	retval.Position = v.Position
 	retval.CreatedAt = v.CreatedAt
 	retval.UpdatedAt = v.UpdatedAt
 	retval.OciRepo = v.OciRepo
@@ -9960,6 +10376,23 @@ func (v *GetComponentComponentOciRepo) __premarshalJSON() (*__premarshalGetCompo
 	return &retval, nil
 }
 
+// GetComponentComponentPosition includes the requested fields of the GraphQL type ComponentPosition.
+// The GraphQL type's documentation follows.
+//
+// A component's position on the visual canvas, in pixel coordinates.
+type GetComponentComponentPosition struct {
+	// Horizontal offset in pixels from the canvas origin.
+	X *int `json:"x"`
+	// Vertical offset in pixels from the canvas origin.
+	Y *int `json:"y"`
+}
+
+// GetX returns GetComponentComponentPosition.X, and is useful for accessing the field via an interface.
+func (v *GetComponentComponentPosition) GetX() *int { return v.X }
+
+// GetY returns GetComponentComponentPosition.Y, and is useful for accessing the field via an interface.
+func (v *GetComponentComponentPosition) GetY() *int { return v.Y }
+
 // GetComponentComponentProject includes the requested fields of the GraphQL type Project.
 // The GraphQL type's documentation follows.
 //
@@ -11117,476 +11550,337 @@ func (v *GetEnvironmentEnvironmentProject) __premarshalJSON() (*__premarshalGetE
 	return &retval, nil
 }
 
-// GetEnvironmentResponse is returned by GetEnvironment on success.
-type GetEnvironmentResponse struct {
-	// Fetch a single environment by its identifier.
-	Environment GetEnvironmentEnvironment `json:"environment"`
-}
-
-// GetEnvironment returns GetEnvironmentResponse.Environment, and is useful for accessing the field via an interface.
-func (v *GetEnvironmentResponse) GetEnvironment() GetEnvironmentEnvironment { return v.Environment }
-
-// GetGroupGroup includes the requested fields of the GraphQL type Group.
+// GetEnvironmentGraphEnvironment includes the requested fields of the GraphQL type Environment.
 // The GraphQL type's documentation follows.
 //
-// A collection of users and service accounts that share the same access level within your organization.
-//
-// Groups are the primary mechanism for managing access control in Massdriver. Rather than
-// assigning permissions to individual users, you add them to groups that define what they
-// can see and do.
-//
-// ```mermaid
-// graph TD
-// O["Organization"] --> G1["Group: Admins"]
-// O --> G2["Group: Developers"]
-// O --> G3["Group: Custom"]
-// G1 --> U1["User: alice@co.com"]
-// G2 --> U2["User: bob@co.com"]
-// G2 --> SA1["Service Account: ci-bot"]
-// G3 -->|"project_admin"| P1["Project: backend"]
-// G3 -->|"project_viewer"| P2["Project: frontend"]
-// ```
+// A deployment target within a project where blueprint components become live infrastructure.
 //
-// **Built-in groups** — Every organization starts with an `Admins` group (`organization_admin` role)
-// and a `Viewers` group (`organization_viewer` role). These cannot be deleted.
+// Each project can have multiple environments (e.g., `staging`, `production`). When you deploy
+// to an environment, every component in the project's blueprint is realized as an **Instance** --
+// a running piece of cloud infrastructure with its own configuration, state, and cost data.
 //
-// **Custom groups** — Create custom groups with the `CUSTOM` role to grant project-level access.
-// Each custom group can be assigned `project_admin` or `project_viewer` on specific projects.
+// Environments inherit attributes from their parent project. You can also set environment-scoped attributes
+// that cascade down to all instances within the environment. **Defaults** let you pre-assign
+// resources (like a shared VPC or DNS zone) so that new instances automatically receive them.
 //
-// **Members** — Both human users and service accounts can be group members. Users live under
-// `members` and are added via `addAccountToGroup` (auto-adds existing org members or sends an
-// invitation otherwise). Service accounts live under `serviceAccounts` and are added via
-// `addServiceAccountToGroup`.
-type GetGroupGroup struct {
-	// Unique identifier for this group.
+// Before deleting an environment, all instances must be decommissioned. Use the `deletable`
+// field to check for blocking constraints.
+type GetEnvironmentGraphEnvironment struct {
 	Id string `json:"id"`
-	// Human-readable name displayed in the UI and API responses.
+	// Display name shown in the UI and CLI. Must be unique within the project.
 	Name string `json:"name"`
-	// Optional text explaining the purpose of this group.
-	Description string `json:"description"`
-	// The access level this group grants to its members.
-	Role GroupRole `json:"role"`
-	// When this group was created (UTC).
-	CreatedAt time.Time `json:"createdAt"`
-	// When this group was last modified (UTC).
-	UpdatedAt time.Time `json:"updatedAt"`
+	// Aggregated cloud-provider cost metrics for all instances in this environment.
+	Cost GetEnvironmentGraphEnvironmentCostCostSummary `json:"cost"`
+	// Infrastructure deployed in this environment.
+	Instances []GetEnvironmentGraphEnvironmentInstancesInstance `json:"instances"`
+	// Runtime wiring between deployed instances in this environment.
+	Connections []GetEnvironmentGraphEnvironmentConnectionsConnection `json:"connections"`
 }
 
-// GetId returns GetGroupGroup.Id, and is useful for accessing the field via an interface.
-func (v *GetGroupGroup) GetId() string { return v.Id }
-
-// GetName returns GetGroupGroup.Name, and is useful for accessing the field via an interface.
-func (v *GetGroupGroup) GetName() string { return v.Name }
+// GetId returns GetEnvironmentGraphEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironment) GetId() string { return v.Id }
 
-// GetDescription returns GetGroupGroup.Description, and is useful for accessing the field via an interface.
-func (v *GetGroupGroup) GetDescription() string { return v.Description }
+// GetName returns GetEnvironmentGraphEnvironment.Name, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironment) GetName() string { return v.Name }
 
-// GetRole returns GetGroupGroup.Role, and is useful for accessing the field via an interface.
-func (v *GetGroupGroup) GetRole() GroupRole { return v.Role }
+// GetCost returns GetEnvironmentGraphEnvironment.Cost, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironment) GetCost() GetEnvironmentGraphEnvironmentCostCostSummary {
+	return v.Cost
+}
 
-// GetCreatedAt returns GetGroupGroup.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetGroupGroup) GetCreatedAt() time.Time { return v.CreatedAt }
+// GetInstances returns GetEnvironmentGraphEnvironment.Instances, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironment) GetInstances() []GetEnvironmentGraphEnvironmentInstancesInstance {
+	return v.Instances
+}
 
-// GetUpdatedAt returns GetGroupGroup.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetGroupGroup) GetUpdatedAt() time.Time { return v.UpdatedAt }
+// GetConnections returns GetEnvironmentGraphEnvironment.Connections, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironment) GetConnections() []GetEnvironmentGraphEnvironmentConnectionsConnection {
+	return v.Connections
+}
 
-// GetGroupPolicyGroupPolicy includes the requested fields of the GraphQL type Policy.
+// GetEnvironmentGraphEnvironmentConnectionsConnection includes the requested fields of the GraphQL type Connection.
 // The GraphQL type's documentation follows.
 //
-// A single ABAC group policy: an effect (`ALLOW`/`DENY`), one or more actions,
-// optional attribute conditions, and the group whose members the policy
-// applies to.
+// A runtime wiring between two instances in an environment.
 //
-// Conditions are evaluated AND within a policy and OR across policies on the
-// same group. A policy with no conditions is a wildcard — it matches any
-// resource of each action's entity. Deny policies win over allow policies. A
-// policy can list actions across different entities (for example
-// `project:view` together with `instance:deploy`); for each action, condition
-// keys whose registered attribute scope is unreachable for that action's
-// entity are skipped, and a policy whose conditions all skip for a given
-// action is a wildcard match for that action. See `docs/guides/abac.md` for
-// the full evaluation model.
-type GetGroupPolicyGroupPolicy struct {
-	// Unique identifier for this policy.
+// A connection is the **runtime realization** of a blueprint link. Where a link
+// says "the database component's `authentication` output goes to the app
+// component's `database` input," the connection in each environment carries the
+// *actual* resource data (e.g., a connection string) from the source instance
+// to the destination instance.
+//
+// Connections are created automatically when instances are deployed and a
+// matching blueprint link exists.
+type GetEnvironmentGraphEnvironmentConnectionsConnection struct {
+	// Unique identifier for this connection.
 	Id string `json:"id"`
-	// Whether this policy grants (`ALLOW`) or blocks (`DENY`) the actions.
-	Effect PolicyEffect `json:"effect"`
-	// The actions this policy authorizes, each in `{entity}:{verb}` form (for example `["repo:pull", "instance:deploy"]`). Always non-empty.
-	Actions []string `json:"actions"`
-	// Either `"*"` (the policy is a wildcard — every resource of the entity matches) or a JSON-encoded object of attribute conditions. Keys are attribute names; values are a string or list of strings.
-	Conditions types.PolicyConditions `json:"-"`
-	// When this policy was created.
-	CreatedAt time.Time `json:"createdAt"`
-	// When this policy was last updated.
-	UpdatedAt time.Time `json:"updatedAt"`
-	// The group this policy applies to.
-	Group GetGroupPolicyGroupPolicyGroup `json:"group"`
+	// The output field name on the source instance that produces the resource.
+	FromField string `json:"fromField"`
+	// The input field name on the destination instance that consumes the resource.
+	ToField string `json:"toField"`
+	// The source instance that produces the resource wired through this connection.
+	FromInstance *GetEnvironmentGraphEnvironmentConnectionsConnectionFromInstance `json:"fromInstance"`
+	// The destination instance that consumes the resource wired through this connection.
+	ToInstance *GetEnvironmentGraphEnvironmentConnectionsConnectionToInstance `json:"toInstance"`
 }
 
-// GetId returns GetGroupPolicyGroupPolicy.Id, and is useful for accessing the field via an interface.
-func (v *GetGroupPolicyGroupPolicy) GetId() string { return v.Id }
-
-// GetEffect returns GetGroupPolicyGroupPolicy.Effect, and is useful for accessing the field via an interface.
-func (v *GetGroupPolicyGroupPolicy) GetEffect() PolicyEffect { return v.Effect }
-
-// GetActions returns GetGroupPolicyGroupPolicy.Actions, and is useful for accessing the field via an interface.
-func (v *GetGroupPolicyGroupPolicy) GetActions() []string { return v.Actions }
-
-// GetConditions returns GetGroupPolicyGroupPolicy.Conditions, and is useful for accessing the field via an interface.
-func (v *GetGroupPolicyGroupPolicy) GetConditions() types.PolicyConditions { return v.Conditions }
-
-// GetCreatedAt returns GetGroupPolicyGroupPolicy.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetGroupPolicyGroupPolicy) GetCreatedAt() time.Time { return v.CreatedAt }
-
-// GetUpdatedAt returns GetGroupPolicyGroupPolicy.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetGroupPolicyGroupPolicy) GetUpdatedAt() time.Time { return v.UpdatedAt }
-
-// GetGroup returns GetGroupPolicyGroupPolicy.Group, and is useful for accessing the field via an interface.
-func (v *GetGroupPolicyGroupPolicy) GetGroup() GetGroupPolicyGroupPolicyGroup { return v.Group }
-
-func (v *GetGroupPolicyGroupPolicy) UnmarshalJSON(b []byte) error {
-
-	if string(b) == "null" {
-		return nil
-	}
-
-	var firstPass struct {
-		*GetGroupPolicyGroupPolicy
-		Conditions json.RawMessage `json:"conditions"`
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.GetGroupPolicyGroupPolicy = v
-
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetId returns GetEnvironmentGraphEnvironmentConnectionsConnection.Id, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentConnectionsConnection) GetId() string { return v.Id }
 
-	{
-		dst := &v.Conditions
-		src := firstPass.Conditions
-		if len(src) != 0 && string(src) != "null" {
-			err = scalars.UnmarshalConditions(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal GetGroupPolicyGroupPolicy.Conditions: %w", err)
-			}
-		}
-	}
-	return nil
+// GetFromField returns GetEnvironmentGraphEnvironmentConnectionsConnection.FromField, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentConnectionsConnection) GetFromField() string {
+	return v.FromField
 }
 
-type __premarshalGetGroupPolicyGroupPolicy struct {
-	Id string `json:"id"`
-
-	Effect PolicyEffect `json:"effect"`
-
-	Actions []string `json:"actions"`
-
-	Conditions json.RawMessage `json:"conditions"`
-
-	CreatedAt time.Time `json:"createdAt"`
-
-	UpdatedAt time.Time `json:"updatedAt"`
-
-	Group GetGroupPolicyGroupPolicyGroup `json:"group"`
-}
+// GetToField returns GetEnvironmentGraphEnvironmentConnectionsConnection.ToField, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentConnectionsConnection) GetToField() string { return v.ToField }
 
-func (v *GetGroupPolicyGroupPolicy) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetFromInstance returns GetEnvironmentGraphEnvironmentConnectionsConnection.FromInstance, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentConnectionsConnection) GetFromInstance() *GetEnvironmentGraphEnvironmentConnectionsConnectionFromInstance {
+	return v.FromInstance
 }
 
-func (v *GetGroupPolicyGroupPolicy) __premarshalJSON() (*__premarshalGetGroupPolicyGroupPolicy, error) {
-	var retval __premarshalGetGroupPolicyGroupPolicy
-
-	retval.Id = v.Id
-	retval.Effect = v.Effect
-	retval.Actions = v.Actions
-	{
-
-		dst := &retval.Conditions
-		src := v.Conditions
-		var err error
-		*dst, err = scalars.MarshalConditions(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal GetGroupPolicyGroupPolicy.Conditions: %w", err)
-		}
-	}
-	retval.CreatedAt = v.CreatedAt
-	retval.UpdatedAt = v.UpdatedAt
-	retval.Group = v.Group
-	return &retval, nil
+// GetToInstance returns GetEnvironmentGraphEnvironmentConnectionsConnection.ToInstance, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentConnectionsConnection) GetToInstance() *GetEnvironmentGraphEnvironmentConnectionsConnectionToInstance {
+	return v.ToInstance
 }
 
-// GetGroupPolicyGroupPolicyGroup includes the requested fields of the GraphQL type Group.
+// GetEnvironmentGraphEnvironmentConnectionsConnectionFromInstance includes the requested fields of the GraphQL type Instance.
 // The GraphQL type's documentation follows.
 //
-// A collection of users and service accounts that share the same access level within your organization.
+// A deployed piece of infrastructure in an environment.
 //
-// Groups are the primary mechanism for managing access control in Massdriver. Rather than
-// assigning permissions to individual users, you add them to groups that define what they
-// can see and do.
+// An instance is the **runtime representation** of a component. When you add a
+// "database" component to your blueprint and deploy it to the `staging`
+// environment, Massdriver creates an instance that tracks the database's
+// configuration, deployment state, costs, and produced resources.
+//
+// **Lifecycle:** Instances progress through a well-defined set of states:
 //
 // ```mermaid
-// graph TD
-// O["Organization"] --> G1["Group: Admins"]
-// O --> G2["Group: Developers"]
-// O --> G3["Group: Custom"]
-// G1 --> U1["User: alice@co.com"]
-// G2 --> U2["User: bob@co.com"]
-// G2 --> SA1["Service Account: ci-bot"]
-// G3 -->|"project_admin"| P1["Project: backend"]
-// G3 -->|"project_viewer"| P2["Project: frontend"]
+// stateDiagram-v2
+// [*] --> INITIALIZED: "Component added to environment"
+// INITIALIZED --> PROVISIONED: "Deployment succeeds"
+// INITIALIZED --> FAILED: "Deployment fails"
+// PROVISIONED --> PROVISIONED: "Redeploy / update"
+// PROVISIONED --> DECOMMISSIONED: "Decommission succeeds"
+// PROVISIONED --> FAILED: "Deployment fails"
+// FAILED --> PROVISIONED: "Retry succeeds"
+// FAILED --> DECOMMISSIONED: "Decommission"
 // ```
 //
-// **Built-in groups** — Every organization starts with an `Admins` group (`organization_admin` role)
-// and a `Viewers` group (`organization_viewer` role). These cannot be deleted.
-//
-// **Custom groups** — Create custom groups with the `CUSTOM` role to grant project-level access.
-// Each custom group can be assigned `project_admin` or `project_viewer` on specific projects.
-//
-// **Members** — Both human users and service accounts can be group members. Users live under
-// `members` and are added via `addAccountToGroup` (auto-adds existing org members or sends an
-// invitation otherwise). Service accounts live under `serviceAccounts` and are added via
-// `addServiceAccountToGroup`.
-type GetGroupPolicyGroupPolicyGroup struct {
-	// Unique identifier for this group.
+// **Version resolution:** Each instance has a `version` constraint (e.g., `~1.0`)
+// and a `releaseStrategy` (stable or development). Together these determine
+// the `resolvedVersion` that will be used on the next deployment. Compare
+// `resolvedVersion` with `deployedVersion` to see if a redeployment is needed,
+// or check `availableUpgrade` for newer matching releases.
+type GetEnvironmentGraphEnvironmentConnectionsConnectionFromInstance struct {
 	Id string `json:"id"`
-	// Human-readable name displayed in the UI and API responses.
+	// Name of the instance.
 	Name string `json:"name"`
 }
 
-// GetId returns GetGroupPolicyGroupPolicyGroup.Id, and is useful for accessing the field via an interface.
-func (v *GetGroupPolicyGroupPolicyGroup) GetId() string { return v.Id }
+// GetId returns GetEnvironmentGraphEnvironmentConnectionsConnectionFromInstance.Id, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentConnectionsConnectionFromInstance) GetId() string { return v.Id }
 
-// GetName returns GetGroupPolicyGroupPolicyGroup.Name, and is useful for accessing the field via an interface.
-func (v *GetGroupPolicyGroupPolicyGroup) GetName() string { return v.Name }
+// GetName returns GetEnvironmentGraphEnvironmentConnectionsConnectionFromInstance.Name, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentConnectionsConnectionFromInstance) GetName() string {
+	return v.Name
+}
 
-// GetGroupPolicyResponse is returned by GetGroupPolicy on success.
-type GetGroupPolicyResponse struct {
-	// Fetch a single ABAC group policy by its unique identifier.
-	//
-	// Returns `null` with a `NOT_FOUND` error if the policy does not exist in
-	// your organization. Use this when you already know the policy's id — for
-	// example, when the terraform provider needs to read a policy it previously
-	// created. To browse policies attached to a group, query the nested
-	// `Group.policies` field instead.
-	GroupPolicy GetGroupPolicyGroupPolicy `json:"groupPolicy"`
+// GetEnvironmentGraphEnvironmentConnectionsConnectionToInstance includes the requested fields of the GraphQL type Instance.
+// The GraphQL type's documentation follows.
+//
+// A deployed piece of infrastructure in an environment.
+//
+// An instance is the **runtime representation** of a component. When you add a
+// "database" component to your blueprint and deploy it to the `staging`
+// environment, Massdriver creates an instance that tracks the database's
+// configuration, deployment state, costs, and produced resources.
+//
+// **Lifecycle:** Instances progress through a well-defined set of states:
+//
+// ```mermaid
+// stateDiagram-v2
+// [*] --> INITIALIZED: "Component added to environment"
+// INITIALIZED --> PROVISIONED: "Deployment succeeds"
+// INITIALIZED --> FAILED: "Deployment fails"
+// PROVISIONED --> PROVISIONED: "Redeploy / update"
+// PROVISIONED --> DECOMMISSIONED: "Decommission succeeds"
+// PROVISIONED --> FAILED: "Deployment fails"
+// FAILED --> PROVISIONED: "Retry succeeds"
+// FAILED --> DECOMMISSIONED: "Decommission"
+// ```
+//
+// **Version resolution:** Each instance has a `version` constraint (e.g., `~1.0`)
+// and a `releaseStrategy` (stable or development). Together these determine
+// the `resolvedVersion` that will be used on the next deployment. Compare
+// `resolvedVersion` with `deployedVersion` to see if a redeployment is needed,
+// or check `availableUpgrade` for newer matching releases.
+type GetEnvironmentGraphEnvironmentConnectionsConnectionToInstance struct {
+	Id string `json:"id"`
+	// Name of the instance.
+	Name string `json:"name"`
 }
 
-// GetGroupPolicy returns GetGroupPolicyResponse.GroupPolicy, and is useful for accessing the field via an interface.
-func (v *GetGroupPolicyResponse) GetGroupPolicy() GetGroupPolicyGroupPolicy { return v.GroupPolicy }
+// GetId returns GetEnvironmentGraphEnvironmentConnectionsConnectionToInstance.Id, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentConnectionsConnectionToInstance) GetId() string { return v.Id }
 
-// GetGroupResponse is returned by GetGroup on success.
-type GetGroupResponse struct {
-	// Retrieve a single group by its identifier.
-	//
-	// Returns `null` with a `NOT_FOUND` error if the group does not exist or you do not have
-	// permission to view it.
-	Group GetGroupGroup `json:"group"`
+// GetName returns GetEnvironmentGraphEnvironmentConnectionsConnectionToInstance.Name, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentConnectionsConnectionToInstance) GetName() string {
+	return v.Name
 }
 
-// GetGroup returns GetGroupResponse.Group, and is useful for accessing the field via an interface.
-func (v *GetGroupResponse) GetGroup() GetGroupGroup { return v.Group }
-
-// GetInstanceAlarmInstanceAlarm includes the requested fields of the GraphQL type Alarm.
+// GetEnvironmentGraphEnvironmentCostCostSummary includes the requested fields of the GraphQL type CostSummary.
 // The GraphQL type's documentation follows.
 //
-// A cloud metric alarm attached to an instance.
+// Aggregated cloud-provider cost metrics for a project or environment.
 //
-// Receives state updates via webhooks from AWS CloudWatch, Azure Monitor,
-// GCP Cloud Monitoring, or Prometheus Alertmanager.
+// Cost data is sourced from your cloud provider's billing APIs and refreshed periodically.
+// Each metric is a `CostSample` containing an amount and currency. All four metrics are
+// always present, but their inner `amount` and `currency` may be null if billing data has
+// not yet been ingested.
 //
-// Check `currentState` to see whether the alarm is firing. A `null`
-// `currentState` means no state has been reported yet for this alarm.
-type GetInstanceAlarmInstanceAlarm struct {
-	// Unique identifier for this alarm.
-	Id string `json:"id"`
-	// Human-readable name for the alarm, set by the cloud provider when the alarm was registered.
-	DisplayName string `json:"displayName"`
-	// The cloud provider's unique identifier for the alarm (e.g., CloudWatch AlarmArn, GCP alert policy name, Azure alert id).
-	CloudResourceId string `json:"cloudResourceId"`
-	// How the metric is compared against `threshold` (e.g., `GREATER_THAN`, `LESS_THAN`, `GREATER_THAN_OR_EQUAL_TO`, `LESS_THAN_OR_EQUAL_TO`). May be null for Alertmanager and GCP alarms.
-	ComparisonOperator string `json:"comparisonOperator"`
-	// The value crossed to trigger the alarm, compared using `comparisonOperator`. May be null for Alertmanager alarms and some GCP conditions.
-	Threshold float64 `json:"threshold"`
-	// Evaluation window in seconds over which the metric is aggregated before the comparison is applied. May be null for alarms ingested from providers that don't expose a period.
-	Period int `json:"period"`
-	// The cloud metric this alarm evaluates. May be null for alarms from providers that don't supply structured metric data (e.g., Alertmanager).
-	Metric *GetInstanceAlarmInstanceAlarmMetric `json:"metric"`
-	// The most recent state reported for this alarm. `null` indicates no state has been recorded yet.
-	CurrentState *GetInstanceAlarmInstanceAlarmCurrentStateAlarmState `json:"currentState"`
-	// When this alarm was registered with Massdriver (UTC).
-	CreatedAt time.Time `json:"createdAt"`
-	// When this alarm's configuration last changed (UTC).
-	UpdatedAt time.Time `json:"updatedAt"`
+// - **last_month** -- Total spend for the most recent complete billing cycle.
+// - **monthly_average** -- Average monthly spend across all available billing cycles.
+// - **last_day** -- Total spend for the most recent 24-hour period.
+// - **daily_average** -- Average daily spend over the last 7 days.
+type GetEnvironmentGraphEnvironmentCostCostSummary struct {
+	// Total cost for the most recent complete billing cycle.
+	LastMonth GetEnvironmentGraphEnvironmentCostCostSummaryLastMonthCostSample `json:"lastMonth"`
+	// Average monthly cost across all available billing cycles.
+	MonthlyAverage GetEnvironmentGraphEnvironmentCostCostSummaryMonthlyAverageCostSample `json:"monthlyAverage"`
+	// Total cost for the most recent 24-hour period.
+	LastDay GetEnvironmentGraphEnvironmentCostCostSummaryLastDayCostSample `json:"lastDay"`
+	// Average daily cost over the last 7 days.
+	DailyAverage GetEnvironmentGraphEnvironmentCostCostSummaryDailyAverageCostSample `json:"dailyAverage"`
 }
 
-// GetId returns GetInstanceAlarmInstanceAlarm.Id, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarm) GetId() string { return v.Id }
-
-// GetDisplayName returns GetInstanceAlarmInstanceAlarm.DisplayName, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarm) GetDisplayName() string { return v.DisplayName }
-
-// GetCloudResourceId returns GetInstanceAlarmInstanceAlarm.CloudResourceId, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarm) GetCloudResourceId() string { return v.CloudResourceId }
-
-// GetComparisonOperator returns GetInstanceAlarmInstanceAlarm.ComparisonOperator, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarm) GetComparisonOperator() string { return v.ComparisonOperator }
-
-// GetThreshold returns GetInstanceAlarmInstanceAlarm.Threshold, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarm) GetThreshold() float64 { return v.Threshold }
-
-// GetPeriod returns GetInstanceAlarmInstanceAlarm.Period, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarm) GetPeriod() int { return v.Period }
-
-// GetMetric returns GetInstanceAlarmInstanceAlarm.Metric, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarm) GetMetric() *GetInstanceAlarmInstanceAlarmMetric {
-	return v.Metric
+// GetLastMonth returns GetEnvironmentGraphEnvironmentCostCostSummary.LastMonth, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentCostCostSummary) GetLastMonth() GetEnvironmentGraphEnvironmentCostCostSummaryLastMonthCostSample {
+	return v.LastMonth
 }
 
-// GetCurrentState returns GetInstanceAlarmInstanceAlarm.CurrentState, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarm) GetCurrentState() *GetInstanceAlarmInstanceAlarmCurrentStateAlarmState {
-	return v.CurrentState
+// GetMonthlyAverage returns GetEnvironmentGraphEnvironmentCostCostSummary.MonthlyAverage, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentCostCostSummary) GetMonthlyAverage() GetEnvironmentGraphEnvironmentCostCostSummaryMonthlyAverageCostSample {
+	return v.MonthlyAverage
 }
 
-// GetCreatedAt returns GetInstanceAlarmInstanceAlarm.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarm) GetCreatedAt() time.Time { return v.CreatedAt }
+// GetLastDay returns GetEnvironmentGraphEnvironmentCostCostSummary.LastDay, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentCostCostSummary) GetLastDay() GetEnvironmentGraphEnvironmentCostCostSummaryLastDayCostSample {
+	return v.LastDay
+}
 
-// GetUpdatedAt returns GetInstanceAlarmInstanceAlarm.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarm) GetUpdatedAt() time.Time { return v.UpdatedAt }
+// GetDailyAverage returns GetEnvironmentGraphEnvironmentCostCostSummary.DailyAverage, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentCostCostSummary) GetDailyAverage() GetEnvironmentGraphEnvironmentCostCostSummaryDailyAverageCostSample {
+	return v.DailyAverage
+}
 
-// GetInstanceAlarmInstanceAlarmCurrentStateAlarmState includes the requested fields of the GraphQL type AlarmState.
+// GetEnvironmentGraphEnvironmentCostCostSummaryDailyAverageCostSample includes the requested fields of the GraphQL type CostSample.
 // The GraphQL type's documentation follows.
 //
-// A single state transition reported for an alarm.
+// A single cost data point containing an amount and its currency.
 //
-// Alarm states are append-only and recorded each time the alarm's status changes
-// (duplicate `OK -> OK` or `ALARM -> ALARM` updates are deduplicated at ingest).
-// The most recent state is exposed as `alarm.currentState`.
-type GetInstanceAlarmInstanceAlarmCurrentStateAlarmState struct {
-	// Unique identifier for this state record.
-	Id string `json:"id"`
-	// Whether the alarm is firing (`ALARM`) or clear (`OK`).
-	Status AlarmStatus `json:"status"`
-	// Provider-supplied human-readable message describing the state change.
-	Message string `json:"message"`
-	// When the state change occurred in the cloud provider (UTC).
-	OccurredAt time.Time `json:"occurredAt"`
+// Both `amount` and `currency` are nullable. A `null` amount means Massdriver has no cost
+// data for the requested period -- this is normal for newly provisioned resources or when
+// cloud provider billing data has not yet been ingested. When data is present, `amount` is
+// always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
+type GetEnvironmentGraphEnvironmentCostCostSummaryDailyAverageCostSample struct {
+	// The cost in the given currency. Null when no billing data is available for this period.
+	Amount float64 `json:"amount"`
+	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
+	Currency string `json:"currency"`
 }
 
-// GetId returns GetInstanceAlarmInstanceAlarmCurrentStateAlarmState.Id, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarmCurrentStateAlarmState) GetId() string { return v.Id }
-
-// GetStatus returns GetInstanceAlarmInstanceAlarmCurrentStateAlarmState.Status, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarmCurrentStateAlarmState) GetStatus() AlarmStatus {
-	return v.Status
+// GetAmount returns GetEnvironmentGraphEnvironmentCostCostSummaryDailyAverageCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentCostCostSummaryDailyAverageCostSample) GetAmount() float64 {
+	return v.Amount
 }
 
-// GetMessage returns GetInstanceAlarmInstanceAlarmCurrentStateAlarmState.Message, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarmCurrentStateAlarmState) GetMessage() string { return v.Message }
-
-// GetOccurredAt returns GetInstanceAlarmInstanceAlarmCurrentStateAlarmState.OccurredAt, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarmCurrentStateAlarmState) GetOccurredAt() time.Time {
-	return v.OccurredAt
+// GetCurrency returns GetEnvironmentGraphEnvironmentCostCostSummaryDailyAverageCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentCostCostSummaryDailyAverageCostSample) GetCurrency() string {
+	return v.Currency
 }
 
-// GetInstanceAlarmInstanceAlarmMetric includes the requested fields of the GraphQL type AlarmMetric.
+// GetEnvironmentGraphEnvironmentCostCostSummaryLastDayCostSample includes the requested fields of the GraphQL type CostSample.
 // The GraphQL type's documentation follows.
 //
-// The cloud metric an alarm is evaluating.
+// A single cost data point containing an amount and its currency.
 //
-// Shape and populated fields vary by provider. AWS and Azure populate
-// `statistic` (e.g., `Average`, `Sum`, `Maximum`); GCP does not. `dimensions`
-// are populated when the provider exposes them as structured key-value pairs.
-type GetInstanceAlarmInstanceAlarmMetric struct {
-	// Cloud service namespace that categorizes the metric. Examples: `AWS/RDS`, `Microsoft.Cache/Redis`, `cloudsql_database`.
-	Namespace string `json:"namespace"`
-	// Metric name within the namespace. Examples: `CPUUtilization` (AWS), `allpercentprocessortime` (Azure).
-	Name string `json:"name"`
-	// Aggregation function applied to metric samples. Examples: `Average`, `Sum`, `Maximum`. May be `null` for providers that don't use this concept (e.g., GCP).
-	Statistic string `json:"statistic"`
-	// Cloud region this metric is scoped to, when provider-reported.
-	Region string `json:"region"`
-	// Dimensions identifying the specific cloud resource being monitored. Empty list when the provider doesn't report structured dimensions.
-	Dimensions []GetInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension `json:"dimensions"`
+// Both `amount` and `currency` are nullable. A `null` amount means Massdriver has no cost
+// data for the requested period -- this is normal for newly provisioned resources or when
+// cloud provider billing data has not yet been ingested. When data is present, `amount` is
+// always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
+type GetEnvironmentGraphEnvironmentCostCostSummaryLastDayCostSample struct {
+	// The cost in the given currency. Null when no billing data is available for this period.
+	Amount float64 `json:"amount"`
+	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
+	Currency string `json:"currency"`
 }
 
-// GetNamespace returns GetInstanceAlarmInstanceAlarmMetric.Namespace, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarmMetric) GetNamespace() string { return v.Namespace }
-
-// GetName returns GetInstanceAlarmInstanceAlarmMetric.Name, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarmMetric) GetName() string { return v.Name }
-
-// GetStatistic returns GetInstanceAlarmInstanceAlarmMetric.Statistic, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarmMetric) GetStatistic() string { return v.Statistic }
-
-// GetRegion returns GetInstanceAlarmInstanceAlarmMetric.Region, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarmMetric) GetRegion() string { return v.Region }
+// GetAmount returns GetEnvironmentGraphEnvironmentCostCostSummaryLastDayCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentCostCostSummaryLastDayCostSample) GetAmount() float64 {
+	return v.Amount
+}
 
-// GetDimensions returns GetInstanceAlarmInstanceAlarmMetric.Dimensions, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarmMetric) GetDimensions() []GetInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension {
-	return v.Dimensions
+// GetCurrency returns GetEnvironmentGraphEnvironmentCostCostSummaryLastDayCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentCostCostSummaryLastDayCostSample) GetCurrency() string {
+	return v.Currency
 }
 
-// GetInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension includes the requested fields of the GraphQL type AlarmMetricDimension.
+// GetEnvironmentGraphEnvironmentCostCostSummaryLastMonthCostSample includes the requested fields of the GraphQL type CostSample.
 // The GraphQL type's documentation follows.
 //
-// A key-value pair identifying the specific cloud resource a metric applies to.
+// A single cost data point containing an amount and its currency.
 //
-// Examples: `{ name: "DBInstanceIdentifier", value: "db-abc123" }` for AWS RDS,
-// `{ name: "InstanceId", value: "i-0a1b2c3d" }` for AWS EC2.
-type GetInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension struct {
-	// Dimension name as defined by the cloud provider.
-	Name string `json:"name"`
-	// Dimension value identifying the monitored resource.
-	Value string `json:"value"`
+// Both `amount` and `currency` are nullable. A `null` amount means Massdriver has no cost
+// data for the requested period -- this is normal for newly provisioned resources or when
+// cloud provider billing data has not yet been ingested. When data is present, `amount` is
+// always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
+type GetEnvironmentGraphEnvironmentCostCostSummaryLastMonthCostSample struct {
+	// The cost in the given currency. Null when no billing data is available for this period.
+	Amount float64 `json:"amount"`
+	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
+	Currency string `json:"currency"`
 }
 
-// GetName returns GetInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension.Name, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension) GetName() string {
-	return v.Name
+// GetAmount returns GetEnvironmentGraphEnvironmentCostCostSummaryLastMonthCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentCostCostSummaryLastMonthCostSample) GetAmount() float64 {
+	return v.Amount
 }
 
-// GetValue returns GetInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension.Value, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension) GetValue() string {
-	return v.Value
+// GetCurrency returns GetEnvironmentGraphEnvironmentCostCostSummaryLastMonthCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentCostCostSummaryLastMonthCostSample) GetCurrency() string {
+	return v.Currency
 }
 
-// GetInstanceAlarmResponse is returned by GetInstanceAlarm on success.
-type GetInstanceAlarmResponse struct {
-	// Fetch a single alarm by its unique identifier.
-	//
-	// Returns the alarm with its most recent `currentState` attached, or a
-	// `NOT_FOUND` error if the alarm doesn't exist or its project isn't
-	// visible to the caller.
-	//
-	// ```graphql
-	// query {
-	// instanceAlarm(organizationId: "my-org", id: "0192…uuid") {
-	// id
-	// displayName
-	// currentState { status occurredAt }
-	// metric { namespace name }
-	// }
-	// }
-	// ```
-	InstanceAlarm GetInstanceAlarmInstanceAlarm `json:"instanceAlarm"`
+// GetEnvironmentGraphEnvironmentCostCostSummaryMonthlyAverageCostSample includes the requested fields of the GraphQL type CostSample.
+// The GraphQL type's documentation follows.
+//
+// A single cost data point containing an amount and its currency.
+//
+// Both `amount` and `currency` are nullable. A `null` amount means Massdriver has no cost
+// data for the requested period -- this is normal for newly provisioned resources or when
+// cloud provider billing data has not yet been ingested. When data is present, `amount` is
+// always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
+type GetEnvironmentGraphEnvironmentCostCostSummaryMonthlyAverageCostSample struct {
+	// The cost in the given currency. Null when no billing data is available for this period.
+	Amount float64 `json:"amount"`
+	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
+	Currency string `json:"currency"`
 }
 
-// GetInstanceAlarm returns GetInstanceAlarmResponse.InstanceAlarm, and is useful for accessing the field via an interface.
-func (v *GetInstanceAlarmResponse) GetInstanceAlarm() GetInstanceAlarmInstanceAlarm {
-	return v.InstanceAlarm
+// GetAmount returns GetEnvironmentGraphEnvironmentCostCostSummaryMonthlyAverageCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentCostCostSummaryMonthlyAverageCostSample) GetAmount() float64 {
+	return v.Amount
 }
 
-// GetInstanceInstance includes the requested fields of the GraphQL type Instance.
+// GetCurrency returns GetEnvironmentGraphEnvironmentCostCostSummaryMonthlyAverageCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentCostCostSummaryMonthlyAverageCostSample) GetCurrency() string {
+	return v.Currency
+}
+
+// GetEnvironmentGraphEnvironmentInstancesInstance includes the requested fields of the GraphQL type Instance.
 // The GraphQL type's documentation follows.
 //
 // A deployed piece of infrastructure in an environment.
@@ -11615,7 +11909,7 @@ func (v *GetInstanceAlarmResponse) GetInstanceAlarm() GetInstanceAlarmInstanceAl
 // the `resolvedVersion` that will be used on the next deployment. Compare
 // `resolvedVersion` with `deployedVersion` to see if a redeployment is needed,
 // or check `availableUpgrade` for newer matching releases.
-type GetInstanceInstance struct {
+type GetEnvironmentGraphEnvironmentInstancesInstance struct {
 	Id string `json:"id"`
 	// Name of the instance.
 	Name string `json:"name"`
@@ -11633,279 +11927,52 @@ type GetInstanceInstance struct {
 	// May differ from `resolvedVersion` if the version constraint has been updated
 	// but no deployment has occurred yet. Null if the instance has never been deployed.
 	DeployedVersion string `json:"deployedVersion"`
-	// The newest bundle version available that satisfies the version constraint.
-	//
-	// Returns null if the instance is already on the latest matching version.
-	// Use this field to detect when an upgrade is available.
-	AvailableUpgrade string `json:"availableUpgrade"`
-	// Cached configuration parameters from the most recent deployment. Null if the instance has never been deployed.
-	Params map[string]any `json:"-"`
-	// JSON Schema describing the configuration parameters this instance accepts.
-	//
-	// The schema is sourced from the instance's resolved bundle release with
-	// Massdriver's `$md` extensions evaluated against the current instance state:
-	//
-	// - `$md.enum` is replaced with a `oneOf` list whose entries are computed by
-	// running the configured jq expression against the connected resource's
-	// payload. Missing connections or jq errors produce a single placeholder
-	// entry whose `title` begins with `ERROR:`.
-	// - `$md.immutable` is rewritten to `readOnly: true` once the instance has
-	// reached a state where the field can no longer be changed (`PROVISIONED`
-	// or `FAILED`).
-	//
-	// Use this schema to drive form rendering, client-side validation, or to
-	// inspect the contract between the bundle and the deployer.
-	ParamsSchema map[string]any `json:"-"`
-	// Key-value attributes assigned directly to this instance.
-	Attributes map[string]any `json:"-"`
-	// When this instance was created (UTC).
-	CreatedAt time.Time `json:"createdAt"`
-	// When this instance was last modified (UTC).
-	UpdatedAt time.Time `json:"updatedAt"`
 	// Cloud provider cost summary for this instance, including daily and monthly breakdowns.
-	Cost GetInstanceInstanceCostCostSummary `json:"cost"`
-	// Terraform/OpenTofu state paths for each provisioning step, ordered by the bundle's step definition.
-	//
-	// Each bundle can define multiple steps (e.g., `core`, `iam`, `monitoring`). Use the
-	// `stateUrl` to configure your Terraform backend or inspect state externally.
-	StatePaths []GetInstanceInstanceStatePathsInstanceStatePath `json:"statePaths"`
-	// The environment this instance is deployed in.
-	Environment GetInstanceInstanceEnvironment `json:"environment"`
+	Cost GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummary `json:"cost"`
 	// The bundle release currently resolved for this instance.
-	Bundle GetInstanceInstanceBundle `json:"bundle"`
+	Bundle *GetEnvironmentGraphEnvironmentInstancesInstanceBundle `json:"bundle"`
 	// The component this instance was deployed from.
-	Component GetInstanceInstanceComponent `json:"component"`
-	// Resources produced by this instance, sorted alphabetically by field.
-	//
-	// Resources are the outputs published after a successful deployment
-	// (e.g., connection strings, endpoints, credentials). Other instances consume
-	// these resources via connections.
-	Resources []GetInstanceInstanceResourcesInstanceResource `json:"resources"`
+	Component GetEnvironmentGraphEnvironmentInstancesInstanceComponent `json:"component"`
 }
 
-// GetId returns GetInstanceInstance.Id, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstance) GetId() string { return v.Id }
-
-// GetName returns GetInstanceInstance.Name, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstance) GetName() string { return v.Name }
-
-// GetStatus returns GetInstanceInstance.Status, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstance) GetStatus() InstanceStatus { return v.Status }
-
-// GetVersion returns GetInstanceInstance.Version, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstance) GetVersion() string { return v.Version }
-
-// GetResolvedVersion returns GetInstanceInstance.ResolvedVersion, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstance) GetResolvedVersion() string { return v.ResolvedVersion }
-
-// GetDeployedVersion returns GetInstanceInstance.DeployedVersion, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstance) GetDeployedVersion() string { return v.DeployedVersion }
-
-// GetAvailableUpgrade returns GetInstanceInstance.AvailableUpgrade, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstance) GetAvailableUpgrade() string { return v.AvailableUpgrade }
-
-// GetParams returns GetInstanceInstance.Params, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstance) GetParams() map[string]any { return v.Params }
-
-// GetParamsSchema returns GetInstanceInstance.ParamsSchema, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstance) GetParamsSchema() map[string]any { return v.ParamsSchema }
-
-// GetAttributes returns GetInstanceInstance.Attributes, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstance) GetAttributes() map[string]any { return v.Attributes }
-
-// GetCreatedAt returns GetInstanceInstance.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstance) GetCreatedAt() time.Time { return v.CreatedAt }
-
-// GetUpdatedAt returns GetInstanceInstance.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstance) GetUpdatedAt() time.Time { return v.UpdatedAt }
-
-// GetCost returns GetInstanceInstance.Cost, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstance) GetCost() GetInstanceInstanceCostCostSummary { return v.Cost }
-
-// GetStatePaths returns GetInstanceInstance.StatePaths, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstance) GetStatePaths() []GetInstanceInstanceStatePathsInstanceStatePath {
-	return v.StatePaths
-}
+// GetId returns GetEnvironmentGraphEnvironmentInstancesInstance.Id, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstance) GetId() string { return v.Id }
 
-// GetEnvironment returns GetInstanceInstance.Environment, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstance) GetEnvironment() GetInstanceInstanceEnvironment { return v.Environment }
+// GetName returns GetEnvironmentGraphEnvironmentInstancesInstance.Name, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstance) GetName() string { return v.Name }
 
-// GetBundle returns GetInstanceInstance.Bundle, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstance) GetBundle() GetInstanceInstanceBundle { return v.Bundle }
+// GetStatus returns GetEnvironmentGraphEnvironmentInstancesInstance.Status, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstance) GetStatus() InstanceStatus { return v.Status }
 
-// GetComponent returns GetInstanceInstance.Component, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstance) GetComponent() GetInstanceInstanceComponent { return v.Component }
+// GetVersion returns GetEnvironmentGraphEnvironmentInstancesInstance.Version, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstance) GetVersion() string { return v.Version }
 
-// GetResources returns GetInstanceInstance.Resources, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstance) GetResources() []GetInstanceInstanceResourcesInstanceResource {
-	return v.Resources
+// GetResolvedVersion returns GetEnvironmentGraphEnvironmentInstancesInstance.ResolvedVersion, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstance) GetResolvedVersion() string {
+	return v.ResolvedVersion
 }
 
-func (v *GetInstanceInstance) UnmarshalJSON(b []byte) error {
-
-	if string(b) == "null" {
-		return nil
-	}
-
-	var firstPass struct {
-		*GetInstanceInstance
-		Params       json.RawMessage `json:"params"`
-		ParamsSchema json.RawMessage `json:"paramsSchema"`
-		Attributes   json.RawMessage `json:"attributes"`
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.GetInstanceInstance = v
-
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
-
-	{
-		dst := &v.Params
-		src := firstPass.Params
-		if len(src) != 0 && string(src) != "null" {
-			err = scalars.UnmarshalJSON(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal GetInstanceInstance.Params: %w", err)
-			}
-		}
-	}
-
-	{
-		dst := &v.ParamsSchema
-		src := firstPass.ParamsSchema
-		if len(src) != 0 && string(src) != "null" {
-			err = scalars.UnmarshalJSON(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal GetInstanceInstance.ParamsSchema: %w", err)
-			}
-		}
-	}
-
-	{
-		dst := &v.Attributes
-		src := firstPass.Attributes
-		if len(src) != 0 && string(src) != "null" {
-			err = scalars.UnmarshalJSON(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal GetInstanceInstance.Attributes: %w", err)
-			}
-		}
-	}
-	return nil
+// GetDeployedVersion returns GetEnvironmentGraphEnvironmentInstancesInstance.DeployedVersion, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstance) GetDeployedVersion() string {
+	return v.DeployedVersion
 }
 
-type __premarshalGetInstanceInstance struct {
-	Id string `json:"id"`
-
-	Name string `json:"name"`
-
-	Status InstanceStatus `json:"status"`
-
-	Version string `json:"version"`
-
-	ResolvedVersion string `json:"resolvedVersion"`
-
-	DeployedVersion string `json:"deployedVersion"`
-
-	AvailableUpgrade string `json:"availableUpgrade"`
-
-	Params json.RawMessage `json:"params"`
-
-	ParamsSchema json.RawMessage `json:"paramsSchema"`
-
-	Attributes json.RawMessage `json:"attributes"`
-
-	CreatedAt time.Time `json:"createdAt"`
-
-	UpdatedAt time.Time `json:"updatedAt"`
-
-	Cost GetInstanceInstanceCostCostSummary `json:"cost"`
-
-	StatePaths []GetInstanceInstanceStatePathsInstanceStatePath `json:"statePaths"`
-
-	Environment GetInstanceInstanceEnvironment `json:"environment"`
-
-	Bundle GetInstanceInstanceBundle `json:"bundle"`
-
-	Component GetInstanceInstanceComponent `json:"component"`
-
-	Resources []GetInstanceInstanceResourcesInstanceResource `json:"resources"`
+// GetCost returns GetEnvironmentGraphEnvironmentInstancesInstance.Cost, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstance) GetCost() GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummary {
+	return v.Cost
 }
 
-func (v *GetInstanceInstance) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetBundle returns GetEnvironmentGraphEnvironmentInstancesInstance.Bundle, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstance) GetBundle() *GetEnvironmentGraphEnvironmentInstancesInstanceBundle {
+	return v.Bundle
 }
 
-func (v *GetInstanceInstance) __premarshalJSON() (*__premarshalGetInstanceInstance, error) {
-	var retval __premarshalGetInstanceInstance
-
-	retval.Id = v.Id
-	retval.Name = v.Name
-	retval.Status = v.Status
-	retval.Version = v.Version
-	retval.ResolvedVersion = v.ResolvedVersion
-	retval.DeployedVersion = v.DeployedVersion
-	retval.AvailableUpgrade = v.AvailableUpgrade
-	{
-
-		dst := &retval.Params
-		src := v.Params
-		var err error
-		*dst, err = scalars.MarshalJSON(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal GetInstanceInstance.Params: %w", err)
-		}
-	}
-	{
-
-		dst := &retval.ParamsSchema
-		src := v.ParamsSchema
-		var err error
-		*dst, err = scalars.MarshalJSON(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal GetInstanceInstance.ParamsSchema: %w", err)
-		}
-	}
-	{
-
-		dst := &retval.Attributes
-		src := v.Attributes
-		var err error
-		*dst, err = scalars.MarshalJSON(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal GetInstanceInstance.Attributes: %w", err)
-		}
-	}
-	retval.CreatedAt = v.CreatedAt
-	retval.UpdatedAt = v.UpdatedAt
-	retval.Cost = v.Cost
-	retval.StatePaths = v.StatePaths
-	retval.Environment = v.Environment
-	retval.Bundle = v.Bundle
-	retval.Component = v.Component
-	retval.Resources = v.Resources
-	return &retval, nil
+// GetComponent returns GetEnvironmentGraphEnvironmentInstancesInstance.Component, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstance) GetComponent() GetEnvironmentGraphEnvironmentInstancesInstanceComponent {
+	return v.Component
 }
 
-// GetInstanceInstanceBundle includes the requested fields of the GraphQL type Bundle.
+// GetEnvironmentGraphEnvironmentInstancesInstanceBundle includes the requested fields of the GraphQL type Bundle.
 // The GraphQL type's documentation follows.
 //
 // A versioned infrastructure-as-code package.
@@ -11931,55 +11998,25 @@ func (v *GetInstanceInstance) __premarshalJSON() (*__premarshalGetInstanceInstan
 // B --> D2["Dependency: aws-vpc"]
 // B --> RES["Resource: aurora-cluster"]
 // ```
-type GetInstanceInstanceBundle struct {
+type GetEnvironmentGraphEnvironmentInstancesInstanceBundle struct {
 	// Composite identifier in `name@version` format (e.g., `aws-aurora-postgres@1.2.3`). Always contains the fully resolved semver version.
 	Id string `json:"id"`
 	// OCI repository name this bundle belongs to (e.g., `aws-aurora-postgres`).
 	Name string `json:"name"`
 	// Fully resolved semantic version of this bundle (e.g., `1.2.3`).
 	Version string `json:"version"`
-	// Short summary of what this bundle provisions.
-	Description string `json:"description"`
-	// URL to the bundle's display icon.
-	Icon string `json:"icon"`
-	// URL to the bundle's source code repository, if published by the author.
-	SourceUrl string `json:"sourceUrl"`
-	// OCI repository name for this bundle (e.g., `aws-aurora-postgres`). Equivalent to `name`.
-	Repo string `json:"repo"`
-	// Timestamp when this bundle version was first published (UTC).
-	CreatedAt time.Time `json:"createdAt"`
-	// Timestamp when this bundle version was last modified (UTC).
-	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-// GetId returns GetInstanceInstanceBundle.Id, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceBundle) GetId() string { return v.Id }
-
-// GetName returns GetInstanceInstanceBundle.Name, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceBundle) GetName() string { return v.Name }
-
-// GetVersion returns GetInstanceInstanceBundle.Version, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceBundle) GetVersion() string { return v.Version }
-
-// GetDescription returns GetInstanceInstanceBundle.Description, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceBundle) GetDescription() string { return v.Description }
-
-// GetIcon returns GetInstanceInstanceBundle.Icon, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceBundle) GetIcon() string { return v.Icon }
+// GetId returns GetEnvironmentGraphEnvironmentInstancesInstanceBundle.Id, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstanceBundle) GetId() string { return v.Id }
 
-// GetSourceUrl returns GetInstanceInstanceBundle.SourceUrl, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceBundle) GetSourceUrl() string { return v.SourceUrl }
-
-// GetRepo returns GetInstanceInstanceBundle.Repo, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceBundle) GetRepo() string { return v.Repo }
+// GetName returns GetEnvironmentGraphEnvironmentInstancesInstanceBundle.Name, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstanceBundle) GetName() string { return v.Name }
 
-// GetCreatedAt returns GetInstanceInstanceBundle.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceBundle) GetCreatedAt() time.Time { return v.CreatedAt }
+// GetVersion returns GetEnvironmentGraphEnvironmentInstancesInstanceBundle.Version, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstanceBundle) GetVersion() string { return v.Version }
 
-// GetUpdatedAt returns GetInstanceInstanceBundle.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceBundle) GetUpdatedAt() time.Time { return v.UpdatedAt }
-
-// GetInstanceInstanceComponent includes the requested fields of the GraphQL type Component.
+// GetEnvironmentGraphEnvironmentInstancesInstanceComponent includes the requested fields of the GraphQL type Component.
 // The GraphQL type's documentation follows.
 //
 // A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
@@ -11992,117 +12029,19 @@ func (v *GetInstanceInstanceBundle) GetUpdatedAt() time.Time { return v.UpdatedA
 // Components are connected to each other via **links**, which declare that one
 // component's output (e.g., a connection string) should be wired into another
 // component's input.
-type GetInstanceInstanceComponent struct {
+type GetEnvironmentGraphEnvironmentInstancesInstanceComponent struct {
 	Id string `json:"id"`
 	// Human-readable display name shown in the UI.
 	Name string `json:"name"`
-	// Optional free-text description of this component's purpose.
-	Description string `json:"description"`
-	// Key-value attributes assigned directly to this component.
-	Attributes map[string]any `json:"-"`
-	// When this component was created (UTC).
-	CreatedAt time.Time `json:"createdAt"`
-	// When this component was last modified (UTC).
-	UpdatedAt time.Time `json:"updatedAt"`
-}
-
-// GetId returns GetInstanceInstanceComponent.Id, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceComponent) GetId() string { return v.Id }
-
-// GetName returns GetInstanceInstanceComponent.Name, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceComponent) GetName() string { return v.Name }
-
-// GetDescription returns GetInstanceInstanceComponent.Description, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceComponent) GetDescription() string { return v.Description }
-
-// GetAttributes returns GetInstanceInstanceComponent.Attributes, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceComponent) GetAttributes() map[string]any { return v.Attributes }
-
-// GetCreatedAt returns GetInstanceInstanceComponent.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceComponent) GetCreatedAt() time.Time { return v.CreatedAt }
-
-// GetUpdatedAt returns GetInstanceInstanceComponent.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceComponent) GetUpdatedAt() time.Time { return v.UpdatedAt }
-
-func (v *GetInstanceInstanceComponent) UnmarshalJSON(b []byte) error {
-
-	if string(b) == "null" {
-		return nil
-	}
-
-	var firstPass struct {
-		*GetInstanceInstanceComponent
-		Attributes json.RawMessage `json:"attributes"`
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.GetInstanceInstanceComponent = v
-
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
-
-	{
-		dst := &v.Attributes
-		src := firstPass.Attributes
-		if len(src) != 0 && string(src) != "null" {
-			err = scalars.UnmarshalJSON(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal GetInstanceInstanceComponent.Attributes: %w", err)
-			}
-		}
-	}
-	return nil
-}
-
-type __premarshalGetInstanceInstanceComponent struct {
-	Id string `json:"id"`
-
-	Name string `json:"name"`
-
-	Description string `json:"description"`
-
-	Attributes json.RawMessage `json:"attributes"`
-
-	CreatedAt time.Time `json:"createdAt"`
-
-	UpdatedAt time.Time `json:"updatedAt"`
-}
-
-func (v *GetInstanceInstanceComponent) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
 }
 
-func (v *GetInstanceInstanceComponent) __premarshalJSON() (*__premarshalGetInstanceInstanceComponent, error) {
-	var retval __premarshalGetInstanceInstanceComponent
-
-	retval.Id = v.Id
-	retval.Name = v.Name
-	retval.Description = v.Description
-	{
+// GetId returns GetEnvironmentGraphEnvironmentInstancesInstanceComponent.Id, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstanceComponent) GetId() string { return v.Id }
 
-		dst := &retval.Attributes
-		src := v.Attributes
-		var err error
-		*dst, err = scalars.MarshalJSON(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal GetInstanceInstanceComponent.Attributes: %w", err)
-		}
-	}
-	retval.CreatedAt = v.CreatedAt
-	retval.UpdatedAt = v.UpdatedAt
-	return &retval, nil
-}
+// GetName returns GetEnvironmentGraphEnvironmentInstancesInstanceComponent.Name, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstanceComponent) GetName() string { return v.Name }
 
-// GetInstanceInstanceCostCostSummary includes the requested fields of the GraphQL type CostSummary.
+// GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummary includes the requested fields of the GraphQL type CostSummary.
 // The GraphQL type's documentation follows.
 //
 // Aggregated cloud-provider cost metrics for a project or environment.
@@ -12116,38 +12055,38 @@ func (v *GetInstanceInstanceComponent) __premarshalJSON() (*__premarshalGetInsta
 // - **monthly_average** -- Average monthly spend across all available billing cycles.
 // - **last_day** -- Total spend for the most recent 24-hour period.
 // - **daily_average** -- Average daily spend over the last 7 days.
-type GetInstanceInstanceCostCostSummary struct {
+type GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummary struct {
 	// Total cost for the most recent complete billing cycle.
-	LastMonth GetInstanceInstanceCostCostSummaryLastMonthCostSample `json:"lastMonth"`
+	LastMonth GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryLastMonthCostSample `json:"lastMonth"`
 	// Average monthly cost across all available billing cycles.
-	MonthlyAverage GetInstanceInstanceCostCostSummaryMonthlyAverageCostSample `json:"monthlyAverage"`
+	MonthlyAverage GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryMonthlyAverageCostSample `json:"monthlyAverage"`
 	// Total cost for the most recent 24-hour period.
-	LastDay GetInstanceInstanceCostCostSummaryLastDayCostSample `json:"lastDay"`
+	LastDay GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryLastDayCostSample `json:"lastDay"`
 	// Average daily cost over the last 7 days.
-	DailyAverage GetInstanceInstanceCostCostSummaryDailyAverageCostSample `json:"dailyAverage"`
+	DailyAverage GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryDailyAverageCostSample `json:"dailyAverage"`
 }
 
-// GetLastMonth returns GetInstanceInstanceCostCostSummary.LastMonth, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceCostCostSummary) GetLastMonth() GetInstanceInstanceCostCostSummaryLastMonthCostSample {
+// GetLastMonth returns GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummary.LastMonth, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummary) GetLastMonth() GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryLastMonthCostSample {
 	return v.LastMonth
 }
 
-// GetMonthlyAverage returns GetInstanceInstanceCostCostSummary.MonthlyAverage, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceCostCostSummary) GetMonthlyAverage() GetInstanceInstanceCostCostSummaryMonthlyAverageCostSample {
+// GetMonthlyAverage returns GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummary.MonthlyAverage, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummary) GetMonthlyAverage() GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryMonthlyAverageCostSample {
 	return v.MonthlyAverage
 }
 
-// GetLastDay returns GetInstanceInstanceCostCostSummary.LastDay, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceCostCostSummary) GetLastDay() GetInstanceInstanceCostCostSummaryLastDayCostSample {
+// GetLastDay returns GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummary.LastDay, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummary) GetLastDay() GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryLastDayCostSample {
 	return v.LastDay
 }
 
-// GetDailyAverage returns GetInstanceInstanceCostCostSummary.DailyAverage, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceCostCostSummary) GetDailyAverage() GetInstanceInstanceCostCostSummaryDailyAverageCostSample {
+// GetDailyAverage returns GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummary.DailyAverage, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummary) GetDailyAverage() GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryDailyAverageCostSample {
 	return v.DailyAverage
 }
 
-// GetInstanceInstanceCostCostSummaryDailyAverageCostSample includes the requested fields of the GraphQL type CostSample.
+// GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryDailyAverageCostSample includes the requested fields of the GraphQL type CostSample.
 // The GraphQL type's documentation follows.
 //
 // A single cost data point containing an amount and its currency.
@@ -12156,24 +12095,24 @@ func (v *GetInstanceInstanceCostCostSummary) GetDailyAverage() GetInstanceInstan
 // data for the requested period -- this is normal for newly provisioned resources or when
 // cloud provider billing data has not yet been ingested. When data is present, `amount` is
 // always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
-type GetInstanceInstanceCostCostSummaryDailyAverageCostSample struct {
+type GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryDailyAverageCostSample struct {
 	// The cost in the given currency. Null when no billing data is available for this period.
 	Amount float64 `json:"amount"`
 	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
 	Currency string `json:"currency"`
 }
 
-// GetAmount returns GetInstanceInstanceCostCostSummaryDailyAverageCostSample.Amount, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceCostCostSummaryDailyAverageCostSample) GetAmount() float64 {
+// GetAmount returns GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryDailyAverageCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryDailyAverageCostSample) GetAmount() float64 {
 	return v.Amount
 }
 
-// GetCurrency returns GetInstanceInstanceCostCostSummaryDailyAverageCostSample.Currency, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceCostCostSummaryDailyAverageCostSample) GetCurrency() string {
+// GetCurrency returns GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryDailyAverageCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryDailyAverageCostSample) GetCurrency() string {
 	return v.Currency
 }
 
-// GetInstanceInstanceCostCostSummaryLastDayCostSample includes the requested fields of the GraphQL type CostSample.
+// GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryLastDayCostSample includes the requested fields of the GraphQL type CostSample.
 // The GraphQL type's documentation follows.
 //
 // A single cost data point containing an amount and its currency.
@@ -12182,20 +12121,24 @@ func (v *GetInstanceInstanceCostCostSummaryDailyAverageCostSample) GetCurrency()
 // data for the requested period -- this is normal for newly provisioned resources or when
 // cloud provider billing data has not yet been ingested. When data is present, `amount` is
 // always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
-type GetInstanceInstanceCostCostSummaryLastDayCostSample struct {
+type GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryLastDayCostSample struct {
 	// The cost in the given currency. Null when no billing data is available for this period.
 	Amount float64 `json:"amount"`
 	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
 	Currency string `json:"currency"`
 }
 
-// GetAmount returns GetInstanceInstanceCostCostSummaryLastDayCostSample.Amount, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceCostCostSummaryLastDayCostSample) GetAmount() float64 { return v.Amount }
+// GetAmount returns GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryLastDayCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryLastDayCostSample) GetAmount() float64 {
+	return v.Amount
+}
 
-// GetCurrency returns GetInstanceInstanceCostCostSummaryLastDayCostSample.Currency, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceCostCostSummaryLastDayCostSample) GetCurrency() string { return v.Currency }
+// GetCurrency returns GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryLastDayCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryLastDayCostSample) GetCurrency() string {
+	return v.Currency
+}
 
-// GetInstanceInstanceCostCostSummaryLastMonthCostSample includes the requested fields of the GraphQL type CostSample.
+// GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryLastMonthCostSample includes the requested fields of the GraphQL type CostSample.
 // The GraphQL type's documentation follows.
 //
 // A single cost data point containing an amount and its currency.
@@ -12204,22 +12147,24 @@ func (v *GetInstanceInstanceCostCostSummaryLastDayCostSample) GetCurrency() stri
 // data for the requested period -- this is normal for newly provisioned resources or when
 // cloud provider billing data has not yet been ingested. When data is present, `amount` is
 // always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
-type GetInstanceInstanceCostCostSummaryLastMonthCostSample struct {
+type GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryLastMonthCostSample struct {
 	// The cost in the given currency. Null when no billing data is available for this period.
 	Amount float64 `json:"amount"`
 	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
 	Currency string `json:"currency"`
 }
 
-// GetAmount returns GetInstanceInstanceCostCostSummaryLastMonthCostSample.Amount, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceCostCostSummaryLastMonthCostSample) GetAmount() float64 { return v.Amount }
+// GetAmount returns GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryLastMonthCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryLastMonthCostSample) GetAmount() float64 {
+	return v.Amount
+}
 
-// GetCurrency returns GetInstanceInstanceCostCostSummaryLastMonthCostSample.Currency, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceCostCostSummaryLastMonthCostSample) GetCurrency() string {
+// GetCurrency returns GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryLastMonthCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryLastMonthCostSample) GetCurrency() string {
 	return v.Currency
 }
 
-// GetInstanceInstanceCostCostSummaryMonthlyAverageCostSample includes the requested fields of the GraphQL type CostSample.
+// GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryMonthlyAverageCostSample includes the requested fields of the GraphQL type CostSample.
 // The GraphQL type's documentation follows.
 //
 // A single cost data point containing an amount and its currency.
@@ -12228,89 +12173,173 @@ func (v *GetInstanceInstanceCostCostSummaryLastMonthCostSample) GetCurrency() st
 // data for the requested period -- this is normal for newly provisioned resources or when
 // cloud provider billing data has not yet been ingested. When data is present, `amount` is
 // always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
-type GetInstanceInstanceCostCostSummaryMonthlyAverageCostSample struct {
+type GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryMonthlyAverageCostSample struct {
 	// The cost in the given currency. Null when no billing data is available for this period.
 	Amount float64 `json:"amount"`
 	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
 	Currency string `json:"currency"`
 }
 
-// GetAmount returns GetInstanceInstanceCostCostSummaryMonthlyAverageCostSample.Amount, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceCostCostSummaryMonthlyAverageCostSample) GetAmount() float64 {
+// GetAmount returns GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryMonthlyAverageCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryMonthlyAverageCostSample) GetAmount() float64 {
 	return v.Amount
 }
 
-// GetCurrency returns GetInstanceInstanceCostCostSummaryMonthlyAverageCostSample.Currency, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceCostCostSummaryMonthlyAverageCostSample) GetCurrency() string {
+// GetCurrency returns GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryMonthlyAverageCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphEnvironmentInstancesInstanceCostCostSummaryMonthlyAverageCostSample) GetCurrency() string {
 	return v.Currency
 }
 
-// GetInstanceInstanceEnvironment includes the requested fields of the GraphQL type Environment.
+// GetEnvironmentGraphResponse is returned by GetEnvironmentGraph on success.
+type GetEnvironmentGraphResponse struct {
+	// Fetch a single environment by its identifier.
+	Environment GetEnvironmentGraphEnvironment `json:"environment"`
+}
+
+// GetEnvironment returns GetEnvironmentGraphResponse.Environment, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentGraphResponse) GetEnvironment() GetEnvironmentGraphEnvironment {
+	return v.Environment
+}
+
+// GetEnvironmentResponse is returned by GetEnvironment on success.
+type GetEnvironmentResponse struct {
+	// Fetch a single environment by its identifier.
+	Environment GetEnvironmentEnvironment `json:"environment"`
+}
+
+// GetEnvironment returns GetEnvironmentResponse.Environment, and is useful for accessing the field via an interface.
+func (v *GetEnvironmentResponse) GetEnvironment() GetEnvironmentEnvironment { return v.Environment }
+
+// GetGroupGroup includes the requested fields of the GraphQL type Group.
 // The GraphQL type's documentation follows.
 //
-// A deployment target within a project where blueprint components become live infrastructure.
+// A collection of users and service accounts that share the same access level within your organization.
 //
-// Each project can have multiple environments (e.g., `staging`, `production`). When you deploy
-// to an environment, every component in the project's blueprint is realized as an **Instance** --
-// a running piece of cloud infrastructure with its own configuration, state, and cost data.
+// Groups are the primary mechanism for managing access control in Massdriver. Rather than
+// assigning permissions to individual users, you add them to groups that define what they
+// can see and do.
 //
-// Environments inherit attributes from their parent project. You can also set environment-scoped attributes
-// that cascade down to all instances within the environment. **Defaults** let you pre-assign
-// resources (like a shared VPC or DNS zone) so that new instances automatically receive them.
+// ```mermaid
+// graph TD
+// O["Organization"] --> G1["Group: Admins"]
+// O --> G2["Group: Developers"]
+// O --> G3["Group: Custom"]
+// G1 --> U1["User: alice@co.com"]
+// G2 --> U2["User: bob@co.com"]
+// G2 --> SA1["Service Account: ci-bot"]
+// G3 -->|"project_admin"| P1["Project: backend"]
+// G3 -->|"project_viewer"| P2["Project: frontend"]
+// ```
 //
-// Before deleting an environment, all instances must be decommissioned. Use the `deletable`
-// field to check for blocking constraints.
-type GetInstanceInstanceEnvironment struct {
+// **Built-in groups** — Every organization starts with an `Admins` group (`organization_admin` role)
+// and a `Viewers` group (`organization_viewer` role). These cannot be deleted.
+//
+// **Custom groups** — Create custom groups with the `CUSTOM` role to grant project-level access.
+// Each custom group can be assigned `project_admin` or `project_viewer` on specific projects.
+//
+// **Members** — Both human users and service accounts can be group members. Users live under
+// `members` and are added via `addAccountToGroup` (auto-adds existing org members or sends an
+// invitation otherwise). Service accounts live under `serviceAccounts` and are added via
+// `addServiceAccountToGroup`.
+type GetGroupGroup struct {
+	// Unique identifier for this group.
 	Id string `json:"id"`
-	// Display name shown in the UI and CLI. Must be unique within the project.
+	// Human-readable name displayed in the UI and API responses.
 	Name string `json:"name"`
-	// Free-text description of what this environment is for.
+	// Optional text explaining the purpose of this group.
 	Description string `json:"description"`
-	// Key-value attributes assigned directly to this environment. Attributes cascade to instances. Must conform to your organization's custom attributes for the `ENVIRONMENT` scope.
-	Attributes map[string]any `json:"-"`
-	// When this environment was created (UTC).
+	// The access level this group grants to its members.
+	Role GroupRole `json:"role"`
+	// When this group was created (UTC).
 	CreatedAt time.Time `json:"createdAt"`
-	// When this environment was last modified (UTC).
+	// When this group was last modified (UTC).
 	UpdatedAt time.Time `json:"updatedAt"`
-	// The parent project that this environment belongs to.
-	Project GetInstanceInstanceEnvironmentProject `json:"project"`
 }
 
-// GetId returns GetInstanceInstanceEnvironment.Id, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceEnvironment) GetId() string { return v.Id }
-
-// GetName returns GetInstanceInstanceEnvironment.Name, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceEnvironment) GetName() string { return v.Name }
-
-// GetDescription returns GetInstanceInstanceEnvironment.Description, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceEnvironment) GetDescription() string { return v.Description }
-
-// GetAttributes returns GetInstanceInstanceEnvironment.Attributes, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceEnvironment) GetAttributes() map[string]any { return v.Attributes }
+// GetId returns GetGroupGroup.Id, and is useful for accessing the field via an interface.
+func (v *GetGroupGroup) GetId() string { return v.Id }
 
-// GetCreatedAt returns GetInstanceInstanceEnvironment.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceEnvironment) GetCreatedAt() time.Time { return v.CreatedAt }
+// GetName returns GetGroupGroup.Name, and is useful for accessing the field via an interface.
+func (v *GetGroupGroup) GetName() string { return v.Name }
 
-// GetUpdatedAt returns GetInstanceInstanceEnvironment.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceEnvironment) GetUpdatedAt() time.Time { return v.UpdatedAt }
+// GetDescription returns GetGroupGroup.Description, and is useful for accessing the field via an interface.
+func (v *GetGroupGroup) GetDescription() string { return v.Description }
 
-// GetProject returns GetInstanceInstanceEnvironment.Project, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceEnvironment) GetProject() GetInstanceInstanceEnvironmentProject {
-	return v.Project
-}
+// GetRole returns GetGroupGroup.Role, and is useful for accessing the field via an interface.
+func (v *GetGroupGroup) GetRole() GroupRole { return v.Role }
 
-func (v *GetInstanceInstanceEnvironment) UnmarshalJSON(b []byte) error {
+// GetCreatedAt returns GetGroupGroup.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetGroupGroup) GetCreatedAt() time.Time { return v.CreatedAt }
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetUpdatedAt returns GetGroupGroup.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetGroupGroup) GetUpdatedAt() time.Time { return v.UpdatedAt }
 
-	var firstPass struct {
-		*GetInstanceInstanceEnvironment
-		Attributes json.RawMessage `json:"attributes"`
+// GetGroupPolicyGroupPolicy includes the requested fields of the GraphQL type Policy.
+// The GraphQL type's documentation follows.
+//
+// A single ABAC group policy: an effect (`ALLOW`/`DENY`), one or more actions,
+// optional attribute conditions, and the group whose members the policy
+// applies to.
+//
+// Conditions are evaluated AND within a policy and OR across policies on the
+// same group. A policy with no conditions is a wildcard — it matches any
+// resource of each action's entity. Deny policies win over allow policies. A
+// policy can list actions across different entities (for example
+// `project:view` together with `instance:deploy`); for each action, condition
+// keys whose registered attribute scope is unreachable for that action's
+// entity are skipped, and a policy whose conditions all skip for a given
+// action is a wildcard match for that action. See `docs/guides/abac.md` for
+// the full evaluation model.
+type GetGroupPolicyGroupPolicy struct {
+	// Unique identifier for this policy.
+	Id string `json:"id"`
+	// Whether this policy grants (`ALLOW`) or blocks (`DENY`) the actions.
+	Effect PolicyEffect `json:"effect"`
+	// The actions this policy authorizes, each in `{entity}:{verb}` form (for example `["repo:pull", "instance:deploy"]`). Always non-empty.
+	Actions []string `json:"actions"`
+	// Either `"*"` (the policy is a wildcard — every resource of the entity matches) or a JSON-encoded object of attribute conditions. Keys are attribute names; values are a string or list of strings.
+	Conditions types.PolicyConditions `json:"-"`
+	// When this policy was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// When this policy was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// The group this policy applies to.
+	Group GetGroupPolicyGroupPolicyGroup `json:"group"`
+}
+
+// GetId returns GetGroupPolicyGroupPolicy.Id, and is useful for accessing the field via an interface.
+func (v *GetGroupPolicyGroupPolicy) GetId() string { return v.Id }
+
+// GetEffect returns GetGroupPolicyGroupPolicy.Effect, and is useful for accessing the field via an interface.
+func (v *GetGroupPolicyGroupPolicy) GetEffect() PolicyEffect { return v.Effect }
+
+// GetActions returns GetGroupPolicyGroupPolicy.Actions, and is useful for accessing the field via an interface.
+func (v *GetGroupPolicyGroupPolicy) GetActions() []string { return v.Actions }
+
+// GetConditions returns GetGroupPolicyGroupPolicy.Conditions, and is useful for accessing the field via an interface.
+func (v *GetGroupPolicyGroupPolicy) GetConditions() types.PolicyConditions { return v.Conditions }
+
+// GetCreatedAt returns GetGroupPolicyGroupPolicy.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetGroupPolicyGroupPolicy) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetUpdatedAt returns GetGroupPolicyGroupPolicy.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetGroupPolicyGroupPolicy) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetGroup returns GetGroupPolicyGroupPolicy.Group, and is useful for accessing the field via an interface.
+func (v *GetGroupPolicyGroupPolicy) GetGroup() GetGroupPolicyGroupPolicyGroup { return v.Group }
+
+func (v *GetGroupPolicyGroupPolicy) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetGroupPolicyGroupPolicy
+		Conditions json.RawMessage `json:"conditions"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.GetInstanceInstanceEnvironment = v
+	firstPass.GetGroupPolicyGroupPolicy = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -12318,37 +12347,37 @@ func (v *GetInstanceInstanceEnvironment) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.Attributes
-		src := firstPass.Attributes
+		dst := &v.Conditions
+		src := firstPass.Conditions
 		if len(src) != 0 && string(src) != "null" {
-			err = scalars.UnmarshalJSON(
+			err = scalars.UnmarshalConditions(
 				src, dst)
 			if err != nil {
 				return fmt.Errorf(
-					"unable to unmarshal GetInstanceInstanceEnvironment.Attributes: %w", err)
+					"unable to unmarshal GetGroupPolicyGroupPolicy.Conditions: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalGetInstanceInstanceEnvironment struct {
+type __premarshalGetGroupPolicyGroupPolicy struct {
 	Id string `json:"id"`
 
-	Name string `json:"name"`
+	Effect PolicyEffect `json:"effect"`
 
-	Description string `json:"description"`
+	Actions []string `json:"actions"`
 
-	Attributes json.RawMessage `json:"attributes"`
+	Conditions json.RawMessage `json:"conditions"`
 
 	CreatedAt time.Time `json:"createdAt"`
 
 	UpdatedAt time.Time `json:"updatedAt"`
 
-	Project GetInstanceInstanceEnvironmentProject `json:"project"`
+	Group GetGroupPolicyGroupPolicyGroup `json:"group"`
 }
 
-func (v *GetInstanceInstanceEnvironment) MarshalJSON() ([]byte, error) {
+func (v *GetGroupPolicyGroupPolicy) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -12356,462 +12385,465 @@ func (v *GetInstanceInstanceEnvironment) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *GetInstanceInstanceEnvironment) __premarshalJSON() (*__premarshalGetInstanceInstanceEnvironment, error) {
-	var retval __premarshalGetInstanceInstanceEnvironment
+func (v *GetGroupPolicyGroupPolicy) __premarshalJSON() (*__premarshalGetGroupPolicyGroupPolicy, error) {
+	var retval __premarshalGetGroupPolicyGroupPolicy
 
 	retval.Id = v.Id
-	retval.Name = v.Name
-	retval.Description = v.Description
+	retval.Effect = v.Effect
+	retval.Actions = v.Actions
 	{
 
-		dst := &retval.Attributes
-		src := v.Attributes
+		dst := &retval.Conditions
+		src := v.Conditions
 		var err error
-		*dst, err = scalars.MarshalJSON(
+		*dst, err = scalars.MarshalConditions(
 			&src)
 		if err != nil {
 			return nil, fmt.Errorf(
-				"unable to marshal GetInstanceInstanceEnvironment.Attributes: %w", err)
+				"unable to marshal GetGroupPolicyGroupPolicy.Conditions: %w", err)
 		}
 	}
 	retval.CreatedAt = v.CreatedAt
 	retval.UpdatedAt = v.UpdatedAt
-	retval.Project = v.Project
+	retval.Group = v.Group
 	return &retval, nil
 }
 
-// GetInstanceInstanceEnvironmentProject includes the requested fields of the GraphQL type Project.
+// GetGroupPolicyGroupPolicyGroup includes the requested fields of the GraphQL type Group.
 // The GraphQL type's documentation follows.
 //
-// A project organizes related infrastructure under a single blueprint.
+// A collection of users and service accounts that share the same access level within your organization.
 //
-// Each project contains a **Blueprint** that defines your infrastructure architecture -- which
-// bundles to use and how they connect -- and one or more **Environments** (like staging or
-// production) where that architecture is actually deployed.
+// Groups are the primary mechanism for managing access control in Massdriver. Rather than
+// assigning permissions to individual users, you add them to groups that define what they
+// can see and do.
 //
 // ```mermaid
-// graph LR
-// P["Project"] --> B["Blueprint"]
-// P --> E1["Environment: staging"]
-// P --> E2["Environment: production"]
-// B --> C1["Component: database"]
-// B --> C2["Component: cache"]
-// C1 -.->|"Link"| C2
+// graph TD
+// O["Organization"] --> G1["Group: Admins"]
+// O --> G2["Group: Developers"]
+// O --> G3["Group: Custom"]
+// G1 --> U1["User: alice@co.com"]
+// G2 --> U2["User: bob@co.com"]
+// G2 --> SA1["Service Account: ci-bot"]
+// G3 -->|"project_admin"| P1["Project: backend"]
+// G3 -->|"project_viewer"| P2["Project: frontend"]
 // ```
 //
-// Attributes set on a project are inherited by all environments and instances within it.
-type GetInstanceInstanceEnvironmentProject struct {
+// **Built-in groups** — Every organization starts with an `Admins` group (`organization_admin` role)
+// and a `Viewers` group (`organization_viewer` role). These cannot be deleted.
+//
+// **Custom groups** — Create custom groups with the `CUSTOM` role to grant project-level access.
+// Each custom group can be assigned `project_admin` or `project_viewer` on specific projects.
+//
+// **Members** — Both human users and service accounts can be group members. Users live under
+// `members` and are added via `addAccountToGroup` (auto-adds existing org members or sends an
+// invitation otherwise). Service accounts live under `serviceAccounts` and are added via
+// `addServiceAccountToGroup`.
+type GetGroupPolicyGroupPolicyGroup struct {
+	// Unique identifier for this group.
 	Id string `json:"id"`
-	// Display name shown in the UI and CLI. Must be unique within the organization.
+	// Human-readable name displayed in the UI and API responses.
 	Name string `json:"name"`
-	// Free-text description of what this project is for.
-	Description string `json:"description"`
 }
 
-// GetId returns GetInstanceInstanceEnvironmentProject.Id, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceEnvironmentProject) GetId() string { return v.Id }
-
-// GetName returns GetInstanceInstanceEnvironmentProject.Name, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceEnvironmentProject) GetName() string { return v.Name }
+// GetId returns GetGroupPolicyGroupPolicyGroup.Id, and is useful for accessing the field via an interface.
+func (v *GetGroupPolicyGroupPolicyGroup) GetId() string { return v.Id }
 
-// GetDescription returns GetInstanceInstanceEnvironmentProject.Description, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceEnvironmentProject) GetDescription() string { return v.Description }
+// GetName returns GetGroupPolicyGroupPolicyGroup.Name, and is useful for accessing the field via an interface.
+func (v *GetGroupPolicyGroupPolicyGroup) GetName() string { return v.Name }
 
-// GetInstanceInstanceResourcesInstanceResource includes the requested fields of the GraphQL type InstanceResource.
-// The GraphQL type's documentation follows.
-//
-// An output resource produced by an instance, keyed by the field handle that produced it.
-//
-// Resources are the outputs an instance publishes after a successful deployment
-// (e.g., a database connection string, a Kubernetes cluster endpoint). Other
-// instances can consume these resources via connections.
-type GetInstanceInstanceResourcesInstanceResource struct {
-	// The resource containing the actual data.
-	Resource GetInstanceInstanceResourcesInstanceResourceResource `json:"resource"`
+// GetGroupPolicyResponse is returned by GetGroupPolicy on success.
+type GetGroupPolicyResponse struct {
+	// Fetch a single ABAC group policy by its unique identifier.
+	//
+	// Returns `null` with a `NOT_FOUND` error if the policy does not exist in
+	// your organization. Use this when you already know the policy's id — for
+	// example, when the terraform provider needs to read a policy it previously
+	// created. To browse policies attached to a group, query the nested
+	// `Group.policies` field instead.
+	GroupPolicy GetGroupPolicyGroupPolicy `json:"groupPolicy"`
 }
 
-// GetResource returns GetInstanceInstanceResourcesInstanceResource.Resource, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceResourcesInstanceResource) GetResource() GetInstanceInstanceResourcesInstanceResourceResource {
-	return v.Resource
+// GetGroupPolicy returns GetGroupPolicyResponse.GroupPolicy, and is useful for accessing the field via an interface.
+func (v *GetGroupPolicyResponse) GetGroupPolicy() GetGroupPolicyGroupPolicy { return v.GroupPolicy }
+
+// GetGroupResponse is returned by GetGroup on success.
+type GetGroupResponse struct {
+	// Retrieve a single group by its identifier.
+	//
+	// Returns `null` with a `NOT_FOUND` error if the group does not exist or you do not have
+	// permission to view it.
+	Group GetGroupGroup `json:"group"`
 }
 
-// GetInstanceInstanceResourcesInstanceResourceResource includes the requested fields of the GraphQL type Resource.
+// GetGroup returns GetGroupResponse.Group, and is useful for accessing the field via an interface.
+func (v *GetGroupResponse) GetGroup() GetGroupGroup { return v.Group }
+
+// GetInstanceAlarmInstanceAlarm includes the requested fields of the GraphQL type Alarm.
 // The GraphQL type's documentation follows.
 //
-// A cloud credential, database connection string, network configuration, or other
-// infrastructure output produced by (or imported into) Massdriver.
+// A cloud metric alarm attached to an instance.
 //
-// Resources are the connective tissue between instances. When an instance is deployed, it
-// produces resources as outputs. Other instances can consume those resources as inputs,
-// creating a dependency graph of your infrastructure.
+// Receives state updates via webhooks from AWS CloudWatch, Azure Monitor,
+// GCP Cloud Monitoring, or Prometheus Alertmanager.
 //
-// Resources have two origins:
-// - **Imported** — created directly through the API (e.g., uploading existing AWS credentials).
-// You have full CRUD control over these resources.
-// - **Provisioned** — created automatically when an instance is deployed. These are read-only
-// and managed entirely by the owning instance's lifecycle.
-type GetInstanceInstanceResourcesInstanceResourceResource struct {
-	// Unique identifier for this resource.
+// Check `currentState` to see whether the alarm is firing. A `null`
+// `currentState` means no state has been reported yet for this alarm.
+type GetInstanceAlarmInstanceAlarm struct {
+	// Unique identifier for this alarm.
 	Id string `json:"id"`
-	// Human-readable display name for this resource.
-	Name string `json:"name"`
-	// How this resource was created. Determines whether it can be modified through the API.
-	Origin ResourceOrigin `json:"origin"`
-	// The bundle output handle that produced this resource (e.g., `authentication`, `database`).
-	//
-	// Set only for **provisioned** resources — it corresponds to a field declared under
-	// `artifacts` in the producing bundle's `massdriver.yaml`. Null for **imported** resources.
-	Field string `json:"field"`
-	// Key-value attributes assigned directly to this resource, used by ABAC
-	// policies. Reserved keys starting with `md-` are auto-injected by the system
-	// and excluded from this map — see `effectiveAttributes` for the merged view.
-	Attributes map[string]any `json:"-"`
-	// The resource's structured payload. Fields marked `$md.sensitive` in the resource type's
-	// schema are masked as `[SENSITIVE]`. Use `exportResource` to retrieve an unmasked copy —
-	// that operation is recorded in the audit log.
-	Payload map[string]any `json:"-"`
-	// When this resource was created (UTC).
+	// Human-readable name for the alarm, set by the cloud provider when the alarm was registered.
+	DisplayName string `json:"displayName"`
+	// The cloud provider's unique identifier for the alarm (e.g., CloudWatch AlarmArn, GCP alert policy name, Azure alert id).
+	CloudResourceId string `json:"cloudResourceId"`
+	// How the metric is compared against `threshold` (e.g., `GREATER_THAN`, `LESS_THAN`, `GREATER_THAN_OR_EQUAL_TO`, `LESS_THAN_OR_EQUAL_TO`). May be null for Alertmanager and GCP alarms.
+	ComparisonOperator string `json:"comparisonOperator"`
+	// The value crossed to trigger the alarm, compared using `comparisonOperator`. May be null for Alertmanager alarms and some GCP conditions.
+	Threshold float64 `json:"threshold"`
+	// Evaluation window in seconds over which the metric is aggregated before the comparison is applied. May be null for alarms ingested from providers that don't expose a period.
+	Period int `json:"period"`
+	// The cloud metric this alarm evaluates. May be null for alarms from providers that don't supply structured metric data (e.g., Alertmanager).
+	Metric *GetInstanceAlarmInstanceAlarmMetric `json:"metric"`
+	// The most recent state reported for this alarm. `null` indicates no state has been recorded yet.
+	CurrentState *GetInstanceAlarmInstanceAlarmCurrentStateAlarmState `json:"currentState"`
+	// When this alarm was registered with Massdriver (UTC).
 	CreatedAt time.Time `json:"createdAt"`
-	// When this resource was last modified (UTC).
+	// When this alarm's configuration last changed (UTC).
 	UpdatedAt time.Time `json:"updatedAt"`
-	// The resource type that this resource conforms to, defining its schema and validation rules.
-	ResourceType *GetInstanceInstanceResourcesInstanceResourceResourceResourceType `json:"resourceType"`
 }
 
-// GetId returns GetInstanceInstanceResourcesInstanceResourceResource.Id, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceResourcesInstanceResourceResource) GetId() string { return v.Id }
-
-// GetName returns GetInstanceInstanceResourcesInstanceResourceResource.Name, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceResourcesInstanceResourceResource) GetName() string { return v.Name }
+// GetId returns GetInstanceAlarmInstanceAlarm.Id, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarm) GetId() string { return v.Id }
 
-// GetOrigin returns GetInstanceInstanceResourcesInstanceResourceResource.Origin, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceResourcesInstanceResourceResource) GetOrigin() ResourceOrigin {
-	return v.Origin
-}
+// GetDisplayName returns GetInstanceAlarmInstanceAlarm.DisplayName, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarm) GetDisplayName() string { return v.DisplayName }
 
-// GetField returns GetInstanceInstanceResourcesInstanceResourceResource.Field, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceResourcesInstanceResourceResource) GetField() string { return v.Field }
+// GetCloudResourceId returns GetInstanceAlarmInstanceAlarm.CloudResourceId, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarm) GetCloudResourceId() string { return v.CloudResourceId }
 
-// GetAttributes returns GetInstanceInstanceResourcesInstanceResourceResource.Attributes, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceResourcesInstanceResourceResource) GetAttributes() map[string]any {
-	return v.Attributes
-}
+// GetComparisonOperator returns GetInstanceAlarmInstanceAlarm.ComparisonOperator, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarm) GetComparisonOperator() string { return v.ComparisonOperator }
 
-// GetPayload returns GetInstanceInstanceResourcesInstanceResourceResource.Payload, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceResourcesInstanceResourceResource) GetPayload() map[string]any {
-	return v.Payload
-}
+// GetThreshold returns GetInstanceAlarmInstanceAlarm.Threshold, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarm) GetThreshold() float64 { return v.Threshold }
 
-// GetCreatedAt returns GetInstanceInstanceResourcesInstanceResourceResource.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceResourcesInstanceResourceResource) GetCreatedAt() time.Time {
-	return v.CreatedAt
-}
+// GetPeriod returns GetInstanceAlarmInstanceAlarm.Period, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarm) GetPeriod() int { return v.Period }
 
-// GetUpdatedAt returns GetInstanceInstanceResourcesInstanceResourceResource.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceResourcesInstanceResourceResource) GetUpdatedAt() time.Time {
-	return v.UpdatedAt
+// GetMetric returns GetInstanceAlarmInstanceAlarm.Metric, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarm) GetMetric() *GetInstanceAlarmInstanceAlarmMetric {
+	return v.Metric
 }
 
-// GetResourceType returns GetInstanceInstanceResourcesInstanceResourceResource.ResourceType, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceResourcesInstanceResourceResource) GetResourceType() *GetInstanceInstanceResourcesInstanceResourceResourceResourceType {
-	return v.ResourceType
+// GetCurrentState returns GetInstanceAlarmInstanceAlarm.CurrentState, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarm) GetCurrentState() *GetInstanceAlarmInstanceAlarmCurrentStateAlarmState {
+	return v.CurrentState
 }
 
-func (v *GetInstanceInstanceResourcesInstanceResourceResource) UnmarshalJSON(b []byte) error {
-
-	if string(b) == "null" {
-		return nil
-	}
-
-	var firstPass struct {
-		*GetInstanceInstanceResourcesInstanceResourceResource
-		Attributes json.RawMessage `json:"attributes"`
-		Payload    json.RawMessage `json:"payload"`
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.GetInstanceInstanceResourcesInstanceResourceResource = v
-
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
-
-	{
-		dst := &v.Attributes
-		src := firstPass.Attributes
-		if len(src) != 0 && string(src) != "null" {
-			err = scalars.UnmarshalJSON(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal GetInstanceInstanceResourcesInstanceResourceResource.Attributes: %w", err)
-			}
-		}
-	}
+// GetCreatedAt returns GetInstanceAlarmInstanceAlarm.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarm) GetCreatedAt() time.Time { return v.CreatedAt }
 
-	{
-		dst := &v.Payload
-		src := firstPass.Payload
-		if len(src) != 0 && string(src) != "null" {
-			err = scalars.UnmarshalJSON(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal GetInstanceInstanceResourcesInstanceResourceResource.Payload: %w", err)
-			}
-		}
-	}
-	return nil
-}
+// GetUpdatedAt returns GetInstanceAlarmInstanceAlarm.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarm) GetUpdatedAt() time.Time { return v.UpdatedAt }
 
-type __premarshalGetInstanceInstanceResourcesInstanceResourceResource struct {
+// GetInstanceAlarmInstanceAlarmCurrentStateAlarmState includes the requested fields of the GraphQL type AlarmState.
+// The GraphQL type's documentation follows.
+//
+// A single state transition reported for an alarm.
+//
+// Alarm states are append-only and recorded each time the alarm's status changes
+// (duplicate `OK -> OK` or `ALARM -> ALARM` updates are deduplicated at ingest).
+// The most recent state is exposed as `alarm.currentState`.
+type GetInstanceAlarmInstanceAlarmCurrentStateAlarmState struct {
+	// Unique identifier for this state record.
 	Id string `json:"id"`
+	// Whether the alarm is firing (`ALARM`) or clear (`OK`).
+	Status AlarmStatus `json:"status"`
+	// Provider-supplied human-readable message describing the state change.
+	Message string `json:"message"`
+	// When the state change occurred in the cloud provider (UTC).
+	OccurredAt time.Time `json:"occurredAt"`
+}
 
-	Name string `json:"name"`
-
-	Origin ResourceOrigin `json:"origin"`
-
-	Field string `json:"field"`
-
-	Attributes json.RawMessage `json:"attributes"`
-
-	Payload json.RawMessage `json:"payload"`
+// GetId returns GetInstanceAlarmInstanceAlarmCurrentStateAlarmState.Id, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarmCurrentStateAlarmState) GetId() string { return v.Id }
 
-	CreatedAt time.Time `json:"createdAt"`
+// GetStatus returns GetInstanceAlarmInstanceAlarmCurrentStateAlarmState.Status, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarmCurrentStateAlarmState) GetStatus() AlarmStatus {
+	return v.Status
+}
 
-	UpdatedAt time.Time `json:"updatedAt"`
+// GetMessage returns GetInstanceAlarmInstanceAlarmCurrentStateAlarmState.Message, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarmCurrentStateAlarmState) GetMessage() string { return v.Message }
 
-	ResourceType *GetInstanceInstanceResourcesInstanceResourceResourceResourceType `json:"resourceType"`
+// GetOccurredAt returns GetInstanceAlarmInstanceAlarmCurrentStateAlarmState.OccurredAt, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarmCurrentStateAlarmState) GetOccurredAt() time.Time {
+	return v.OccurredAt
 }
 
-func (v *GetInstanceInstanceResourcesInstanceResourceResource) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetInstanceAlarmInstanceAlarmMetric includes the requested fields of the GraphQL type AlarmMetric.
+// The GraphQL type's documentation follows.
+//
+// The cloud metric an alarm is evaluating.
+//
+// Shape and populated fields vary by provider. AWS and Azure populate
+// `statistic` (e.g., `Average`, `Sum`, `Maximum`); GCP does not. `dimensions`
+// are populated when the provider exposes them as structured key-value pairs.
+type GetInstanceAlarmInstanceAlarmMetric struct {
+	// Cloud service namespace that categorizes the metric. Examples: `AWS/RDS`, `Microsoft.Cache/Redis`, `cloudsql_database`.
+	Namespace string `json:"namespace"`
+	// Metric name within the namespace. Examples: `CPUUtilization` (AWS), `allpercentprocessortime` (Azure).
+	Name string `json:"name"`
+	// Aggregation function applied to metric samples. Examples: `Average`, `Sum`, `Maximum`. May be `null` for providers that don't use this concept (e.g., GCP).
+	Statistic string `json:"statistic"`
+	// Cloud region this metric is scoped to, when provider-reported.
+	Region string `json:"region"`
+	// Dimensions identifying the specific cloud resource being monitored. Empty list when the provider doesn't report structured dimensions.
+	Dimensions []GetInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension `json:"dimensions"`
 }
 
-func (v *GetInstanceInstanceResourcesInstanceResourceResource) __premarshalJSON() (*__premarshalGetInstanceInstanceResourcesInstanceResourceResource, error) {
-	var retval __premarshalGetInstanceInstanceResourcesInstanceResourceResource
+// GetNamespace returns GetInstanceAlarmInstanceAlarmMetric.Namespace, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarmMetric) GetNamespace() string { return v.Namespace }
 
-	retval.Id = v.Id
-	retval.Name = v.Name
-	retval.Origin = v.Origin
-	retval.Field = v.Field
-	{
+// GetName returns GetInstanceAlarmInstanceAlarmMetric.Name, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarmMetric) GetName() string { return v.Name }
 
-		dst := &retval.Attributes
-		src := v.Attributes
-		var err error
-		*dst, err = scalars.MarshalJSON(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal GetInstanceInstanceResourcesInstanceResourceResource.Attributes: %w", err)
-		}
-	}
-	{
+// GetStatistic returns GetInstanceAlarmInstanceAlarmMetric.Statistic, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarmMetric) GetStatistic() string { return v.Statistic }
 
-		dst := &retval.Payload
-		src := v.Payload
-		var err error
-		*dst, err = scalars.MarshalJSON(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal GetInstanceInstanceResourcesInstanceResourceResource.Payload: %w", err)
-		}
-	}
-	retval.CreatedAt = v.CreatedAt
-	retval.UpdatedAt = v.UpdatedAt
-	retval.ResourceType = v.ResourceType
-	return &retval, nil
+// GetRegion returns GetInstanceAlarmInstanceAlarmMetric.Region, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarmMetric) GetRegion() string { return v.Region }
+
+// GetDimensions returns GetInstanceAlarmInstanceAlarmMetric.Dimensions, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarmMetric) GetDimensions() []GetInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension {
+	return v.Dimensions
 }
 
-// GetInstanceInstanceResourcesInstanceResourceResourceResourceType includes the requested fields of the GraphQL type ResourceType.
+// GetInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension includes the requested fields of the GraphQL type AlarmMetricDimension.
 // The GraphQL type's documentation follows.
 //
-// A resource type that defines what kind of infrastructure a resource represents.
-//
-// Resource types are the schema layer for Massdriver's connection system. Every
-// dependency a bundle declares and every resource a bundle produces references a
-// resource type. This is what makes bundles composable -- a database bundle that
-// produces an `aws-rds-instance` resource can be connected to any application
-// bundle that declares an `aws-rds-instance` dependency.
+// A key-value pair identifying the specific cloud resource a metric applies to.
 //
-// Resource types include both public types provided by Massdriver (e.g.,
-// `aws-iam-role`, `kubernetes-cluster`) and private types defined by your
-// organization for custom infrastructure.
-type GetInstanceInstanceResourcesInstanceResourceResourceResourceType struct {
-	// Unique identifier in kebab-case (e.g., `aws-iam-role`, `kubernetes-cluster`).
-	Id string `json:"id"`
-	// Human-readable display name (e.g., "AWS IAM Role", "Kubernetes Cluster").
+// Examples: `{ name: "DBInstanceIdentifier", value: "db-abc123" }` for AWS RDS,
+// `{ name: "InstanceId", value: "i-0a1b2c3d" }` for AWS EC2.
+type GetInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension struct {
+	// Dimension name as defined by the cloud provider.
 	Name string `json:"name"`
+	// Dimension value identifying the monitored resource.
+	Value string `json:"value"`
 }
 
-// GetId returns GetInstanceInstanceResourcesInstanceResourceResourceResourceType.Id, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceResourcesInstanceResourceResourceResourceType) GetId() string {
-	return v.Id
-}
-
-// GetName returns GetInstanceInstanceResourcesInstanceResourceResourceResourceType.Name, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceResourcesInstanceResourceResourceResourceType) GetName() string {
+// GetName returns GetInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension.Name, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension) GetName() string {
 	return v.Name
 }
 
-// GetInstanceInstanceStatePathsInstanceStatePath includes the requested fields of the GraphQL type InstanceStatePath.
-// The GraphQL type's documentation follows.
-//
-// A Terraform/OpenTofu state path for a single deployment step.
-//
-// Bundles can define multiple provisioning steps (e.g., `core`, `iam`, `monitoring`).
-// Each step has its own state file managed by the Massdriver HTTP state backend.
-type GetInstanceInstanceStatePathsInstanceStatePath struct {
-	// The step's path identifier as defined in the bundle's `massdriver.yaml`.
-	StepName string `json:"stepName"`
-	// Full URL for this step's state file on the Massdriver HTTP state backend.
-	StateUrl string `json:"stateUrl"`
+// GetValue returns GetInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension.Value, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmInstanceAlarmMetricDimensionsAlarmMetricDimension) GetValue() string {
+	return v.Value
 }
 
-// GetStepName returns GetInstanceInstanceStatePathsInstanceStatePath.StepName, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceStatePathsInstanceStatePath) GetStepName() string { return v.StepName }
-
-// GetStateUrl returns GetInstanceInstanceStatePathsInstanceStatePath.StateUrl, and is useful for accessing the field via an interface.
-func (v *GetInstanceInstanceStatePathsInstanceStatePath) GetStateUrl() string { return v.StateUrl }
-
-// GetInstanceResponse is returned by GetInstance on success.
-type GetInstanceResponse struct {
-	// Fetch a single instance by its ID. Returns null with a `NOT_FOUND` error if the instance does not exist.
-	Instance GetInstanceInstance `json:"instance"`
+// GetInstanceAlarmResponse is returned by GetInstanceAlarm on success.
+type GetInstanceAlarmResponse struct {
+	// Fetch a single alarm by its unique identifier.
+	//
+	// Returns the alarm with its most recent `currentState` attached, or a
+	// `NOT_FOUND` error if the alarm doesn't exist or its project isn't
+	// visible to the caller.
+	//
+	// ```graphql
+	// query {
+	// instanceAlarm(organizationId: "my-org", id: "0192…uuid") {
+	// id
+	// displayName
+	// currentState { status occurredAt }
+	// metric { namespace name }
+	// }
+	// }
+	// ```
+	InstanceAlarm GetInstanceAlarmInstanceAlarm `json:"instanceAlarm"`
 }
 
-// GetInstance returns GetInstanceResponse.Instance, and is useful for accessing the field via an interface.
-func (v *GetInstanceResponse) GetInstance() GetInstanceInstance { return v.Instance }
+// GetInstanceAlarm returns GetInstanceAlarmResponse.InstanceAlarm, and is useful for accessing the field via an interface.
+func (v *GetInstanceAlarmResponse) GetInstanceAlarm() GetInstanceAlarmInstanceAlarm {
+	return v.InstanceAlarm
+}
 
-// GetOciRepoOciRepo includes the requested fields of the GraphQL type OciRepo.
+// GetInstanceInstance includes the requested fields of the GraphQL type Instance.
 // The GraphQL type's documentation follows.
 //
-// An OCI repository in your organization's bundle catalog.
+// A deployed piece of infrastructure in an environment.
 //
-// An OCI repository is the container for all published versions of a single
-// infrastructure-as-code package. It is analogous to a Docker image repository
-// but for Massdriver bundles.
+// An instance is the **runtime representation** of a component. When you add a
+// "database" component to your blueprint and deploy it to the `staging`
+// environment, Massdriver creates an instance that tracks the database's
+// configuration, deployment state, costs, and produced resources.
 //
-// Each repository has a unique `name` (e.g., `aws-aurora-postgres`) and contains:
+// **Lifecycle:** Instances progress through a well-defined set of states:
 //
-// - **Tags** -- the individual published versions (`1.0.0`, `1.1.0`, `1.2.3`, etc.)
-// - **Release channels** -- auto-resolving version constraints (`latest`, `~1`, `~1.2`)
-// that always point to the newest matching tag
+// ```mermaid
+// stateDiagram-v2
+// [*] --> INITIALIZED: "Component added to environment"
+// INITIALIZED --> PROVISIONED: "Deployment succeeds"
+// INITIALIZED --> FAILED: "Deployment fails"
+// PROVISIONED --> PROVISIONED: "Redeploy / update"
+// PROVISIONED --> DECOMMISSIONED: "Decommission succeeds"
+// PROVISIONED --> FAILED: "Deployment fails"
+// FAILED --> PROVISIONED: "Retry succeeds"
+// FAILED --> DECOMMISSIONED: "Decommission"
+// ```
 //
-// To fetch a specific bundle version from a repository, use the `bundle` query
-// with a `BundleId` like `aws-aurora-postgres@1.2.3` or `aws-aurora-postgres@~1`.
-type GetOciRepoOciRepo struct {
+// **Version resolution:** Each instance has a `version` constraint (e.g., `~1.0`)
+// and a `releaseStrategy` (stable or development). Together these determine
+// the `resolvedVersion` that will be used on the next deployment. Compare
+// `resolvedVersion` with `deployedVersion` to see if a redeployment is needed,
+// or check `availableUpgrade` for newer matching releases.
+type GetInstanceInstance struct {
 	Id string `json:"id"`
-	// Repository name, unique within your organization (e.g., `aws-aurora-postgres`).
+	// Name of the instance.
 	Name string `json:"name"`
-	// The bare [OCI reference](https://github.com/opencontainers/distribution-spec/blob/main/spec.md#pulling-manifests)
-	// for this repository: `<registry>/<org>/<repo>` (for example,
-	// `api.massdriver.cloud/acme/aws-aurora-postgres`).
+	// Current lifecycle state of the instance.
+	Status InstanceStatus `json:"status"`
+	// The version constraint controlling which bundle releases are eligible for deployment. Accepts any value accepted by the `VersionConstraint` scalar: a pinned semver (e.g., `1.2.3`) or a release channel name as listed by `ociRepo.releaseChannels` (e.g., `latest`, `~1.2`, `~1.2+dev`). Round-trips: the value returned here is valid input for the next `updateInstance` mutation.
+	Version string `json:"version"`
+	// Deprecated. Derived from `version`: `:development` when `version` is a development release channel, otherwise `:stable`. Will be removed.
+	ReleaseStrategy ReleaseStrategy `json:"releaseStrategy"`
+	// The concrete bundle version resolved from the version constraint and release strategy.
 	//
-	// Append `:<tag>` or `@<digest>` to address a specific manifest and use the
-	// result directly with `oras`, `docker`, or any OCI-compliant client:
+	// This is the version that will be used on the **next** deployment. Compare
+	// with `deployedVersion` to determine if a redeployment would change anything.
+	ResolvedVersion string `json:"resolvedVersion"`
+	// The bundle version that was last successfully deployed to infrastructure.
 	//
-	// ```bash
-	// oras pull api.massdriver.cloud/acme/aws-aurora-postgres:1.2.3
-	// ```
-	Reference string `json:"reference"`
-	// The [OCI artifact type](https://github.com/opencontainers/image-spec/blob/main/manifest.md#guidelines-for-artifact-usage)
-	// stored in this repository. Currently always
-	// `application/vnd.massdriver.bundle.v1+json`.
-	ArtifactType string `json:"artifactType"`
-	// Key-value attributes assigned directly to this repository, used by ABAC
-	// policies. Reserved keys starting with `md-` are auto-injected by the system
-	// and excluded from this map — see `effectiveAttributes` for the merged view.
-	Attributes map[string]any `json:"-"`
-	// Short summary of what this repository provisions, taken from the latest
-	// published release. `null` when the repository has no releases yet or the
-	// author omitted the description.
-	Description string `json:"description"`
-	// URL to this repository's display icon (SVG), taken from the latest
-	// published release. `null` when the repository has no releases yet or the
-	// latest release has no icon.
+	// May differ from `resolvedVersion` if the version constraint has been updated
+	// but no deployment has occurred yet. Null if the instance has never been deployed.
+	DeployedVersion string `json:"deployedVersion"`
+	// The newest bundle version available that satisfies the version constraint.
 	//
-	// The URL is **authenticated** — callers must have an active browser session
-	// or valid API credentials for the owning organization. Embedding it in an
-	// `<img>` tag from the web UI works because the browser sends the session
-	// cookie automatically; programmatic clients must include an `Authorization`
-	// header.
-	Icon string `json:"icon"`
-	// URL of the source code this repository is published from (for example, a
-	// GitHub link), when the author provides one. Taken from the latest release.
-	SourceUrl string `json:"sourceUrl"`
-	// Timestamp when this repository was created (UTC).
+	// Returns null if the instance is already on the latest matching version.
+	// Use this field to detect when an upgrade is available.
+	AvailableUpgrade string `json:"availableUpgrade"`
+	// Cached configuration parameters from the most recent deployment. Null if the instance has never been deployed.
+	Params map[string]any `json:"-"`
+	// JSON Schema describing the configuration parameters this instance accepts.
+	//
+	// The schema is sourced from the instance's resolved bundle release with
+	// Massdriver's `$md` extensions evaluated against the current instance state:
+	//
+	// - `$md.enum` is replaced with a `oneOf` list whose entries are computed by
+	// running the configured jq expression against the connected resource's
+	// payload. Missing connections or jq errors produce a single placeholder
+	// entry whose `title` begins with `ERROR:`.
+	// - `$md.immutable` is rewritten to `readOnly: true` once the instance has
+	// reached a state where the field can no longer be changed (`PROVISIONED`
+	// or `FAILED`).
+	//
+	// Use this schema to drive form rendering, client-side validation, or to
+	// inspect the contract between the bundle and the deployer.
+	ParamsSchema map[string]any `json:"-"`
+	// Key-value attributes assigned directly to this instance.
+	Attributes map[string]any `json:"-"`
+	// When this instance was created (UTC).
 	CreatedAt time.Time `json:"createdAt"`
-	// Timestamp when this repository was last modified (UTC).
+	// When this instance was last modified (UTC).
 	UpdatedAt time.Time `json:"updatedAt"`
-	// Paginated list of published version tags in this repository.
+	// Cloud provider cost summary for this instance, including daily and monthly breakdowns.
+	Cost GetInstanceInstanceCostCostSummary `json:"cost"`
+	// Terraform/OpenTofu state paths for each provisioning step, ordered by the bundle's step definition.
 	//
-	// Each tag represents a single immutable bundle release. Defaults to newest
-	// version first (`version` descending).
-	Tags GetOciRepoOciRepoTagsOciRepoTagsPage `json:"tags"`
-	// Paginated list of release channels for this repository.
+	// Each bundle can define multiple steps (e.g., `core`, `iam`, `monitoring`). Use the
+	// `stateUrl` to configure your Terraform backend or inspect state externally.
+	StatePaths []GetInstanceInstanceStatePathsInstanceStatePath `json:"statePaths"`
+	// The environment this instance is deployed in.
+	Environment GetInstanceInstanceEnvironment `json:"environment"`
+	// The bundle release currently resolved for this instance.
+	Bundle GetInstanceInstanceBundle `json:"bundle"`
+	// The component this instance was deployed from.
+	Component GetInstanceInstanceComponent `json:"component"`
+	// Resources produced by this instance, sorted alphabetically by field.
 	//
-	// Each channel is a version constraint that auto-resolves to the latest matching
-	// tag. Use the `stable` filter to show only stable or only development channels.
-	ReleaseChannels GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPage `json:"releaseChannels"`
+	// Resources are the outputs published after a successful deployment
+	// (e.g., connection strings, endpoints, credentials). Other instances consume
+	// these resources via connections.
+	Resources []GetInstanceInstanceResourcesInstanceResource `json:"resources"`
 }
 
-// GetId returns GetOciRepoOciRepo.Id, and is useful for accessing the field via an interface.
-func (v *GetOciRepoOciRepo) GetId() string { return v.Id }
+// GetId returns GetInstanceInstance.Id, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetId() string { return v.Id }
 
-// GetName returns GetOciRepoOciRepo.Name, and is useful for accessing the field via an interface.
-func (v *GetOciRepoOciRepo) GetName() string { return v.Name }
+// GetName returns GetInstanceInstance.Name, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetName() string { return v.Name }
 
-// GetReference returns GetOciRepoOciRepo.Reference, and is useful for accessing the field via an interface.
-func (v *GetOciRepoOciRepo) GetReference() string { return v.Reference }
+// GetStatus returns GetInstanceInstance.Status, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetStatus() InstanceStatus { return v.Status }
 
-// GetArtifactType returns GetOciRepoOciRepo.ArtifactType, and is useful for accessing the field via an interface.
-func (v *GetOciRepoOciRepo) GetArtifactType() string { return v.ArtifactType }
+// GetVersion returns GetInstanceInstance.Version, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetVersion() string { return v.Version }
 
-// GetAttributes returns GetOciRepoOciRepo.Attributes, and is useful for accessing the field via an interface.
-func (v *GetOciRepoOciRepo) GetAttributes() map[string]any { return v.Attributes }
+// GetReleaseStrategy returns GetInstanceInstance.ReleaseStrategy, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetReleaseStrategy() ReleaseStrategy { return v.ReleaseStrategy }
 
-// GetDescription returns GetOciRepoOciRepo.Description, and is useful for accessing the field via an interface.
-func (v *GetOciRepoOciRepo) GetDescription() string { return v.Description }
+// GetResolvedVersion returns GetInstanceInstance.ResolvedVersion, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetResolvedVersion() string { return v.ResolvedVersion }
 
-// GetIcon returns GetOciRepoOciRepo.Icon, and is useful for accessing the field via an interface.
-func (v *GetOciRepoOciRepo) GetIcon() string { return v.Icon }
+// GetDeployedVersion returns GetInstanceInstance.DeployedVersion, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetDeployedVersion() string { return v.DeployedVersion }
 
-// GetSourceUrl returns GetOciRepoOciRepo.SourceUrl, and is useful for accessing the field via an interface.
-func (v *GetOciRepoOciRepo) GetSourceUrl() string { return v.SourceUrl }
+// GetAvailableUpgrade returns GetInstanceInstance.AvailableUpgrade, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetAvailableUpgrade() string { return v.AvailableUpgrade }
 
-// GetCreatedAt returns GetOciRepoOciRepo.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetOciRepoOciRepo) GetCreatedAt() time.Time { return v.CreatedAt }
+// GetParams returns GetInstanceInstance.Params, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetParams() map[string]any { return v.Params }
 
-// GetUpdatedAt returns GetOciRepoOciRepo.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetOciRepoOciRepo) GetUpdatedAt() time.Time { return v.UpdatedAt }
+// GetParamsSchema returns GetInstanceInstance.ParamsSchema, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetParamsSchema() map[string]any { return v.ParamsSchema }
 
-// GetTags returns GetOciRepoOciRepo.Tags, and is useful for accessing the field via an interface.
-func (v *GetOciRepoOciRepo) GetTags() GetOciRepoOciRepoTagsOciRepoTagsPage { return v.Tags }
+// GetAttributes returns GetInstanceInstance.Attributes, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetAttributes() map[string]any { return v.Attributes }
 
-// GetReleaseChannels returns GetOciRepoOciRepo.ReleaseChannels, and is useful for accessing the field via an interface.
-func (v *GetOciRepoOciRepo) GetReleaseChannels() GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPage {
-	return v.ReleaseChannels
+// GetCreatedAt returns GetInstanceInstance.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetUpdatedAt returns GetInstanceInstance.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetCost returns GetInstanceInstance.Cost, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetCost() GetInstanceInstanceCostCostSummary { return v.Cost }
+
+// GetStatePaths returns GetInstanceInstance.StatePaths, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetStatePaths() []GetInstanceInstanceStatePathsInstanceStatePath {
+	return v.StatePaths
 }
 
-func (v *GetOciRepoOciRepo) UnmarshalJSON(b []byte) error {
+// GetEnvironment returns GetInstanceInstance.Environment, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetEnvironment() GetInstanceInstanceEnvironment { return v.Environment }
+
+// GetBundle returns GetInstanceInstance.Bundle, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetBundle() GetInstanceInstanceBundle { return v.Bundle }
+
+// GetComponent returns GetInstanceInstance.Component, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetComponent() GetInstanceInstanceComponent { return v.Component }
+
+// GetResources returns GetInstanceInstance.Resources, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstance) GetResources() []GetInstanceInstanceResourcesInstanceResource {
+	return v.Resources
+}
+
+func (v *GetInstanceInstance) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*GetOciRepoOciRepo
-		Attributes json.RawMessage `json:"attributes"`
+		*GetInstanceInstance
+		Params       json.RawMessage `json:"params"`
+		ParamsSchema json.RawMessage `json:"paramsSchema"`
+		Attributes   json.RawMessage `json:"attributes"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.GetOciRepoOciRepo = v
+	firstPass.GetInstanceInstance = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -12819,47 +12851,87 @@ func (v *GetOciRepoOciRepo) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.Attributes
-		src := firstPass.Attributes
+		dst := &v.Params
+		src := firstPass.Params
 		if len(src) != 0 && string(src) != "null" {
 			err = scalars.UnmarshalJSON(
 				src, dst)
 			if err != nil {
 				return fmt.Errorf(
-					"unable to unmarshal GetOciRepoOciRepo.Attributes: %w", err)
+					"unable to unmarshal GetInstanceInstance.Params: %w", err)
 			}
 		}
 	}
-	return nil
-}
 
-type __premarshalGetOciRepoOciRepo struct {
-	Id string `json:"id"`
+	{
+		dst := &v.ParamsSchema
+		src := firstPass.ParamsSchema
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetInstanceInstance.ParamsSchema: %w", err)
+			}
+		}
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetInstanceInstance.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetInstanceInstance struct {
+	Id string `json:"id"`
 
 	Name string `json:"name"`
 
-	Reference string `json:"reference"`
+	Status InstanceStatus `json:"status"`
 
-	ArtifactType string `json:"artifactType"`
+	Version string `json:"version"`
 
-	Attributes json.RawMessage `json:"attributes"`
+	ReleaseStrategy ReleaseStrategy `json:"releaseStrategy"`
 
-	Description string `json:"description"`
+	ResolvedVersion string `json:"resolvedVersion"`
 
-	Icon string `json:"icon"`
+	DeployedVersion string `json:"deployedVersion"`
 
-	SourceUrl string `json:"sourceUrl"`
+	AvailableUpgrade string `json:"availableUpgrade"`
+
+	Params json.RawMessage `json:"params"`
+
+	ParamsSchema json.RawMessage `json:"paramsSchema"`
+
+	Attributes json.RawMessage `json:"attributes"`
 
 	CreatedAt time.Time `json:"createdAt"`
 
 	UpdatedAt time.Time `json:"updatedAt"`
 
-	Tags GetOciRepoOciRepoTagsOciRepoTagsPage `json:"tags"`
+	Cost GetInstanceInstanceCostCostSummary `json:"cost"`
 
-	ReleaseChannels GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPage `json:"releaseChannels"`
+	StatePaths []GetInstanceInstanceStatePathsInstanceStatePath `json:"statePaths"`
+
+	Environment GetInstanceInstanceEnvironment `json:"environment"`
+
+	Bundle GetInstanceInstanceBundle `json:"bundle"`
+
+	Component GetInstanceInstanceComponent `json:"component"`
+
+	Resources []GetInstanceInstanceResourcesInstanceResource `json:"resources"`
 }
 
-func (v *GetOciRepoOciRepo) MarshalJSON() ([]byte, error) {
+func (v *GetInstanceInstance) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -12867,13 +12939,41 @@ func (v *GetOciRepoOciRepo) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *GetOciRepoOciRepo) __premarshalJSON() (*__premarshalGetOciRepoOciRepo, error) {
-	var retval __premarshalGetOciRepoOciRepo
+func (v *GetInstanceInstance) __premarshalJSON() (*__premarshalGetInstanceInstance, error) {
+	var retval __premarshalGetInstanceInstance
 
 	retval.Id = v.Id
 	retval.Name = v.Name
-	retval.Reference = v.Reference
-	retval.ArtifactType = v.ArtifactType
+	retval.Status = v.Status
+	retval.Version = v.Version
+	retval.ReleaseStrategy = v.ReleaseStrategy
+	retval.ResolvedVersion = v.ResolvedVersion
+	retval.DeployedVersion = v.DeployedVersion
+	retval.AvailableUpgrade = v.AvailableUpgrade
+	{
+
+		dst := &retval.Params
+		src := v.Params
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetInstanceInstance.Params: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.ParamsSchema
+		src := v.ParamsSchema
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetInstanceInstance.ParamsSchema: %w", err)
+		}
+	}
 	{
 
 		dst := &retval.Attributes
@@ -12883,300 +12983,151 @@ func (v *GetOciRepoOciRepo) __premarshalJSON() (*__premarshalGetOciRepoOciRepo,
 			&src)
 		if err != nil {
 			return nil, fmt.Errorf(
-				"unable to marshal GetOciRepoOciRepo.Attributes: %w", err)
+				"unable to marshal GetInstanceInstance.Attributes: %w", err)
 		}
 	}
-	retval.Description = v.Description
-	retval.Icon = v.Icon
-	retval.SourceUrl = v.SourceUrl
 	retval.CreatedAt = v.CreatedAt
 	retval.UpdatedAt = v.UpdatedAt
-	retval.Tags = v.Tags
-	retval.ReleaseChannels = v.ReleaseChannels
+	retval.Cost = v.Cost
+	retval.StatePaths = v.StatePaths
+	retval.Environment = v.Environment
+	retval.Bundle = v.Bundle
+	retval.Component = v.Component
+	retval.Resources = v.Resources
 	return &retval, nil
 }
 
-// GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPage includes the requested fields of the GraphQL type OciRepoReleaseChannelsPage.
-type GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPage struct {
-	// A list of type oci_repo_release_channel.
-	Items []GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPageItemsOciRepoReleaseChannel `json:"items"`
-}
-
-// GetItems returns GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPage.Items, and is useful for accessing the field via an interface.
-func (v *GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPage) GetItems() []GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPageItemsOciRepoReleaseChannel {
-	return v.Items
-}
-
-// GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPageItemsOciRepoReleaseChannel includes the requested fields of the GraphQL type OciRepoReleaseChannel.
+// GetInstanceInstanceBundle includes the requested fields of the GraphQL type Bundle.
 // The GraphQL type's documentation follows.
 //
-// A release channel within an OCI repository.
+// A versioned infrastructure-as-code package.
 //
-// Release channels are auto-resolving version constraints. Each channel points to
-// the latest published tag that matches its constraint. As new versions are
-// published, channels automatically update to point to the newest match.
+// A bundle is a single published version of an IaC package in your organization's
+// catalog. Each bundle belongs to an OCI repository and is identified by a composite
+// `name@version` string (e.g., `aws-aurora-postgres@1.2.3`).
 //
-// For example, channel `~1` always resolves to the highest `1.x.x` stable tag.
-// If `1.2.3` is the latest, the channel points there. When `1.3.0` is published,
-// the channel automatically advances.
-type GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPageItemsOciRepoReleaseChannel struct {
-	// The channel constraint expression (e.g., `~1`, `~1.2`, `latest`, `latest+dev`).
+// Bundles declare **dependencies** (inputs they require from other bundles) and
+// **resources** (outputs they produce). These declarations drive the connection
+// system on the Massdriver canvas -- when you add a component to a blueprint,
+// the platform knows which other components can satisfy its dependencies.
+//
+// ```mermaid
+// graph TD
+// R["OCI Repository: aws-aurora-postgres"] --> T1["Tag: 1.0.0"]
+// R --> T2["Tag: 1.1.0"]
+// R --> T3["Tag: 1.2.3"]
+// R --> RC1["Channel: ~1 → 1.2.3"]
+// R --> RC2["Channel: latest → 1.2.3"]
+// T3 --> B["Bundle: aws-aurora-postgres@1.2.3"]
+// B --> D1["Dependency: aws-iam-role"]
+// B --> D2["Dependency: aws-vpc"]
+// B --> RES["Resource: aurora-cluster"]
+// ```
+type GetInstanceInstanceBundle struct {
+	// Composite identifier in `name@version` format (e.g., `aws-aurora-postgres@1.2.3`). Always contains the fully resolved semver version.
+	Id string `json:"id"`
+	// OCI repository name this bundle belongs to (e.g., `aws-aurora-postgres`).
 	Name string `json:"name"`
-	// The fully resolved semver version this channel currently points to.
-	Tag string `json:"tag"`
+	// Fully resolved semantic version of this bundle (e.g., `1.2.3`).
+	Version string `json:"version"`
+	// Short summary of what this bundle provisions.
+	Description string `json:"description"`
+	// URL to the bundle's display icon.
+	Icon string `json:"icon"`
+	// URL to the bundle's source code repository, if published by the author.
+	SourceUrl string `json:"sourceUrl"`
+	// OCI repository name for this bundle (e.g., `aws-aurora-postgres`). Equivalent to `name`.
+	Repo string `json:"repo"`
+	// Timestamp when this bundle version was first published (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+	// Timestamp when this bundle version was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-// GetName returns GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPageItemsOciRepoReleaseChannel.Name, and is useful for accessing the field via an interface.
-func (v *GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPageItemsOciRepoReleaseChannel) GetName() string {
-	return v.Name
-}
+// GetId returns GetInstanceInstanceBundle.Id, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceBundle) GetId() string { return v.Id }
 
-// GetTag returns GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPageItemsOciRepoReleaseChannel.Tag, and is useful for accessing the field via an interface.
-func (v *GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPageItemsOciRepoReleaseChannel) GetTag() string {
-	return v.Tag
-}
+// GetName returns GetInstanceInstanceBundle.Name, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceBundle) GetName() string { return v.Name }
 
-// GetOciRepoOciRepoTagsOciRepoTagsPage includes the requested fields of the GraphQL type OciRepoTagsPage.
-type GetOciRepoOciRepoTagsOciRepoTagsPage struct {
-	// A list of type oci_repo_tag.
-	Items []GetOciRepoOciRepoTagsOciRepoTagsPageItemsOciRepoTag `json:"items"`
-}
+// GetVersion returns GetInstanceInstanceBundle.Version, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceBundle) GetVersion() string { return v.Version }
 
-// GetItems returns GetOciRepoOciRepoTagsOciRepoTagsPage.Items, and is useful for accessing the field via an interface.
-func (v *GetOciRepoOciRepoTagsOciRepoTagsPage) GetItems() []GetOciRepoOciRepoTagsOciRepoTagsPageItemsOciRepoTag {
-	return v.Items
-}
+// GetDescription returns GetInstanceInstanceBundle.Description, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceBundle) GetDescription() string { return v.Description }
 
-// GetOciRepoOciRepoTagsOciRepoTagsPageItemsOciRepoTag includes the requested fields of the GraphQL type OciRepoTag.
+// GetIcon returns GetInstanceInstanceBundle.Icon, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceBundle) GetIcon() string { return v.Icon }
+
+// GetSourceUrl returns GetInstanceInstanceBundle.SourceUrl, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceBundle) GetSourceUrl() string { return v.SourceUrl }
+
+// GetRepo returns GetInstanceInstanceBundle.Repo, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceBundle) GetRepo() string { return v.Repo }
+
+// GetCreatedAt returns GetInstanceInstanceBundle.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceBundle) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetUpdatedAt returns GetInstanceInstanceBundle.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceBundle) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetInstanceInstanceComponent includes the requested fields of the GraphQL type Component.
 // The GraphQL type's documentation follows.
 //
-// A published version tag in an OCI repository.
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
 //
-// Each tag corresponds to a single bundle release. Tags are immutable -- once
-// a version is published, its contents cannot change. Development tags use
-// a `-dev.TIMESTAMP` suffix (e.g., `1.2.3-dev.20060102T150405Z`).
-type GetOciRepoOciRepoTagsOciRepoTagsPageItemsOciRepoTag struct {
-	// The semantic version string (e.g., `1.2.3` or `1.2.3-dev.20060102T150405Z`).
-	Tag string `json:"tag"`
-	// Timestamp when this version was published (UTC).
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
+//
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type GetInstanceInstanceComponent struct {
+	Id string `json:"id"`
+	// Human-readable display name shown in the UI.
+	Name string `json:"name"`
+	// Optional free-text description of this component's purpose.
+	Description string `json:"description"`
+	// Key-value attributes assigned directly to this component.
+	Attributes map[string]any `json:"-"`
+	// When this component was created (UTC).
 	CreatedAt time.Time `json:"createdAt"`
+	// When this component was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-// GetTag returns GetOciRepoOciRepoTagsOciRepoTagsPageItemsOciRepoTag.Tag, and is useful for accessing the field via an interface.
-func (v *GetOciRepoOciRepoTagsOciRepoTagsPageItemsOciRepoTag) GetTag() string { return v.Tag }
+// GetId returns GetInstanceInstanceComponent.Id, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceComponent) GetId() string { return v.Id }
 
-// GetCreatedAt returns GetOciRepoOciRepoTagsOciRepoTagsPageItemsOciRepoTag.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetOciRepoOciRepoTagsOciRepoTagsPageItemsOciRepoTag) GetCreatedAt() time.Time {
-	return v.CreatedAt
-}
+// GetName returns GetInstanceInstanceComponent.Name, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceComponent) GetName() string { return v.Name }
 
-// GetOciRepoResponse is returned by GetOciRepo on success.
-type GetOciRepoResponse struct {
-	// Fetch a single OCI repository by name.
-	//
-	// Returns the repository along with its nested `tags` and `releaseChannels`
-	// collections. Returns `null` with a `NOT_FOUND` error if the repository
-	// does not exist in your organization.
-	//
-	// ```graphql
-	// query {
-	// ociRepo(organizationId: "your-org-id", id: "aws-aurora-postgres") {
-	// id
-	// name
-	// artifactType
-	// tags(sort: { field: VERSION, order: DESC }) {
-	// items { tag createdAt }
-	// cursor { next }
-	// }
-	// releaseChannels(filter: { stable: true }) {
-	// items { name tag }
-	// }
-	// }
-	// }
-	// ```
-	OciRepo GetOciRepoOciRepo `json:"ociRepo"`
-}
-
-// GetOciRepo returns GetOciRepoResponse.OciRepo, and is useful for accessing the field via an interface.
-func (v *GetOciRepoResponse) GetOciRepo() GetOciRepoOciRepo { return v.OciRepo }
-
-// GetOrganizationOrganization includes the requested fields of the GraphQL type Organization.
-// The GraphQL type's documentation follows.
-//
-// The top-level account that owns all your infrastructure, projects, and team members.
-//
-// An organization is the root of the Massdriver resource hierarchy. Everything you build
-// and deploy lives under an organization: **Projects** contain your infrastructure designs,
-// **Environments** (like staging and production) are where those designs come to life, and
-// **Instances** are the actual running cloud resources.
-//
-// ```mermaid
-// graph TD
-// O["Organization"] --> P1["Project"]
-// O --> P2["Project"]
-// P1 --> E1["Environment: staging"]
-// P1 --> E2["Environment: production"]
-// E1 --> I1["Instance"]
-// E1 --> I2["Instance"]
-// ```
-//
-// Members access resources through **group memberships** with role-based permissions.
-// Custom attributes defined at the organization level govern attribute metadata across all child resources.
-//
-// Administrative fields (`billing`, `members`, `customAttributes`) resolve to `null` for
-// callers who lack the corresponding ABAC action; in that case a top-level `FORBIDDEN`
-// error is added to the response while the rest of the organization still resolves.
-type GetOrganizationOrganization struct {
-	Id string `json:"id"`
-	// Display name shown in the UI and CLI.
-	Name string `json:"name"`
-	// Subscription status of the organization. Visible to every member so the UI can
-	// surface billing warnings or lock down features for delinquent accounts. Detailed
-	// payment, seat, and invoice data live behind the admin-only `billing` field.
-	SubscriptionStatus OrganizationSubscriptionStatus `json:"subscriptionStatus"`
-	// When the current free trial expires (UTC).
-	//
-	// Only populated while `subscriptionStatus` is `TRIAL`. If a paid plan is not
-	// active by this timestamp, `subscriptionStatus` becomes `EXPIRED` and most
-	// write operations are blocked until billing is resolved. Once the organization
-	// upgrades to a paid plan or the trial expires, this field returns `null`.
-	TrialEndsAt time.Time `json:"trialEndsAt"`
-	// When this organization was created (UTC).
-	CreatedAt time.Time `json:"createdAt"`
-	// When this organization was last modified (UTC).
-	UpdatedAt time.Time `json:"updatedAt"`
-}
-
-// GetId returns GetOrganizationOrganization.Id, and is useful for accessing the field via an interface.
-func (v *GetOrganizationOrganization) GetId() string { return v.Id }
-
-// GetName returns GetOrganizationOrganization.Name, and is useful for accessing the field via an interface.
-func (v *GetOrganizationOrganization) GetName() string { return v.Name }
-
-// GetSubscriptionStatus returns GetOrganizationOrganization.SubscriptionStatus, and is useful for accessing the field via an interface.
-func (v *GetOrganizationOrganization) GetSubscriptionStatus() OrganizationSubscriptionStatus {
-	return v.SubscriptionStatus
-}
-
-// GetTrialEndsAt returns GetOrganizationOrganization.TrialEndsAt, and is useful for accessing the field via an interface.
-func (v *GetOrganizationOrganization) GetTrialEndsAt() time.Time { return v.TrialEndsAt }
-
-// GetCreatedAt returns GetOrganizationOrganization.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetOrganizationOrganization) GetCreatedAt() time.Time { return v.CreatedAt }
-
-// GetUpdatedAt returns GetOrganizationOrganization.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetOrganizationOrganization) GetUpdatedAt() time.Time { return v.UpdatedAt }
-
-// GetOrganizationResponse is returned by GetOrganization on success.
-type GetOrganizationResponse struct {
-	// Fetch your organization's details, including custom attributes and logo.
-	//
-	// ```graphql
-	// query {
-	// organization(organizationId: "my-org") {
-	// id
-	// name
-	// subscriptionStatus
-	// customAttributes { items { key scope required } }
-	// }
-	// }
-	// ```
-	Organization GetOrganizationOrganization `json:"organization"`
-}
-
-// GetOrganization returns GetOrganizationResponse.Organization, and is useful for accessing the field via an interface.
-func (v *GetOrganizationResponse) GetOrganization() GetOrganizationOrganization {
-	return v.Organization
-}
-
-// GetProjectProject includes the requested fields of the GraphQL type Project.
-// The GraphQL type's documentation follows.
-//
-// A project organizes related infrastructure under a single blueprint.
-//
-// Each project contains a **Blueprint** that defines your infrastructure architecture -- which
-// bundles to use and how they connect -- and one or more **Environments** (like staging or
-// production) where that architecture is actually deployed.
-//
-// ```mermaid
-// graph LR
-// P["Project"] --> B["Blueprint"]
-// P --> E1["Environment: staging"]
-// P --> E2["Environment: production"]
-// B --> C1["Component: database"]
-// B --> C2["Component: cache"]
-// C1 -.->|"Link"| C2
-// ```
-//
-// Attributes set on a project are inherited by all environments and instances within it.
-type GetProjectProject struct {
-	Id string `json:"id"`
-	// Display name shown in the UI and CLI. Must be unique within the organization.
-	Name string `json:"name"`
-	// Free-text description of what this project is for.
-	Description string `json:"description"`
-	// Key-value attributes assigned directly to this project. Attributes cascade to environments and instances. Must conform to your organization's custom attributes for the `PROJECT` scope.
-	Attributes map[string]any `json:"-"`
-	// When this project was created (UTC).
-	CreatedAt time.Time `json:"createdAt"`
-	// When this project was last modified (UTC).
-	UpdatedAt time.Time `json:"updatedAt"`
-	// Aggregated cloud-provider cost metrics for all instances in this project.
-	Cost GetProjectProjectCostCostSummary `json:"cost"`
-	// Paginated list of environments in this project (e.g., staging, production).
-	Environments GetProjectProjectEnvironmentsEnvironmentsPage `json:"environments"`
-	// Components that make up the project's infrastructure architecture.
-	Components []GetProjectProjectComponentsComponent `json:"components"`
-	// Links between components that wire one component's output to another's input.
-	Links []GetProjectProjectLinksLink `json:"links"`
-}
-
-// GetId returns GetProjectProject.Id, and is useful for accessing the field via an interface.
-func (v *GetProjectProject) GetId() string { return v.Id }
-
-// GetName returns GetProjectProject.Name, and is useful for accessing the field via an interface.
-func (v *GetProjectProject) GetName() string { return v.Name }
-
-// GetDescription returns GetProjectProject.Description, and is useful for accessing the field via an interface.
-func (v *GetProjectProject) GetDescription() string { return v.Description }
-
-// GetAttributes returns GetProjectProject.Attributes, and is useful for accessing the field via an interface.
-func (v *GetProjectProject) GetAttributes() map[string]any { return v.Attributes }
-
-// GetCreatedAt returns GetProjectProject.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetProjectProject) GetCreatedAt() time.Time { return v.CreatedAt }
-
-// GetUpdatedAt returns GetProjectProject.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetProjectProject) GetUpdatedAt() time.Time { return v.UpdatedAt }
-
-// GetCost returns GetProjectProject.Cost, and is useful for accessing the field via an interface.
-func (v *GetProjectProject) GetCost() GetProjectProjectCostCostSummary { return v.Cost }
+// GetDescription returns GetInstanceInstanceComponent.Description, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceComponent) GetDescription() string { return v.Description }
 
-// GetEnvironments returns GetProjectProject.Environments, and is useful for accessing the field via an interface.
-func (v *GetProjectProject) GetEnvironments() GetProjectProjectEnvironmentsEnvironmentsPage {
-	return v.Environments
-}
+// GetAttributes returns GetInstanceInstanceComponent.Attributes, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceComponent) GetAttributes() map[string]any { return v.Attributes }
 
-// GetComponents returns GetProjectProject.Components, and is useful for accessing the field via an interface.
-func (v *GetProjectProject) GetComponents() []GetProjectProjectComponentsComponent {
-	return v.Components
-}
+// GetCreatedAt returns GetInstanceInstanceComponent.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceComponent) GetCreatedAt() time.Time { return v.CreatedAt }
 
-// GetLinks returns GetProjectProject.Links, and is useful for accessing the field via an interface.
-func (v *GetProjectProject) GetLinks() []GetProjectProjectLinksLink { return v.Links }
+// GetUpdatedAt returns GetInstanceInstanceComponent.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceComponent) GetUpdatedAt() time.Time { return v.UpdatedAt }
 
-func (v *GetProjectProject) UnmarshalJSON(b []byte) error {
+func (v *GetInstanceInstanceComponent) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*GetProjectProject
+		*GetInstanceInstanceComponent
 		Attributes json.RawMessage `json:"attributes"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.GetProjectProject = v
+	firstPass.GetInstanceInstanceComponent = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -13191,14 +13142,14 @@ func (v *GetProjectProject) UnmarshalJSON(b []byte) error {
 				src, dst)
 			if err != nil {
 				return fmt.Errorf(
-					"unable to unmarshal GetProjectProject.Attributes: %w", err)
+					"unable to unmarshal GetInstanceInstanceComponent.Attributes: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalGetProjectProject struct {
+type __premarshalGetInstanceInstanceComponent struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
@@ -13210,17 +13161,9 @@ type __premarshalGetProjectProject struct {
 	CreatedAt time.Time `json:"createdAt"`
 
 	UpdatedAt time.Time `json:"updatedAt"`
-
-	Cost GetProjectProjectCostCostSummary `json:"cost"`
-
-	Environments GetProjectProjectEnvironmentsEnvironmentsPage `json:"environments"`
-
-	Components []GetProjectProjectComponentsComponent `json:"components"`
-
-	Links []GetProjectProjectLinksLink `json:"links"`
 }
 
-func (v *GetProjectProject) MarshalJSON() ([]byte, error) {
+func (v *GetInstanceInstanceComponent) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -13228,8 +13171,8 @@ func (v *GetProjectProject) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *GetProjectProject) __premarshalJSON() (*__premarshalGetProjectProject, error) {
-	var retval __premarshalGetProjectProject
+func (v *GetInstanceInstanceComponent) __premarshalJSON() (*__premarshalGetInstanceInstanceComponent, error) {
+	var retval __premarshalGetInstanceInstanceComponent
 
 	retval.Id = v.Id
 	retval.Name = v.Name
@@ -13243,240 +13186,60 @@ func (v *GetProjectProject) __premarshalJSON() (*__premarshalGetProjectProject,
 			&src)
 		if err != nil {
 			return nil, fmt.Errorf(
-				"unable to marshal GetProjectProject.Attributes: %w", err)
+				"unable to marshal GetInstanceInstanceComponent.Attributes: %w", err)
 		}
 	}
 	retval.CreatedAt = v.CreatedAt
 	retval.UpdatedAt = v.UpdatedAt
-	retval.Cost = v.Cost
-	retval.Environments = v.Environments
-	retval.Components = v.Components
-	retval.Links = v.Links
 	return &retval, nil
 }
 
-// GetProjectProjectComponentsComponent includes the requested fields of the GraphQL type Component.
+// GetInstanceInstanceCostCostSummary includes the requested fields of the GraphQL type CostSummary.
 // The GraphQL type's documentation follows.
 //
-// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+// Aggregated cloud-provider cost metrics for a project or environment.
 //
-// A component is the **design-time** building block of your architecture. It says
-// "I want a database here" or "I need a Kubernetes cluster there." The component
-// defines *what* to deploy; the actual running infrastructure lives in **instances**
-// -- one per environment the component is deployed to.
-//
-// Components are connected to each other via **links**, which declare that one
-// component's output (e.g., a connection string) should be wired into another
-// component's input.
-type GetProjectProjectComponentsComponent struct {
-	Id string `json:"id"`
-	// Human-readable display name shown in the UI.
-	Name string `json:"name"`
-	// Optional free-text description of this component's purpose.
-	Description string `json:"description"`
-	// Key-value attributes assigned directly to this component.
-	Attributes map[string]any `json:"-"`
-	// When this component was created (UTC).
-	CreatedAt time.Time `json:"createdAt"`
-	// When this component was last modified (UTC).
-	UpdatedAt time.Time `json:"updatedAt"`
-	// The OCI repository (bundle) this component is based on.
-	OciRepo GetProjectProjectComponentsComponentOciRepo `json:"ociRepo"`
-}
-
-// GetId returns GetProjectProjectComponentsComponent.Id, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectComponentsComponent) GetId() string { return v.Id }
-
-// GetName returns GetProjectProjectComponentsComponent.Name, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectComponentsComponent) GetName() string { return v.Name }
-
-// GetDescription returns GetProjectProjectComponentsComponent.Description, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectComponentsComponent) GetDescription() string { return v.Description }
-
-// GetAttributes returns GetProjectProjectComponentsComponent.Attributes, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectComponentsComponent) GetAttributes() map[string]any { return v.Attributes }
-
-// GetCreatedAt returns GetProjectProjectComponentsComponent.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectComponentsComponent) GetCreatedAt() time.Time { return v.CreatedAt }
-
-// GetUpdatedAt returns GetProjectProjectComponentsComponent.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectComponentsComponent) GetUpdatedAt() time.Time { return v.UpdatedAt }
-
-// GetOciRepo returns GetProjectProjectComponentsComponent.OciRepo, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectComponentsComponent) GetOciRepo() GetProjectProjectComponentsComponentOciRepo {
-	return v.OciRepo
-}
-
-func (v *GetProjectProjectComponentsComponent) UnmarshalJSON(b []byte) error {
-
-	if string(b) == "null" {
-		return nil
-	}
-
-	var firstPass struct {
-		*GetProjectProjectComponentsComponent
-		Attributes json.RawMessage `json:"attributes"`
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.GetProjectProjectComponentsComponent = v
-
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
-
-	{
-		dst := &v.Attributes
-		src := firstPass.Attributes
-		if len(src) != 0 && string(src) != "null" {
-			err = scalars.UnmarshalJSON(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal GetProjectProjectComponentsComponent.Attributes: %w", err)
-			}
-		}
-	}
-	return nil
-}
-
-type __premarshalGetProjectProjectComponentsComponent struct {
-	Id string `json:"id"`
-
-	Name string `json:"name"`
-
-	Description string `json:"description"`
-
-	Attributes json.RawMessage `json:"attributes"`
-
-	CreatedAt time.Time `json:"createdAt"`
-
-	UpdatedAt time.Time `json:"updatedAt"`
-
-	OciRepo GetProjectProjectComponentsComponentOciRepo `json:"ociRepo"`
-}
-
-func (v *GetProjectProjectComponentsComponent) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
-
-func (v *GetProjectProjectComponentsComponent) __premarshalJSON() (*__premarshalGetProjectProjectComponentsComponent, error) {
-	var retval __premarshalGetProjectProjectComponentsComponent
-
-	retval.Id = v.Id
-	retval.Name = v.Name
-	retval.Description = v.Description
-	{
-
-		dst := &retval.Attributes
-		src := v.Attributes
-		var err error
-		*dst, err = scalars.MarshalJSON(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal GetProjectProjectComponentsComponent.Attributes: %w", err)
-		}
-	}
-	retval.CreatedAt = v.CreatedAt
-	retval.UpdatedAt = v.UpdatedAt
-	retval.OciRepo = v.OciRepo
-	return &retval, nil
-}
-
-// GetProjectProjectComponentsComponentOciRepo includes the requested fields of the GraphQL type OciRepo.
-// The GraphQL type's documentation follows.
-//
-// An OCI repository in your organization's bundle catalog.
-//
-// An OCI repository is the container for all published versions of a single
-// infrastructure-as-code package. It is analogous to a Docker image repository
-// but for Massdriver bundles.
-//
-// Each repository has a unique `name` (e.g., `aws-aurora-postgres`) and contains:
-//
-// - **Tags** -- the individual published versions (`1.0.0`, `1.1.0`, `1.2.3`, etc.)
-// - **Release channels** -- auto-resolving version constraints (`latest`, `~1`, `~1.2`)
-// that always point to the newest matching tag
-//
-// To fetch a specific bundle version from a repository, use the `bundle` query
-// with a `BundleId` like `aws-aurora-postgres@1.2.3` or `aws-aurora-postgres@~1`.
-type GetProjectProjectComponentsComponentOciRepo struct {
-	Id string `json:"id"`
-	// Repository name, unique within your organization (e.g., `aws-aurora-postgres`).
-	Name string `json:"name"`
-	// The bare [OCI reference](https://github.com/opencontainers/distribution-spec/blob/main/spec.md#pulling-manifests)
-	// for this repository: `<registry>/<org>/<repo>` (for example,
-	// `api.massdriver.cloud/acme/aws-aurora-postgres`).
-	//
-	// Append `:<tag>` or `@<digest>` to address a specific manifest and use the
-	// result directly with `oras`, `docker`, or any OCI-compliant client:
-	//
-	// ```bash
-	// oras pull api.massdriver.cloud/acme/aws-aurora-postgres:1.2.3
-	// ```
-	Reference string `json:"reference"`
-}
-
-// GetId returns GetProjectProjectComponentsComponentOciRepo.Id, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectComponentsComponentOciRepo) GetId() string { return v.Id }
-
-// GetName returns GetProjectProjectComponentsComponentOciRepo.Name, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectComponentsComponentOciRepo) GetName() string { return v.Name }
-
-// GetReference returns GetProjectProjectComponentsComponentOciRepo.Reference, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectComponentsComponentOciRepo) GetReference() string { return v.Reference }
-
-// GetProjectProjectCostCostSummary includes the requested fields of the GraphQL type CostSummary.
-// The GraphQL type's documentation follows.
-//
-// Aggregated cloud-provider cost metrics for a project or environment.
-//
-// Cost data is sourced from your cloud provider's billing APIs and refreshed periodically.
-// Each metric is a `CostSample` containing an amount and currency. All four metrics are
-// always present, but their inner `amount` and `currency` may be null if billing data has
-// not yet been ingested.
+// Cost data is sourced from your cloud provider's billing APIs and refreshed periodically.
+// Each metric is a `CostSample` containing an amount and currency. All four metrics are
+// always present, but their inner `amount` and `currency` may be null if billing data has
+// not yet been ingested.
 //
 // - **last_month** -- Total spend for the most recent complete billing cycle.
 // - **monthly_average** -- Average monthly spend across all available billing cycles.
 // - **last_day** -- Total spend for the most recent 24-hour period.
 // - **daily_average** -- Average daily spend over the last 7 days.
-type GetProjectProjectCostCostSummary struct {
+type GetInstanceInstanceCostCostSummary struct {
 	// Total cost for the most recent complete billing cycle.
-	LastMonth GetProjectProjectCostCostSummaryLastMonthCostSample `json:"lastMonth"`
+	LastMonth GetInstanceInstanceCostCostSummaryLastMonthCostSample `json:"lastMonth"`
 	// Average monthly cost across all available billing cycles.
-	MonthlyAverage GetProjectProjectCostCostSummaryMonthlyAverageCostSample `json:"monthlyAverage"`
+	MonthlyAverage GetInstanceInstanceCostCostSummaryMonthlyAverageCostSample `json:"monthlyAverage"`
 	// Total cost for the most recent 24-hour period.
-	LastDay GetProjectProjectCostCostSummaryLastDayCostSample `json:"lastDay"`
+	LastDay GetInstanceInstanceCostCostSummaryLastDayCostSample `json:"lastDay"`
 	// Average daily cost over the last 7 days.
-	DailyAverage GetProjectProjectCostCostSummaryDailyAverageCostSample `json:"dailyAverage"`
+	DailyAverage GetInstanceInstanceCostCostSummaryDailyAverageCostSample `json:"dailyAverage"`
 }
 
-// GetLastMonth returns GetProjectProjectCostCostSummary.LastMonth, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectCostCostSummary) GetLastMonth() GetProjectProjectCostCostSummaryLastMonthCostSample {
+// GetLastMonth returns GetInstanceInstanceCostCostSummary.LastMonth, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceCostCostSummary) GetLastMonth() GetInstanceInstanceCostCostSummaryLastMonthCostSample {
 	return v.LastMonth
 }
 
-// GetMonthlyAverage returns GetProjectProjectCostCostSummary.MonthlyAverage, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectCostCostSummary) GetMonthlyAverage() GetProjectProjectCostCostSummaryMonthlyAverageCostSample {
+// GetMonthlyAverage returns GetInstanceInstanceCostCostSummary.MonthlyAverage, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceCostCostSummary) GetMonthlyAverage() GetInstanceInstanceCostCostSummaryMonthlyAverageCostSample {
 	return v.MonthlyAverage
 }
 
-// GetLastDay returns GetProjectProjectCostCostSummary.LastDay, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectCostCostSummary) GetLastDay() GetProjectProjectCostCostSummaryLastDayCostSample {
+// GetLastDay returns GetInstanceInstanceCostCostSummary.LastDay, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceCostCostSummary) GetLastDay() GetInstanceInstanceCostCostSummaryLastDayCostSample {
 	return v.LastDay
 }
 
-// GetDailyAverage returns GetProjectProjectCostCostSummary.DailyAverage, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectCostCostSummary) GetDailyAverage() GetProjectProjectCostCostSummaryDailyAverageCostSample {
+// GetDailyAverage returns GetInstanceInstanceCostCostSummary.DailyAverage, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceCostCostSummary) GetDailyAverage() GetInstanceInstanceCostCostSummaryDailyAverageCostSample {
 	return v.DailyAverage
 }
 
-// GetProjectProjectCostCostSummaryDailyAverageCostSample includes the requested fields of the GraphQL type CostSample.
+// GetInstanceInstanceCostCostSummaryDailyAverageCostSample includes the requested fields of the GraphQL type CostSample.
 // The GraphQL type's documentation follows.
 //
 // A single cost data point containing an amount and its currency.
@@ -13485,22 +13248,24 @@ func (v *GetProjectProjectCostCostSummary) GetDailyAverage() GetProjectProjectCo
 // data for the requested period -- this is normal for newly provisioned resources or when
 // cloud provider billing data has not yet been ingested. When data is present, `amount` is
 // always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
-type GetProjectProjectCostCostSummaryDailyAverageCostSample struct {
+type GetInstanceInstanceCostCostSummaryDailyAverageCostSample struct {
 	// The cost in the given currency. Null when no billing data is available for this period.
 	Amount float64 `json:"amount"`
 	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
 	Currency string `json:"currency"`
 }
 
-// GetAmount returns GetProjectProjectCostCostSummaryDailyAverageCostSample.Amount, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectCostCostSummaryDailyAverageCostSample) GetAmount() float64 { return v.Amount }
+// GetAmount returns GetInstanceInstanceCostCostSummaryDailyAverageCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceCostCostSummaryDailyAverageCostSample) GetAmount() float64 {
+	return v.Amount
+}
 
-// GetCurrency returns GetProjectProjectCostCostSummaryDailyAverageCostSample.Currency, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectCostCostSummaryDailyAverageCostSample) GetCurrency() string {
+// GetCurrency returns GetInstanceInstanceCostCostSummaryDailyAverageCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceCostCostSummaryDailyAverageCostSample) GetCurrency() string {
 	return v.Currency
 }
 
-// GetProjectProjectCostCostSummaryLastDayCostSample includes the requested fields of the GraphQL type CostSample.
+// GetInstanceInstanceCostCostSummaryLastDayCostSample includes the requested fields of the GraphQL type CostSample.
 // The GraphQL type's documentation follows.
 //
 // A single cost data point containing an amount and its currency.
@@ -13509,20 +13274,20 @@ func (v *GetProjectProjectCostCostSummaryDailyAverageCostSample) GetCurrency() s
 // data for the requested period -- this is normal for newly provisioned resources or when
 // cloud provider billing data has not yet been ingested. When data is present, `amount` is
 // always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
-type GetProjectProjectCostCostSummaryLastDayCostSample struct {
+type GetInstanceInstanceCostCostSummaryLastDayCostSample struct {
 	// The cost in the given currency. Null when no billing data is available for this period.
 	Amount float64 `json:"amount"`
 	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
 	Currency string `json:"currency"`
 }
 
-// GetAmount returns GetProjectProjectCostCostSummaryLastDayCostSample.Amount, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectCostCostSummaryLastDayCostSample) GetAmount() float64 { return v.Amount }
+// GetAmount returns GetInstanceInstanceCostCostSummaryLastDayCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceCostCostSummaryLastDayCostSample) GetAmount() float64 { return v.Amount }
 
-// GetCurrency returns GetProjectProjectCostCostSummaryLastDayCostSample.Currency, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectCostCostSummaryLastDayCostSample) GetCurrency() string { return v.Currency }
+// GetCurrency returns GetInstanceInstanceCostCostSummaryLastDayCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceCostCostSummaryLastDayCostSample) GetCurrency() string { return v.Currency }
 
-// GetProjectProjectCostCostSummaryLastMonthCostSample includes the requested fields of the GraphQL type CostSample.
+// GetInstanceInstanceCostCostSummaryLastMonthCostSample includes the requested fields of the GraphQL type CostSample.
 // The GraphQL type's documentation follows.
 //
 // A single cost data point containing an amount and its currency.
@@ -13531,20 +13296,22 @@ func (v *GetProjectProjectCostCostSummaryLastDayCostSample) GetCurrency() string
 // data for the requested period -- this is normal for newly provisioned resources or when
 // cloud provider billing data has not yet been ingested. When data is present, `amount` is
 // always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
-type GetProjectProjectCostCostSummaryLastMonthCostSample struct {
+type GetInstanceInstanceCostCostSummaryLastMonthCostSample struct {
 	// The cost in the given currency. Null when no billing data is available for this period.
 	Amount float64 `json:"amount"`
 	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
 	Currency string `json:"currency"`
 }
 
-// GetAmount returns GetProjectProjectCostCostSummaryLastMonthCostSample.Amount, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectCostCostSummaryLastMonthCostSample) GetAmount() float64 { return v.Amount }
+// GetAmount returns GetInstanceInstanceCostCostSummaryLastMonthCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceCostCostSummaryLastMonthCostSample) GetAmount() float64 { return v.Amount }
 
-// GetCurrency returns GetProjectProjectCostCostSummaryLastMonthCostSample.Currency, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectCostCostSummaryLastMonthCostSample) GetCurrency() string { return v.Currency }
+// GetCurrency returns GetInstanceInstanceCostCostSummaryLastMonthCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceCostCostSummaryLastMonthCostSample) GetCurrency() string {
+	return v.Currency
+}
 
-// GetProjectProjectCostCostSummaryMonthlyAverageCostSample includes the requested fields of the GraphQL type CostSample.
+// GetInstanceInstanceCostCostSummaryMonthlyAverageCostSample includes the requested fields of the GraphQL type CostSample.
 // The GraphQL type's documentation follows.
 //
 // A single cost data point containing an amount and its currency.
@@ -13553,35 +13320,24 @@ func (v *GetProjectProjectCostCostSummaryLastMonthCostSample) GetCurrency() stri
 // data for the requested period -- this is normal for newly provisioned resources or when
 // cloud provider billing data has not yet been ingested. When data is present, `amount` is
 // always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
-type GetProjectProjectCostCostSummaryMonthlyAverageCostSample struct {
+type GetInstanceInstanceCostCostSummaryMonthlyAverageCostSample struct {
 	// The cost in the given currency. Null when no billing data is available for this period.
 	Amount float64 `json:"amount"`
 	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
 	Currency string `json:"currency"`
 }
 
-// GetAmount returns GetProjectProjectCostCostSummaryMonthlyAverageCostSample.Amount, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectCostCostSummaryMonthlyAverageCostSample) GetAmount() float64 {
+// GetAmount returns GetInstanceInstanceCostCostSummaryMonthlyAverageCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceCostCostSummaryMonthlyAverageCostSample) GetAmount() float64 {
 	return v.Amount
 }
 
-// GetCurrency returns GetProjectProjectCostCostSummaryMonthlyAverageCostSample.Currency, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectCostCostSummaryMonthlyAverageCostSample) GetCurrency() string {
+// GetCurrency returns GetInstanceInstanceCostCostSummaryMonthlyAverageCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceCostCostSummaryMonthlyAverageCostSample) GetCurrency() string {
 	return v.Currency
 }
 
-// GetProjectProjectEnvironmentsEnvironmentsPage includes the requested fields of the GraphQL type EnvironmentsPage.
-type GetProjectProjectEnvironmentsEnvironmentsPage struct {
-	// A list of type environment.
-	Items []GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment `json:"items"`
-}
-
-// GetItems returns GetProjectProjectEnvironmentsEnvironmentsPage.Items, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectEnvironmentsEnvironmentsPage) GetItems() []GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment {
-	return v.Items
-}
-
-// GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment includes the requested fields of the GraphQL type Environment.
+// GetInstanceInstanceEnvironment includes the requested fields of the GraphQL type Environment.
 // The GraphQL type's documentation follows.
 //
 // A deployment target within a project where blueprint components become live infrastructure.
@@ -13596,7 +13352,7 @@ func (v *GetProjectProjectEnvironmentsEnvironmentsPage) GetItems() []GetProjectP
 //
 // Before deleting an environment, all instances must be decommissioned. Use the `deletable`
 // field to check for blocking constraints.
-type GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment struct {
+type GetInstanceInstanceEnvironment struct {
 	Id string `json:"id"`
 	// Display name shown in the UI and CLI. Must be unique within the project.
 	Name string `json:"name"`
@@ -13608,48 +13364,45 @@ type GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment struct {
 	CreatedAt time.Time `json:"createdAt"`
 	// When this environment was last modified (UTC).
 	UpdatedAt time.Time `json:"updatedAt"`
+	// The parent project that this environment belongs to.
+	Project GetInstanceInstanceEnvironmentProject `json:"project"`
 }
 
-// GetId returns GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment.Id, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) GetId() string { return v.Id }
+// GetId returns GetInstanceInstanceEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceEnvironment) GetId() string { return v.Id }
 
-// GetName returns GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment.Name, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) GetName() string {
-	return v.Name
-}
+// GetName returns GetInstanceInstanceEnvironment.Name, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceEnvironment) GetName() string { return v.Name }
 
-// GetDescription returns GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment.Description, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) GetDescription() string {
-	return v.Description
-}
+// GetDescription returns GetInstanceInstanceEnvironment.Description, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceEnvironment) GetDescription() string { return v.Description }
 
-// GetAttributes returns GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment.Attributes, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) GetAttributes() map[string]any {
-	return v.Attributes
-}
+// GetAttributes returns GetInstanceInstanceEnvironment.Attributes, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceEnvironment) GetAttributes() map[string]any { return v.Attributes }
 
-// GetCreatedAt returns GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) GetCreatedAt() time.Time {
-	return v.CreatedAt
-}
+// GetCreatedAt returns GetInstanceInstanceEnvironment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceEnvironment) GetCreatedAt() time.Time { return v.CreatedAt }
 
-// GetUpdatedAt returns GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) GetUpdatedAt() time.Time {
-	return v.UpdatedAt
+// GetUpdatedAt returns GetInstanceInstanceEnvironment.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceEnvironment) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetProject returns GetInstanceInstanceEnvironment.Project, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceEnvironment) GetProject() GetInstanceInstanceEnvironmentProject {
+	return v.Project
 }
 
-func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) UnmarshalJSON(b []byte) error {
+func (v *GetInstanceInstanceEnvironment) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment
+		*GetInstanceInstanceEnvironment
 		Attributes json.RawMessage `json:"attributes"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment = v
+	firstPass.GetInstanceInstanceEnvironment = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -13664,14 +13417,14 @@ func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) Unmarsha
 				src, dst)
 			if err != nil {
 				return fmt.Errorf(
-					"unable to unmarshal GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment.Attributes: %w", err)
+					"unable to unmarshal GetInstanceInstanceEnvironment.Attributes: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalGetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment struct {
+type __premarshalGetInstanceInstanceEnvironment struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
@@ -13683,9 +13436,11 @@ type __premarshalGetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment s
 	CreatedAt time.Time `json:"createdAt"`
 
 	UpdatedAt time.Time `json:"updatedAt"`
+
+	Project GetInstanceInstanceEnvironmentProject `json:"project"`
 }
 
-func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) MarshalJSON() ([]byte, error) {
+func (v *GetInstanceInstanceEnvironment) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -13693,8 +13448,8 @@ func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) MarshalJ
 	return json.Marshal(premarshaled)
 }
 
-func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) __premarshalJSON() (*__premarshalGetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment, error) {
-	var retval __premarshalGetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment
+func (v *GetInstanceInstanceEnvironment) __premarshalJSON() (*__premarshalGetInstanceInstanceEnvironment, error) {
+	var retval __premarshalGetInstanceInstanceEnvironment
 
 	retval.Id = v.Id
 	retval.Name = v.Name
@@ -13708,128 +13463,71 @@ func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) __premar
 			&src)
 		if err != nil {
 			return nil, fmt.Errorf(
-				"unable to marshal GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment.Attributes: %w", err)
+				"unable to marshal GetInstanceInstanceEnvironment.Attributes: %w", err)
 		}
 	}
 	retval.CreatedAt = v.CreatedAt
 	retval.UpdatedAt = v.UpdatedAt
+	retval.Project = v.Project
 	return &retval, nil
 }
 
-// GetProjectProjectLinksLink includes the requested fields of the GraphQL type Link.
+// GetInstanceInstanceEnvironmentProject includes the requested fields of the GraphQL type Project.
 // The GraphQL type's documentation follows.
 //
-// A design-time dependency between two components in a blueprint.
-//
-// A link declares that one component's output should be wired into another
-// component's input. For example, a link from a database component's
-// `authentication` output to an application component's `database` input
-// ensures the app receives the database connection string.
-//
-// At deploy time, each link is realized as a **connection** in the environment,
-// wiring the actual instance outputs to instance inputs.
-type GetProjectProjectLinksLink struct {
-	// Unique identifier for this link.
-	Id string `json:"id"`
-	// The output field name on the source component (e.g., `authentication`).
-	FromField string `json:"fromField"`
-	// The input field name on the destination component (e.g., `database`).
-	ToField string `json:"toField"`
-	// When this link was created (UTC).
-	CreatedAt time.Time `json:"createdAt"`
-	// When this link was last modified (UTC).
-	UpdatedAt time.Time `json:"updatedAt"`
-	// The source component that produces the output.
-	FromComponent GetProjectProjectLinksLinkFromComponent `json:"fromComponent"`
-	// The destination component that consumes the input.
-	ToComponent GetProjectProjectLinksLinkToComponent `json:"toComponent"`
-}
-
-// GetId returns GetProjectProjectLinksLink.Id, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectLinksLink) GetId() string { return v.Id }
-
-// GetFromField returns GetProjectProjectLinksLink.FromField, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectLinksLink) GetFromField() string { return v.FromField }
-
-// GetToField returns GetProjectProjectLinksLink.ToField, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectLinksLink) GetToField() string { return v.ToField }
-
-// GetCreatedAt returns GetProjectProjectLinksLink.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectLinksLink) GetCreatedAt() time.Time { return v.CreatedAt }
-
-// GetUpdatedAt returns GetProjectProjectLinksLink.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectLinksLink) GetUpdatedAt() time.Time { return v.UpdatedAt }
-
-// GetFromComponent returns GetProjectProjectLinksLink.FromComponent, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectLinksLink) GetFromComponent() GetProjectProjectLinksLinkFromComponent {
-	return v.FromComponent
-}
-
-// GetToComponent returns GetProjectProjectLinksLink.ToComponent, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectLinksLink) GetToComponent() GetProjectProjectLinksLinkToComponent {
-	return v.ToComponent
-}
-
-// GetProjectProjectLinksLinkFromComponent includes the requested fields of the GraphQL type Component.
-// The GraphQL type's documentation follows.
+// A project organizes related infrastructure under a single blueprint.
 //
-// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+// Each project contains a **Blueprint** that defines your infrastructure architecture -- which
+// bundles to use and how they connect -- and one or more **Environments** (like staging or
+// production) where that architecture is actually deployed.
 //
-// A component is the **design-time** building block of your architecture. It says
-// "I want a database here" or "I need a Kubernetes cluster there." The component
-// defines *what* to deploy; the actual running infrastructure lives in **instances**
-// -- one per environment the component is deployed to.
+// ```mermaid
+// graph LR
+// P["Project"] --> B["Blueprint"]
+// P --> E1["Environment: staging"]
+// P --> E2["Environment: production"]
+// B --> C1["Component: database"]
+// B --> C2["Component: cache"]
+// C1 -.->|"Link"| C2
+// ```
 //
-// Components are connected to each other via **links**, which declare that one
-// component's output (e.g., a connection string) should be wired into another
-// component's input.
-type GetProjectProjectLinksLinkFromComponent struct {
+// Attributes set on a project are inherited by all environments and instances within it.
+type GetInstanceInstanceEnvironmentProject struct {
 	Id string `json:"id"`
-	// Human-readable display name shown in the UI.
+	// Display name shown in the UI and CLI. Must be unique within the organization.
 	Name string `json:"name"`
+	// Free-text description of what this project is for.
+	Description string `json:"description"`
 }
 
-// GetId returns GetProjectProjectLinksLinkFromComponent.Id, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectLinksLinkFromComponent) GetId() string { return v.Id }
+// GetId returns GetInstanceInstanceEnvironmentProject.Id, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceEnvironmentProject) GetId() string { return v.Id }
 
-// GetName returns GetProjectProjectLinksLinkFromComponent.Name, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectLinksLinkFromComponent) GetName() string { return v.Name }
+// GetName returns GetInstanceInstanceEnvironmentProject.Name, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceEnvironmentProject) GetName() string { return v.Name }
 
-// GetProjectProjectLinksLinkToComponent includes the requested fields of the GraphQL type Component.
+// GetDescription returns GetInstanceInstanceEnvironmentProject.Description, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceEnvironmentProject) GetDescription() string { return v.Description }
+
+// GetInstanceInstanceResourcesInstanceResource includes the requested fields of the GraphQL type InstanceResource.
 // The GraphQL type's documentation follows.
 //
-// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
-//
-// A component is the **design-time** building block of your architecture. It says
-// "I want a database here" or "I need a Kubernetes cluster there." The component
-// defines *what* to deploy; the actual running infrastructure lives in **instances**
-// -- one per environment the component is deployed to.
+// An output resource produced by an instance, keyed by the field handle that produced it.
 //
-// Components are connected to each other via **links**, which declare that one
-// component's output (e.g., a connection string) should be wired into another
-// component's input.
-type GetProjectProjectLinksLinkToComponent struct {
-	Id string `json:"id"`
-	// Human-readable display name shown in the UI.
-	Name string `json:"name"`
+// Resources are the outputs an instance publishes after a successful deployment
+// (e.g., a database connection string, a Kubernetes cluster endpoint). Other
+// instances can consume these resources via connections.
+type GetInstanceInstanceResourcesInstanceResource struct {
+	// The resource containing the actual data.
+	Resource GetInstanceInstanceResourcesInstanceResourceResource `json:"resource"`
 }
 
-// GetId returns GetProjectProjectLinksLinkToComponent.Id, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectLinksLinkToComponent) GetId() string { return v.Id }
-
-// GetName returns GetProjectProjectLinksLinkToComponent.Name, and is useful for accessing the field via an interface.
-func (v *GetProjectProjectLinksLinkToComponent) GetName() string { return v.Name }
-
-// GetProjectResponse is returned by GetProject on success.
-type GetProjectResponse struct {
-	// Fetch a single project by its identifier.
-	Project GetProjectProject `json:"project"`
+// GetResource returns GetInstanceInstanceResourcesInstanceResource.Resource, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceResourcesInstanceResource) GetResource() GetInstanceInstanceResourcesInstanceResourceResource {
+	return v.Resource
 }
 
-// GetProject returns GetProjectResponse.Project, and is useful for accessing the field via an interface.
-func (v *GetProjectResponse) GetProject() GetProjectProject { return v.Project }
-
-// GetResourceResource includes the requested fields of the GraphQL type Resource.
+// GetInstanceInstanceResourcesInstanceResourceResource includes the requested fields of the GraphQL type Resource.
 // The GraphQL type's documentation follows.
 //
 // A cloud credential, database connection string, network configuration, or other
@@ -13844,7 +13542,7 @@ func (v *GetProjectResponse) GetProject() GetProjectProject { return v.Project }
 // You have full CRUD control over these resources.
 // - **Provisioned** — created automatically when an instance is deployed. These are read-only
 // and managed entirely by the owning instance's lifecycle.
-type GetResourceResource struct {
+type GetInstanceInstanceResourcesInstanceResourceResource struct {
 	// Unique identifier for this resource.
 	Id string `json:"id"`
 	// Human-readable display name for this resource.
@@ -13856,83 +13554,74 @@ type GetResourceResource struct {
 	// Set only for **provisioned** resources — it corresponds to a field declared under
 	// `artifacts` in the producing bundle's `massdriver.yaml`. Null for **imported** resources.
 	Field string `json:"field"`
-	// Download formats supported for this resource.
-	//
-	// Always includes `json` (the raw payload). Additional formats come from the resource
-	// type's `$md.export` declarations, which can render the payload as YAML or other
-	// templated outputs. Pass a returned format to `downloadArtifact` to retrieve the
-	// rendered content.
-	Formats []string `json:"formats"`
-	// The resource's structured payload. Fields marked `$md.sensitive` in the resource type's
-	// schema are masked as `[SENSITIVE]`. Use `exportResource` to retrieve an unmasked copy —
-	// that operation is recorded in the audit log.
-	Payload map[string]any `json:"-"`
 	// Key-value attributes assigned directly to this resource, used by ABAC
 	// policies. Reserved keys starting with `md-` are auto-injected by the system
 	// and excluded from this map — see `effectiveAttributes` for the merged view.
 	Attributes map[string]any `json:"-"`
+	// The resource's structured payload. Fields marked `$md.sensitive` in the resource type's
+	// schema are masked as `[SENSITIVE]`. Use `exportResource` to retrieve an unmasked copy —
+	// that operation is recorded in the audit log.
+	Payload map[string]any `json:"-"`
 	// When this resource was created (UTC).
 	CreatedAt time.Time `json:"createdAt"`
 	// When this resource was last modified (UTC).
 	UpdatedAt time.Time `json:"updatedAt"`
 	// The resource type that this resource conforms to, defining its schema and validation rules.
-	ResourceType *GetResourceResourceResourceType `json:"resourceType"`
-	// The instance whose deployment produced this resource.
-	//
-	// Null for **imported** resources. For **provisioned** resources, this is the instance
-	// that owns the resource's lifecycle — updating or decommissioning the instance will
-	// update or remove the resource.
-	Instance *GetResourceResourceInstance `json:"instance"`
+	ResourceType *GetInstanceInstanceResourcesInstanceResourceResourceResourceType `json:"resourceType"`
 }
 
-// GetId returns GetResourceResource.Id, and is useful for accessing the field via an interface.
-func (v *GetResourceResource) GetId() string { return v.Id }
+// GetId returns GetInstanceInstanceResourcesInstanceResourceResource.Id, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceResourcesInstanceResourceResource) GetId() string { return v.Id }
 
-// GetName returns GetResourceResource.Name, and is useful for accessing the field via an interface.
-func (v *GetResourceResource) GetName() string { return v.Name }
+// GetName returns GetInstanceInstanceResourcesInstanceResourceResource.Name, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceResourcesInstanceResourceResource) GetName() string { return v.Name }
 
-// GetOrigin returns GetResourceResource.Origin, and is useful for accessing the field via an interface.
-func (v *GetResourceResource) GetOrigin() ResourceOrigin { return v.Origin }
+// GetOrigin returns GetInstanceInstanceResourcesInstanceResourceResource.Origin, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceResourcesInstanceResourceResource) GetOrigin() ResourceOrigin {
+	return v.Origin
+}
 
-// GetField returns GetResourceResource.Field, and is useful for accessing the field via an interface.
-func (v *GetResourceResource) GetField() string { return v.Field }
+// GetField returns GetInstanceInstanceResourcesInstanceResourceResource.Field, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceResourcesInstanceResourceResource) GetField() string { return v.Field }
 
-// GetFormats returns GetResourceResource.Formats, and is useful for accessing the field via an interface.
-func (v *GetResourceResource) GetFormats() []string { return v.Formats }
+// GetAttributes returns GetInstanceInstanceResourcesInstanceResourceResource.Attributes, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceResourcesInstanceResourceResource) GetAttributes() map[string]any {
+	return v.Attributes
+}
 
-// GetPayload returns GetResourceResource.Payload, and is useful for accessing the field via an interface.
-func (v *GetResourceResource) GetPayload() map[string]any { return v.Payload }
+// GetPayload returns GetInstanceInstanceResourcesInstanceResourceResource.Payload, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceResourcesInstanceResourceResource) GetPayload() map[string]any {
+	return v.Payload
+}
 
-// GetAttributes returns GetResourceResource.Attributes, and is useful for accessing the field via an interface.
-func (v *GetResourceResource) GetAttributes() map[string]any { return v.Attributes }
+// GetCreatedAt returns GetInstanceInstanceResourcesInstanceResourceResource.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceResourcesInstanceResourceResource) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
 
-// GetCreatedAt returns GetResourceResource.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetResourceResource) GetCreatedAt() time.Time { return v.CreatedAt }
+// GetUpdatedAt returns GetInstanceInstanceResourcesInstanceResourceResource.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceResourcesInstanceResourceResource) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
 
-// GetUpdatedAt returns GetResourceResource.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetResourceResource) GetUpdatedAt() time.Time { return v.UpdatedAt }
-
-// GetResourceType returns GetResourceResource.ResourceType, and is useful for accessing the field via an interface.
-func (v *GetResourceResource) GetResourceType() *GetResourceResourceResourceType {
+// GetResourceType returns GetInstanceInstanceResourcesInstanceResourceResource.ResourceType, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceResourcesInstanceResourceResource) GetResourceType() *GetInstanceInstanceResourcesInstanceResourceResourceResourceType {
 	return v.ResourceType
 }
 
-// GetInstance returns GetResourceResource.Instance, and is useful for accessing the field via an interface.
-func (v *GetResourceResource) GetInstance() *GetResourceResourceInstance { return v.Instance }
-
-func (v *GetResourceResource) UnmarshalJSON(b []byte) error {
+func (v *GetInstanceInstanceResourcesInstanceResourceResource) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*GetResourceResource
-		Payload    json.RawMessage `json:"payload"`
+		*GetInstanceInstanceResourcesInstanceResourceResource
 		Attributes json.RawMessage `json:"attributes"`
+		Payload    json.RawMessage `json:"payload"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.GetResourceResource = v
+	firstPass.GetInstanceInstanceResourcesInstanceResourceResource = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -13940,34 +13629,34 @@ func (v *GetResourceResource) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.Payload
-		src := firstPass.Payload
+		dst := &v.Attributes
+		src := firstPass.Attributes
 		if len(src) != 0 && string(src) != "null" {
 			err = scalars.UnmarshalJSON(
 				src, dst)
 			if err != nil {
 				return fmt.Errorf(
-					"unable to unmarshal GetResourceResource.Payload: %w", err)
+					"unable to unmarshal GetInstanceInstanceResourcesInstanceResourceResource.Attributes: %w", err)
 			}
 		}
 	}
 
 	{
-		dst := &v.Attributes
-		src := firstPass.Attributes
+		dst := &v.Payload
+		src := firstPass.Payload
 		if len(src) != 0 && string(src) != "null" {
 			err = scalars.UnmarshalJSON(
 				src, dst)
 			if err != nil {
 				return fmt.Errorf(
-					"unable to unmarshal GetResourceResource.Attributes: %w", err)
+					"unable to unmarshal GetInstanceInstanceResourcesInstanceResourceResource.Payload: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalGetResourceResource struct {
+type __premarshalGetInstanceInstanceResourcesInstanceResourceResource struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
@@ -13976,22 +13665,18 @@ type __premarshalGetResourceResource struct {
 
 	Field string `json:"field"`
 
-	Formats []string `json:"formats"`
+	Attributes json.RawMessage `json:"attributes"`
 
 	Payload json.RawMessage `json:"payload"`
 
-	Attributes json.RawMessage `json:"attributes"`
-
 	CreatedAt time.Time `json:"createdAt"`
 
 	UpdatedAt time.Time `json:"updatedAt"`
 
-	ResourceType *GetResourceResourceResourceType `json:"resourceType"`
-
-	Instance *GetResourceResourceInstance `json:"instance"`
+	ResourceType *GetInstanceInstanceResourcesInstanceResourceResourceResourceType `json:"resourceType"`
 }
 
-func (v *GetResourceResource) MarshalJSON() ([]byte, error) {
+func (v *GetInstanceInstanceResourcesInstanceResourceResource) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -13999,46 +13684,95 @@ func (v *GetResourceResource) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *GetResourceResource) __premarshalJSON() (*__premarshalGetResourceResource, error) {
-	var retval __premarshalGetResourceResource
+func (v *GetInstanceInstanceResourcesInstanceResourceResource) __premarshalJSON() (*__premarshalGetInstanceInstanceResourcesInstanceResourceResource, error) {
+	var retval __premarshalGetInstanceInstanceResourcesInstanceResourceResource
 
 	retval.Id = v.Id
 	retval.Name = v.Name
 	retval.Origin = v.Origin
 	retval.Field = v.Field
-	retval.Formats = v.Formats
 	{
 
-		dst := &retval.Payload
-		src := v.Payload
+		dst := &retval.Attributes
+		src := v.Attributes
 		var err error
 		*dst, err = scalars.MarshalJSON(
 			&src)
 		if err != nil {
 			return nil, fmt.Errorf(
-				"unable to marshal GetResourceResource.Payload: %w", err)
+				"unable to marshal GetInstanceInstanceResourcesInstanceResourceResource.Attributes: %w", err)
 		}
 	}
 	{
 
-		dst := &retval.Attributes
-		src := v.Attributes
+		dst := &retval.Payload
+		src := v.Payload
 		var err error
 		*dst, err = scalars.MarshalJSON(
 			&src)
 		if err != nil {
 			return nil, fmt.Errorf(
-				"unable to marshal GetResourceResource.Attributes: %w", err)
+				"unable to marshal GetInstanceInstanceResourcesInstanceResourceResource.Payload: %w", err)
 		}
 	}
 	retval.CreatedAt = v.CreatedAt
 	retval.UpdatedAt = v.UpdatedAt
 	retval.ResourceType = v.ResourceType
-	retval.Instance = v.Instance
 	return &retval, nil
 }
 
-// GetResourceResourceInstance includes the requested fields of the GraphQL type Instance.
+// GetInstanceInstanceResourcesInstanceResourceResourceResourceType includes the requested fields of the GraphQL type ResourceType.
+// The GraphQL type's documentation follows.
+//
+// A resource type that defines what kind of infrastructure a resource represents.
+//
+// Resource types are the schema layer for Massdriver's connection system. Every
+// dependency a bundle declares and every resource a bundle produces references a
+// resource type. This is what makes bundles composable -- a database bundle that
+// produces an `aws-rds-instance` resource can be connected to any application
+// bundle that declares an `aws-rds-instance` dependency.
+//
+// Resource types include both public types provided by Massdriver (e.g.,
+// `aws-iam-role`, `kubernetes-cluster`) and private types defined by your
+// organization for custom infrastructure.
+type GetInstanceInstanceResourcesInstanceResourceResourceResourceType struct {
+	// Unique identifier in kebab-case (e.g., `aws-iam-role`, `kubernetes-cluster`).
+	Id string `json:"id"`
+	// Human-readable display name (e.g., "AWS IAM Role", "Kubernetes Cluster").
+	Name string `json:"name"`
+}
+
+// GetId returns GetInstanceInstanceResourcesInstanceResourceResourceResourceType.Id, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceResourcesInstanceResourceResourceResourceType) GetId() string {
+	return v.Id
+}
+
+// GetName returns GetInstanceInstanceResourcesInstanceResourceResourceResourceType.Name, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceResourcesInstanceResourceResourceResourceType) GetName() string {
+	return v.Name
+}
+
+// GetInstanceInstanceStatePathsInstanceStatePath includes the requested fields of the GraphQL type InstanceStatePath.
+// The GraphQL type's documentation follows.
+//
+// A Terraform/OpenTofu state path for a single deployment step.
+//
+// Bundles can define multiple provisioning steps (e.g., `core`, `iam`, `monitoring`).
+// Each step has its own state file managed by the Massdriver HTTP state backend.
+type GetInstanceInstanceStatePathsInstanceStatePath struct {
+	// The step's path identifier as defined in the bundle's `massdriver.yaml`.
+	StepName string `json:"stepName"`
+	// Full URL for this step's state file on the Massdriver HTTP state backend.
+	StateUrl string `json:"stateUrl"`
+}
+
+// GetStepName returns GetInstanceInstanceStatePathsInstanceStatePath.StepName, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceStatePathsInstanceStatePath) GetStepName() string { return v.StepName }
+
+// GetStateUrl returns GetInstanceInstanceStatePathsInstanceStatePath.StateUrl, and is useful for accessing the field via an interface.
+func (v *GetInstanceInstanceStatePathsInstanceStatePath) GetStateUrl() string { return v.StateUrl }
+
+// GetInstanceRemoteReferencesInstance includes the requested fields of the GraphQL type Instance.
 // The GraphQL type's documentation follows.
 //
 // A deployed piece of infrastructure in an environment.
@@ -14067,354 +13801,149 @@ func (v *GetResourceResource) __premarshalJSON() (*__premarshalGetResourceResour
 // the `resolvedVersion` that will be used on the next deployment. Compare
 // `resolvedVersion` with `deployedVersion` to see if a redeployment is needed,
 // or check `availableUpgrade` for newer matching releases.
-type GetResourceResourceInstance struct {
+type GetInstanceRemoteReferencesInstance struct {
 	Id string `json:"id"`
-	// Name of the instance.
-	Name string `json:"name"`
+	// Dependencies wired into this instance's bundle slots, sorted alphabetically by field.
+	//
+	// Each entry is one filled slot from the bundle's `connections_schema` along
+	// with the source object that filled it — a blueprint `Connection`, a
+	// per-instance `RemoteReference`, or an `EnvironmentDefault` from the
+	// environment. Unfilled slots are not included.
+	Dependencies []GetInstanceRemoteReferencesInstanceDependenciesInstanceDependency `json:"dependencies"`
 }
 
-// GetId returns GetResourceResourceInstance.Id, and is useful for accessing the field via an interface.
-func (v *GetResourceResourceInstance) GetId() string { return v.Id }
+// GetId returns GetInstanceRemoteReferencesInstance.Id, and is useful for accessing the field via an interface.
+func (v *GetInstanceRemoteReferencesInstance) GetId() string { return v.Id }
 
-// GetName returns GetResourceResourceInstance.Name, and is useful for accessing the field via an interface.
-func (v *GetResourceResourceInstance) GetName() string { return v.Name }
+// GetDependencies returns GetInstanceRemoteReferencesInstance.Dependencies, and is useful for accessing the field via an interface.
+func (v *GetInstanceRemoteReferencesInstance) GetDependencies() []GetInstanceRemoteReferencesInstanceDependenciesInstanceDependency {
+	return v.Dependencies
+}
 
-// GetResourceResourceResourceType includes the requested fields of the GraphQL type ResourceType.
+// GetInstanceRemoteReferencesInstanceDependenciesInstanceDependency includes the requested fields of the GraphQL type InstanceDependency.
 // The GraphQL type's documentation follows.
 //
-// A resource type that defines what kind of infrastructure a resource represents.
-//
-// Resource types are the schema layer for Massdriver's connection system. Every
-// dependency a bundle declares and every resource a bundle produces references a
-// resource type. This is what makes bundles composable -- a database bundle that
-// produces an `aws-rds-instance` resource can be connected to any application
-// bundle that declares an `aws-rds-instance` dependency.
+// An input dependency consumed by an instance, keyed by the field handle that receives it.
 //
-// Resource types include both public types provided by Massdriver (e.g.,
-// `aws-iam-role`, `kubernetes-cluster`) and private types defined by your
-// organization for custom infrastructure.
-type GetResourceResourceResourceType struct {
-	// Unique identifier in kebab-case (e.g., `aws-iam-role`, `kubernetes-cluster`).
-	Id string `json:"id"`
-	// Human-readable display name (e.g., "AWS IAM Role", "Kubernetes Cluster").
-	Name string `json:"name"`
+// Dependencies are resources wired into this instance's bundle slots — either
+// through a blueprint connection, a per-instance remote-reference override, or
+// the environment's default for the resource type.
+type GetInstanceRemoteReferencesInstanceDependenciesInstanceDependency struct {
+	// The input handle name that consumes this resource (e.g., `database`).
+	Field string `json:"field"`
+	// Where this slot's wire-in comes from. Inspect the concrete type — `Connection`, `RemoteReference`, or `EnvironmentDefault` — to distinguish.
+	Source GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySource `json:"-"`
 }
 
-// GetId returns GetResourceResourceResourceType.Id, and is useful for accessing the field via an interface.
-func (v *GetResourceResourceResourceType) GetId() string { return v.Id }
-
-// GetName returns GetResourceResourceResourceType.Name, and is useful for accessing the field via an interface.
-func (v *GetResourceResourceResourceType) GetName() string { return v.Name }
+// GetField returns GetInstanceRemoteReferencesInstanceDependenciesInstanceDependency.Field, and is useful for accessing the field via an interface.
+func (v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependency) GetField() string {
+	return v.Field
+}
 
-// GetResourceResponse is returned by GetResource on success.
-type GetResourceResponse struct {
-	// Fetch a single resource by its unique identifier.
-	//
-	// Returns the full resource record including its origin, resource type, and timestamps.
-	Resource GetResourceResource `json:"resource"`
+// GetSource returns GetInstanceRemoteReferencesInstanceDependenciesInstanceDependency.Source, and is useful for accessing the field via an interface.
+func (v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependency) GetSource() GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySource {
+	return v.Source
 }
 
-// GetResource returns GetResourceResponse.Resource, and is useful for accessing the field via an interface.
-func (v *GetResourceResponse) GetResource() GetResourceResource { return v.Resource }
+func (v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependency) UnmarshalJSON(b []byte) error {
 
-// GetServerResponse is returned by GetServer on success.
-type GetServerResponse struct {
-	// Get server metadata and available authentication methods.
-	//
-	// This query does **not** require authentication and is intended to be the first
-	// call a client makes. Use the response to determine which login methods to present
-	// and to verify API compatibility via the server version.
-	//
-	// ```graphql
-	// query {
-	// server {
-	// version
-	// mode
-	// appUrl
-	// ssoProviders {
-	// name
-	// loginUrl
-	// uiLabel
-	// uiIconUrl
-	// }
-	// emailAuthMethods {
-	// name
-	// }
-	// }
-	// }
-	// ```
-	Server GetServerServer `json:"server"`
-}
+	if string(b) == "null" {
+		return nil
+	}
 
-// GetServer returns GetServerResponse.Server, and is useful for accessing the field via an interface.
-func (v *GetServerResponse) GetServer() GetServerServer { return v.Server }
+	var firstPass struct {
+		*GetInstanceRemoteReferencesInstanceDependenciesInstanceDependency
+		Source json.RawMessage `json:"source"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetInstanceRemoteReferencesInstanceDependenciesInstanceDependency = v
 
-// GetServerServer includes the requested fields of the GraphQL type Server.
-// The GraphQL type's documentation follows.
-//
-// Information about the Massdriver server you are connected to.
-//
-// Use this to discover the server's version, deployment mode, and available
-// authentication methods. This is typically the first query a client makes
-// to determine how to render the login screen and check API compatibility.
-type GetServerServer struct {
-	// The base URL of the Massdriver web application (e.g., `"https://app.massdriver.cloud"`).
-	AppUrl string `json:"appUrl"`
-	// The server's semantic version (e.g., `"1.2.3"`).
-	Version string `json:"version"`
-	// Whether this is a self-hosted installation or Massdriver Cloud.
-	Mode ServerMode `json:"mode"`
-	// SSO identity providers available for authentication. Empty if no SSO is configured.
-	SsoProviders []GetServerServerSsoProvidersSsoProvider `json:"ssoProviders"`
-	// Email-based authentication methods available (e.g., passkeys). Empty if only SSO is available.
-	EmailAuthMethods []GetServerServerEmailAuthMethodsEmailAuthMethod `json:"emailAuthMethods"`
-}
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
 
-// GetAppUrl returns GetServerServer.AppUrl, and is useful for accessing the field via an interface.
-func (v *GetServerServer) GetAppUrl() string { return v.AppUrl }
+	{
+		dst := &v.Source
+		src := firstPass.Source
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetInstanceRemoteReferencesInstanceDependenciesInstanceDependency.Source: %w", err)
+			}
+		}
+	}
+	return nil
+}
 
-// GetVersion returns GetServerServer.Version, and is useful for accessing the field via an interface.
-func (v *GetServerServer) GetVersion() string { return v.Version }
+type __premarshalGetInstanceRemoteReferencesInstanceDependenciesInstanceDependency struct {
+	Field string `json:"field"`
 
-// GetMode returns GetServerServer.Mode, and is useful for accessing the field via an interface.
-func (v *GetServerServer) GetMode() ServerMode { return v.Mode }
+	Source json.RawMessage `json:"source"`
+}
 
-// GetSsoProviders returns GetServerServer.SsoProviders, and is useful for accessing the field via an interface.
-func (v *GetServerServer) GetSsoProviders() []GetServerServerSsoProvidersSsoProvider {
-	return v.SsoProviders
+func (v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependency) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetEmailAuthMethods returns GetServerServer.EmailAuthMethods, and is useful for accessing the field via an interface.
-func (v *GetServerServer) GetEmailAuthMethods() []GetServerServerEmailAuthMethodsEmailAuthMethod {
-	return v.EmailAuthMethods
+func (v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependency) __premarshalJSON() (*__premarshalGetInstanceRemoteReferencesInstanceDependenciesInstanceDependency, error) {
+	var retval __premarshalGetInstanceRemoteReferencesInstanceDependenciesInstanceDependency
+
+	retval.Field = v.Field
+	{
+
+		dst := &retval.Source
+		src := v.Source
+		var err error
+		*dst, err = __marshalGetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetInstanceRemoteReferencesInstanceDependenciesInstanceDependency.Source: %w", err)
+		}
+	}
+	return &retval, nil
 }
 
-// GetServerServerEmailAuthMethodsEmailAuthMethod includes the requested fields of the GraphQL type EmailAuthMethod.
+// GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySource includes the requested fields of the GraphQL interface InstanceDependencySource.
+//
+// GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySource is implemented by the following types:
+// GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceConnection
+// GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceEnvironmentDefault
+// GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference
 // The GraphQL type's documentation follows.
 //
-// An email-based authentication method available on this server.
+// Where a dependency wire-in comes from.
 //
-// Email auth methods do not require an external identity provider. They are
-// configured at the server level and available to all users.
-type GetServerServerEmailAuthMethodsEmailAuthMethod struct {
-	// The authentication mechanism type (e.g., `PASSKEY`).
-	Name EmailAuthMethodType `json:"name"`
-}
-
-// GetName returns GetServerServerEmailAuthMethodsEmailAuthMethod.Name, and is useful for accessing the field via an interface.
-func (v *GetServerServerEmailAuthMethodsEmailAuthMethod) GetName() EmailAuthMethodType { return v.Name }
-
-// GetServerServerSsoProvidersSsoProvider includes the requested fields of the GraphQL type SsoProvider.
-// The GraphQL type's documentation follows.
-//
-// An SSO (Single Sign-On) provider configured for this Massdriver server.
-//
-// Each provider represents an OAuth 2.0 or OpenID Connect identity provider that
-// users can authenticate with. Use the `loginUrl` to redirect users to the
-// provider's login page.
-type GetServerServerSsoProvidersSsoProvider struct {
-	// The provider's identifier (e.g., `"google"`, `"okta"`, `"azure-ad"`).
-	Name string `json:"name"`
-	// The URL to redirect the user to in order to start the SSO login flow.
-	LoginUrl string `json:"loginUrl"`
-	// URL of the provider's icon, for rendering on login buttons.
-	UiIconUrl string `json:"uiIconUrl"`
-	// Display label for the provider (e.g., `"Sign in with Google"`).
-	UiLabel string `json:"uiLabel"`
-}
-
-// GetName returns GetServerServerSsoProvidersSsoProvider.Name, and is useful for accessing the field via an interface.
-func (v *GetServerServerSsoProvidersSsoProvider) GetName() string { return v.Name }
-
-// GetLoginUrl returns GetServerServerSsoProvidersSsoProvider.LoginUrl, and is useful for accessing the field via an interface.
-func (v *GetServerServerSsoProvidersSsoProvider) GetLoginUrl() string { return v.LoginUrl }
-
-// GetUiIconUrl returns GetServerServerSsoProvidersSsoProvider.UiIconUrl, and is useful for accessing the field via an interface.
-func (v *GetServerServerSsoProvidersSsoProvider) GetUiIconUrl() string { return v.UiIconUrl }
-
-// GetUiLabel returns GetServerServerSsoProvidersSsoProvider.UiLabel, and is useful for accessing the field via an interface.
-func (v *GetServerServerSsoProvidersSsoProvider) GetUiLabel() string { return v.UiLabel }
-
-// GetServiceAccountResponse is returned by GetServiceAccount on success.
-type GetServiceAccountResponse struct {
-	// Fetch a single service account by id. Requires the `organization:manageServiceAccounts` action.
-	ServiceAccount GetServiceAccountServiceAccount `json:"serviceAccount"`
-}
-
-// GetServiceAccount returns GetServiceAccountResponse.ServiceAccount, and is useful for accessing the field via an interface.
-func (v *GetServiceAccountResponse) GetServiceAccount() GetServiceAccountServiceAccount {
-	return v.ServiceAccount
-}
-
-// GetServiceAccountServiceAccount includes the requested fields of the GraphQL type ServiceAccount.
-// The GraphQL type's documentation follows.
-//
-// A non-human identity for programmatic API access.
-//
-// Service accounts let you integrate CI/CD pipelines, scripts, and external tools with the
-// Massdriver API. They authenticate using access tokens — issue one with `createAccessToken`
-// and pass it as a bearer token on API requests.
-//
-// **Permissions** — Service accounts have no inherent permissions. Add them to groups to grant
-// access, using the same role model as human users. A service account in the `Admins` group
-// has the same access as a human admin.
+// - `Connection` — the wire was drawn from a blueprint Link between two
+// components in this project.
+// - `RemoteReference` — the wire is a per-instance override pointing at a
+// resource from another project (or an imported resource).
+// - `EnvironmentDefault` — no explicit wire was set, so the slot is filled
+// from the environment's default for this resource type.
 //
-// **Lifecycle:**
-// 1. Create the service account.
-// 2. Add the service account to one or more groups to grant access.
-// 3. Issue one or more access tokens for the service account via `createAccessToken`. The raw
-// token value is only shown once at creation — store it securely before navigating away.
-// 4. Delete the service account when it is no longer needed. This immediately revokes all access,
-// including any active access tokens.
-type GetServiceAccountServiceAccount struct {
-	// Unique identifier for this service account.
-	Id string `json:"id"`
-	// Human-readable name displayed in the UI, logs, and audit trail.
-	Name string `json:"name"`
-	// Optional text explaining what this service account is used for.
-	Description string `json:"description"`
-	// When this service account was created (UTC).
-	CreatedAt time.Time `json:"createdAt"`
-	// When this service account was last modified (UTC).
-	UpdatedAt time.Time `json:"updatedAt"`
-}
-
-// GetId returns GetServiceAccountServiceAccount.Id, and is useful for accessing the field via an interface.
-func (v *GetServiceAccountServiceAccount) GetId() string { return v.Id }
-
-// GetName returns GetServiceAccountServiceAccount.Name, and is useful for accessing the field via an interface.
-func (v *GetServiceAccountServiceAccount) GetName() string { return v.Name }
-
-// GetDescription returns GetServiceAccountServiceAccount.Description, and is useful for accessing the field via an interface.
-func (v *GetServiceAccountServiceAccount) GetDescription() string { return v.Description }
-
-// GetCreatedAt returns GetServiceAccountServiceAccount.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetServiceAccountServiceAccount) GetCreatedAt() time.Time { return v.CreatedAt }
-
-// GetUpdatedAt returns GetServiceAccountServiceAccount.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetServiceAccountServiceAccount) GetUpdatedAt() time.Time { return v.UpdatedAt }
-
-// GetViewerResponse is returned by GetViewer on success.
-type GetViewerResponse struct {
-	// Get information about the currently authenticated entity.
-	//
-	// Returns an `AccountViewer` for human users or a `ServiceAccountViewer` for API clients.
-	// This is the "who am I?" query — use it to bootstrap user profiles, display org switchers,
-	// check billing status, or verify which service account is making requests.
-	//
-	// Returns an authentication error if the request has no valid credentials.
-	Viewer GetViewerViewer `json:"-"`
-}
-
-// GetViewer returns GetViewerResponse.Viewer, and is useful for accessing the field via an interface.
-func (v *GetViewerResponse) GetViewer() GetViewerViewer { return v.Viewer }
-
-func (v *GetViewerResponse) UnmarshalJSON(b []byte) error {
-
-	if string(b) == "null" {
-		return nil
-	}
-
-	var firstPass struct {
-		*GetViewerResponse
-		Viewer json.RawMessage `json:"viewer"`
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.GetViewerResponse = v
-
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
-
-	{
-		dst := &v.Viewer
-		src := firstPass.Viewer
-		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalGetViewerViewer(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal GetViewerResponse.Viewer: %w", err)
-			}
-		}
-	}
-	return nil
-}
-
-type __premarshalGetViewerResponse struct {
-	Viewer json.RawMessage `json:"viewer"`
+// Per-instance `RemoteReference` overrides take priority over blueprint
+// `Connection`s, which take priority over `EnvironmentDefault`s.
+type GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySource interface {
+	implementsGraphQLInterfaceGetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySource()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
 }
 
-func (v *GetViewerResponse) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+func (v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceConnection) implementsGraphQLInterfaceGetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySource() {
 }
-
-func (v *GetViewerResponse) __premarshalJSON() (*__premarshalGetViewerResponse, error) {
-	var retval __premarshalGetViewerResponse
-
-	{
-
-		dst := &retval.Viewer
-		src := v.Viewer
-		var err error
-		*dst, err = __marshalGetViewerViewer(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal GetViewerResponse.Viewer: %w", err)
-		}
-	}
-	return &retval, nil
+func (v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceEnvironmentDefault) implementsGraphQLInterfaceGetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySource() {
 }
-
-// GetViewerViewer includes the requested fields of the GraphQL interface Viewer.
-//
-// GetViewerViewer is implemented by the following types:
-// GetViewerViewerAccountViewer
-// GetViewerViewerServiceAccountViewer
-// The GraphQL type's documentation follows.
-//
-// The authenticated entity making this API request.
-//
-// This is a union type — check `__typename` to determine whether the viewer is a human
-// user (`AccountViewer`) or an API client (`ServiceAccountViewer`), then query the
-// appropriate fields.
-//
-// **Example:**
-//
-// ```graphql
-// query {
-// viewer {
-// __typename
-// ... on AccountViewer {
-// id
-// email
-// firstName
-// organizations { data { id name } }
-// }
-// ... on ServiceAccountViewer {
-// id
-// name
-// organization { id name }
-// }
-// }
-// }
-// ```
-type GetViewerViewer interface {
-	implementsGraphQLInterfaceGetViewerViewer()
-	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
-	GetTypename() string
+func (v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference) implementsGraphQLInterfaceGetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySource() {
 }
 
-func (v *GetViewerViewerAccountViewer) implementsGraphQLInterfaceGetViewerViewer()        {}
-func (v *GetViewerViewerServiceAccountViewer) implementsGraphQLInterfaceGetViewerViewer() {}
-
-func __unmarshalGetViewerViewer(b []byte, v *GetViewerViewer) error {
+func __unmarshalGetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySource(b []byte, v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySource) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -14428,789 +13957,3285 @@ func __unmarshalGetViewerViewer(b []byte, v *GetViewerViewer) error {
 	}
 
 	switch tn.TypeName {
-	case "AccountViewer":
-		*v = new(GetViewerViewerAccountViewer)
+	case "Connection":
+		*v = new(GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceConnection)
 		return json.Unmarshal(b, *v)
-	case "ServiceAccountViewer":
-		*v = new(GetViewerViewerServiceAccountViewer)
+	case "EnvironmentDefault":
+		*v = new(GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceEnvironmentDefault)
+		return json.Unmarshal(b, *v)
+	case "RemoteReference":
+		*v = new(GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing Viewer.__typename")
+			"response was missing InstanceDependencySource.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for GetViewerViewer: "%v"`, tn.TypeName)
+			`unexpected concrete type for GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySource: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalGetViewerViewer(v *GetViewerViewer) ([]byte, error) {
+func __marshalGetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySource(v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySource) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *GetViewerViewerAccountViewer:
-		typename = "AccountViewer"
+	case *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceConnection:
+		typename = "Connection"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*GetViewerViewerAccountViewer
+			*GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceConnection
 		}{typename, v}
 		return json.Marshal(result)
-	case *GetViewerViewerServiceAccountViewer:
-		typename = "ServiceAccountViewer"
+	case *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceEnvironmentDefault:
+		typename = "EnvironmentDefault"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*GetViewerViewerServiceAccountViewer
+			*GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceEnvironmentDefault
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference:
+		typename = "RemoteReference"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for GetViewerViewer: "%T"`, v)
+			`unexpected concrete type for GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySource: "%T"`, v)
 	}
 }
 
-// GetViewerViewerAccountViewer includes the requested fields of the GraphQL type AccountViewer.
+// GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceConnection includes the requested fields of the GraphQL type Connection.
 // The GraphQL type's documentation follows.
 //
-// The authenticated human user (account).
+// A runtime wiring between two instances in an environment.
 //
-// Returned by the `viewer` query when the request is authenticated with a user session or
-// on behalf of a human account. Contains profile information, organization memberships,
-// linked OAuth identities, and pending group invitations.
+// A connection is the **runtime realization** of a blueprint link. Where a link
+// says "the database component's `authentication` output goes to the app
+// component's `database` input," the connection in each environment carries the
+// *actual* resource data (e.g., a connection string) from the source instance
+// to the destination instance.
 //
-// Use `organizations` to build an org switcher, `defaultOrganization` to set an initial
-// context, and `invites` to show pending invitations the user can accept.
-type GetViewerViewerAccountViewer struct {
+// Connections are created automatically when instances are deployed and a
+// matching blueprint link exists.
+type GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceConnection struct {
 	Typename string `json:"__typename"`
-	// Unique identifier for this account.
-	Id string `json:"id"`
-	// Primary email address associated with the account.
-	Email string `json:"email"`
-	// First name, if set in the user's profile.
-	FirstName string `json:"firstName"`
-	// Last name, if set in the user's profile.
-	LastName string `json:"lastName"`
-	// Your most recently joined organization.
-	//
-	// Useful for setting a default context when the user first opens the application.
-	// Returns `null` if the user does not belong to any organization.
-	DefaultOrganization GetViewerViewerAccountViewerDefaultOrganization `json:"defaultOrganization"`
 }
 
-// GetTypename returns GetViewerViewerAccountViewer.Typename, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerAccountViewer) GetTypename() string { return v.Typename }
-
-// GetId returns GetViewerViewerAccountViewer.Id, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerAccountViewer) GetId() string { return v.Id }
-
-// GetEmail returns GetViewerViewerAccountViewer.Email, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerAccountViewer) GetEmail() string { return v.Email }
-
-// GetFirstName returns GetViewerViewerAccountViewer.FirstName, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerAccountViewer) GetFirstName() string { return v.FirstName }
-
-// GetLastName returns GetViewerViewerAccountViewer.LastName, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerAccountViewer) GetLastName() string { return v.LastName }
-
-// GetDefaultOrganization returns GetViewerViewerAccountViewer.DefaultOrganization, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerAccountViewer) GetDefaultOrganization() GetViewerViewerAccountViewerDefaultOrganization {
-	return v.DefaultOrganization
+// GetTypename returns GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceConnection.Typename, and is useful for accessing the field via an interface.
+func (v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceConnection) GetTypename() string {
+	return v.Typename
 }
 
-// GetViewerViewerAccountViewerDefaultOrganization includes the requested fields of the GraphQL type Organization.
+// GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceEnvironmentDefault includes the requested fields of the GraphQL type EnvironmentDefault.
 // The GraphQL type's documentation follows.
 //
-// The top-level account that owns all your infrastructure, projects, and team members.
+// An environment default that automatically provides a resource to instances.
 //
-// An organization is the root of the Massdriver resource hierarchy. Everything you build
-// and deploy lives under an organization: **Projects** contain your infrastructure designs,
-// **Environments** (like staging and production) are where those designs come to life, and
-// **Instances** are the actual running cloud resources.
+// When an instance in the environment requires a resource type that matches this default,
+// the resource is automatically connected without manual configuration. Only one default
+// per resource type is allowed per environment -- remove the existing default before
+// setting a new one.
+type GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceEnvironmentDefault struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceEnvironmentDefault.Typename, and is useful for accessing the field via an interface.
+func (v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceEnvironmentDefault) GetTypename() string {
+	return v.Typename
+}
+
+// GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference includes the requested fields of the GraphQL type RemoteReference.
+// The GraphQL type's documentation follows.
 //
-// ```mermaid
-// graph TD
-// O["Organization"] --> P1["Project"]
-// O --> P2["Project"]
-// P1 --> E1["Environment: staging"]
-// P1 --> E2["Environment: production"]
-// E1 --> I1["Instance"]
-// E1 --> I2["Instance"]
-// ```
+// A per-instance override of a single connection slot. The blueprint Link wires
+// a slot from a sibling package's output; a remote reference overrides that
+// wiring on one instance, pointing the slot at a resource from another project
+// (or an imported resource) instead.
 //
-// Members access resources through **group memberships** with role-based permissions.
-// Custom attributes defined at the organization level govern attribute metadata across all child resources.
+// Remote references enable cross-project infrastructure sharing. For example, a
+// networking team provisions a VPC in one project, and application teams override
+// the `vpc` connection slot on their database/cache/etc. instances to point at
+// that shared VPC.
 //
-// Administrative fields (`billing`, `members`, `customAttributes`) resolve to `null` for
-// callers who lack the corresponding ABAC action; in that case a top-level `FORBIDDEN`
-// error is added to the response while the rest of the organization still resolves.
-type GetViewerViewerAccountViewerDefaultOrganization struct {
+// Each remote reference binds a specific `field` on the instance — a key in the
+// instance's bundle's `connectionsSchema` — to the target resource. The override
+// takes priority over any blueprint-level Link on the same slot, and reverts to
+// the Link (or environment default) when removed.
+type GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference struct {
+	Typename string `json:"__typename"`
+	// Unique identifier for this remote reference.
 	Id string `json:"id"`
-	// Display name shown in the UI and CLI.
-	Name string `json:"name"`
-	// When this organization was created (UTC).
+	// The name of the resource field on the instance that this reference satisfies (e.g., `aws_authentication` or `vpc`).
+	Field string `json:"field"`
+	// When this remote reference was created (UTC).
 	CreatedAt time.Time `json:"createdAt"`
-	// When this organization was last modified (UTC).
+	// When this remote reference was last modified (UTC).
 	UpdatedAt time.Time `json:"updatedAt"`
+	// The resource from another project (or an imported resource) that this reference points to.
+	Resource GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReferenceResource `json:"resource"`
 }
 
-// GetId returns GetViewerViewerAccountViewerDefaultOrganization.Id, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerAccountViewerDefaultOrganization) GetId() string { return v.Id }
+// GetTypename returns GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference.Typename, and is useful for accessing the field via an interface.
+func (v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference) GetTypename() string {
+	return v.Typename
+}
 
-// GetName returns GetViewerViewerAccountViewerDefaultOrganization.Name, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerAccountViewerDefaultOrganization) GetName() string { return v.Name }
+// GetId returns GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference.Id, and is useful for accessing the field via an interface.
+func (v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference) GetId() string {
+	return v.Id
+}
 
-// GetCreatedAt returns GetViewerViewerAccountViewerDefaultOrganization.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerAccountViewerDefaultOrganization) GetCreatedAt() time.Time {
+// GetField returns GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference.Field, and is useful for accessing the field via an interface.
+func (v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference) GetField() string {
+	return v.Field
+}
+
+// GetCreatedAt returns GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference) GetCreatedAt() time.Time {
 	return v.CreatedAt
 }
 
-// GetUpdatedAt returns GetViewerViewerAccountViewerDefaultOrganization.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerAccountViewerDefaultOrganization) GetUpdatedAt() time.Time {
+// GetUpdatedAt returns GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference) GetUpdatedAt() time.Time {
 	return v.UpdatedAt
 }
 
-// GetViewerViewerServiceAccountViewer includes the requested fields of the GraphQL type ServiceAccountViewer.
+// GetResource returns GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference.Resource, and is useful for accessing the field via an interface.
+func (v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference) GetResource() GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReferenceResource {
+	return v.Resource
+}
+
+// GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReferenceResource includes the requested fields of the GraphQL type Resource.
 // The GraphQL type's documentation follows.
 //
-// The authenticated service account (API client).
+// A cloud credential, database connection string, network configuration, or other
+// infrastructure output produced by (or imported into) Massdriver.
 //
-// Returned by the `viewer` query when the request is authenticated with a service account
-// credential (Basic auth or access token). Contains the service account's identity and the
-// organization it belongs to.
-type GetViewerViewerServiceAccountViewer struct {
-	Typename string `json:"__typename"`
-	// Unique identifier for this service account.
+// Resources are the connective tissue between instances. When an instance is deployed, it
+// produces resources as outputs. Other instances can consume those resources as inputs,
+// creating a dependency graph of your infrastructure.
+//
+// Resources have two origins:
+// - **Imported** — created directly through the API (e.g., uploading existing AWS credentials).
+// You have full CRUD control over these resources.
+// - **Provisioned** — created automatically when an instance is deployed. These are read-only
+// and managed entirely by the owning instance's lifecycle.
+type GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReferenceResource struct {
+	// Unique identifier for this resource.
 	Id string `json:"id"`
-	// Human-readable name of the service account.
+	// Human-readable display name for this resource.
 	Name string `json:"name"`
-	// Optional text describing the purpose of this service account.
-	Description string `json:"description"`
-	// The organization this service account belongs to.
-	Organization GetViewerViewerServiceAccountViewerOrganization `json:"organization"`
 }
 
-// GetTypename returns GetViewerViewerServiceAccountViewer.Typename, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerServiceAccountViewer) GetTypename() string { return v.Typename }
+// GetId returns GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReferenceResource.Id, and is useful for accessing the field via an interface.
+func (v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReferenceResource) GetId() string {
+	return v.Id
+}
 
-// GetId returns GetViewerViewerServiceAccountViewer.Id, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerServiceAccountViewer) GetId() string { return v.Id }
+// GetName returns GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReferenceResource.Name, and is useful for accessing the field via an interface.
+func (v *GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReferenceResource) GetName() string {
+	return v.Name
+}
 
-// GetName returns GetViewerViewerServiceAccountViewer.Name, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerServiceAccountViewer) GetName() string { return v.Name }
+// GetInstanceRemoteReferencesResponse is returned by GetInstanceRemoteReferences on success.
+type GetInstanceRemoteReferencesResponse struct {
+	// Fetch a single instance by its ID. Returns null with a `NOT_FOUND` error if the instance does not exist.
+	Instance GetInstanceRemoteReferencesInstance `json:"instance"`
+}
 
-// GetDescription returns GetViewerViewerServiceAccountViewer.Description, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerServiceAccountViewer) GetDescription() string { return v.Description }
+// GetInstance returns GetInstanceRemoteReferencesResponse.Instance, and is useful for accessing the field via an interface.
+func (v *GetInstanceRemoteReferencesResponse) GetInstance() GetInstanceRemoteReferencesInstance {
+	return v.Instance
+}
 
-// GetOrganization returns GetViewerViewerServiceAccountViewer.Organization, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerServiceAccountViewer) GetOrganization() GetViewerViewerServiceAccountViewerOrganization {
-	return v.Organization
+// GetInstanceResponse is returned by GetInstance on success.
+type GetInstanceResponse struct {
+	// Fetch a single instance by its ID. Returns null with a `NOT_FOUND` error if the instance does not exist.
+	Instance GetInstanceInstance `json:"instance"`
 }
 
-// GetViewerViewerServiceAccountViewerOrganization includes the requested fields of the GraphQL type Organization.
+// GetInstance returns GetInstanceResponse.Instance, and is useful for accessing the field via an interface.
+func (v *GetInstanceResponse) GetInstance() GetInstanceInstance { return v.Instance }
+
+// GetInstanceSecretFieldsInstance includes the requested fields of the GraphQL type Instance.
 // The GraphQL type's documentation follows.
 //
-// The top-level account that owns all your infrastructure, projects, and team members.
+// A deployed piece of infrastructure in an environment.
 //
-// An organization is the root of the Massdriver resource hierarchy. Everything you build
-// and deploy lives under an organization: **Projects** contain your infrastructure designs,
-// **Environments** (like staging and production) are where those designs come to life, and
-// **Instances** are the actual running cloud resources.
+// An instance is the **runtime representation** of a component. When you add a
+// "database" component to your blueprint and deploy it to the `staging`
+// environment, Massdriver creates an instance that tracks the database's
+// configuration, deployment state, costs, and produced resources.
+//
+// **Lifecycle:** Instances progress through a well-defined set of states:
 //
 // ```mermaid
-// graph TD
-// O["Organization"] --> P1["Project"]
-// O --> P2["Project"]
-// P1 --> E1["Environment: staging"]
-// P1 --> E2["Environment: production"]
-// E1 --> I1["Instance"]
-// E1 --> I2["Instance"]
+// stateDiagram-v2
+// [*] --> INITIALIZED: "Component added to environment"
+// INITIALIZED --> PROVISIONED: "Deployment succeeds"
+// INITIALIZED --> FAILED: "Deployment fails"
+// PROVISIONED --> PROVISIONED: "Redeploy / update"
+// PROVISIONED --> DECOMMISSIONED: "Decommission succeeds"
+// PROVISIONED --> FAILED: "Deployment fails"
+// FAILED --> PROVISIONED: "Retry succeeds"
+// FAILED --> DECOMMISSIONED: "Decommission"
 // ```
 //
-// Members access resources through **group memberships** with role-based permissions.
-// Custom attributes defined at the organization level govern attribute metadata across all child resources.
-//
-// Administrative fields (`billing`, `members`, `customAttributes`) resolve to `null` for
-// callers who lack the corresponding ABAC action; in that case a top-level `FORBIDDEN`
-// error is added to the response while the rest of the organization still resolves.
-type GetViewerViewerServiceAccountViewerOrganization struct {
+// **Version resolution:** Each instance has a `version` constraint (e.g., `~1.0`)
+// and a `releaseStrategy` (stable or development). Together these determine
+// the `resolvedVersion` that will be used on the next deployment. Compare
+// `resolvedVersion` with `deployedVersion` to see if a redeployment is needed,
+// or check `availableUpgrade` for newer matching releases.
+type GetInstanceSecretFieldsInstance struct {
 	Id string `json:"id"`
-	// Display name shown in the UI and CLI.
-	Name string `json:"name"`
-	// When this organization was created (UTC).
-	CreatedAt time.Time `json:"createdAt"`
-	// When this organization was last modified (UTC).
-	UpdatedAt time.Time `json:"updatedAt"`
+	// Definitions of the secrets this instance's bundle expects, sorted by name.
+	//
+	// Each entry pairs the bundle's declared field (name, required flag, optional title /
+	// description) with the stored value's `sha256` fingerprint when one has been set.
+	// Use null vs non-null `sha256` to render set/unset state. Secret values are never
+	// returned by the API.
+	SecretFields []GetInstanceSecretFieldsInstanceSecretFieldsInstanceSecretField `json:"secretFields"`
 }
 
-// GetId returns GetViewerViewerServiceAccountViewerOrganization.Id, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerServiceAccountViewerOrganization) GetId() string { return v.Id }
-
-// GetName returns GetViewerViewerServiceAccountViewerOrganization.Name, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerServiceAccountViewerOrganization) GetName() string { return v.Name }
-
-// GetCreatedAt returns GetViewerViewerServiceAccountViewerOrganization.CreatedAt, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerServiceAccountViewerOrganization) GetCreatedAt() time.Time {
-	return v.CreatedAt
-}
+// GetId returns GetInstanceSecretFieldsInstance.Id, and is useful for accessing the field via an interface.
+func (v *GetInstanceSecretFieldsInstance) GetId() string { return v.Id }
 
-// GetUpdatedAt returns GetViewerViewerServiceAccountViewerOrganization.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *GetViewerViewerServiceAccountViewerOrganization) GetUpdatedAt() time.Time {
-	return v.UpdatedAt
+// GetSecretFields returns GetInstanceSecretFieldsInstance.SecretFields, and is useful for accessing the field via an interface.
+func (v *GetInstanceSecretFieldsInstance) GetSecretFields() []GetInstanceSecretFieldsInstanceSecretFieldsInstanceSecretField {
+	return v.SecretFields
 }
 
-// The access level assigned to a group within an organization.
+// GetInstanceSecretFieldsInstanceSecretFieldsInstanceSecretField includes the requested fields of the GraphQL type InstanceSecretField.
+// The GraphQL type's documentation follows.
 //
-// Every group has exactly one role that determines what its members can do:
+// Definition of a secret expected by an instance's bundle, with the stored value's
+// fingerprint when one has been set.
 //
-// - **`ORGANIZATION_ADMIN`** — Full administrative access across all projects.
-// - **`ORGANIZATION_VIEWER`** — Read-only access across all projects.
-// - **`CUSTOM`** — Fine-grained, project-level access grants. Use this when you need
-// to give a team access to specific projects without organization-wide permissions.
-type GroupRole string
-
-const (
-	// Full administrative access to all projects and settings in the organization. Members can manage groups, service accounts, billing, and all infrastructure.
-	GroupRoleOrganizationAdmin GroupRole = "ORGANIZATION_ADMIN"
-	// Read-only access to all projects in the organization. Members can view infrastructure, deployments, and logs but cannot make changes.
-	GroupRoleOrganizationViewer GroupRole = "ORGANIZATION_VIEWER"
-	// Project-level access grants. Members only see projects explicitly assigned to this group, with either `project_admin` or `project_viewer` permissions per project.
-	GroupRoleCustom GroupRole = "CUSTOM"
-)
+// Bundles declare the secrets they consume in their `app.secrets` manifest. Each
+// field describes one expected secret. The `sha256` fingerprint is null when no
+// value has been stored, and the lowercase hex SHA-256 of the stored value otherwise --
+// use it to render set/unset state and to dirty-check edits client-side. Secret values
+// themselves are never returned by the API.
+type GetInstanceSecretFieldsInstanceSecretFieldsInstanceSecretField struct {
+	// The secret's key name (typically an environment variable name like `DATABASE_PASSWORD`). Use this name when calling `setInstanceSecret`.
+	Name string `json:"name"`
+	// Whether this secret must be set before the instance can be deployed.
+	Required bool `json:"required"`
+	// Human-readable display name shown in the UI.
+	Title string `json:"title"`
+	// Explanation of what this secret is used for.
+	Description string `json:"description"`
+	// Lowercase hex SHA-256 of the stored value, or null when no value has been set. Use null vs non-null to detect whether the secret is set.
+	Sha256 string `json:"sha256"`
+}
 
-var AllGroupRole = []GroupRole{
-	GroupRoleOrganizationAdmin,
-	GroupRoleOrganizationViewer,
-	GroupRoleCustom,
+// GetName returns GetInstanceSecretFieldsInstanceSecretFieldsInstanceSecretField.Name, and is useful for accessing the field via an interface.
+func (v *GetInstanceSecretFieldsInstanceSecretFieldsInstanceSecretField) GetName() string {
+	return v.Name
 }
 
-// Sorting options for the groups list.
-type GroupsSort struct {
-	// The field to sort by.
-	Field GroupsSortField `json:"field"`
-	// Ascending (`ASC`) or descending (`DESC`).
-	Order SortOrder `json:"order"`
+// GetRequired returns GetInstanceSecretFieldsInstanceSecretFieldsInstanceSecretField.Required, and is useful for accessing the field via an interface.
+func (v *GetInstanceSecretFieldsInstanceSecretFieldsInstanceSecretField) GetRequired() bool {
+	return v.Required
 }
 
-// GetField returns GroupsSort.Field, and is useful for accessing the field via an interface.
-func (v *GroupsSort) GetField() GroupsSortField { return v.Field }
+// GetTitle returns GetInstanceSecretFieldsInstanceSecretFieldsInstanceSecretField.Title, and is useful for accessing the field via an interface.
+func (v *GetInstanceSecretFieldsInstanceSecretFieldsInstanceSecretField) GetTitle() string {
+	return v.Title
+}
 
-// GetOrder returns GroupsSort.Order, and is useful for accessing the field via an interface.
-func (v *GroupsSort) GetOrder() SortOrder { return v.Order }
+// GetDescription returns GetInstanceSecretFieldsInstanceSecretFieldsInstanceSecretField.Description, and is useful for accessing the field via an interface.
+func (v *GetInstanceSecretFieldsInstanceSecretFieldsInstanceSecretField) GetDescription() string {
+	return v.Description
+}
 
-// Available fields for sorting groups.
-type GroupsSortField string
+// GetSha256 returns GetInstanceSecretFieldsInstanceSecretFieldsInstanceSecretField.Sha256, and is useful for accessing the field via an interface.
+func (v *GetInstanceSecretFieldsInstanceSecretFieldsInstanceSecretField) GetSha256() string {
+	return v.Sha256
+}
 
-const (
-	// Sort alphabetically by group name (A-Z or Z-A).
-	GroupsSortFieldName GroupsSortField = "NAME"
-	// Sort by creation date (oldest first or newest first).
-	GroupsSortFieldCreatedAt GroupsSortField = "CREATED_AT"
-)
+// GetInstanceSecretFieldsResponse is returned by GetInstanceSecretFields on success.
+type GetInstanceSecretFieldsResponse struct {
+	// Fetch a single instance by its ID. Returns null with a `NOT_FOUND` error if the instance does not exist.
+	Instance GetInstanceSecretFieldsInstance `json:"instance"`
+}
 
-var AllGroupsSortField = []GroupsSortField{
-	GroupsSortFieldName,
-	GroupsSortFieldCreatedAt,
+// GetInstance returns GetInstanceSecretFieldsResponse.Instance, and is useful for accessing the field via an interface.
+func (v *GetInstanceSecretFieldsResponse) GetInstance() GetInstanceSecretFieldsInstance {
+	return v.Instance
 }
 
-// Filter by an identifier field.
+// GetOciRepoOciRepo includes the requested fields of the GraphQL type OciRepo.
+// The GraphQL type's documentation follows.
 //
-// All operators within a single filter are combined with **AND**. To match any of
-// several values, use the `in` operator rather than multiple `eq` filters.
+// An OCI repository in your organization's bundle catalog.
 //
-// ```graphql
-// # Exact match
+// An OCI repository is the container for all published versions of a single
+// infrastructure-as-code package. It is analogous to a Docker image repository
+// but for Massdriver bundles.
+//
+// Each repository has a unique `name` (e.g., `aws-aurora-postgres`) and contains:
+//
+// - **Tags** -- the individual published versions (`1.0.0`, `1.1.0`, `1.2.3`, etc.)
+// - **Release channels** -- auto-resolving version constraints (`latest`, `~1`, `~1.2`)
+// that always point to the newest matching tag
+//
+// To fetch a specific bundle version from a repository, use the `bundle` query
+// with a `BundleId` like `aws-aurora-postgres@1.2.3` or `aws-aurora-postgres@~1`.
+type GetOciRepoOciRepo struct {
+	Id string `json:"id"`
+	// Repository name, unique within your organization (e.g., `aws-aurora-postgres`).
+	Name string `json:"name"`
+	// The bare [OCI reference](https://github.com/opencontainers/distribution-spec/blob/main/spec.md#pulling-manifests)
+	// for this repository: `<registry>/<org>/<repo>` (for example,
+	// `api.massdriver.cloud/acme/aws-aurora-postgres`).
+	//
+	// Append `:<tag>` or `@<digest>` to address a specific manifest and use the
+	// result directly with `oras`, `docker`, or any OCI-compliant client:
+	//
+	// ```bash
+	// oras pull api.massdriver.cloud/acme/aws-aurora-postgres:1.2.3
+	// ```
+	Reference string `json:"reference"`
+	// The [OCI artifact type](https://github.com/opencontainers/image-spec/blob/main/manifest.md#guidelines-for-artifact-usage)
+	// stored in this repository. Currently always
+	// `application/vnd.massdriver.bundle.v1+json`.
+	ArtifactType string `json:"artifactType"`
+	// Key-value attributes assigned directly to this repository, used by ABAC
+	// policies. Reserved keys starting with `md-` are auto-injected by the system
+	// and excluded from this map — see `effectiveAttributes` for the merged view.
+	Attributes map[string]any `json:"-"`
+	// Short summary of what this repository provisions, taken from the latest
+	// published release. `null` when the repository has no releases yet or the
+	// author omitted the description.
+	Description string `json:"description"`
+	// URL to this repository's display icon (SVG), taken from the latest
+	// published release. `null` when the repository has no releases yet or the
+	// latest release has no icon.
+	//
+	// The URL is **authenticated** — callers must have an active browser session
+	// or valid API credentials for the owning organization. Embedding it in an
+	// `<img>` tag from the web UI works because the browser sends the session
+	// cookie automatically; programmatic clients must include an `Authorization`
+	// header.
+	Icon string `json:"icon"`
+	// URL of the source code this repository is published from (for example, a
+	// GitHub link), when the author provides one. Taken from the latest release.
+	SourceUrl string `json:"sourceUrl"`
+	// Timestamp when this repository was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+	// Timestamp when this repository was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Paginated list of published version tags in this repository.
+	//
+	// Each tag represents a single immutable bundle release. Defaults to newest
+	// version first (`version` descending).
+	Tags GetOciRepoOciRepoTagsOciRepoTagsPage `json:"tags"`
+	// Paginated list of release channels for this repository.
+	//
+	// Each channel is a version constraint that auto-resolves to the latest matching
+	// tag. Use the `stable` filter to show only stable or only development channels.
+	ReleaseChannels GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPage `json:"releaseChannels"`
+}
+
+// GetId returns GetOciRepoOciRepo.Id, and is useful for accessing the field via an interface.
+func (v *GetOciRepoOciRepo) GetId() string { return v.Id }
+
+// GetName returns GetOciRepoOciRepo.Name, and is useful for accessing the field via an interface.
+func (v *GetOciRepoOciRepo) GetName() string { return v.Name }
+
+// GetReference returns GetOciRepoOciRepo.Reference, and is useful for accessing the field via an interface.
+func (v *GetOciRepoOciRepo) GetReference() string { return v.Reference }
+
+// GetArtifactType returns GetOciRepoOciRepo.ArtifactType, and is useful for accessing the field via an interface.
+func (v *GetOciRepoOciRepo) GetArtifactType() string { return v.ArtifactType }
+
+// GetAttributes returns GetOciRepoOciRepo.Attributes, and is useful for accessing the field via an interface.
+func (v *GetOciRepoOciRepo) GetAttributes() map[string]any { return v.Attributes }
+
+// GetDescription returns GetOciRepoOciRepo.Description, and is useful for accessing the field via an interface.
+func (v *GetOciRepoOciRepo) GetDescription() string { return v.Description }
+
+// GetIcon returns GetOciRepoOciRepo.Icon, and is useful for accessing the field via an interface.
+func (v *GetOciRepoOciRepo) GetIcon() string { return v.Icon }
+
+// GetSourceUrl returns GetOciRepoOciRepo.SourceUrl, and is useful for accessing the field via an interface.
+func (v *GetOciRepoOciRepo) GetSourceUrl() string { return v.SourceUrl }
+
+// GetCreatedAt returns GetOciRepoOciRepo.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetOciRepoOciRepo) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetUpdatedAt returns GetOciRepoOciRepo.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetOciRepoOciRepo) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetTags returns GetOciRepoOciRepo.Tags, and is useful for accessing the field via an interface.
+func (v *GetOciRepoOciRepo) GetTags() GetOciRepoOciRepoTagsOciRepoTagsPage { return v.Tags }
+
+// GetReleaseChannels returns GetOciRepoOciRepo.ReleaseChannels, and is useful for accessing the field via an interface.
+func (v *GetOciRepoOciRepo) GetReleaseChannels() GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPage {
+	return v.ReleaseChannels
+}
+
+func (v *GetOciRepoOciRepo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetOciRepoOciRepo
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetOciRepoOciRepo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetOciRepoOciRepo.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetOciRepoOciRepo struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Reference string `json:"reference"`
+
+	ArtifactType string `json:"artifactType"`
+
+	Attributes json.RawMessage `json:"attributes"`
+
+	Description string `json:"description"`
+
+	Icon string `json:"icon"`
+
+	SourceUrl string `json:"sourceUrl"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	Tags GetOciRepoOciRepoTagsOciRepoTagsPage `json:"tags"`
+
+	ReleaseChannels GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPage `json:"releaseChannels"`
+}
+
+func (v *GetOciRepoOciRepo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetOciRepoOciRepo) __premarshalJSON() (*__premarshalGetOciRepoOciRepo, error) {
+	var retval __premarshalGetOciRepoOciRepo
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Reference = v.Reference
+	retval.ArtifactType = v.ArtifactType
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetOciRepoOciRepo.Attributes: %w", err)
+		}
+	}
+	retval.Description = v.Description
+	retval.Icon = v.Icon
+	retval.SourceUrl = v.SourceUrl
+	retval.CreatedAt = v.CreatedAt
+	retval.UpdatedAt = v.UpdatedAt
+	retval.Tags = v.Tags
+	retval.ReleaseChannels = v.ReleaseChannels
+	return &retval, nil
+}
+
+// GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPage includes the requested fields of the GraphQL type OciRepoReleaseChannelsPage.
+type GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPage struct {
+	// A list of type oci_repo_release_channel.
+	Items []GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPageItemsOciRepoReleaseChannel `json:"items"`
+}
+
+// GetItems returns GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPage.Items, and is useful for accessing the field via an interface.
+func (v *GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPage) GetItems() []GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPageItemsOciRepoReleaseChannel {
+	return v.Items
+}
+
+// GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPageItemsOciRepoReleaseChannel includes the requested fields of the GraphQL type OciRepoReleaseChannel.
+// The GraphQL type's documentation follows.
+//
+// A release channel within an OCI repository.
+//
+// Release channels are auto-resolving version constraints. Each channel points to
+// the latest published tag that matches its constraint. As new versions are
+// published, channels automatically update to point to the newest match.
+//
+// For example, channel `~1` always resolves to the highest `1.x.x` stable tag.
+// If `1.2.3` is the latest, the channel points there. When `1.3.0` is published,
+// the channel automatically advances.
+type GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPageItemsOciRepoReleaseChannel struct {
+	// The channel constraint expression (e.g., `~1`, `~1.2`, `latest`, `latest+dev`).
+	Name string `json:"name"`
+	// The fully resolved semver version this channel currently points to.
+	Tag string `json:"tag"`
+}
+
+// GetName returns GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPageItemsOciRepoReleaseChannel.Name, and is useful for accessing the field via an interface.
+func (v *GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPageItemsOciRepoReleaseChannel) GetName() string {
+	return v.Name
+}
+
+// GetTag returns GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPageItemsOciRepoReleaseChannel.Tag, and is useful for accessing the field via an interface.
+func (v *GetOciRepoOciRepoReleaseChannelsOciRepoReleaseChannelsPageItemsOciRepoReleaseChannel) GetTag() string {
+	return v.Tag
+}
+
+// GetOciRepoOciRepoTagsOciRepoTagsPage includes the requested fields of the GraphQL type OciRepoTagsPage.
+type GetOciRepoOciRepoTagsOciRepoTagsPage struct {
+	// A list of type oci_repo_tag.
+	Items []GetOciRepoOciRepoTagsOciRepoTagsPageItemsOciRepoTag `json:"items"`
+}
+
+// GetItems returns GetOciRepoOciRepoTagsOciRepoTagsPage.Items, and is useful for accessing the field via an interface.
+func (v *GetOciRepoOciRepoTagsOciRepoTagsPage) GetItems() []GetOciRepoOciRepoTagsOciRepoTagsPageItemsOciRepoTag {
+	return v.Items
+}
+
+// GetOciRepoOciRepoTagsOciRepoTagsPageItemsOciRepoTag includes the requested fields of the GraphQL type OciRepoTag.
+// The GraphQL type's documentation follows.
+//
+// A published version tag in an OCI repository.
+//
+// Each tag corresponds to a single bundle release. Tags are immutable -- once
+// a version is published, its contents cannot change. Development tags use
+// a `-dev.TIMESTAMP` suffix (e.g., `1.2.3-dev.20060102T150405Z`).
+type GetOciRepoOciRepoTagsOciRepoTagsPageItemsOciRepoTag struct {
+	// The semantic version string (e.g., `1.2.3` or `1.2.3-dev.20060102T150405Z`).
+	Tag string `json:"tag"`
+	// Timestamp when this version was published (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetTag returns GetOciRepoOciRepoTagsOciRepoTagsPageItemsOciRepoTag.Tag, and is useful for accessing the field via an interface.
+func (v *GetOciRepoOciRepoTagsOciRepoTagsPageItemsOciRepoTag) GetTag() string { return v.Tag }
+
+// GetCreatedAt returns GetOciRepoOciRepoTagsOciRepoTagsPageItemsOciRepoTag.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetOciRepoOciRepoTagsOciRepoTagsPageItemsOciRepoTag) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetOciRepoResponse is returned by GetOciRepo on success.
+type GetOciRepoResponse struct {
+	// Fetch a single OCI repository by name.
+	//
+	// Returns the repository along with its nested `tags` and `releaseChannels`
+	// collections. Returns `null` with a `NOT_FOUND` error if the repository
+	// does not exist in your organization.
+	//
+	// ```graphql
+	// query {
+	// ociRepo(organizationId: "your-org-id", id: "aws-aurora-postgres") {
+	// id
+	// name
+	// artifactType
+	// tags(sort: { field: VERSION, order: DESC }) {
+	// items { tag createdAt }
+	// cursor { next }
+	// }
+	// releaseChannels(filter: { stable: true }) {
+	// items { name tag }
+	// }
+	// }
+	// }
+	// ```
+	OciRepo GetOciRepoOciRepo `json:"ociRepo"`
+}
+
+// GetOciRepo returns GetOciRepoResponse.OciRepo, and is useful for accessing the field via an interface.
+func (v *GetOciRepoResponse) GetOciRepo() GetOciRepoOciRepo { return v.OciRepo }
+
+// GetOrganizationOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// The top-level account that owns all your infrastructure, projects, and team members.
+//
+// An organization is the root of the Massdriver resource hierarchy. Everything you build
+// and deploy lives under an organization: **Projects** contain your infrastructure designs,
+// **Environments** (like staging and production) are where those designs come to life, and
+// **Instances** are the actual running cloud resources.
+//
+// ```mermaid
+// graph TD
+// O["Organization"] --> P1["Project"]
+// O --> P2["Project"]
+// P1 --> E1["Environment: staging"]
+// P1 --> E2["Environment: production"]
+// E1 --> I1["Instance"]
+// E1 --> I2["Instance"]
+// ```
+//
+// Members access resources through **group memberships** with role-based permissions.
+// Custom attributes defined at the organization level govern attribute metadata across all child resources.
+//
+// Administrative fields (`billing`, `members`, `customAttributes`) resolve to `null` for
+// callers who lack the corresponding ABAC action; in that case a top-level `FORBIDDEN`
+// error is added to the response while the rest of the organization still resolves.
+type GetOrganizationOrganization struct {
+	Id string `json:"id"`
+	// Display name shown in the UI and CLI.
+	Name string `json:"name"`
+	// Subscription status of the organization. Visible to every member so the UI can
+	// surface billing warnings or lock down features for delinquent accounts. Detailed
+	// payment, seat, and invoice data live behind the admin-only `billing` field.
+	SubscriptionStatus OrganizationSubscriptionStatus `json:"subscriptionStatus"`
+	// When the current free trial expires (UTC).
+	//
+	// Only populated while `subscriptionStatus` is `TRIAL`. If a paid plan is not
+	// active by this timestamp, `subscriptionStatus` becomes `EXPIRED` and most
+	// write operations are blocked until billing is resolved. Once the organization
+	// upgrades to a paid plan or the trial expires, this field returns `null`.
+	TrialEndsAt time.Time `json:"trialEndsAt"`
+	// When this organization was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+	// When this organization was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetId returns GetOrganizationOrganization.Id, and is useful for accessing the field via an interface.
+func (v *GetOrganizationOrganization) GetId() string { return v.Id }
+
+// GetName returns GetOrganizationOrganization.Name, and is useful for accessing the field via an interface.
+func (v *GetOrganizationOrganization) GetName() string { return v.Name }
+
+// GetSubscriptionStatus returns GetOrganizationOrganization.SubscriptionStatus, and is useful for accessing the field via an interface.
+func (v *GetOrganizationOrganization) GetSubscriptionStatus() OrganizationSubscriptionStatus {
+	return v.SubscriptionStatus
+}
+
+// GetTrialEndsAt returns GetOrganizationOrganization.TrialEndsAt, and is useful for accessing the field via an interface.
+func (v *GetOrganizationOrganization) GetTrialEndsAt() time.Time { return v.TrialEndsAt }
+
+// GetCreatedAt returns GetOrganizationOrganization.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetOrganizationOrganization) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetUpdatedAt returns GetOrganizationOrganization.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetOrganizationOrganization) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetOrganizationResponse is returned by GetOrganization on success.
+type GetOrganizationResponse struct {
+	// Fetch your organization's details, including custom attributes and logo.
+	//
+	// ```graphql
+	// query {
+	// organization(organizationId: "my-org") {
+	// id
+	// name
+	// subscriptionStatus
+	// customAttributes { items { key scope required } }
+	// }
+	// }
+	// ```
+	Organization GetOrganizationOrganization `json:"organization"`
+}
+
+// GetOrganization returns GetOrganizationResponse.Organization, and is useful for accessing the field via an interface.
+func (v *GetOrganizationResponse) GetOrganization() GetOrganizationOrganization {
+	return v.Organization
+}
+
+// GetProjectProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// A project organizes related infrastructure under a single blueprint.
+//
+// Each project contains a **Blueprint** that defines your infrastructure architecture -- which
+// bundles to use and how they connect -- and one or more **Environments** (like staging or
+// production) where that architecture is actually deployed.
+//
+// ```mermaid
+// graph LR
+// P["Project"] --> B["Blueprint"]
+// P --> E1["Environment: staging"]
+// P --> E2["Environment: production"]
+// B --> C1["Component: database"]
+// B --> C2["Component: cache"]
+// C1 -.->|"Link"| C2
+// ```
+//
+// Attributes set on a project are inherited by all environments and instances within it.
+type GetProjectProject struct {
+	Id string `json:"id"`
+	// Display name shown in the UI and CLI. Must be unique within the organization.
+	Name string `json:"name"`
+	// Free-text description of what this project is for.
+	Description string `json:"description"`
+	// Key-value attributes assigned directly to this project. Attributes cascade to environments and instances. Must conform to your organization's custom attributes for the `PROJECT` scope.
+	Attributes map[string]any `json:"-"`
+	// When this project was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+	// When this project was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Aggregated cloud-provider cost metrics for all instances in this project.
+	Cost GetProjectProjectCostCostSummary `json:"cost"`
+	// Paginated list of environments in this project (e.g., staging, production).
+	Environments GetProjectProjectEnvironmentsEnvironmentsPage `json:"environments"`
+	// Components that make up the project's infrastructure architecture.
+	Components []GetProjectProjectComponentsComponent `json:"components"`
+	// Links between components that wire one component's output to another's input.
+	Links []GetProjectProjectLinksLink `json:"links"`
+}
+
+// GetId returns GetProjectProject.Id, and is useful for accessing the field via an interface.
+func (v *GetProjectProject) GetId() string { return v.Id }
+
+// GetName returns GetProjectProject.Name, and is useful for accessing the field via an interface.
+func (v *GetProjectProject) GetName() string { return v.Name }
+
+// GetDescription returns GetProjectProject.Description, and is useful for accessing the field via an interface.
+func (v *GetProjectProject) GetDescription() string { return v.Description }
+
+// GetAttributes returns GetProjectProject.Attributes, and is useful for accessing the field via an interface.
+func (v *GetProjectProject) GetAttributes() map[string]any { return v.Attributes }
+
+// GetCreatedAt returns GetProjectProject.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetProjectProject) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetUpdatedAt returns GetProjectProject.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetProjectProject) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetCost returns GetProjectProject.Cost, and is useful for accessing the field via an interface.
+func (v *GetProjectProject) GetCost() GetProjectProjectCostCostSummary { return v.Cost }
+
+// GetEnvironments returns GetProjectProject.Environments, and is useful for accessing the field via an interface.
+func (v *GetProjectProject) GetEnvironments() GetProjectProjectEnvironmentsEnvironmentsPage {
+	return v.Environments
+}
+
+// GetComponents returns GetProjectProject.Components, and is useful for accessing the field via an interface.
+func (v *GetProjectProject) GetComponents() []GetProjectProjectComponentsComponent {
+	return v.Components
+}
+
+// GetLinks returns GetProjectProject.Links, and is useful for accessing the field via an interface.
+func (v *GetProjectProject) GetLinks() []GetProjectProjectLinksLink { return v.Links }
+
+func (v *GetProjectProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetProjectProject
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetProjectProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetProjectProject.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetProjectProject struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Attributes json.RawMessage `json:"attributes"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	Cost GetProjectProjectCostCostSummary `json:"cost"`
+
+	Environments GetProjectProjectEnvironmentsEnvironmentsPage `json:"environments"`
+
+	Components []GetProjectProjectComponentsComponent `json:"components"`
+
+	Links []GetProjectProjectLinksLink `json:"links"`
+}
+
+func (v *GetProjectProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetProjectProject) __premarshalJSON() (*__premarshalGetProjectProject, error) {
+	var retval __premarshalGetProjectProject
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Description = v.Description
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetProjectProject.Attributes: %w", err)
+		}
+	}
+	retval.CreatedAt = v.CreatedAt
+	retval.UpdatedAt = v.UpdatedAt
+	retval.Cost = v.Cost
+	retval.Environments = v.Environments
+	retval.Components = v.Components
+	retval.Links = v.Links
+	return &retval, nil
+}
+
+// GetProjectProjectComponentsComponent includes the requested fields of the GraphQL type Component.
+// The GraphQL type's documentation follows.
+//
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+//
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
+//
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type GetProjectProjectComponentsComponent struct {
+	Id string `json:"id"`
+	// Human-readable display name shown in the UI.
+	Name string `json:"name"`
+	// Optional free-text description of this component's purpose.
+	Description string `json:"description"`
+	// Key-value attributes assigned directly to this component.
+	Attributes map[string]any `json:"-"`
+	// Position on the visual canvas. Null if never placed.
+	Position *GetProjectProjectComponentsComponentPosition `json:"position"`
+	// When this component was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+	// When this component was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
+	// The OCI repository (bundle) this component is based on.
+	OciRepo GetProjectProjectComponentsComponentOciRepo `json:"ociRepo"`
+}
+
+// GetId returns GetProjectProjectComponentsComponent.Id, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectComponentsComponent) GetId() string { return v.Id }
+
+// GetName returns GetProjectProjectComponentsComponent.Name, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectComponentsComponent) GetName() string { return v.Name }
+
+// GetDescription returns GetProjectProjectComponentsComponent.Description, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectComponentsComponent) GetDescription() string { return v.Description }
+
+// GetAttributes returns GetProjectProjectComponentsComponent.Attributes, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectComponentsComponent) GetAttributes() map[string]any { return v.Attributes }
+
+// GetPosition returns GetProjectProjectComponentsComponent.Position, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectComponentsComponent) GetPosition() *GetProjectProjectComponentsComponentPosition {
+	return v.Position
+}
+
+// GetCreatedAt returns GetProjectProjectComponentsComponent.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectComponentsComponent) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetUpdatedAt returns GetProjectProjectComponentsComponent.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectComponentsComponent) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetOciRepo returns GetProjectProjectComponentsComponent.OciRepo, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectComponentsComponent) GetOciRepo() GetProjectProjectComponentsComponentOciRepo {
+	return v.OciRepo
+}
+
+func (v *GetProjectProjectComponentsComponent) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetProjectProjectComponentsComponent
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetProjectProjectComponentsComponent = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetProjectProjectComponentsComponent.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetProjectProjectComponentsComponent struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Attributes json.RawMessage `json:"attributes"`
+
+	Position *GetProjectProjectComponentsComponentPosition `json:"position"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	OciRepo GetProjectProjectComponentsComponentOciRepo `json:"ociRepo"`
+}
+
+func (v *GetProjectProjectComponentsComponent) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetProjectProjectComponentsComponent) __premarshalJSON() (*__premarshalGetProjectProjectComponentsComponent, error) {
+	var retval __premarshalGetProjectProjectComponentsComponent
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Description = v.Description
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetProjectProjectComponentsComponent.Attributes: %w", err)
+		}
+	}
+	retval.Position = v.Position
+	retval.CreatedAt = v.CreatedAt
+	retval.UpdatedAt = v.UpdatedAt
+	retval.OciRepo = v.OciRepo
+	return &retval, nil
+}
+
+// GetProjectProjectComponentsComponentOciRepo includes the requested fields of the GraphQL type OciRepo.
+// The GraphQL type's documentation follows.
+//
+// An OCI repository in your organization's bundle catalog.
+//
+// An OCI repository is the container for all published versions of a single
+// infrastructure-as-code package. It is analogous to a Docker image repository
+// but for Massdriver bundles.
+//
+// Each repository has a unique `name` (e.g., `aws-aurora-postgres`) and contains:
+//
+// - **Tags** -- the individual published versions (`1.0.0`, `1.1.0`, `1.2.3`, etc.)
+// - **Release channels** -- auto-resolving version constraints (`latest`, `~1`, `~1.2`)
+// that always point to the newest matching tag
+//
+// To fetch a specific bundle version from a repository, use the `bundle` query
+// with a `BundleId` like `aws-aurora-postgres@1.2.3` or `aws-aurora-postgres@~1`.
+type GetProjectProjectComponentsComponentOciRepo struct {
+	Id string `json:"id"`
+	// Repository name, unique within your organization (e.g., `aws-aurora-postgres`).
+	Name string `json:"name"`
+	// The bare [OCI reference](https://github.com/opencontainers/distribution-spec/blob/main/spec.md#pulling-manifests)
+	// for this repository: `<registry>/<org>/<repo>` (for example,
+	// `api.massdriver.cloud/acme/aws-aurora-postgres`).
+	//
+	// Append `:<tag>` or `@<digest>` to address a specific manifest and use the
+	// result directly with `oras`, `docker`, or any OCI-compliant client:
+	//
+	// ```bash
+	// oras pull api.massdriver.cloud/acme/aws-aurora-postgres:1.2.3
+	// ```
+	Reference string `json:"reference"`
+}
+
+// GetId returns GetProjectProjectComponentsComponentOciRepo.Id, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectComponentsComponentOciRepo) GetId() string { return v.Id }
+
+// GetName returns GetProjectProjectComponentsComponentOciRepo.Name, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectComponentsComponentOciRepo) GetName() string { return v.Name }
+
+// GetReference returns GetProjectProjectComponentsComponentOciRepo.Reference, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectComponentsComponentOciRepo) GetReference() string { return v.Reference }
+
+// GetProjectProjectComponentsComponentPosition includes the requested fields of the GraphQL type ComponentPosition.
+// The GraphQL type's documentation follows.
+//
+// A component's position on the visual canvas, in pixel coordinates.
+type GetProjectProjectComponentsComponentPosition struct {
+	// Horizontal offset in pixels from the canvas origin.
+	X *int `json:"x"`
+	// Vertical offset in pixels from the canvas origin.
+	Y *int `json:"y"`
+}
+
+// GetX returns GetProjectProjectComponentsComponentPosition.X, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectComponentsComponentPosition) GetX() *int { return v.X }
+
+// GetY returns GetProjectProjectComponentsComponentPosition.Y, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectComponentsComponentPosition) GetY() *int { return v.Y }
+
+// GetProjectProjectCostCostSummary includes the requested fields of the GraphQL type CostSummary.
+// The GraphQL type's documentation follows.
+//
+// Aggregated cloud-provider cost metrics for a project or environment.
+//
+// Cost data is sourced from your cloud provider's billing APIs and refreshed periodically.
+// Each metric is a `CostSample` containing an amount and currency. All four metrics are
+// always present, but their inner `amount` and `currency` may be null if billing data has
+// not yet been ingested.
+//
+// - **last_month** -- Total spend for the most recent complete billing cycle.
+// - **monthly_average** -- Average monthly spend across all available billing cycles.
+// - **last_day** -- Total spend for the most recent 24-hour period.
+// - **daily_average** -- Average daily spend over the last 7 days.
+type GetProjectProjectCostCostSummary struct {
+	// Total cost for the most recent complete billing cycle.
+	LastMonth GetProjectProjectCostCostSummaryLastMonthCostSample `json:"lastMonth"`
+	// Average monthly cost across all available billing cycles.
+	MonthlyAverage GetProjectProjectCostCostSummaryMonthlyAverageCostSample `json:"monthlyAverage"`
+	// Total cost for the most recent 24-hour period.
+	LastDay GetProjectProjectCostCostSummaryLastDayCostSample `json:"lastDay"`
+	// Average daily cost over the last 7 days.
+	DailyAverage GetProjectProjectCostCostSummaryDailyAverageCostSample `json:"dailyAverage"`
+}
+
+// GetLastMonth returns GetProjectProjectCostCostSummary.LastMonth, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectCostCostSummary) GetLastMonth() GetProjectProjectCostCostSummaryLastMonthCostSample {
+	return v.LastMonth
+}
+
+// GetMonthlyAverage returns GetProjectProjectCostCostSummary.MonthlyAverage, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectCostCostSummary) GetMonthlyAverage() GetProjectProjectCostCostSummaryMonthlyAverageCostSample {
+	return v.MonthlyAverage
+}
+
+// GetLastDay returns GetProjectProjectCostCostSummary.LastDay, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectCostCostSummary) GetLastDay() GetProjectProjectCostCostSummaryLastDayCostSample {
+	return v.LastDay
+}
+
+// GetDailyAverage returns GetProjectProjectCostCostSummary.DailyAverage, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectCostCostSummary) GetDailyAverage() GetProjectProjectCostCostSummaryDailyAverageCostSample {
+	return v.DailyAverage
+}
+
+// GetProjectProjectCostCostSummaryDailyAverageCostSample includes the requested fields of the GraphQL type CostSample.
+// The GraphQL type's documentation follows.
+//
+// A single cost data point containing an amount and its currency.
+//
+// Both `amount` and `currency` are nullable. A `null` amount means Massdriver has no cost
+// data for the requested period -- this is normal for newly provisioned resources or when
+// cloud provider billing data has not yet been ingested. When data is present, `amount` is
+// always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
+type GetProjectProjectCostCostSummaryDailyAverageCostSample struct {
+	// The cost in the given currency. Null when no billing data is available for this period.
+	Amount float64 `json:"amount"`
+	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
+	Currency string `json:"currency"`
+}
+
+// GetAmount returns GetProjectProjectCostCostSummaryDailyAverageCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectCostCostSummaryDailyAverageCostSample) GetAmount() float64 { return v.Amount }
+
+// GetCurrency returns GetProjectProjectCostCostSummaryDailyAverageCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectCostCostSummaryDailyAverageCostSample) GetCurrency() string {
+	return v.Currency
+}
+
+// GetProjectProjectCostCostSummaryLastDayCostSample includes the requested fields of the GraphQL type CostSample.
+// The GraphQL type's documentation follows.
+//
+// A single cost data point containing an amount and its currency.
+//
+// Both `amount` and `currency` are nullable. A `null` amount means Massdriver has no cost
+// data for the requested period -- this is normal for newly provisioned resources or when
+// cloud provider billing data has not yet been ingested. When data is present, `amount` is
+// always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
+type GetProjectProjectCostCostSummaryLastDayCostSample struct {
+	// The cost in the given currency. Null when no billing data is available for this period.
+	Amount float64 `json:"amount"`
+	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
+	Currency string `json:"currency"`
+}
+
+// GetAmount returns GetProjectProjectCostCostSummaryLastDayCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectCostCostSummaryLastDayCostSample) GetAmount() float64 { return v.Amount }
+
+// GetCurrency returns GetProjectProjectCostCostSummaryLastDayCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectCostCostSummaryLastDayCostSample) GetCurrency() string { return v.Currency }
+
+// GetProjectProjectCostCostSummaryLastMonthCostSample includes the requested fields of the GraphQL type CostSample.
+// The GraphQL type's documentation follows.
+//
+// A single cost data point containing an amount and its currency.
+//
+// Both `amount` and `currency` are nullable. A `null` amount means Massdriver has no cost
+// data for the requested period -- this is normal for newly provisioned resources or when
+// cloud provider billing data has not yet been ingested. When data is present, `amount` is
+// always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
+type GetProjectProjectCostCostSummaryLastMonthCostSample struct {
+	// The cost in the given currency. Null when no billing data is available for this period.
+	Amount float64 `json:"amount"`
+	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
+	Currency string `json:"currency"`
+}
+
+// GetAmount returns GetProjectProjectCostCostSummaryLastMonthCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectCostCostSummaryLastMonthCostSample) GetAmount() float64 { return v.Amount }
+
+// GetCurrency returns GetProjectProjectCostCostSummaryLastMonthCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectCostCostSummaryLastMonthCostSample) GetCurrency() string { return v.Currency }
+
+// GetProjectProjectCostCostSummaryMonthlyAverageCostSample includes the requested fields of the GraphQL type CostSample.
+// The GraphQL type's documentation follows.
+//
+// A single cost data point containing an amount and its currency.
+//
+// Both `amount` and `currency` are nullable. A `null` amount means Massdriver has no cost
+// data for the requested period -- this is normal for newly provisioned resources or when
+// cloud provider billing data has not yet been ingested. When data is present, `amount` is
+// always a positive float and `currency` is an ISO 4217 code (e.g., `USD`, `EUR`).
+type GetProjectProjectCostCostSummaryMonthlyAverageCostSample struct {
+	// The cost in the given currency. Null when no billing data is available for this period.
+	Amount float64 `json:"amount"`
+	// ISO 4217 currency code (e.g., `USD`). Null when no billing data is available.
+	Currency string `json:"currency"`
+}
+
+// GetAmount returns GetProjectProjectCostCostSummaryMonthlyAverageCostSample.Amount, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectCostCostSummaryMonthlyAverageCostSample) GetAmount() float64 {
+	return v.Amount
+}
+
+// GetCurrency returns GetProjectProjectCostCostSummaryMonthlyAverageCostSample.Currency, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectCostCostSummaryMonthlyAverageCostSample) GetCurrency() string {
+	return v.Currency
+}
+
+// GetProjectProjectEnvironmentsEnvironmentsPage includes the requested fields of the GraphQL type EnvironmentsPage.
+type GetProjectProjectEnvironmentsEnvironmentsPage struct {
+	// A list of type environment.
+	Items []GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment `json:"items"`
+}
+
+// GetItems returns GetProjectProjectEnvironmentsEnvironmentsPage.Items, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectEnvironmentsEnvironmentsPage) GetItems() []GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment {
+	return v.Items
+}
+
+// GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment includes the requested fields of the GraphQL type Environment.
+// The GraphQL type's documentation follows.
+//
+// A deployment target within a project where blueprint components become live infrastructure.
+//
+// Each project can have multiple environments (e.g., `staging`, `production`). When you deploy
+// to an environment, every component in the project's blueprint is realized as an **Instance** --
+// a running piece of cloud infrastructure with its own configuration, state, and cost data.
+//
+// Environments inherit attributes from their parent project. You can also set environment-scoped attributes
+// that cascade down to all instances within the environment. **Defaults** let you pre-assign
+// resources (like a shared VPC or DNS zone) so that new instances automatically receive them.
+//
+// Before deleting an environment, all instances must be decommissioned. Use the `deletable`
+// field to check for blocking constraints.
+type GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment struct {
+	Id string `json:"id"`
+	// Display name shown in the UI and CLI. Must be unique within the project.
+	Name string `json:"name"`
+	// Free-text description of what this environment is for.
+	Description string `json:"description"`
+	// Key-value attributes assigned directly to this environment. Attributes cascade to instances. Must conform to your organization's custom attributes for the `ENVIRONMENT` scope.
+	Attributes map[string]any `json:"-"`
+	// When this environment was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+	// When this environment was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetId returns GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) GetId() string { return v.Id }
+
+// GetName returns GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment.Name, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment.Description, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) GetDescription() string {
+	return v.Description
+}
+
+// GetAttributes returns GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment.Attributes, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) GetAttributes() map[string]any {
+	return v.Attributes
+}
+
+// GetCreatedAt returns GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetUpdatedAt returns GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
+
+func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	Attributes json.RawMessage `json:"attributes"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment) __premarshalJSON() (*__premarshalGetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment, error) {
+	var retval __premarshalGetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Description = v.Description
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetProjectProjectEnvironmentsEnvironmentsPageItemsEnvironment.Attributes: %w", err)
+		}
+	}
+	retval.CreatedAt = v.CreatedAt
+	retval.UpdatedAt = v.UpdatedAt
+	return &retval, nil
+}
+
+// GetProjectProjectLinksLink includes the requested fields of the GraphQL type Link.
+// The GraphQL type's documentation follows.
+//
+// A design-time dependency between two components in a blueprint.
+//
+// A link declares that one component's output should be wired into another
+// component's input. For example, a link from a database component's
+// `authentication` output to an application component's `database` input
+// ensures the app receives the database connection string.
+//
+// At deploy time, each link is realized as a **connection** in the environment,
+// wiring the actual instance outputs to instance inputs.
+type GetProjectProjectLinksLink struct {
+	// Unique identifier for this link.
+	Id string `json:"id"`
+	// The output field name on the source component (e.g., `authentication`).
+	FromField string `json:"fromField"`
+	// The input field name on the destination component (e.g., `database`).
+	ToField string `json:"toField"`
+	// When this link was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+	// When this link was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
+	// The source component that produces the output.
+	FromComponent GetProjectProjectLinksLinkFromComponent `json:"fromComponent"`
+	// The destination component that consumes the input.
+	ToComponent GetProjectProjectLinksLinkToComponent `json:"toComponent"`
+}
+
+// GetId returns GetProjectProjectLinksLink.Id, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectLinksLink) GetId() string { return v.Id }
+
+// GetFromField returns GetProjectProjectLinksLink.FromField, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectLinksLink) GetFromField() string { return v.FromField }
+
+// GetToField returns GetProjectProjectLinksLink.ToField, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectLinksLink) GetToField() string { return v.ToField }
+
+// GetCreatedAt returns GetProjectProjectLinksLink.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectLinksLink) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetUpdatedAt returns GetProjectProjectLinksLink.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectLinksLink) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetFromComponent returns GetProjectProjectLinksLink.FromComponent, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectLinksLink) GetFromComponent() GetProjectProjectLinksLinkFromComponent {
+	return v.FromComponent
+}
+
+// GetToComponent returns GetProjectProjectLinksLink.ToComponent, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectLinksLink) GetToComponent() GetProjectProjectLinksLinkToComponent {
+	return v.ToComponent
+}
+
+// GetProjectProjectLinksLinkFromComponent includes the requested fields of the GraphQL type Component.
+// The GraphQL type's documentation follows.
+//
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+//
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
+//
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type GetProjectProjectLinksLinkFromComponent struct {
+	Id string `json:"id"`
+	// Human-readable display name shown in the UI.
+	Name string `json:"name"`
+}
+
+// GetId returns GetProjectProjectLinksLinkFromComponent.Id, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectLinksLinkFromComponent) GetId() string { return v.Id }
+
+// GetName returns GetProjectProjectLinksLinkFromComponent.Name, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectLinksLinkFromComponent) GetName() string { return v.Name }
+
+// GetProjectProjectLinksLinkToComponent includes the requested fields of the GraphQL type Component.
+// The GraphQL type's documentation follows.
+//
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+//
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
+//
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type GetProjectProjectLinksLinkToComponent struct {
+	Id string `json:"id"`
+	// Human-readable display name shown in the UI.
+	Name string `json:"name"`
+}
+
+// GetId returns GetProjectProjectLinksLinkToComponent.Id, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectLinksLinkToComponent) GetId() string { return v.Id }
+
+// GetName returns GetProjectProjectLinksLinkToComponent.Name, and is useful for accessing the field via an interface.
+func (v *GetProjectProjectLinksLinkToComponent) GetName() string { return v.Name }
+
+// GetProjectResponse is returned by GetProject on success.
+type GetProjectResponse struct {
+	// Fetch a single project by its identifier.
+	Project GetProjectProject `json:"project"`
+}
+
+// GetProject returns GetProjectResponse.Project, and is useful for accessing the field via an interface.
+func (v *GetProjectResponse) GetProject() GetProjectProject { return v.Project }
+
+// GetResourceResource includes the requested fields of the GraphQL type Resource.
+// The GraphQL type's documentation follows.
+//
+// A cloud credential, database connection string, network configuration, or other
+// infrastructure output produced by (or imported into) Massdriver.
+//
+// Resources are the connective tissue between instances. When an instance is deployed, it
+// produces resources as outputs. Other instances can consume those resources as inputs,
+// creating a dependency graph of your infrastructure.
+//
+// Resources have two origins:
+// - **Imported** — created directly through the API (e.g., uploading existing AWS credentials).
+// You have full CRUD control over these resources.
+// - **Provisioned** — created automatically when an instance is deployed. These are read-only
+// and managed entirely by the owning instance's lifecycle.
+type GetResourceResource struct {
+	// Unique identifier for this resource.
+	Id string `json:"id"`
+	// Human-readable display name for this resource.
+	Name string `json:"name"`
+	// How this resource was created. Determines whether it can be modified through the API.
+	Origin ResourceOrigin `json:"origin"`
+	// The bundle output handle that produced this resource (e.g., `authentication`, `database`).
+	//
+	// Set only for **provisioned** resources — it corresponds to a field declared under
+	// `artifacts` in the producing bundle's `massdriver.yaml`. Null for **imported** resources.
+	Field string `json:"field"`
+	// Download formats supported for this resource.
+	//
+	// Always includes `json` (the raw payload). Additional formats come from the resource
+	// type's `$md.export` declarations, which can render the payload as YAML or other
+	// templated outputs. Pass a returned format to `downloadArtifact` to retrieve the
+	// rendered content.
+	Formats []string `json:"formats"`
+	// The resource's structured payload. Fields marked `$md.sensitive` in the resource type's
+	// schema are masked as `[SENSITIVE]`. Use `exportResource` to retrieve an unmasked copy —
+	// that operation is recorded in the audit log.
+	Payload map[string]any `json:"-"`
+	// Key-value attributes assigned directly to this resource, used by ABAC
+	// policies. Reserved keys starting with `md-` are auto-injected by the system
+	// and excluded from this map — see `effectiveAttributes` for the merged view.
+	Attributes map[string]any `json:"-"`
+	// When this resource was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+	// When this resource was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
+	// The resource type that this resource conforms to, defining its schema and validation rules.
+	ResourceType *GetResourceResourceResourceType `json:"resourceType"`
+	// The instance whose deployment produced this resource.
+	//
+	// Null for **imported** resources. For **provisioned** resources, this is the instance
+	// that owns the resource's lifecycle — updating or decommissioning the instance will
+	// update or remove the resource.
+	Instance *GetResourceResourceInstance `json:"instance"`
+}
+
+// GetId returns GetResourceResource.Id, and is useful for accessing the field via an interface.
+func (v *GetResourceResource) GetId() string { return v.Id }
+
+// GetName returns GetResourceResource.Name, and is useful for accessing the field via an interface.
+func (v *GetResourceResource) GetName() string { return v.Name }
+
+// GetOrigin returns GetResourceResource.Origin, and is useful for accessing the field via an interface.
+func (v *GetResourceResource) GetOrigin() ResourceOrigin { return v.Origin }
+
+// GetField returns GetResourceResource.Field, and is useful for accessing the field via an interface.
+func (v *GetResourceResource) GetField() string { return v.Field }
+
+// GetFormats returns GetResourceResource.Formats, and is useful for accessing the field via an interface.
+func (v *GetResourceResource) GetFormats() []string { return v.Formats }
+
+// GetPayload returns GetResourceResource.Payload, and is useful for accessing the field via an interface.
+func (v *GetResourceResource) GetPayload() map[string]any { return v.Payload }
+
+// GetAttributes returns GetResourceResource.Attributes, and is useful for accessing the field via an interface.
+func (v *GetResourceResource) GetAttributes() map[string]any { return v.Attributes }
+
+// GetCreatedAt returns GetResourceResource.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetResourceResource) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetUpdatedAt returns GetResourceResource.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetResourceResource) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetResourceType returns GetResourceResource.ResourceType, and is useful for accessing the field via an interface.
+func (v *GetResourceResource) GetResourceType() *GetResourceResourceResourceType {
+	return v.ResourceType
+}
+
+// GetInstance returns GetResourceResource.Instance, and is useful for accessing the field via an interface.
+func (v *GetResourceResource) GetInstance() *GetResourceResourceInstance { return v.Instance }
+
+func (v *GetResourceResource) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetResourceResource
+		Payload    json.RawMessage `json:"payload"`
+		Attributes json.RawMessage `json:"attributes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetResourceResource = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Payload
+		src := firstPass.Payload
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetResourceResource.Payload: %w", err)
+			}
+		}
+	}
+
+	{
+		dst := &v.Attributes
+		src := firstPass.Attributes
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetResourceResource.Attributes: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetResourceResource struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Origin ResourceOrigin `json:"origin"`
+
+	Field string `json:"field"`
+
+	Formats []string `json:"formats"`
+
+	Payload json.RawMessage `json:"payload"`
+
+	Attributes json.RawMessage `json:"attributes"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	ResourceType *GetResourceResourceResourceType `json:"resourceType"`
+
+	Instance *GetResourceResourceInstance `json:"instance"`
+}
+
+func (v *GetResourceResource) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetResourceResource) __premarshalJSON() (*__premarshalGetResourceResource, error) {
+	var retval __premarshalGetResourceResource
+
+	retval.Id = v.Id
+	retval.Name = v.Name
+	retval.Origin = v.Origin
+	retval.Field = v.Field
+	retval.Formats = v.Formats
+	{
+
+		dst := &retval.Payload
+		src := v.Payload
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetResourceResource.Payload: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.Attributes
+		src := v.Attributes
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetResourceResource.Attributes: %w", err)
+		}
+	}
+	retval.CreatedAt = v.CreatedAt
+	retval.UpdatedAt = v.UpdatedAt
+	retval.ResourceType = v.ResourceType
+	retval.Instance = v.Instance
+	return &retval, nil
+}
+
+// GetResourceResourceInstance includes the requested fields of the GraphQL type Instance.
+// The GraphQL type's documentation follows.
+//
+// A deployed piece of infrastructure in an environment.
+//
+// An instance is the **runtime representation** of a component. When you add a
+// "database" component to your blueprint and deploy it to the `staging`
+// environment, Massdriver creates an instance that tracks the database's
+// configuration, deployment state, costs, and produced resources.
+//
+// **Lifecycle:** Instances progress through a well-defined set of states:
+//
+// ```mermaid
+// stateDiagram-v2
+// [*] --> INITIALIZED: "Component added to environment"
+// INITIALIZED --> PROVISIONED: "Deployment succeeds"
+// INITIALIZED --> FAILED: "Deployment fails"
+// PROVISIONED --> PROVISIONED: "Redeploy / update"
+// PROVISIONED --> DECOMMISSIONED: "Decommission succeeds"
+// PROVISIONED --> FAILED: "Deployment fails"
+// FAILED --> PROVISIONED: "Retry succeeds"
+// FAILED --> DECOMMISSIONED: "Decommission"
+// ```
+//
+// **Version resolution:** Each instance has a `version` constraint (e.g., `~1.0`)
+// and a `releaseStrategy` (stable or development). Together these determine
+// the `resolvedVersion` that will be used on the next deployment. Compare
+// `resolvedVersion` with `deployedVersion` to see if a redeployment is needed,
+// or check `availableUpgrade` for newer matching releases.
+type GetResourceResourceInstance struct {
+	Id string `json:"id"`
+	// Name of the instance.
+	Name string `json:"name"`
+}
+
+// GetId returns GetResourceResourceInstance.Id, and is useful for accessing the field via an interface.
+func (v *GetResourceResourceInstance) GetId() string { return v.Id }
+
+// GetName returns GetResourceResourceInstance.Name, and is useful for accessing the field via an interface.
+func (v *GetResourceResourceInstance) GetName() string { return v.Name }
+
+// GetResourceResourceResourceType includes the requested fields of the GraphQL type ResourceType.
+// The GraphQL type's documentation follows.
+//
+// A resource type that defines what kind of infrastructure a resource represents.
+//
+// Resource types are the schema layer for Massdriver's connection system. Every
+// dependency a bundle declares and every resource a bundle produces references a
+// resource type. This is what makes bundles composable -- a database bundle that
+// produces an `aws-rds-instance` resource can be connected to any application
+// bundle that declares an `aws-rds-instance` dependency.
+//
+// Resource types include both public types provided by Massdriver (e.g.,
+// `aws-iam-role`, `kubernetes-cluster`) and private types defined by your
+// organization for custom infrastructure.
+type GetResourceResourceResourceType struct {
+	// Unique identifier in kebab-case (e.g., `aws-iam-role`, `kubernetes-cluster`).
+	Id string `json:"id"`
+	// Human-readable display name (e.g., "AWS IAM Role", "Kubernetes Cluster").
+	Name string `json:"name"`
+}
+
+// GetId returns GetResourceResourceResourceType.Id, and is useful for accessing the field via an interface.
+func (v *GetResourceResourceResourceType) GetId() string { return v.Id }
+
+// GetName returns GetResourceResourceResourceType.Name, and is useful for accessing the field via an interface.
+func (v *GetResourceResourceResourceType) GetName() string { return v.Name }
+
+// GetResourceResponse is returned by GetResource on success.
+type GetResourceResponse struct {
+	// Fetch a single resource by its unique identifier.
+	//
+	// Returns the full resource record including its origin, resource type, and timestamps.
+	Resource GetResourceResource `json:"resource"`
+}
+
+// GetResource returns GetResourceResponse.Resource, and is useful for accessing the field via an interface.
+func (v *GetResourceResponse) GetResource() GetResourceResource { return v.Resource }
+
+// GetServerResponse is returned by GetServer on success.
+type GetServerResponse struct {
+	// Get server metadata and available authentication methods.
+	//
+	// This query does **not** require authentication and is intended to be the first
+	// call a client makes. Use the response to determine which login methods to present
+	// and to verify API compatibility via the server version.
+	//
+	// ```graphql
+	// query {
+	// server {
+	// version
+	// mode
+	// appUrl
+	// ssoProviders {
+	// name
+	// loginUrl
+	// uiLabel
+	// uiIconUrl
+	// }
+	// emailAuthMethods {
+	// name
+	// }
+	// }
+	// }
+	// ```
+	Server GetServerServer `json:"server"`
+}
+
+// GetServer returns GetServerResponse.Server, and is useful for accessing the field via an interface.
+func (v *GetServerResponse) GetServer() GetServerServer { return v.Server }
+
+// GetServerServer includes the requested fields of the GraphQL type Server.
+// The GraphQL type's documentation follows.
+//
+// Information about the Massdriver server you are connected to.
+//
+// Use this to discover the server's version, deployment mode, and available
+// authentication methods. This is typically the first query a client makes
+// to determine how to render the login screen and check API compatibility.
+type GetServerServer struct {
+	// The base URL of the Massdriver web application (e.g., `"https://app.massdriver.cloud"`).
+	AppUrl string `json:"appUrl"`
+	// The server's semantic version (e.g., `"1.2.3"`).
+	Version string `json:"version"`
+	// Whether this is a self-hosted installation or Massdriver Cloud.
+	Mode ServerMode `json:"mode"`
+	// SSO identity providers available for authentication. Empty if no SSO is configured.
+	SsoProviders []GetServerServerSsoProvidersSsoProvider `json:"ssoProviders"`
+	// Email-based authentication methods available (e.g., passkeys). Empty if only SSO is available.
+	EmailAuthMethods []GetServerServerEmailAuthMethodsEmailAuthMethod `json:"emailAuthMethods"`
+}
+
+// GetAppUrl returns GetServerServer.AppUrl, and is useful for accessing the field via an interface.
+func (v *GetServerServer) GetAppUrl() string { return v.AppUrl }
+
+// GetVersion returns GetServerServer.Version, and is useful for accessing the field via an interface.
+func (v *GetServerServer) GetVersion() string { return v.Version }
+
+// GetMode returns GetServerServer.Mode, and is useful for accessing the field via an interface.
+func (v *GetServerServer) GetMode() ServerMode { return v.Mode }
+
+// GetSsoProviders returns GetServerServer.SsoProviders, and is useful for accessing the field via an interface.
+func (v *GetServerServer) GetSsoProviders() []GetServerServerSsoProvidersSsoProvider {
+	return v.SsoProviders
+}
+
+// GetEmailAuthMethods returns GetServerServer.EmailAuthMethods, and is useful for accessing the field via an interface.
+func (v *GetServerServer) GetEmailAuthMethods() []GetServerServerEmailAuthMethodsEmailAuthMethod {
+	return v.EmailAuthMethods
+}
+
+// GetServerServerEmailAuthMethodsEmailAuthMethod includes the requested fields of the GraphQL type EmailAuthMethod.
+// The GraphQL type's documentation follows.
+//
+// An email-based authentication method available on this server.
+//
+// Email auth methods do not require an external identity provider. They are
+// configured at the server level and available to all users.
+type GetServerServerEmailAuthMethodsEmailAuthMethod struct {
+	// The authentication mechanism type (e.g., `PASSKEY`).
+	Name EmailAuthMethodType `json:"name"`
+}
+
+// GetName returns GetServerServerEmailAuthMethodsEmailAuthMethod.Name, and is useful for accessing the field via an interface.
+func (v *GetServerServerEmailAuthMethodsEmailAuthMethod) GetName() EmailAuthMethodType { return v.Name }
+
+// GetServerServerSsoProvidersSsoProvider includes the requested fields of the GraphQL type SsoProvider.
+// The GraphQL type's documentation follows.
+//
+// An SSO (Single Sign-On) provider configured for this Massdriver server.
+//
+// Each provider represents an OAuth 2.0 or OpenID Connect identity provider that
+// users can authenticate with. Use the `loginUrl` to redirect users to the
+// provider's login page.
+type GetServerServerSsoProvidersSsoProvider struct {
+	// The provider's identifier (e.g., `"google"`, `"okta"`, `"azure-ad"`).
+	Name string `json:"name"`
+	// The URL to redirect the user to in order to start the SSO login flow.
+	LoginUrl string `json:"loginUrl"`
+	// URL of the provider's icon, for rendering on login buttons.
+	UiIconUrl string `json:"uiIconUrl"`
+	// Display label for the provider (e.g., `"Sign in with Google"`).
+	UiLabel string `json:"uiLabel"`
+}
+
+// GetName returns GetServerServerSsoProvidersSsoProvider.Name, and is useful for accessing the field via an interface.
+func (v *GetServerServerSsoProvidersSsoProvider) GetName() string { return v.Name }
+
+// GetLoginUrl returns GetServerServerSsoProvidersSsoProvider.LoginUrl, and is useful for accessing the field via an interface.
+func (v *GetServerServerSsoProvidersSsoProvider) GetLoginUrl() string { return v.LoginUrl }
+
+// GetUiIconUrl returns GetServerServerSsoProvidersSsoProvider.UiIconUrl, and is useful for accessing the field via an interface.
+func (v *GetServerServerSsoProvidersSsoProvider) GetUiIconUrl() string { return v.UiIconUrl }
+
+// GetUiLabel returns GetServerServerSsoProvidersSsoProvider.UiLabel, and is useful for accessing the field via an interface.
+func (v *GetServerServerSsoProvidersSsoProvider) GetUiLabel() string { return v.UiLabel }
+
+// GetServiceAccountResponse is returned by GetServiceAccount on success.
+type GetServiceAccountResponse struct {
+	// Fetch a single service account by id. Requires the `organization:manageServiceAccounts` action.
+	ServiceAccount GetServiceAccountServiceAccount `json:"serviceAccount"`
+}
+
+// GetServiceAccount returns GetServiceAccountResponse.ServiceAccount, and is useful for accessing the field via an interface.
+func (v *GetServiceAccountResponse) GetServiceAccount() GetServiceAccountServiceAccount {
+	return v.ServiceAccount
+}
+
+// GetServiceAccountServiceAccount includes the requested fields of the GraphQL type ServiceAccount.
+// The GraphQL type's documentation follows.
+//
+// A non-human identity for programmatic API access.
+//
+// Service accounts let you integrate CI/CD pipelines, scripts, and external tools with the
+// Massdriver API. They authenticate using access tokens — issue one with `createAccessToken`
+// and pass it as a bearer token on API requests.
+//
+// **Permissions** — Service accounts have no inherent permissions. Add them to groups to grant
+// access, using the same role model as human users. A service account in the `Admins` group
+// has the same access as a human admin.
+//
+// **Lifecycle:**
+// 1. Create the service account.
+// 2. Add the service account to one or more groups to grant access.
+// 3. Issue one or more access tokens for the service account via `createAccessToken`. The raw
+// token value is only shown once at creation — store it securely before navigating away.
+// 4. Delete the service account when it is no longer needed. This immediately revokes all access,
+// including any active access tokens.
+type GetServiceAccountServiceAccount struct {
+	// Unique identifier for this service account.
+	Id string `json:"id"`
+	// Human-readable name displayed in the UI, logs, and audit trail.
+	Name string `json:"name"`
+	// Optional text explaining what this service account is used for.
+	Description string `json:"description"`
+	// When this service account was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+	// When this service account was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetId returns GetServiceAccountServiceAccount.Id, and is useful for accessing the field via an interface.
+func (v *GetServiceAccountServiceAccount) GetId() string { return v.Id }
+
+// GetName returns GetServiceAccountServiceAccount.Name, and is useful for accessing the field via an interface.
+func (v *GetServiceAccountServiceAccount) GetName() string { return v.Name }
+
+// GetDescription returns GetServiceAccountServiceAccount.Description, and is useful for accessing the field via an interface.
+func (v *GetServiceAccountServiceAccount) GetDescription() string { return v.Description }
+
+// GetCreatedAt returns GetServiceAccountServiceAccount.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetServiceAccountServiceAccount) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetUpdatedAt returns GetServiceAccountServiceAccount.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetServiceAccountServiceAccount) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetViewerResponse is returned by GetViewer on success.
+type GetViewerResponse struct {
+	// Get information about the currently authenticated entity.
+	//
+	// Returns an `AccountViewer` for human users or a `ServiceAccountViewer` for API clients.
+	// This is the "who am I?" query — use it to bootstrap user profiles, display org switchers,
+	// check billing status, or verify which service account is making requests.
+	//
+	// Returns an authentication error if the request has no valid credentials.
+	Viewer GetViewerViewer `json:"-"`
+}
+
+// GetViewer returns GetViewerResponse.Viewer, and is useful for accessing the field via an interface.
+func (v *GetViewerResponse) GetViewer() GetViewerViewer { return v.Viewer }
+
+func (v *GetViewerResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetViewerResponse
+		Viewer json.RawMessage `json:"viewer"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetViewerResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Viewer
+		src := firstPass.Viewer
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetViewerViewer(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal GetViewerResponse.Viewer: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetViewerResponse struct {
+	Viewer json.RawMessage `json:"viewer"`
+}
+
+func (v *GetViewerResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetViewerResponse) __premarshalJSON() (*__premarshalGetViewerResponse, error) {
+	var retval __premarshalGetViewerResponse
+
+	{
+
+		dst := &retval.Viewer
+		src := v.Viewer
+		var err error
+		*dst, err = __marshalGetViewerViewer(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal GetViewerResponse.Viewer: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetViewerViewer includes the requested fields of the GraphQL interface Viewer.
+//
+// GetViewerViewer is implemented by the following types:
+// GetViewerViewerAccountViewer
+// GetViewerViewerServiceAccountViewer
+// The GraphQL type's documentation follows.
+//
+// The authenticated entity making this API request.
+//
+// This is a union type — check `__typename` to determine whether the viewer is a human
+// user (`AccountViewer`) or an API client (`ServiceAccountViewer`), then query the
+// appropriate fields.
+//
+// **Example:**
+//
+// ```graphql
+// query {
+// viewer {
+// __typename
+// ... on AccountViewer {
+// id
+// email
+// firstName
+// organizations { data { id name } }
+// }
+// ... on ServiceAccountViewer {
+// id
+// name
+// organization { id name }
+// }
+// }
+// }
+// ```
+type GetViewerViewer interface {
+	implementsGraphQLInterfaceGetViewerViewer()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *GetViewerViewerAccountViewer) implementsGraphQLInterfaceGetViewerViewer()        {}
+func (v *GetViewerViewerServiceAccountViewer) implementsGraphQLInterfaceGetViewerViewer() {}
+
+func __unmarshalGetViewerViewer(b []byte, v *GetViewerViewer) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "AccountViewer":
+		*v = new(GetViewerViewerAccountViewer)
+		return json.Unmarshal(b, *v)
+	case "ServiceAccountViewer":
+		*v = new(GetViewerViewerServiceAccountViewer)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Viewer.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetViewerViewer: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetViewerViewer(v *GetViewerViewer) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetViewerViewerAccountViewer:
+		typename = "AccountViewer"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetViewerViewerAccountViewer
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetViewerViewerServiceAccountViewer:
+		typename = "ServiceAccountViewer"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetViewerViewerServiceAccountViewer
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetViewerViewer: "%T"`, v)
+	}
+}
+
+// GetViewerViewerAccountViewer includes the requested fields of the GraphQL type AccountViewer.
+// The GraphQL type's documentation follows.
+//
+// The authenticated human user (account).
+//
+// Returned by the `viewer` query when the request is authenticated with a user session or
+// on behalf of a human account. Contains profile information, organization memberships,
+// linked OAuth identities, and pending group invitations.
+//
+// Use `organizations` to build an org switcher, `defaultOrganization` to set an initial
+// context, and `invites` to show pending invitations the user can accept.
+type GetViewerViewerAccountViewer struct {
+	Typename string `json:"__typename"`
+	// Unique identifier for this account.
+	Id string `json:"id"`
+	// Primary email address associated with the account.
+	Email string `json:"email"`
+	// First name, if set in the user's profile.
+	FirstName string `json:"firstName"`
+	// Last name, if set in the user's profile.
+	LastName string `json:"lastName"`
+	// Your most recently joined organization.
+	//
+	// Useful for setting a default context when the user first opens the application.
+	// Returns `null` if the user does not belong to any organization.
+	DefaultOrganization GetViewerViewerAccountViewerDefaultOrganization `json:"defaultOrganization"`
+}
+
+// GetTypename returns GetViewerViewerAccountViewer.Typename, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerAccountViewer) GetTypename() string { return v.Typename }
+
+// GetId returns GetViewerViewerAccountViewer.Id, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerAccountViewer) GetId() string { return v.Id }
+
+// GetEmail returns GetViewerViewerAccountViewer.Email, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerAccountViewer) GetEmail() string { return v.Email }
+
+// GetFirstName returns GetViewerViewerAccountViewer.FirstName, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerAccountViewer) GetFirstName() string { return v.FirstName }
+
+// GetLastName returns GetViewerViewerAccountViewer.LastName, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerAccountViewer) GetLastName() string { return v.LastName }
+
+// GetDefaultOrganization returns GetViewerViewerAccountViewer.DefaultOrganization, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerAccountViewer) GetDefaultOrganization() GetViewerViewerAccountViewerDefaultOrganization {
+	return v.DefaultOrganization
+}
+
+// GetViewerViewerAccountViewerDefaultOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// The top-level account that owns all your infrastructure, projects, and team members.
+//
+// An organization is the root of the Massdriver resource hierarchy. Everything you build
+// and deploy lives under an organization: **Projects** contain your infrastructure designs,
+// **Environments** (like staging and production) are where those designs come to life, and
+// **Instances** are the actual running cloud resources.
+//
+// ```mermaid
+// graph TD
+// O["Organization"] --> P1["Project"]
+// O --> P2["Project"]
+// P1 --> E1["Environment: staging"]
+// P1 --> E2["Environment: production"]
+// E1 --> I1["Instance"]
+// E1 --> I2["Instance"]
+// ```
+//
+// Members access resources through **group memberships** with role-based permissions.
+// Custom attributes defined at the organization level govern attribute metadata across all child resources.
+//
+// Administrative fields (`billing`, `members`, `customAttributes`) resolve to `null` for
+// callers who lack the corresponding ABAC action; in that case a top-level `FORBIDDEN`
+// error is added to the response while the rest of the organization still resolves.
+type GetViewerViewerAccountViewerDefaultOrganization struct {
+	Id string `json:"id"`
+	// Display name shown in the UI and CLI.
+	Name string `json:"name"`
+	// When this organization was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+	// When this organization was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetId returns GetViewerViewerAccountViewerDefaultOrganization.Id, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerAccountViewerDefaultOrganization) GetId() string { return v.Id }
+
+// GetName returns GetViewerViewerAccountViewerDefaultOrganization.Name, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerAccountViewerDefaultOrganization) GetName() string { return v.Name }
+
+// GetCreatedAt returns GetViewerViewerAccountViewerDefaultOrganization.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerAccountViewerDefaultOrganization) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetUpdatedAt returns GetViewerViewerAccountViewerDefaultOrganization.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerAccountViewerDefaultOrganization) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
+
+// GetViewerViewerServiceAccountViewer includes the requested fields of the GraphQL type ServiceAccountViewer.
+// The GraphQL type's documentation follows.
+//
+// The authenticated service account (API client).
+//
+// Returned by the `viewer` query when the request is authenticated with a service account
+// credential (Basic auth or access token). Contains the service account's identity and the
+// organization it belongs to.
+type GetViewerViewerServiceAccountViewer struct {
+	Typename string `json:"__typename"`
+	// Unique identifier for this service account.
+	Id string `json:"id"`
+	// Human-readable name of the service account.
+	Name string `json:"name"`
+	// Optional text describing the purpose of this service account.
+	Description string `json:"description"`
+	// The organization this service account belongs to.
+	Organization GetViewerViewerServiceAccountViewerOrganization `json:"organization"`
+}
+
+// GetTypename returns GetViewerViewerServiceAccountViewer.Typename, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerServiceAccountViewer) GetTypename() string { return v.Typename }
+
+// GetId returns GetViewerViewerServiceAccountViewer.Id, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerServiceAccountViewer) GetId() string { return v.Id }
+
+// GetName returns GetViewerViewerServiceAccountViewer.Name, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerServiceAccountViewer) GetName() string { return v.Name }
+
+// GetDescription returns GetViewerViewerServiceAccountViewer.Description, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerServiceAccountViewer) GetDescription() string { return v.Description }
+
+// GetOrganization returns GetViewerViewerServiceAccountViewer.Organization, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerServiceAccountViewer) GetOrganization() GetViewerViewerServiceAccountViewerOrganization {
+	return v.Organization
+}
+
+// GetViewerViewerServiceAccountViewerOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// The top-level account that owns all your infrastructure, projects, and team members.
+//
+// An organization is the root of the Massdriver resource hierarchy. Everything you build
+// and deploy lives under an organization: **Projects** contain your infrastructure designs,
+// **Environments** (like staging and production) are where those designs come to life, and
+// **Instances** are the actual running cloud resources.
+//
+// ```mermaid
+// graph TD
+// O["Organization"] --> P1["Project"]
+// O --> P2["Project"]
+// P1 --> E1["Environment: staging"]
+// P1 --> E2["Environment: production"]
+// E1 --> I1["Instance"]
+// E1 --> I2["Instance"]
+// ```
+//
+// Members access resources through **group memberships** with role-based permissions.
+// Custom attributes defined at the organization level govern attribute metadata across all child resources.
+//
+// Administrative fields (`billing`, `members`, `customAttributes`) resolve to `null` for
+// callers who lack the corresponding ABAC action; in that case a top-level `FORBIDDEN`
+// error is added to the response while the rest of the organization still resolves.
+type GetViewerViewerServiceAccountViewerOrganization struct {
+	Id string `json:"id"`
+	// Display name shown in the UI and CLI.
+	Name string `json:"name"`
+	// When this organization was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+	// When this organization was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetId returns GetViewerViewerServiceAccountViewerOrganization.Id, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerServiceAccountViewerOrganization) GetId() string { return v.Id }
+
+// GetName returns GetViewerViewerServiceAccountViewerOrganization.Name, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerServiceAccountViewerOrganization) GetName() string { return v.Name }
+
+// GetCreatedAt returns GetViewerViewerServiceAccountViewerOrganization.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerServiceAccountViewerOrganization) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetUpdatedAt returns GetViewerViewerServiceAccountViewerOrganization.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetViewerViewerServiceAccountViewerOrganization) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
+
+// The access level assigned to a group within an organization.
+//
+// Every group has exactly one role that determines what its members can do:
+//
+// - **`ORGANIZATION_ADMIN`** — Full administrative access across all projects.
+// - **`ORGANIZATION_VIEWER`** — Read-only access across all projects.
+// - **`CUSTOM`** — Fine-grained, project-level access grants. Use this when you need
+// to give a team access to specific projects without organization-wide permissions.
+type GroupRole string
+
+const (
+	// Full administrative access to all projects and settings in the organization. Members can manage groups, service accounts, billing, and all infrastructure.
+	GroupRoleOrganizationAdmin GroupRole = "ORGANIZATION_ADMIN"
+	// Read-only access to all projects in the organization. Members can view infrastructure, deployments, and logs but cannot make changes.
+	GroupRoleOrganizationViewer GroupRole = "ORGANIZATION_VIEWER"
+	// Project-level access grants. Members only see projects explicitly assigned to this group, with either `project_admin` or `project_viewer` permissions per project.
+	GroupRoleCustom GroupRole = "CUSTOM"
+)
+
+var AllGroupRole = []GroupRole{
+	GroupRoleOrganizationAdmin,
+	GroupRoleOrganizationViewer,
+	GroupRoleCustom,
+}
+
+// Sorting options for the groups list.
+type GroupsSort struct {
+	// The field to sort by.
+	Field GroupsSortField `json:"field"`
+	// Ascending (`ASC`) or descending (`DESC`).
+	Order SortOrder `json:"order"`
+}
+
+// GetField returns GroupsSort.Field, and is useful for accessing the field via an interface.
+func (v *GroupsSort) GetField() GroupsSortField { return v.Field }
+
+// GetOrder returns GroupsSort.Order, and is useful for accessing the field via an interface.
+func (v *GroupsSort) GetOrder() SortOrder { return v.Order }
+
+// Available fields for sorting groups.
+type GroupsSortField string
+
+const (
+	// Sort alphabetically by group name (A-Z or Z-A).
+	GroupsSortFieldName GroupsSortField = "NAME"
+	// Sort by creation date (oldest first or newest first).
+	GroupsSortFieldCreatedAt GroupsSortField = "CREATED_AT"
+)
+
+var AllGroupsSortField = []GroupsSortField{
+	GroupsSortFieldName,
+	GroupsSortFieldCreatedAt,
+}
+
+// Filter by an identifier field.
+//
+// All operators within a single filter are combined with **AND**. To match any of
+// several values, use the `in` operator rather than multiple `eq` filters.
+//
+// ```graphql
+// # Exact match
 // { "id": { "eq": "my-resource" } }
 //
-// # Match several IDs (logical OR between values)
-// { "id": { "in": ["resource-a", "resource-b"] } }
+// # Match several IDs (logical OR between values)
+// { "id": { "in": ["resource-a", "resource-b"] } }
+// ```
+type IdFilter struct {
+	// Return only results whose ID exactly equals this value.
+	Eq string `json:"eq"`
+	// Return results whose ID matches any value in this list.
+	In []string `json:"in"`
+}
+
+// GetEq returns IdFilter.Eq, and is useful for accessing the field via an interface.
+func (v *IdFilter) GetEq() string { return v.Eq }
+
+// GetIn returns IdFilter.In, and is useful for accessing the field via an interface.
+func (v *IdFilter) GetIn() []string { return v.In }
+
+// Narrows the instance alarms list to only matching records.
+//
+// All filters are combined with AND logic. Omit a filter to skip that criterion.
+type InstanceAlarmsFilter struct {
+	// Return only alarms in the specified project(s).
+	ProjectId *IdFilter `json:"projectId,omitempty"`
+	// Return only alarms in the specified environment(s).
+	EnvironmentId *IdFilter `json:"environmentId,omitempty"`
+	// Return only alarms attached to the specified component(s).
+	ComponentId *IdFilter `json:"componentId,omitempty"`
+	// Return only alarms for the specified instance(s).
+	InstanceId *IdFilter `json:"instanceId,omitempty"`
+	// Return only alarms on instances of the given bundle (e.g., `aws-rds`). Matches every version published to the repo.
+	OciRepoName *OciRepoNameFilter `json:"ociRepoName,omitempty"`
+	// Return only alarms on instances pinned to a specific bundle version (`name@version`) or release channel (`name@~1`, `name@latest`). Use `ociRepoName` instead to match every version of a bundle.
+	BundleId *BundleIdFilter `json:"bundleId,omitempty"`
+}
+
+// GetProjectId returns InstanceAlarmsFilter.ProjectId, and is useful for accessing the field via an interface.
+func (v *InstanceAlarmsFilter) GetProjectId() *IdFilter { return v.ProjectId }
+
+// GetEnvironmentId returns InstanceAlarmsFilter.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *InstanceAlarmsFilter) GetEnvironmentId() *IdFilter { return v.EnvironmentId }
+
+// GetComponentId returns InstanceAlarmsFilter.ComponentId, and is useful for accessing the field via an interface.
+func (v *InstanceAlarmsFilter) GetComponentId() *IdFilter { return v.ComponentId }
+
+// GetInstanceId returns InstanceAlarmsFilter.InstanceId, and is useful for accessing the field via an interface.
+func (v *InstanceAlarmsFilter) GetInstanceId() *IdFilter { return v.InstanceId }
+
+// GetOciRepoName returns InstanceAlarmsFilter.OciRepoName, and is useful for accessing the field via an interface.
+func (v *InstanceAlarmsFilter) GetOciRepoName() *OciRepoNameFilter { return v.OciRepoName }
+
+// GetBundleId returns InstanceAlarmsFilter.BundleId, and is useful for accessing the field via an interface.
+func (v *InstanceAlarmsFilter) GetBundleId() *BundleIdFilter { return v.BundleId }
+
+// Sorting options for the instance alarms list. Specify a field and direction.
+type InstanceAlarmsSort struct {
+	// The field to sort by.
+	Field InstanceAlarmsSortField `json:"field"`
+	// `ASC` for A-Z / oldest first, `DESC` for Z-A / newest first.
+	Order SortOrder `json:"order"`
+}
+
+// GetField returns InstanceAlarmsSort.Field, and is useful for accessing the field via an interface.
+func (v *InstanceAlarmsSort) GetField() InstanceAlarmsSortField { return v.Field }
+
+// GetOrder returns InstanceAlarmsSort.Order, and is useful for accessing the field via an interface.
+func (v *InstanceAlarmsSort) GetOrder() SortOrder { return v.Order }
+
+// Available fields for sorting the instance alarms list.
+type InstanceAlarmsSortField string
+
+const (
+	// Alphabetical by alarm display name (A-Z or Z-A).
+	InstanceAlarmsSortFieldDisplayName InstanceAlarmsSortField = "DISPLAY_NAME"
+	// Chronological by creation time (oldest or newest first).
+	InstanceAlarmsSortFieldCreatedAt InstanceAlarmsSortField = "CREATED_AT"
+)
+
+var AllInstanceAlarmsSortField = []InstanceAlarmsSortField{
+	InstanceAlarmsSortFieldDisplayName,
+	InstanceAlarmsSortFieldCreatedAt,
+}
+
+// The current lifecycle state of an instance.
+//
+// ```mermaid
+// stateDiagram-v2
+// [*] --> INITIALIZED: "Component added to environment"
+// INITIALIZED --> PROVISIONED: "Deployment succeeds"
+// INITIALIZED --> FAILED: "Deployment fails"
+// PROVISIONED --> PROVISIONED: "Redeploy / update"
+// PROVISIONED --> DECOMMISSIONED: "Decommission succeeds"
+// PROVISIONED --> FAILED: "Deployment fails"
+// FAILED --> PROVISIONED: "Retry succeeds"
+// FAILED --> DECOMMISSIONED: "Decommission"
 // ```
-type IdFilter struct {
-	// Return only results whose ID exactly equals this value.
-	Eq string `json:"eq"`
-	// Return results whose ID matches any value in this list.
-	In []string `json:"in"`
+type InstanceStatus string
+
+const (
+	// The instance has been created but no deployment has started yet.
+	InstanceStatusInitialized InstanceStatus = "INITIALIZED"
+	// Infrastructure is successfully deployed and running.
+	InstanceStatusProvisioned InstanceStatus = "PROVISIONED"
+	// Infrastructure has been torn down. The instance record is retained for audit purposes.
+	InstanceStatusDecommissioned InstanceStatus = "DECOMMISSIONED"
+	// The most recent deployment failed. Check deployment logs for details. Can be retried.
+	InstanceStatusFailed InstanceStatus = "FAILED"
+)
+
+var AllInstanceStatus = []InstanceStatus{
+	InstanceStatusInitialized,
+	InstanceStatusProvisioned,
+	InstanceStatusDecommissioned,
+	InstanceStatusFailed,
+}
+
+// Filter by instance status.
+//
+// All operators within a single filter are combined with **AND**. Use `in` to match
+// instances in any of several statuses.
+//
+// ```graphql
+// # All provisioned instances
+// { "status": { "eq": "PROVISIONED" } }
+//
+// # Instances that are provisioning or provisioned
+// { "status": { "in": ["PROVISIONING", "PROVISIONED"] } }
+// ```
+type InstanceStatusFilter struct {
+	// Return only instances whose status exactly equals this value.
+	Eq InstanceStatus `json:"eq,omitempty"`
+	// Return instances whose status matches any value in this list.
+	In []InstanceStatus `json:"in,omitempty"`
+}
+
+// GetEq returns InstanceStatusFilter.Eq, and is useful for accessing the field via an interface.
+func (v *InstanceStatusFilter) GetEq() InstanceStatus { return v.Eq }
+
+// GetIn returns InstanceStatusFilter.In, and is useful for accessing the field via an interface.
+func (v *InstanceStatusFilter) GetIn() []InstanceStatus { return v.In }
+
+// Filter which instances to return.
+//
+// All filters are combined with AND. For example, setting both `status` and
+// `ociRepoName` returns only instances that match both criteria.
+type InstancesFilter struct {
+	// Match by the owning project's ID.
+	ProjectId *IdFilter `json:"projectId,omitempty"`
+	// Match by the environment's ID.
+	EnvironmentId *IdFilter `json:"environmentId,omitempty"`
+	// Match by lifecycle status (e.g., `PROVISIONED`, `FAILED`).
+	Status *InstanceStatusFilter `json:"status,omitempty"`
+	// Match by the OCI repository name of the underlying bundle. Returns every version published to the repo.
+	OciRepoName *OciRepoNameFilter `json:"ociRepoName,omitempty"`
+	// Match by a specific bundle version (`name@version`) or release channel (`name@~1`, `name@latest`). Use `ociRepoName` instead to match every version of a bundle.
+	BundleId *BundleIdFilter `json:"bundleId,omitempty"`
+	// Filter by configuration parameter values. Each entry targets a specific param field; multiple entries are combined with AND.
+	ParamDimension []ParamDimensionFilter `json:"paramDimension,omitempty"`
+	// Match by effective attributes — the instance's own attributes plus those inherited from its component, environment, and project. Each entry targets one attribute key; multiple entries are combined with AND.
+	Attributes []AttributeFilter `json:"attributes,omitempty"`
+}
+
+// GetProjectId returns InstancesFilter.ProjectId, and is useful for accessing the field via an interface.
+func (v *InstancesFilter) GetProjectId() *IdFilter { return v.ProjectId }
+
+// GetEnvironmentId returns InstancesFilter.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *InstancesFilter) GetEnvironmentId() *IdFilter { return v.EnvironmentId }
+
+// GetStatus returns InstancesFilter.Status, and is useful for accessing the field via an interface.
+func (v *InstancesFilter) GetStatus() *InstanceStatusFilter { return v.Status }
+
+// GetOciRepoName returns InstancesFilter.OciRepoName, and is useful for accessing the field via an interface.
+func (v *InstancesFilter) GetOciRepoName() *OciRepoNameFilter { return v.OciRepoName }
+
+// GetBundleId returns InstancesFilter.BundleId, and is useful for accessing the field via an interface.
+func (v *InstancesFilter) GetBundleId() *BundleIdFilter { return v.BundleId }
+
+// GetParamDimension returns InstancesFilter.ParamDimension, and is useful for accessing the field via an interface.
+func (v *InstancesFilter) GetParamDimension() []ParamDimensionFilter { return v.ParamDimension }
+
+// GetAttributes returns InstancesFilter.Attributes, and is useful for accessing the field via an interface.
+func (v *InstancesFilter) GetAttributes() []AttributeFilter { return v.Attributes }
+
+// Sorting options for the instances list.
+type InstancesSort struct {
+	// The field to sort by.
+	Field InstancesSortField `json:"field"`
+	// Ascending or descending.
+	Order SortOrder `json:"order"`
+}
+
+// GetField returns InstancesSort.Field, and is useful for accessing the field via an interface.
+func (v *InstancesSort) GetField() InstancesSortField { return v.Field }
+
+// GetOrder returns InstancesSort.Order, and is useful for accessing the field via an interface.
+func (v *InstancesSort) GetOrder() SortOrder { return v.Order }
+
+// Available fields for sorting instances.
+type InstancesSortField string
+
+const (
+	// Alphabetical by instance name (A-Z or Z-A).
+	InstancesSortFieldName InstancesSortField = "NAME"
+	// Chronological by creation time (oldest or newest first).
+	InstancesSortFieldCreatedAt InstancesSortField = "CREATED_AT"
+)
+
+var AllInstancesSortField = []InstancesSortField{
+	InstancesSortFieldName,
+	InstancesSortFieldCreatedAt,
+}
+
+// Create a link between two components in a project's blueprint. Links connect an output field on the source component to an input field on the destination component, establishing data flow between infrastructure resources.
+type LinkComponentsInput struct {
+	// ID of the component that produces the resource (e.g., 'myproj-database').
+	FromComponentId string `json:"fromComponentId"`
+	// Output field name on the source component
+	FromField string `json:"fromField"`
+	// Version constraint for the source component (e.g., '~1.0', '1.2.3', 'latest')
+	FromVersion string `json:"fromVersion"`
+	// ID of the component that consumes the resource (e.g., 'myproj-app').
+	ToComponentId string `json:"toComponentId"`
+	// Input field name on the destination component
+	ToField string `json:"toField"`
+	// Version constraint for the destination component (e.g., '~1.0', '1.2.3', 'latest')
+	ToVersion string `json:"toVersion"`
+}
+
+// GetFromComponentId returns LinkComponentsInput.FromComponentId, and is useful for accessing the field via an interface.
+func (v *LinkComponentsInput) GetFromComponentId() string { return v.FromComponentId }
+
+// GetFromField returns LinkComponentsInput.FromField, and is useful for accessing the field via an interface.
+func (v *LinkComponentsInput) GetFromField() string { return v.FromField }
+
+// GetFromVersion returns LinkComponentsInput.FromVersion, and is useful for accessing the field via an interface.
+func (v *LinkComponentsInput) GetFromVersion() string { return v.FromVersion }
+
+// GetToComponentId returns LinkComponentsInput.ToComponentId, and is useful for accessing the field via an interface.
+func (v *LinkComponentsInput) GetToComponentId() string { return v.ToComponentId }
+
+// GetToField returns LinkComponentsInput.ToField, and is useful for accessing the field via an interface.
+func (v *LinkComponentsInput) GetToField() string { return v.ToField }
+
+// GetToVersion returns LinkComponentsInput.ToVersion, and is useful for accessing the field via an interface.
+func (v *LinkComponentsInput) GetToVersion() string { return v.ToVersion }
+
+// LinkComponentsLinkComponentsLinkPayload includes the requested fields of the GraphQL type LinkPayload.
+type LinkComponentsLinkComponentsLinkPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result LinkComponentsLinkComponentsLinkPayloadResultLink `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns LinkComponentsLinkComponentsLinkPayload.Result, and is useful for accessing the field via an interface.
+func (v *LinkComponentsLinkComponentsLinkPayload) GetResult() LinkComponentsLinkComponentsLinkPayloadResultLink {
+	return v.Result
+}
+
+// GetSuccessful returns LinkComponentsLinkComponentsLinkPayload.Successful, and is useful for accessing the field via an interface.
+func (v *LinkComponentsLinkComponentsLinkPayload) GetSuccessful() bool { return v.Successful }
+
+// GetMessages returns LinkComponentsLinkComponentsLinkPayload.Messages, and is useful for accessing the field via an interface.
+func (v *LinkComponentsLinkComponentsLinkPayload) GetMessages() []LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
 }
 
-// GetEq returns IdFilter.Eq, and is useful for accessing the field via an interface.
-func (v *IdFilter) GetEq() string { return v.Eq }
+// GetCode returns LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
 
-// GetIn returns IdFilter.In, and is useful for accessing the field via an interface.
-func (v *IdFilter) GetIn() []string { return v.In }
+// GetField returns LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
 
-// Narrows the instance alarms list to only matching records.
+// GetMessage returns LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// LinkComponentsLinkComponentsLinkPayloadResultLink includes the requested fields of the GraphQL type Link.
+// The GraphQL type's documentation follows.
 //
-// All filters are combined with AND logic. Omit a filter to skip that criterion.
-type InstanceAlarmsFilter struct {
-	// Return only alarms in the specified project(s).
-	ProjectId *IdFilter `json:"projectId,omitempty"`
-	// Return only alarms in the specified environment(s).
-	EnvironmentId *IdFilter `json:"environmentId,omitempty"`
-	// Return only alarms attached to the specified component(s).
-	ComponentId *IdFilter `json:"componentId,omitempty"`
-	// Return only alarms for the specified instance(s).
-	InstanceId *IdFilter `json:"instanceId,omitempty"`
-	// Return only alarms on instances of the given bundle (e.g., `aws-rds`). Matches every version published to the repo.
-	OciRepoName *OciRepoNameFilter `json:"ociRepoName,omitempty"`
-	// Return only alarms on instances pinned to a specific bundle version (`name@version`) or release channel (`name@~1`, `name@latest`). Use `ociRepoName` instead to match every version of a bundle.
-	BundleId *BundleIdFilter `json:"bundleId,omitempty"`
+// A design-time dependency between two components in a blueprint.
+//
+// A link declares that one component's output should be wired into another
+// component's input. For example, a link from a database component's
+// `authentication` output to an application component's `database` input
+// ensures the app receives the database connection string.
+//
+// At deploy time, each link is realized as a **connection** in the environment,
+// wiring the actual instance outputs to instance inputs.
+type LinkComponentsLinkComponentsLinkPayloadResultLink struct {
+	// Unique identifier for this link.
+	Id string `json:"id"`
+	// The output field name on the source component (e.g., `authentication`).
+	FromField string `json:"fromField"`
+	// The input field name on the destination component (e.g., `database`).
+	ToField string `json:"toField"`
+	// When this link was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+	// When this link was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
+	// The source component that produces the output.
+	FromComponent LinkComponentsLinkComponentsLinkPayloadResultLinkFromComponent `json:"fromComponent"`
+	// The destination component that consumes the input.
+	ToComponent LinkComponentsLinkComponentsLinkPayloadResultLinkToComponent `json:"toComponent"`
 }
 
-// GetProjectId returns InstanceAlarmsFilter.ProjectId, and is useful for accessing the field via an interface.
-func (v *InstanceAlarmsFilter) GetProjectId() *IdFilter { return v.ProjectId }
+// GetId returns LinkComponentsLinkComponentsLinkPayloadResultLink.Id, and is useful for accessing the field via an interface.
+func (v *LinkComponentsLinkComponentsLinkPayloadResultLink) GetId() string { return v.Id }
 
-// GetEnvironmentId returns InstanceAlarmsFilter.EnvironmentId, and is useful for accessing the field via an interface.
-func (v *InstanceAlarmsFilter) GetEnvironmentId() *IdFilter { return v.EnvironmentId }
+// GetFromField returns LinkComponentsLinkComponentsLinkPayloadResultLink.FromField, and is useful for accessing the field via an interface.
+func (v *LinkComponentsLinkComponentsLinkPayloadResultLink) GetFromField() string { return v.FromField }
 
-// GetComponentId returns InstanceAlarmsFilter.ComponentId, and is useful for accessing the field via an interface.
-func (v *InstanceAlarmsFilter) GetComponentId() *IdFilter { return v.ComponentId }
+// GetToField returns LinkComponentsLinkComponentsLinkPayloadResultLink.ToField, and is useful for accessing the field via an interface.
+func (v *LinkComponentsLinkComponentsLinkPayloadResultLink) GetToField() string { return v.ToField }
 
-// GetInstanceId returns InstanceAlarmsFilter.InstanceId, and is useful for accessing the field via an interface.
-func (v *InstanceAlarmsFilter) GetInstanceId() *IdFilter { return v.InstanceId }
+// GetCreatedAt returns LinkComponentsLinkComponentsLinkPayloadResultLink.CreatedAt, and is useful for accessing the field via an interface.
+func (v *LinkComponentsLinkComponentsLinkPayloadResultLink) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
 
-// GetOciRepoName returns InstanceAlarmsFilter.OciRepoName, and is useful for accessing the field via an interface.
-func (v *InstanceAlarmsFilter) GetOciRepoName() *OciRepoNameFilter { return v.OciRepoName }
+// GetUpdatedAt returns LinkComponentsLinkComponentsLinkPayloadResultLink.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *LinkComponentsLinkComponentsLinkPayloadResultLink) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
 
-// GetBundleId returns InstanceAlarmsFilter.BundleId, and is useful for accessing the field via an interface.
-func (v *InstanceAlarmsFilter) GetBundleId() *BundleIdFilter { return v.BundleId }
+// GetFromComponent returns LinkComponentsLinkComponentsLinkPayloadResultLink.FromComponent, and is useful for accessing the field via an interface.
+func (v *LinkComponentsLinkComponentsLinkPayloadResultLink) GetFromComponent() LinkComponentsLinkComponentsLinkPayloadResultLinkFromComponent {
+	return v.FromComponent
+}
 
-// Sorting options for the instance alarms list. Specify a field and direction.
-type InstanceAlarmsSort struct {
-	// The field to sort by.
-	Field InstanceAlarmsSortField `json:"field"`
-	// `ASC` for A-Z / oldest first, `DESC` for Z-A / newest first.
-	Order SortOrder `json:"order"`
+// GetToComponent returns LinkComponentsLinkComponentsLinkPayloadResultLink.ToComponent, and is useful for accessing the field via an interface.
+func (v *LinkComponentsLinkComponentsLinkPayloadResultLink) GetToComponent() LinkComponentsLinkComponentsLinkPayloadResultLinkToComponent {
+	return v.ToComponent
 }
 
-// GetField returns InstanceAlarmsSort.Field, and is useful for accessing the field via an interface.
-func (v *InstanceAlarmsSort) GetField() InstanceAlarmsSortField { return v.Field }
+// LinkComponentsLinkComponentsLinkPayloadResultLinkFromComponent includes the requested fields of the GraphQL type Component.
+// The GraphQL type's documentation follows.
+//
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+//
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
+//
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type LinkComponentsLinkComponentsLinkPayloadResultLinkFromComponent struct {
+	Id string `json:"id"`
+	// Human-readable display name shown in the UI.
+	Name string `json:"name"`
+}
 
-// GetOrder returns InstanceAlarmsSort.Order, and is useful for accessing the field via an interface.
-func (v *InstanceAlarmsSort) GetOrder() SortOrder { return v.Order }
+// GetId returns LinkComponentsLinkComponentsLinkPayloadResultLinkFromComponent.Id, and is useful for accessing the field via an interface.
+func (v *LinkComponentsLinkComponentsLinkPayloadResultLinkFromComponent) GetId() string { return v.Id }
 
-// Available fields for sorting the instance alarms list.
-type InstanceAlarmsSortField string
+// GetName returns LinkComponentsLinkComponentsLinkPayloadResultLinkFromComponent.Name, and is useful for accessing the field via an interface.
+func (v *LinkComponentsLinkComponentsLinkPayloadResultLinkFromComponent) GetName() string {
+	return v.Name
+}
 
-const (
-	// Alphabetical by alarm display name (A-Z or Z-A).
-	InstanceAlarmsSortFieldDisplayName InstanceAlarmsSortField = "DISPLAY_NAME"
-	// Chronological by creation time (oldest or newest first).
-	InstanceAlarmsSortFieldCreatedAt InstanceAlarmsSortField = "CREATED_AT"
-)
+// LinkComponentsLinkComponentsLinkPayloadResultLinkToComponent includes the requested fields of the GraphQL type Component.
+// The GraphQL type's documentation follows.
+//
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+//
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
+//
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type LinkComponentsLinkComponentsLinkPayloadResultLinkToComponent struct {
+	Id string `json:"id"`
+	// Human-readable display name shown in the UI.
+	Name string `json:"name"`
+}
 
-var AllInstanceAlarmsSortField = []InstanceAlarmsSortField{
-	InstanceAlarmsSortFieldDisplayName,
-	InstanceAlarmsSortFieldCreatedAt,
+// GetId returns LinkComponentsLinkComponentsLinkPayloadResultLinkToComponent.Id, and is useful for accessing the field via an interface.
+func (v *LinkComponentsLinkComponentsLinkPayloadResultLinkToComponent) GetId() string { return v.Id }
+
+// GetName returns LinkComponentsLinkComponentsLinkPayloadResultLinkToComponent.Name, and is useful for accessing the field via an interface.
+func (v *LinkComponentsLinkComponentsLinkPayloadResultLinkToComponent) GetName() string {
+	return v.Name
 }
 
-// The current lifecycle state of an instance.
-//
-// ```mermaid
-// stateDiagram-v2
-// [*] --> INITIALIZED: "Component added to environment"
-// INITIALIZED --> PROVISIONED: "Deployment succeeds"
-// INITIALIZED --> FAILED: "Deployment fails"
-// PROVISIONED --> PROVISIONED: "Redeploy / update"
-// PROVISIONED --> DECOMMISSIONED: "Decommission succeeds"
-// PROVISIONED --> FAILED: "Deployment fails"
-// FAILED --> PROVISIONED: "Retry succeeds"
-// FAILED --> DECOMMISSIONED: "Decommission"
-// ```
-type InstanceStatus string
+// LinkComponentsResponse is returned by LinkComponents on success.
+type LinkComponentsResponse struct {
+	// Create a link between two components.
+	//
+	// Declares that the `fromField` output of one component should be wired to the
+	// `toField` input of another. Massdriver validates that the resource types are
+	// compatible before creating the link. When environments are deployed, each link
+	// becomes a **connection** that carries the actual resource data between instances.
+	//
+	// ```graphql
+	// mutation {
+	// linkComponents(
+	// organizationId: "my-org"
+	// input: {
+	// fromComponentId: "my-project-database"
+	// fromField: "authentication"
+	// fromVersion: "~1.0"
+	// toComponentId: "my-project-app"
+	// toField: "database"
+	// toVersion: "~2.0"
+	// }
+	// ) {
+	// result { id fromField toField }
+	// successful
+	// }
+	// }
+	// ```
+	LinkComponents LinkComponentsLinkComponentsLinkPayload `json:"linkComponents"`
+}
 
-const (
-	// The instance has been created but no deployment has started yet.
-	InstanceStatusInitialized InstanceStatus = "INITIALIZED"
-	// Infrastructure is successfully deployed and running.
-	InstanceStatusProvisioned InstanceStatus = "PROVISIONED"
-	// Infrastructure has been torn down. The instance record is retained for audit purposes.
-	InstanceStatusDecommissioned InstanceStatus = "DECOMMISSIONED"
-	// The most recent deployment failed. Check deployment logs for details. Can be retried.
-	InstanceStatusFailed InstanceStatus = "FAILED"
-)
+// GetLinkComponents returns LinkComponentsResponse.LinkComponents, and is useful for accessing the field via an interface.
+func (v *LinkComponentsResponse) GetLinkComponents() LinkComponentsLinkComponentsLinkPayload {
+	return v.LinkComponents
+}
 
-var AllInstanceStatus = []InstanceStatus{
-	InstanceStatusInitialized,
-	InstanceStatusProvisioned,
-	InstanceStatusDecommissioned,
-	InstanceStatusFailed,
+// ListAccessTokensAccessTokensAccessTokensPage includes the requested fields of the GraphQL type AccessTokensPage.
+type ListAccessTokensAccessTokensAccessTokensPage struct {
+	// Pagination cursors for navigating between pages.
+	Cursor ListAccessTokensAccessTokensAccessTokensPageCursorPaginationCursor `json:"cursor"`
+	// A list of type access_token.
+	Items []ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken `json:"items"`
 }
 
-// Filter by instance status.
-//
-// All operators within a single filter are combined with **AND**. Use `in` to match
-// instances in any of several statuses.
-//
-// ```graphql
-// # All provisioned instances
-// { "status": { "eq": "PROVISIONED" } }
-//
-// # Instances that are provisioning or provisioned
-// { "status": { "in": ["PROVISIONING", "PROVISIONED"] } }
-// ```
-type InstanceStatusFilter struct {
-	// Return only instances whose status exactly equals this value.
-	Eq InstanceStatus `json:"eq,omitempty"`
-	// Return instances whose status matches any value in this list.
-	In []InstanceStatus `json:"in,omitempty"`
+// GetCursor returns ListAccessTokensAccessTokensAccessTokensPage.Cursor, and is useful for accessing the field via an interface.
+func (v *ListAccessTokensAccessTokensAccessTokensPage) GetCursor() ListAccessTokensAccessTokensAccessTokensPageCursorPaginationCursor {
+	return v.Cursor
 }
 
-// GetEq returns InstanceStatusFilter.Eq, and is useful for accessing the field via an interface.
-func (v *InstanceStatusFilter) GetEq() InstanceStatus { return v.Eq }
-
-// GetIn returns InstanceStatusFilter.In, and is useful for accessing the field via an interface.
-func (v *InstanceStatusFilter) GetIn() []InstanceStatus { return v.In }
+// GetItems returns ListAccessTokensAccessTokensAccessTokensPage.Items, and is useful for accessing the field via an interface.
+func (v *ListAccessTokensAccessTokensAccessTokensPage) GetItems() []ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken {
+	return v.Items
+}
 
-// Filter which instances to return.
+// ListAccessTokensAccessTokensAccessTokensPageCursorPaginationCursor includes the requested fields of the GraphQL type PaginationCursor.
+// The GraphQL type's documentation follows.
 //
-// All filters are combined with AND. For example, setting both `status` and
-// `ociRepoName` returns only instances that match both criteria.
-type InstancesFilter struct {
-	// Match by the owning project's ID.
-	ProjectId *IdFilter `json:"projectId,omitempty"`
-	// Match by the environment's ID.
-	EnvironmentId *IdFilter `json:"environmentId,omitempty"`
-	// Match by lifecycle status (e.g., `PROVISIONED`, `FAILED`).
-	Status *InstanceStatusFilter `json:"status,omitempty"`
-	// Match by the OCI repository name of the underlying bundle. Returns every version published to the repo.
-	OciRepoName *OciRepoNameFilter `json:"ociRepoName,omitempty"`
-	// Match by a specific bundle version (`name@version`) or release channel (`name@~1`, `name@latest`). Use `ociRepoName` instead to match every version of a bundle.
-	BundleId *BundleIdFilter `json:"bundleId,omitempty"`
-	// Filter by configuration parameter values. Each entry targets a specific param field; multiple entries are combined with AND.
-	ParamDimension []ParamDimensionFilter `json:"paramDimension,omitempty"`
-	// Match by effective attributes — the instance's own attributes plus those inherited from its component, environment, and project. Each entry targets one attribute key; multiple entries are combined with AND.
-	Attributes []AttributeFilter `json:"attributes,omitempty"`
+// Pagination cursors returned with every paginated response.
+//
+// Contains opaque cursor strings for navigating forward and backward through results.
+// A `null` value for `next` indicates you have reached the last page; a `null` value
+// for `previous` indicates you are on the first page.
+type ListAccessTokensAccessTokensAccessTokensPageCursorPaginationCursor struct {
+	// Cursor for the next page. `null` if there are no more results.
+	Next string `json:"next"`
+	// Cursor for the previous page. `null` if this is the first page.
+	Previous string `json:"previous"`
 }
 
-// GetProjectId returns InstancesFilter.ProjectId, and is useful for accessing the field via an interface.
-func (v *InstancesFilter) GetProjectId() *IdFilter { return v.ProjectId }
-
-// GetEnvironmentId returns InstancesFilter.EnvironmentId, and is useful for accessing the field via an interface.
-func (v *InstancesFilter) GetEnvironmentId() *IdFilter { return v.EnvironmentId }
-
-// GetStatus returns InstancesFilter.Status, and is useful for accessing the field via an interface.
-func (v *InstancesFilter) GetStatus() *InstanceStatusFilter { return v.Status }
-
-// GetOciRepoName returns InstancesFilter.OciRepoName, and is useful for accessing the field via an interface.
-func (v *InstancesFilter) GetOciRepoName() *OciRepoNameFilter { return v.OciRepoName }
+// GetNext returns ListAccessTokensAccessTokensAccessTokensPageCursorPaginationCursor.Next, and is useful for accessing the field via an interface.
+func (v *ListAccessTokensAccessTokensAccessTokensPageCursorPaginationCursor) GetNext() string {
+	return v.Next
+}
 
-// GetBundleId returns InstancesFilter.BundleId, and is useful for accessing the field via an interface.
-func (v *InstancesFilter) GetBundleId() *BundleIdFilter { return v.BundleId }
+// GetPrevious returns ListAccessTokensAccessTokensAccessTokensPageCursorPaginationCursor.Previous, and is useful for accessing the field via an interface.
+func (v *ListAccessTokensAccessTokensAccessTokensPageCursorPaginationCursor) GetPrevious() string {
+	return v.Previous
+}
 
-// GetParamDimension returns InstancesFilter.ParamDimension, and is useful for accessing the field via an interface.
-func (v *InstancesFilter) GetParamDimension() []ParamDimensionFilter { return v.ParamDimension }
+// ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken includes the requested fields of the GraphQL type AccessToken.
+// The GraphQL type's documentation follows.
+//
+// An access token's metadata, without the raw token value.
+//
+// Use this type to list, inspect, and manage existing tokens. The full token value is only
+// available at creation time — if you need to identify a specific token, use its `prefix`
+// (e.g., `md_a1b2c3d4`).
+//
+// **Token states:**
+// - **Active** — `revokedAt` is `null` and `expiresAt` is in the future.
+// - **Expired** — `expiresAt` is in the past. The token no longer works.
+// - **Revoked** — `revokedAt` is set. The token was manually disabled and no longer works.
+type ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken struct {
+	// Unique identifier for this access token.
+	Id string `json:"id"`
+	// Human-readable label for identifying this token.
+	Name string `json:"name"`
+	// A short, non-secret prefix (e.g., `md_a1b2c3d4`) used to identify this token in lists and logs without exposing the full value.
+	Prefix string `json:"prefix"`
+	// Permission scopes that limit what this token can do.
+	Scopes []string `json:"scopes"`
+	// When this token expires and stops working (UTC).
+	ExpiresAt time.Time `json:"expiresAt"`
+	// When this token was manually revoked, or `null` if still active.
+	RevokedAt time.Time `json:"revokedAt"`
+	// When this token was last used to authenticate an API request, or `null` if never used.
+	LastUsedAt time.Time `json:"lastUsedAt"`
+	// When this token was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+}
 
-// GetAttributes returns InstancesFilter.Attributes, and is useful for accessing the field via an interface.
-func (v *InstancesFilter) GetAttributes() []AttributeFilter { return v.Attributes }
+// GetId returns ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken.Id, and is useful for accessing the field via an interface.
+func (v *ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken) GetId() string { return v.Id }
 
-// Sorting options for the instances list.
-type InstancesSort struct {
-	// The field to sort by.
-	Field InstancesSortField `json:"field"`
-	// Ascending or descending.
-	Order SortOrder `json:"order"`
+// GetName returns ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken.Name, and is useful for accessing the field via an interface.
+func (v *ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken) GetName() string {
+	return v.Name
 }
 
-// GetField returns InstancesSort.Field, and is useful for accessing the field via an interface.
-func (v *InstancesSort) GetField() InstancesSortField { return v.Field }
+// GetPrefix returns ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken.Prefix, and is useful for accessing the field via an interface.
+func (v *ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken) GetPrefix() string {
+	return v.Prefix
+}
 
-// GetOrder returns InstancesSort.Order, and is useful for accessing the field via an interface.
-func (v *InstancesSort) GetOrder() SortOrder { return v.Order }
+// GetScopes returns ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken.Scopes, and is useful for accessing the field via an interface.
+func (v *ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken) GetScopes() []string {
+	return v.Scopes
+}
 
-// Available fields for sorting instances.
-type InstancesSortField string
+// GetExpiresAt returns ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken) GetExpiresAt() time.Time {
+	return v.ExpiresAt
+}
 
-const (
-	// Alphabetical by instance name (A-Z or Z-A).
-	InstancesSortFieldName InstancesSortField = "NAME"
-	// Chronological by creation time (oldest or newest first).
-	InstancesSortFieldCreatedAt InstancesSortField = "CREATED_AT"
-)
+// GetRevokedAt returns ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken.RevokedAt, and is useful for accessing the field via an interface.
+func (v *ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken) GetRevokedAt() time.Time {
+	return v.RevokedAt
+}
 
-var AllInstancesSortField = []InstancesSortField{
-	InstancesSortFieldName,
-	InstancesSortFieldCreatedAt,
+// GetLastUsedAt returns ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken.LastUsedAt, and is useful for accessing the field via an interface.
+func (v *ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken) GetLastUsedAt() time.Time {
+	return v.LastUsedAt
 }
 
-// Create a link between two components in a project's blueprint. Links connect an output field on the source component to an input field on the destination component, establishing data flow between infrastructure resources.
-type LinkComponentsInput struct {
-	// ID of the component that produces the resource (e.g., 'myproj-database').
-	FromComponentId string `json:"fromComponentId"`
-	// Output field name on the source component
-	FromField string `json:"fromField"`
-	// Version constraint for the source component (e.g., '~1.0', '1.2.3', 'latest')
-	FromVersion string `json:"fromVersion"`
-	// ID of the component that consumes the resource (e.g., 'myproj-app').
-	ToComponentId string `json:"toComponentId"`
-	// Input field name on the destination component
-	ToField string `json:"toField"`
-	// Version constraint for the destination component (e.g., '~1.0', '1.2.3', 'latest')
-	ToVersion string `json:"toVersion"`
+// GetCreatedAt returns ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken) GetCreatedAt() time.Time {
+	return v.CreatedAt
 }
 
-// GetFromComponentId returns LinkComponentsInput.FromComponentId, and is useful for accessing the field via an interface.
-func (v *LinkComponentsInput) GetFromComponentId() string { return v.FromComponentId }
+// ListAccessTokensResponse is returned by ListAccessTokens on success.
+type ListAccessTokensResponse struct {
+	// List access tokens you own in an organization.
+	//
+	// Returns only tokens owned by the authenticated caller. Accounts see their own personal
+	// tokens; service accounts see tokens issued to themselves. There is no admin view of
+	// another user's personal tokens.
+	//
+	// **Example:**
+	//
+	// ```graphql
+	// query {
+	// accessTokens(
+	// organizationId: "my-org"
+	// filter: { revoked: false }
+	// sort: { field: EXPIRES_AT, order: ASC }
+	// ) {
+	// items { id name prefix expiresAt }
+	// cursor { next }
+	// }
+	// }
+	// ```
+	AccessTokens ListAccessTokensAccessTokensAccessTokensPage `json:"accessTokens"`
+}
 
-// GetFromField returns LinkComponentsInput.FromField, and is useful for accessing the field via an interface.
-func (v *LinkComponentsInput) GetFromField() string { return v.FromField }
+// GetAccessTokens returns ListAccessTokensResponse.AccessTokens, and is useful for accessing the field via an interface.
+func (v *ListAccessTokensResponse) GetAccessTokens() ListAccessTokensAccessTokensAccessTokensPage {
+	return v.AccessTokens
+}
 
-// GetFromVersion returns LinkComponentsInput.FromVersion, and is useful for accessing the field via an interface.
-func (v *LinkComponentsInput) GetFromVersion() string { return v.FromVersion }
+// ListAuditLogEventTypesResponse is returned by ListAuditLogEventTypes on success.
+type ListAuditLogEventTypesResponse struct {
+	// Return the complete catalog of audit log event types emitted by Massdriver.
+	//
+	// Event types are dot-notated strings (e.g., `project.created`, `deployment.completed`)
+	// that categorize audit log events. This query returns the full static list — not just
+	// the types that currently have events in your organization — so it's ideal for
+	// populating a filter dropdown or building a dashboard that groups events by category.
+	//
+	// The list is sorted alphabetically and does not require pagination.
+	AuditLogEventTypes []string `json:"auditLogEventTypes"`
+}
 
-// GetToComponentId returns LinkComponentsInput.ToComponentId, and is useful for accessing the field via an interface.
-func (v *LinkComponentsInput) GetToComponentId() string { return v.ToComponentId }
+// GetAuditLogEventTypes returns ListAuditLogEventTypesResponse.AuditLogEventTypes, and is useful for accessing the field via an interface.
+func (v *ListAuditLogEventTypesResponse) GetAuditLogEventTypes() []string {
+	return v.AuditLogEventTypes
+}
 
-// GetToField returns LinkComponentsInput.ToField, and is useful for accessing the field via an interface.
-func (v *LinkComponentsInput) GetToField() string { return v.ToField }
+// ListAuditLogsAuditLogsAuditLogsPage includes the requested fields of the GraphQL type AuditLogsPage.
+type ListAuditLogsAuditLogsAuditLogsPage struct {
+	// Pagination cursors for navigating between pages.
+	Cursor ListAuditLogsAuditLogsAuditLogsPageCursorPaginationCursor `json:"cursor"`
+	// A list of type audit_log.
+	Items []ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog `json:"items"`
+}
 
-// GetToVersion returns LinkComponentsInput.ToVersion, and is useful for accessing the field via an interface.
-func (v *LinkComponentsInput) GetToVersion() string { return v.ToVersion }
+// GetCursor returns ListAuditLogsAuditLogsAuditLogsPage.Cursor, and is useful for accessing the field via an interface.
+func (v *ListAuditLogsAuditLogsAuditLogsPage) GetCursor() ListAuditLogsAuditLogsAuditLogsPageCursorPaginationCursor {
+	return v.Cursor
+}
 
-// LinkComponentsLinkComponentsLinkPayload includes the requested fields of the GraphQL type LinkPayload.
-type LinkComponentsLinkComponentsLinkPayload struct {
-	// The object created/updated/deleted by the mutation. May be null if mutation failed.
-	Result LinkComponentsLinkComponentsLinkPayloadResultLink `json:"result"`
-	// Indicates if the mutation completed successfully or not.
-	Successful bool `json:"successful"`
-	// A list of failed validations. May be blank or null if mutation succeeded.
-	Messages []LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage `json:"messages"`
+// GetItems returns ListAuditLogsAuditLogsAuditLogsPage.Items, and is useful for accessing the field via an interface.
+func (v *ListAuditLogsAuditLogsAuditLogsPage) GetItems() []ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog {
+	return v.Items
 }
 
-// GetResult returns LinkComponentsLinkComponentsLinkPayload.Result, and is useful for accessing the field via an interface.
-func (v *LinkComponentsLinkComponentsLinkPayload) GetResult() LinkComponentsLinkComponentsLinkPayloadResultLink {
-	return v.Result
+// ListAuditLogsAuditLogsAuditLogsPageCursorPaginationCursor includes the requested fields of the GraphQL type PaginationCursor.
+// The GraphQL type's documentation follows.
+//
+// Pagination cursors returned with every paginated response.
+//
+// Contains opaque cursor strings for navigating forward and backward through results.
+// A `null` value for `next` indicates you have reached the last page; a `null` value
+// for `previous` indicates you are on the first page.
+type ListAuditLogsAuditLogsAuditLogsPageCursorPaginationCursor struct {
+	// Cursor for the next page. `null` if there are no more results.
+	Next string `json:"next"`
+	// Cursor for the previous page. `null` if this is the first page.
+	Previous string `json:"previous"`
 }
 
-// GetSuccessful returns LinkComponentsLinkComponentsLinkPayload.Successful, and is useful for accessing the field via an interface.
-func (v *LinkComponentsLinkComponentsLinkPayload) GetSuccessful() bool { return v.Successful }
+// GetNext returns ListAuditLogsAuditLogsAuditLogsPageCursorPaginationCursor.Next, and is useful for accessing the field via an interface.
+func (v *ListAuditLogsAuditLogsAuditLogsPageCursorPaginationCursor) GetNext() string { return v.Next }
 
-// GetMessages returns LinkComponentsLinkComponentsLinkPayload.Messages, and is useful for accessing the field via an interface.
-func (v *LinkComponentsLinkComponentsLinkPayload) GetMessages() []LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage {
-	return v.Messages
+// GetPrevious returns ListAuditLogsAuditLogsAuditLogsPageCursorPaginationCursor.Previous, and is useful for accessing the field via an interface.
+func (v *ListAuditLogsAuditLogsAuditLogsPageCursorPaginationCursor) GetPrevious() string {
+	return v.Previous
 }
 
-// LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog includes the requested fields of the GraphQL type AuditLog.
 // The GraphQL type's documentation follows.
 //
-// Validation messages are returned when mutation input does not meet the requirements.
-// While client-side validation is highly recommended to provide the best User Experience,
-// All inputs will always be validated server-side.
+// An audit log event recording a significant action in your organization.
 //
-// Some examples of validations are:
+// Every state-changing operation in Massdriver is captured as an audit log event, following
+// the [CloudEvents](https://cloudevents.io/) specification. Use audit logs to track who
+// made changes, investigate incidents, and satisfy compliance requirements.
 //
-// * Username must be at least 10 characters
-// * Email field does not contain an email address
-// * Birth Date is required
+// **Event types** use dot notation to categorize actions (e.g., `project.created`,
+// `deployment.completed`, `group.member_added`).
 //
-// While GraphQL has support for required values, mutation data fields are always
-// set to optional in our API. This allows 'required field' messages
-// to be returned in the same manner as other validations. The only exceptions
-// are id fields, which may be required to perform updates or deletes.
-type LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage struct {
-	// A unique error code for the type of validation used.
-	Code string `json:"code"`
-	// The input field that the error applies to. The field can be used to
-	// identify which field the error message should be displayed next to in the
-	// presentation layer.
-	//
-	// If there are multiple errors to display for a field, multiple validation
-	// messages will be in the result.
-	//
-	// This field may be null in cases where an error cannot be applied to a specific field.
-	Field string `json:"field"`
-	// A friendly error message, appropriate for display to the end user.
-	//
-	// The message is interpolated to include the appropriate variables.
-	//
-	// Example: `Username must be at least 10 characters`
-	//
-	// This message may change without notice, so we do not recommend you match against the text.
-	// Instead, use the *code* field for matching.
-	Message string `json:"message"`
+// **Subjects** use MRI (Massdriver Resource Identifier) format to identify the affected
+// resource (e.g., `mri://organization/my-org/project/backend`).
+type ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog struct {
+	// Unique identifier for this audit log event.
+	Id string `json:"id"`
+	// When the event occurred (UTC). This is the authoritative timestamp for the action.
+	OccurredAt time.Time `json:"occurredAt"`
+	// Event type in dot notation (e.g., `project.created`, `deployment.completed`).
+	Type string `json:"type"`
+	// The system component or service that generated this event.
+	Source string `json:"source"`
+	// The affected resource in MRI format (e.g., `mri://organization/my-org/project/backend`). May be `null` for organization-wide events.
+	Subject string `json:"subject"`
+	// Event payload containing context-specific details about the action. The structure varies by event type.
+	Data map[string]any `json:"-"`
+	// The actor (user, service account, deployment, or system) that performed this action.
+	Actor ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor `json:"actor"`
 }
 
-// GetCode returns LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
-func (v *LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage) GetCode() string {
-	return v.Code
+// GetId returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog.Id, and is useful for accessing the field via an interface.
+func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) GetId() string { return v.Id }
+
+// GetOccurredAt returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog.OccurredAt, and is useful for accessing the field via an interface.
+func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) GetOccurredAt() time.Time {
+	return v.OccurredAt
 }
 
-// GetField returns LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
-func (v *LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage) GetField() string {
-	return v.Field
+// GetType returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog.Type, and is useful for accessing the field via an interface.
+func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) GetType() string { return v.Type }
+
+// GetSource returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog.Source, and is useful for accessing the field via an interface.
+func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) GetSource() string { return v.Source }
+
+// GetSubject returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog.Subject, and is useful for accessing the field via an interface.
+func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) GetSubject() string { return v.Subject }
+
+// GetData returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog.Data, and is useful for accessing the field via an interface.
+func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) GetData() map[string]any { return v.Data }
+
+// GetActor returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog.Actor, and is useful for accessing the field via an interface.
+func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) GetActor() ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor {
+	return v.Actor
 }
 
-// GetMessage returns LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
-func (v *LinkComponentsLinkComponentsLinkPayloadMessagesValidationMessage) GetMessage() string {
-	return v.Message
+func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog
+		Data json.RawMessage `json:"data"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Data
+		src := firstPass.Data
+		if len(src) != 0 && string(src) != "null" {
+			err = scalars.UnmarshalJSON(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog.Data: %w", err)
+			}
+		}
+	}
+	return nil
 }
 
-// LinkComponentsLinkComponentsLinkPayloadResultLink includes the requested fields of the GraphQL type Link.
-// The GraphQL type's documentation follows.
-//
-// A design-time dependency between two components in a blueprint.
-//
-// A link declares that one component's output should be wired into another
-// component's input. For example, a link from a database component's
-// `authentication` output to an application component's `database` input
-// ensures the app receives the database connection string.
-//
-// At deploy time, each link is realized as a **connection** in the environment,
-// wiring the actual instance outputs to instance inputs.
-type LinkComponentsLinkComponentsLinkPayloadResultLink struct {
-	// Unique identifier for this link.
+type __premarshalListAuditLogsAuditLogsAuditLogsPageItemsAuditLog struct {
 	Id string `json:"id"`
-	// The output field name on the source component (e.g., `authentication`).
-	FromField string `json:"fromField"`
-	// The input field name on the destination component (e.g., `database`).
-	ToField string `json:"toField"`
-	// When this link was created (UTC).
-	CreatedAt time.Time `json:"createdAt"`
-	// When this link was last modified (UTC).
-	UpdatedAt time.Time `json:"updatedAt"`
-	// The source component that produces the output.
-	FromComponent LinkComponentsLinkComponentsLinkPayloadResultLinkFromComponent `json:"fromComponent"`
-	// The destination component that consumes the input.
-	ToComponent LinkComponentsLinkComponentsLinkPayloadResultLinkToComponent `json:"toComponent"`
-}
 
-// GetId returns LinkComponentsLinkComponentsLinkPayloadResultLink.Id, and is useful for accessing the field via an interface.
-func (v *LinkComponentsLinkComponentsLinkPayloadResultLink) GetId() string { return v.Id }
+	OccurredAt time.Time `json:"occurredAt"`
 
-// GetFromField returns LinkComponentsLinkComponentsLinkPayloadResultLink.FromField, and is useful for accessing the field via an interface.
-func (v *LinkComponentsLinkComponentsLinkPayloadResultLink) GetFromField() string { return v.FromField }
+	Type string `json:"type"`
 
-// GetToField returns LinkComponentsLinkComponentsLinkPayloadResultLink.ToField, and is useful for accessing the field via an interface.
-func (v *LinkComponentsLinkComponentsLinkPayloadResultLink) GetToField() string { return v.ToField }
+	Source string `json:"source"`
 
-// GetCreatedAt returns LinkComponentsLinkComponentsLinkPayloadResultLink.CreatedAt, and is useful for accessing the field via an interface.
-func (v *LinkComponentsLinkComponentsLinkPayloadResultLink) GetCreatedAt() time.Time {
-	return v.CreatedAt
-}
+	Subject string `json:"subject"`
 
-// GetUpdatedAt returns LinkComponentsLinkComponentsLinkPayloadResultLink.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *LinkComponentsLinkComponentsLinkPayloadResultLink) GetUpdatedAt() time.Time {
-	return v.UpdatedAt
-}
+	Data json.RawMessage `json:"data"`
 
-// GetFromComponent returns LinkComponentsLinkComponentsLinkPayloadResultLink.FromComponent, and is useful for accessing the field via an interface.
-func (v *LinkComponentsLinkComponentsLinkPayloadResultLink) GetFromComponent() LinkComponentsLinkComponentsLinkPayloadResultLinkFromComponent {
-	return v.FromComponent
+	Actor ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor `json:"actor"`
 }
 
-// GetToComponent returns LinkComponentsLinkComponentsLinkPayloadResultLink.ToComponent, and is useful for accessing the field via an interface.
-func (v *LinkComponentsLinkComponentsLinkPayloadResultLink) GetToComponent() LinkComponentsLinkComponentsLinkPayloadResultLinkToComponent {
-	return v.ToComponent
+func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// LinkComponentsLinkComponentsLinkPayloadResultLinkFromComponent includes the requested fields of the GraphQL type Component.
-// The GraphQL type's documentation follows.
-//
-// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
-//
-// A component is the **design-time** building block of your architecture. It says
-// "I want a database here" or "I need a Kubernetes cluster there." The component
-// defines *what* to deploy; the actual running infrastructure lives in **instances**
-// -- one per environment the component is deployed to.
-//
-// Components are connected to each other via **links**, which declare that one
-// component's output (e.g., a connection string) should be wired into another
-// component's input.
-type LinkComponentsLinkComponentsLinkPayloadResultLinkFromComponent struct {
-	Id string `json:"id"`
-	// Human-readable display name shown in the UI.
-	Name string `json:"name"`
-}
+func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) __premarshalJSON() (*__premarshalListAuditLogsAuditLogsAuditLogsPageItemsAuditLog, error) {
+	var retval __premarshalListAuditLogsAuditLogsAuditLogsPageItemsAuditLog
 
-// GetId returns LinkComponentsLinkComponentsLinkPayloadResultLinkFromComponent.Id, and is useful for accessing the field via an interface.
-func (v *LinkComponentsLinkComponentsLinkPayloadResultLinkFromComponent) GetId() string { return v.Id }
+	retval.Id = v.Id
+	retval.OccurredAt = v.OccurredAt
+	retval.Type = v.Type
+	retval.Source = v.Source
+	retval.Subject = v.Subject
+	{
 
-// GetName returns LinkComponentsLinkComponentsLinkPayloadResultLinkFromComponent.Name, and is useful for accessing the field via an interface.
-func (v *LinkComponentsLinkComponentsLinkPayloadResultLinkFromComponent) GetName() string {
-	return v.Name
+		dst := &retval.Data
+		src := v.Data
+		var err error
+		*dst, err = scalars.MarshalJSON(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog.Data: %w", err)
+		}
+	}
+	retval.Actor = v.Actor
+	return &retval, nil
 }
 
-// LinkComponentsLinkComponentsLinkPayloadResultLinkToComponent includes the requested fields of the GraphQL type Component.
+// ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor includes the requested fields of the GraphQL type AuditLogActor.
 // The GraphQL type's documentation follows.
 //
-// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+// The actor that performed an audit-logged action.
 //
-// A component is the **design-time** building block of your architecture. It says
-// "I want a database here" or "I need a Kubernetes cluster there." The component
-// defines *what* to deploy; the actual running infrastructure lives in **instances**
-// -- one per environment the component is deployed to.
+// Identifies who or what triggered the event. The `type` field tells you the category
+// of actor, and `name` provides a human-readable label:
 //
-// Components are connected to each other via **links**, which declare that one
-// component's output (e.g., a connection string) should be wired into another
-// component's input.
-type LinkComponentsLinkComponentsLinkPayloadResultLinkToComponent struct {
+// - **`ACCOUNT`** — `name` is the user's email address.
+// - **`SERVICE_ACCOUNT`** — `name` is the service account's name.
+// - **`DEPLOYMENT`** — `name` is a reference like `deployment:<id>`.
+// - **`SYSTEM`** — `name` is `system` for internal actions.
+//
+// If the original actor has been deleted, the `name` will indicate this (e.g., "deleted account").
+type ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor struct {
+	// Unique identifier of the actor that performed the action.
 	Id string `json:"id"`
-	// Human-readable display name shown in the UI.
+	// What kind of entity performed the action (human, service account, deployment, or system).
+	Type AuditLogActorType `json:"type"`
+	// Human-readable label for the actor. Email address for users, name for service accounts, or a reference identifier for deployments and system actions.
 	Name string `json:"name"`
 }
 
-// GetId returns LinkComponentsLinkComponentsLinkPayloadResultLinkToComponent.Id, and is useful for accessing the field via an interface.
-func (v *LinkComponentsLinkComponentsLinkPayloadResultLinkToComponent) GetId() string { return v.Id }
+// GetId returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor.Id, and is useful for accessing the field via an interface.
+func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor) GetId() string { return v.Id }
 
-// GetName returns LinkComponentsLinkComponentsLinkPayloadResultLinkToComponent.Name, and is useful for accessing the field via an interface.
-func (v *LinkComponentsLinkComponentsLinkPayloadResultLinkToComponent) GetName() string {
-	return v.Name
+// GetType returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor.Type, and is useful for accessing the field via an interface.
+func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor) GetType() AuditLogActorType {
+	return v.Type
 }
 
-// LinkComponentsResponse is returned by LinkComponents on success.
-type LinkComponentsResponse struct {
-	// Create a link between two components.
+// GetName returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor.Name, and is useful for accessing the field via an interface.
+func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor) GetName() string { return v.Name }
+
+// ListAuditLogsResponse is returned by ListAuditLogs on success.
+type ListAuditLogsResponse struct {
+	// List audit log events for your organization.
 	//
-	// Declares that the `fromField` output of one component should be wired to the
-	// `toField` input of another. Massdriver validates that the resource types are
-	// compatible before creating the link. When environments are deployed, each link
-	// becomes a **connection** that carries the actual resource data between instances.
+	// Returns a paginated, filterable, sortable list of audit log events. By default, events are
+	// sorted newest first (`occurredAt` descending).
+	//
+	// **Filtering** — Use the `filter` argument to narrow results by time range, event type,
+	// actor type, or specific actor. All filters are combined with AND logic.
+	//
+	// **Example:**
 	//
 	// ```graphql
-	// mutation {
-	// linkComponents(
+	// query {
+	// auditLogs(
 	// organizationId: "my-org"
-	// input: {
-	// fromComponentId: "my-project-database"
-	// fromField: "authentication"
-	// fromVersion: "~1.0"
-	// toComponentId: "my-project-app"
-	// toField: "database"
-	// toVersion: "~2.0"
+	// filter: {
+	// occurredAt: { gte: "2025-01-01T00:00:00Z" }
+	// type: { eq: "project.created" }
+	// actorType: { in: [ACCOUNT, SERVICE_ACCOUNT] }
+	// actor: { search: "alice" }
 	// }
 	// ) {
-	// result { id fromField toField }
-	// successful
+	// items {
+	// id
+	// occurredAt
+	// type
+	// subject
+	// actor { type name }
+	// }
+	// cursor { next }
 	// }
 	// }
 	// ```
-	LinkComponents LinkComponentsLinkComponentsLinkPayload `json:"linkComponents"`
+	AuditLogs ListAuditLogsAuditLogsAuditLogsPage `json:"auditLogs"`
 }
 
-// GetLinkComponents returns LinkComponentsResponse.LinkComponents, and is useful for accessing the field via an interface.
-func (v *LinkComponentsResponse) GetLinkComponents() LinkComponentsLinkComponentsLinkPayload {
-	return v.LinkComponents
+// GetAuditLogs returns ListAuditLogsResponse.AuditLogs, and is useful for accessing the field via an interface.
+func (v *ListAuditLogsResponse) GetAuditLogs() ListAuditLogsAuditLogsAuditLogsPage {
+	return v.AuditLogs
 }
 
-// ListAccessTokensAccessTokensAccessTokensPage includes the requested fields of the GraphQL type AccessTokensPage.
-type ListAccessTokensAccessTokensAccessTokensPage struct {
+// ListBundlesBundlesBundlesPage includes the requested fields of the GraphQL type BundlesPage.
+type ListBundlesBundlesBundlesPage struct {
 	// Pagination cursors for navigating between pages.
-	Cursor ListAccessTokensAccessTokensAccessTokensPageCursorPaginationCursor `json:"cursor"`
-	// A list of type access_token.
-	Items []ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken `json:"items"`
+	Cursor ListBundlesBundlesBundlesPageCursorPaginationCursor `json:"cursor"`
+	// A list of type bundle.
+	Items []ListBundlesBundlesBundlesPageItemsBundle `json:"items"`
 }
 
-// GetCursor returns ListAccessTokensAccessTokensAccessTokensPage.Cursor, and is useful for accessing the field via an interface.
-func (v *ListAccessTokensAccessTokensAccessTokensPage) GetCursor() ListAccessTokensAccessTokensAccessTokensPageCursorPaginationCursor {
+// GetCursor returns ListBundlesBundlesBundlesPage.Cursor, and is useful for accessing the field via an interface.
+func (v *ListBundlesBundlesBundlesPage) GetCursor() ListBundlesBundlesBundlesPageCursorPaginationCursor {
 	return v.Cursor
 }
 
-// GetItems returns ListAccessTokensAccessTokensAccessTokensPage.Items, and is useful for accessing the field via an interface.
-func (v *ListAccessTokensAccessTokensAccessTokensPage) GetItems() []ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken {
+// GetItems returns ListBundlesBundlesBundlesPage.Items, and is useful for accessing the field via an interface.
+func (v *ListBundlesBundlesBundlesPage) GetItems() []ListBundlesBundlesBundlesPageItemsBundle {
 	return v.Items
 }
 
-// ListAccessTokensAccessTokensAccessTokensPageCursorPaginationCursor includes the requested fields of the GraphQL type PaginationCursor.
+// ListBundlesBundlesBundlesPageCursorPaginationCursor includes the requested fields of the GraphQL type PaginationCursor.
 // The GraphQL type's documentation follows.
 //
 // Pagination cursors returned with every paginated response.
@@ -15218,250 +17243,378 @@ func (v *ListAccessTokensAccessTokensAccessTokensPage) GetItems() []ListAccessTo
 // Contains opaque cursor strings for navigating forward and backward through results.
 // A `null` value for `next` indicates you have reached the last page; a `null` value
 // for `previous` indicates you are on the first page.
-type ListAccessTokensAccessTokensAccessTokensPageCursorPaginationCursor struct {
+type ListBundlesBundlesBundlesPageCursorPaginationCursor struct {
 	// Cursor for the next page. `null` if there are no more results.
 	Next string `json:"next"`
 	// Cursor for the previous page. `null` if this is the first page.
 	Previous string `json:"previous"`
 }
 
-// GetNext returns ListAccessTokensAccessTokensAccessTokensPageCursorPaginationCursor.Next, and is useful for accessing the field via an interface.
-func (v *ListAccessTokensAccessTokensAccessTokensPageCursorPaginationCursor) GetNext() string {
-	return v.Next
+// GetNext returns ListBundlesBundlesBundlesPageCursorPaginationCursor.Next, and is useful for accessing the field via an interface.
+func (v *ListBundlesBundlesBundlesPageCursorPaginationCursor) GetNext() string { return v.Next }
+
+// GetPrevious returns ListBundlesBundlesBundlesPageCursorPaginationCursor.Previous, and is useful for accessing the field via an interface.
+func (v *ListBundlesBundlesBundlesPageCursorPaginationCursor) GetPrevious() string { return v.Previous }
+
+// ListBundlesBundlesBundlesPageItemsBundle includes the requested fields of the GraphQL type Bundle.
+// The GraphQL type's documentation follows.
+//
+// A versioned infrastructure-as-code package.
+//
+// A bundle is a single published version of an IaC package in your organization's
+// catalog. Each bundle belongs to an OCI repository and is identified by a composite
+// `name@version` string (e.g., `aws-aurora-postgres@1.2.3`).
+//
+// Bundles declare **dependencies** (inputs they require from other bundles) and
+// **resources** (outputs they produce). These declarations drive the connection
+// system on the Massdriver canvas -- when you add a component to a blueprint,
+// the platform knows which other components can satisfy its dependencies.
+//
+// ```mermaid
+// graph TD
+// R["OCI Repository: aws-aurora-postgres"] --> T1["Tag: 1.0.0"]
+// R --> T2["Tag: 1.1.0"]
+// R --> T3["Tag: 1.2.3"]
+// R --> RC1["Channel: ~1 → 1.2.3"]
+// R --> RC2["Channel: latest → 1.2.3"]
+// T3 --> B["Bundle: aws-aurora-postgres@1.2.3"]
+// B --> D1["Dependency: aws-iam-role"]
+// B --> D2["Dependency: aws-vpc"]
+// B --> RES["Resource: aurora-cluster"]
+// ```
+type ListBundlesBundlesBundlesPageItemsBundle struct {
+	// Composite identifier in `name@version` format (e.g., `aws-aurora-postgres@1.2.3`). Always contains the fully resolved semver version.
+	Id string `json:"id"`
+	// OCI repository name this bundle belongs to (e.g., `aws-aurora-postgres`).
+	Name string `json:"name"`
+	// Fully resolved semantic version of this bundle (e.g., `1.2.3`).
+	Version string `json:"version"`
+	// Short summary of what this bundle provisions.
+	Description string `json:"description"`
+	// URL to the bundle's display icon.
+	Icon string `json:"icon"`
+	// URL to the bundle's source code repository, if published by the author.
+	SourceUrl string `json:"sourceUrl"`
+	// OCI repository name for this bundle (e.g., `aws-aurora-postgres`). Equivalent to `name`.
+	Repo string `json:"repo"`
+	// Timestamp when this bundle version was first published (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+	// Timestamp when this bundle version was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-// GetPrevious returns ListAccessTokensAccessTokensAccessTokensPageCursorPaginationCursor.Previous, and is useful for accessing the field via an interface.
-func (v *ListAccessTokensAccessTokensAccessTokensPageCursorPaginationCursor) GetPrevious() string {
-	return v.Previous
+// GetId returns ListBundlesBundlesBundlesPageItemsBundle.Id, and is useful for accessing the field via an interface.
+func (v *ListBundlesBundlesBundlesPageItemsBundle) GetId() string { return v.Id }
+
+// GetName returns ListBundlesBundlesBundlesPageItemsBundle.Name, and is useful for accessing the field via an interface.
+func (v *ListBundlesBundlesBundlesPageItemsBundle) GetName() string { return v.Name }
+
+// GetVersion returns ListBundlesBundlesBundlesPageItemsBundle.Version, and is useful for accessing the field via an interface.
+func (v *ListBundlesBundlesBundlesPageItemsBundle) GetVersion() string { return v.Version }
+
+// GetDescription returns ListBundlesBundlesBundlesPageItemsBundle.Description, and is useful for accessing the field via an interface.
+func (v *ListBundlesBundlesBundlesPageItemsBundle) GetDescription() string { return v.Description }
+
+// GetIcon returns ListBundlesBundlesBundlesPageItemsBundle.Icon, and is useful for accessing the field via an interface.
+func (v *ListBundlesBundlesBundlesPageItemsBundle) GetIcon() string { return v.Icon }
+
+// GetSourceUrl returns ListBundlesBundlesBundlesPageItemsBundle.SourceUrl, and is useful for accessing the field via an interface.
+func (v *ListBundlesBundlesBundlesPageItemsBundle) GetSourceUrl() string { return v.SourceUrl }
+
+// GetRepo returns ListBundlesBundlesBundlesPageItemsBundle.Repo, and is useful for accessing the field via an interface.
+func (v *ListBundlesBundlesBundlesPageItemsBundle) GetRepo() string { return v.Repo }
+
+// GetCreatedAt returns ListBundlesBundlesBundlesPageItemsBundle.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ListBundlesBundlesBundlesPageItemsBundle) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetUpdatedAt returns ListBundlesBundlesBundlesPageItemsBundle.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ListBundlesBundlesBundlesPageItemsBundle) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// ListBundlesResponse is returned by ListBundles on success.
+type ListBundlesResponse struct {
+	// List published bundle versions in your organization's catalog.
+	//
+	// Returns a paginated list of all bundle releases across all OCI repositories.
+	// Each item is a specific version (e.g., `aws-aurora-postgres@1.2.3`), not just
+	// the repository.
+	//
+	// Use `filter` to narrow results by repository name, resource type produced,
+	// dependency type consumed, or full-text search. When `search` is active, results
+	// are ranked by relevance unless an explicit `sort` is provided.
+	//
+	// ```graphql
+	// query {
+	// bundles(organizationId: "your-org-id", filter: { resourceType: { eq: "aws-iam-role" } }) {
+	// items {
+	// id
+	// name
+	// version
+	// description
+	// dependencies { name resourceType { id } }
+	// resources { name resourceType { id } }
+	// }
+	// cursor { next }
+	// }
+	// }
+	// ```
+	Bundles ListBundlesBundlesBundlesPage `json:"bundles"`
 }
 
-// ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken includes the requested fields of the GraphQL type AccessToken.
+// GetBundles returns ListBundlesResponse.Bundles, and is useful for accessing the field via an interface.
+func (v *ListBundlesResponse) GetBundles() ListBundlesBundlesBundlesPage { return v.Bundles }
+
+// ListComponentLinksProject includes the requested fields of the GraphQL type Project.
 // The GraphQL type's documentation follows.
 //
-// An access token's metadata, without the raw token value.
+// A project organizes related infrastructure under a single blueprint.
 //
-// Use this type to list, inspect, and manage existing tokens. The full token value is only
-// available at creation time — if you need to identify a specific token, use its `prefix`
-// (e.g., `md_a1b2c3d4`).
+// Each project contains a **Blueprint** that defines your infrastructure architecture -- which
+// bundles to use and how they connect -- and one or more **Environments** (like staging or
+// production) where that architecture is actually deployed.
 //
-// **Token states:**
-// - **Active** — `revokedAt` is `null` and `expiresAt` is in the future.
-// - **Expired** — `expiresAt` is in the past. The token no longer works.
-// - **Revoked** — `revokedAt` is set. The token was manually disabled and no longer works.
-type ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken struct {
-	// Unique identifier for this access token.
+// ```mermaid
+// graph LR
+// P["Project"] --> B["Blueprint"]
+// P --> E1["Environment: staging"]
+// P --> E2["Environment: production"]
+// B --> C1["Component: database"]
+// B --> C2["Component: cache"]
+// C1 -.->|"Link"| C2
+// ```
+//
+// Attributes set on a project are inherited by all environments and instances within it.
+type ListComponentLinksProject struct {
 	Id string `json:"id"`
-	// Human-readable label for identifying this token.
-	Name string `json:"name"`
-	// A short, non-secret prefix (e.g., `md_a1b2c3d4`) used to identify this token in lists and logs without exposing the full value.
-	Prefix string `json:"prefix"`
-	// Permission scopes that limit what this token can do.
-	Scopes []string `json:"scopes"`
-	// When this token expires and stops working (UTC).
-	ExpiresAt time.Time `json:"expiresAt"`
-	// When this token was manually revoked, or `null` if still active.
-	RevokedAt time.Time `json:"revokedAt"`
-	// When this token was last used to authenticate an API request, or `null` if never used.
-	LastUsedAt time.Time `json:"lastUsedAt"`
-	// When this token was created (UTC).
+	// Links between components that wire one component's output to another's input.
+	Links []ListComponentLinksProjectLinksLink `json:"links"`
+}
+
+// GetId returns ListComponentLinksProject.Id, and is useful for accessing the field via an interface.
+func (v *ListComponentLinksProject) GetId() string { return v.Id }
+
+// GetLinks returns ListComponentLinksProject.Links, and is useful for accessing the field via an interface.
+func (v *ListComponentLinksProject) GetLinks() []ListComponentLinksProjectLinksLink { return v.Links }
+
+// ListComponentLinksProjectLinksLink includes the requested fields of the GraphQL type Link.
+// The GraphQL type's documentation follows.
+//
+// A design-time dependency between two components in a blueprint.
+//
+// A link declares that one component's output should be wired into another
+// component's input. For example, a link from a database component's
+// `authentication` output to an application component's `database` input
+// ensures the app receives the database connection string.
+//
+// At deploy time, each link is realized as a **connection** in the environment,
+// wiring the actual instance outputs to instance inputs.
+type ListComponentLinksProjectLinksLink struct {
+	// Unique identifier for this link.
+	Id string `json:"id"`
+	// The output field name on the source component (e.g., `authentication`).
+	FromField string `json:"fromField"`
+	// The input field name on the destination component (e.g., `database`).
+	ToField string `json:"toField"`
+	// When this link was created (UTC).
 	CreatedAt time.Time `json:"createdAt"`
+	// When this link was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
+	// The source component that produces the output.
+	FromComponent ListComponentLinksProjectLinksLinkFromComponent `json:"fromComponent"`
+	// The destination component that consumes the input.
+	ToComponent ListComponentLinksProjectLinksLinkToComponent `json:"toComponent"`
 }
 
-// GetId returns ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken.Id, and is useful for accessing the field via an interface.
-func (v *ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken) GetId() string { return v.Id }
+// GetId returns ListComponentLinksProjectLinksLink.Id, and is useful for accessing the field via an interface.
+func (v *ListComponentLinksProjectLinksLink) GetId() string { return v.Id }
 
-// GetName returns ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken.Name, and is useful for accessing the field via an interface.
-func (v *ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken) GetName() string {
-	return v.Name
-}
+// GetFromField returns ListComponentLinksProjectLinksLink.FromField, and is useful for accessing the field via an interface.
+func (v *ListComponentLinksProjectLinksLink) GetFromField() string { return v.FromField }
 
-// GetPrefix returns ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken.Prefix, and is useful for accessing the field via an interface.
-func (v *ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken) GetPrefix() string {
-	return v.Prefix
-}
+// GetToField returns ListComponentLinksProjectLinksLink.ToField, and is useful for accessing the field via an interface.
+func (v *ListComponentLinksProjectLinksLink) GetToField() string { return v.ToField }
 
-// GetScopes returns ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken.Scopes, and is useful for accessing the field via an interface.
-func (v *ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken) GetScopes() []string {
-	return v.Scopes
-}
+// GetCreatedAt returns ListComponentLinksProjectLinksLink.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ListComponentLinksProjectLinksLink) GetCreatedAt() time.Time { return v.CreatedAt }
 
-// GetExpiresAt returns ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken.ExpiresAt, and is useful for accessing the field via an interface.
-func (v *ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken) GetExpiresAt() time.Time {
-	return v.ExpiresAt
-}
+// GetUpdatedAt returns ListComponentLinksProjectLinksLink.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ListComponentLinksProjectLinksLink) GetUpdatedAt() time.Time { return v.UpdatedAt }
 
-// GetRevokedAt returns ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken.RevokedAt, and is useful for accessing the field via an interface.
-func (v *ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken) GetRevokedAt() time.Time {
-	return v.RevokedAt
+// GetFromComponent returns ListComponentLinksProjectLinksLink.FromComponent, and is useful for accessing the field via an interface.
+func (v *ListComponentLinksProjectLinksLink) GetFromComponent() ListComponentLinksProjectLinksLinkFromComponent {
+	return v.FromComponent
 }
 
-// GetLastUsedAt returns ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken.LastUsedAt, and is useful for accessing the field via an interface.
-func (v *ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken) GetLastUsedAt() time.Time {
-	return v.LastUsedAt
+// GetToComponent returns ListComponentLinksProjectLinksLink.ToComponent, and is useful for accessing the field via an interface.
+func (v *ListComponentLinksProjectLinksLink) GetToComponent() ListComponentLinksProjectLinksLinkToComponent {
+	return v.ToComponent
 }
 
-// GetCreatedAt returns ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken.CreatedAt, and is useful for accessing the field via an interface.
-func (v *ListAccessTokensAccessTokensAccessTokensPageItemsAccessToken) GetCreatedAt() time.Time {
-	return v.CreatedAt
+// ListComponentLinksProjectLinksLinkFromComponent includes the requested fields of the GraphQL type Component.
+// The GraphQL type's documentation follows.
+//
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+//
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
+//
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type ListComponentLinksProjectLinksLinkFromComponent struct {
+	Id string `json:"id"`
+	// Human-readable display name shown in the UI.
+	Name string `json:"name"`
 }
 
-// ListAccessTokensResponse is returned by ListAccessTokens on success.
-type ListAccessTokensResponse struct {
-	// List access tokens you own in an organization.
-	//
-	// Returns only tokens owned by the authenticated caller. Accounts see their own personal
-	// tokens; service accounts see tokens issued to themselves. There is no admin view of
-	// another user's personal tokens.
-	//
-	// **Example:**
-	//
-	// ```graphql
-	// query {
-	// accessTokens(
-	// organizationId: "my-org"
-	// filter: { revoked: false }
-	// sort: { field: EXPIRES_AT, order: ASC }
-	// ) {
-	// items { id name prefix expiresAt }
-	// cursor { next }
-	// }
-	// }
-	// ```
-	AccessTokens ListAccessTokensAccessTokensAccessTokensPage `json:"accessTokens"`
-}
+// GetId returns ListComponentLinksProjectLinksLinkFromComponent.Id, and is useful for accessing the field via an interface.
+func (v *ListComponentLinksProjectLinksLinkFromComponent) GetId() string { return v.Id }
 
-// GetAccessTokens returns ListAccessTokensResponse.AccessTokens, and is useful for accessing the field via an interface.
-func (v *ListAccessTokensResponse) GetAccessTokens() ListAccessTokensAccessTokensAccessTokensPage {
-	return v.AccessTokens
-}
+// GetName returns ListComponentLinksProjectLinksLinkFromComponent.Name, and is useful for accessing the field via an interface.
+func (v *ListComponentLinksProjectLinksLinkFromComponent) GetName() string { return v.Name }
 
-// ListAuditLogEventTypesResponse is returned by ListAuditLogEventTypes on success.
-type ListAuditLogEventTypesResponse struct {
-	// Return the complete catalog of audit log event types emitted by Massdriver.
-	//
-	// Event types are dot-notated strings (e.g., `project.created`, `deployment.completed`)
-	// that categorize audit log events. This query returns the full static list — not just
-	// the types that currently have events in your organization — so it's ideal for
-	// populating a filter dropdown or building a dashboard that groups events by category.
-	//
-	// The list is sorted alphabetically and does not require pagination.
-	AuditLogEventTypes []string `json:"auditLogEventTypes"`
+// ListComponentLinksProjectLinksLinkToComponent includes the requested fields of the GraphQL type Component.
+// The GraphQL type's documentation follows.
+//
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+//
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
+//
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type ListComponentLinksProjectLinksLinkToComponent struct {
+	Id string `json:"id"`
+	// Human-readable display name shown in the UI.
+	Name string `json:"name"`
 }
 
-// GetAuditLogEventTypes returns ListAuditLogEventTypesResponse.AuditLogEventTypes, and is useful for accessing the field via an interface.
-func (v *ListAuditLogEventTypesResponse) GetAuditLogEventTypes() []string {
-	return v.AuditLogEventTypes
-}
+// GetId returns ListComponentLinksProjectLinksLinkToComponent.Id, and is useful for accessing the field via an interface.
+func (v *ListComponentLinksProjectLinksLinkToComponent) GetId() string { return v.Id }
 
-// ListAuditLogsAuditLogsAuditLogsPage includes the requested fields of the GraphQL type AuditLogsPage.
-type ListAuditLogsAuditLogsAuditLogsPage struct {
-	// Pagination cursors for navigating between pages.
-	Cursor ListAuditLogsAuditLogsAuditLogsPageCursorPaginationCursor `json:"cursor"`
-	// A list of type audit_log.
-	Items []ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog `json:"items"`
-}
+// GetName returns ListComponentLinksProjectLinksLinkToComponent.Name, and is useful for accessing the field via an interface.
+func (v *ListComponentLinksProjectLinksLinkToComponent) GetName() string { return v.Name }
 
-// GetCursor returns ListAuditLogsAuditLogsAuditLogsPage.Cursor, and is useful for accessing the field via an interface.
-func (v *ListAuditLogsAuditLogsAuditLogsPage) GetCursor() ListAuditLogsAuditLogsAuditLogsPageCursorPaginationCursor {
-	return v.Cursor
+// ListComponentLinksResponse is returned by ListComponentLinks on success.
+type ListComponentLinksResponse struct {
+	// Fetch a single project by its identifier.
+	Project ListComponentLinksProject `json:"project"`
 }
 
-// GetItems returns ListAuditLogsAuditLogsAuditLogsPage.Items, and is useful for accessing the field via an interface.
-func (v *ListAuditLogsAuditLogsAuditLogsPage) GetItems() []ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog {
-	return v.Items
-}
+// GetProject returns ListComponentLinksResponse.Project, and is useful for accessing the field via an interface.
+func (v *ListComponentLinksResponse) GetProject() ListComponentLinksProject { return v.Project }
 
-// ListAuditLogsAuditLogsAuditLogsPageCursorPaginationCursor includes the requested fields of the GraphQL type PaginationCursor.
+// ListComponentsProject includes the requested fields of the GraphQL type Project.
 // The GraphQL type's documentation follows.
 //
-// Pagination cursors returned with every paginated response.
+// A project organizes related infrastructure under a single blueprint.
 //
-// Contains opaque cursor strings for navigating forward and backward through results.
-// A `null` value for `next` indicates you have reached the last page; a `null` value
-// for `previous` indicates you are on the first page.
-type ListAuditLogsAuditLogsAuditLogsPageCursorPaginationCursor struct {
-	// Cursor for the next page. `null` if there are no more results.
-	Next string `json:"next"`
-	// Cursor for the previous page. `null` if this is the first page.
-	Previous string `json:"previous"`
+// Each project contains a **Blueprint** that defines your infrastructure architecture -- which
+// bundles to use and how they connect -- and one or more **Environments** (like staging or
+// production) where that architecture is actually deployed.
+//
+// ```mermaid
+// graph LR
+// P["Project"] --> B["Blueprint"]
+// P --> E1["Environment: staging"]
+// P --> E2["Environment: production"]
+// B --> C1["Component: database"]
+// B --> C2["Component: cache"]
+// C1 -.->|"Link"| C2
+// ```
+//
+// Attributes set on a project are inherited by all environments and instances within it.
+type ListComponentsProject struct {
+	Id string `json:"id"`
+	// Components that make up the project's infrastructure architecture.
+	Components []ListComponentsProjectComponentsComponent `json:"components"`
 }
 
-// GetNext returns ListAuditLogsAuditLogsAuditLogsPageCursorPaginationCursor.Next, and is useful for accessing the field via an interface.
-func (v *ListAuditLogsAuditLogsAuditLogsPageCursorPaginationCursor) GetNext() string { return v.Next }
+// GetId returns ListComponentsProject.Id, and is useful for accessing the field via an interface.
+func (v *ListComponentsProject) GetId() string { return v.Id }
 
-// GetPrevious returns ListAuditLogsAuditLogsAuditLogsPageCursorPaginationCursor.Previous, and is useful for accessing the field via an interface.
-func (v *ListAuditLogsAuditLogsAuditLogsPageCursorPaginationCursor) GetPrevious() string {
-	return v.Previous
+// GetComponents returns ListComponentsProject.Components, and is useful for accessing the field via an interface.
+func (v *ListComponentsProject) GetComponents() []ListComponentsProjectComponentsComponent {
+	return v.Components
 }
 
-// ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog includes the requested fields of the GraphQL type AuditLog.
+// ListComponentsProjectComponentsComponent includes the requested fields of the GraphQL type Component.
 // The GraphQL type's documentation follows.
 //
-// An audit log event recording a significant action in your organization.
-//
-// Every state-changing operation in Massdriver is captured as an audit log event, following
-// the [CloudEvents](https://cloudevents.io/) specification. Use audit logs to track who
-// made changes, investigate incidents, and satisfy compliance requirements.
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
 //
-// **Event types** use dot notation to categorize actions (e.g., `project.created`,
-// `deployment.completed`, `group.member_added`).
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
 //
-// **Subjects** use MRI (Massdriver Resource Identifier) format to identify the affected
-// resource (e.g., `mri://organization/my-org/project/backend`).
-type ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog struct {
-	// Unique identifier for this audit log event.
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type ListComponentsProjectComponentsComponent struct {
 	Id string `json:"id"`
-	// When the event occurred (UTC). This is the authoritative timestamp for the action.
-	OccurredAt time.Time `json:"occurredAt"`
-	// Event type in dot notation (e.g., `project.created`, `deployment.completed`).
-	Type string `json:"type"`
-	// The system component or service that generated this event.
-	Source string `json:"source"`
-	// The affected resource in MRI format (e.g., `mri://organization/my-org/project/backend`). May be `null` for organization-wide events.
-	Subject string `json:"subject"`
-	// Event payload containing context-specific details about the action. The structure varies by event type.
-	Data map[string]any `json:"-"`
-	// The actor (user, service account, deployment, or system) that performed this action.
-	Actor ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor `json:"actor"`
+	// Human-readable display name shown in the UI.
+	Name string `json:"name"`
+	// Optional free-text description of this component's purpose.
+	Description string `json:"description"`
+	// Key-value attributes assigned directly to this component.
+	Attributes map[string]any `json:"-"`
+	// Position on the visual canvas. Null if never placed.
+	Position *ListComponentsProjectComponentsComponentPosition `json:"position"`
+	// When this component was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+	// When this component was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
+	// The OCI repository (bundle) this component is based on.
+	OciRepo ListComponentsProjectComponentsComponentOciRepo `json:"ociRepo"`
 }
 
-// GetId returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog.Id, and is useful for accessing the field via an interface.
-func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) GetId() string { return v.Id }
+// GetId returns ListComponentsProjectComponentsComponent.Id, and is useful for accessing the field via an interface.
+func (v *ListComponentsProjectComponentsComponent) GetId() string { return v.Id }
 
-// GetOccurredAt returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog.OccurredAt, and is useful for accessing the field via an interface.
-func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) GetOccurredAt() time.Time {
-	return v.OccurredAt
-}
+// GetName returns ListComponentsProjectComponentsComponent.Name, and is useful for accessing the field via an interface.
+func (v *ListComponentsProjectComponentsComponent) GetName() string { return v.Name }
 
-// GetType returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog.Type, and is useful for accessing the field via an interface.
-func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) GetType() string { return v.Type }
+// GetDescription returns ListComponentsProjectComponentsComponent.Description, and is useful for accessing the field via an interface.
+func (v *ListComponentsProjectComponentsComponent) GetDescription() string { return v.Description }
 
-// GetSource returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog.Source, and is useful for accessing the field via an interface.
-func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) GetSource() string { return v.Source }
+// GetAttributes returns ListComponentsProjectComponentsComponent.Attributes, and is useful for accessing the field via an interface.
+func (v *ListComponentsProjectComponentsComponent) GetAttributes() map[string]any {
+	return v.Attributes
+}
 
-// GetSubject returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog.Subject, and is useful for accessing the field via an interface.
-func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) GetSubject() string { return v.Subject }
+// GetPosition returns ListComponentsProjectComponentsComponent.Position, and is useful for accessing the field via an interface.
+func (v *ListComponentsProjectComponentsComponent) GetPosition() *ListComponentsProjectComponentsComponentPosition {
+	return v.Position
+}
 
-// GetData returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog.Data, and is useful for accessing the field via an interface.
-func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) GetData() map[string]any { return v.Data }
+// GetCreatedAt returns ListComponentsProjectComponentsComponent.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ListComponentsProjectComponentsComponent) GetCreatedAt() time.Time { return v.CreatedAt }
 
-// GetActor returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog.Actor, and is useful for accessing the field via an interface.
-func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) GetActor() ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor {
-	return v.Actor
+// GetUpdatedAt returns ListComponentsProjectComponentsComponent.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ListComponentsProjectComponentsComponent) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetOciRepo returns ListComponentsProjectComponentsComponent.OciRepo, and is useful for accessing the field via an interface.
+func (v *ListComponentsProjectComponentsComponent) GetOciRepo() ListComponentsProjectComponentsComponentOciRepo {
+	return v.OciRepo
 }
 
-func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) UnmarshalJSON(b []byte) error {
+func (v *ListComponentsProjectComponentsComponent) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog
-		Data json.RawMessage `json:"data"`
+		*ListComponentsProjectComponentsComponent
+		Attributes json.RawMessage `json:"attributes"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog = v
+	firstPass.ListComponentsProjectComponentsComponent = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -15469,37 +17622,39 @@ func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) UnmarshalJSON(b []byt
 	}
 
 	{
-		dst := &v.Data
-		src := firstPass.Data
+		dst := &v.Attributes
+		src := firstPass.Attributes
 		if len(src) != 0 && string(src) != "null" {
 			err = scalars.UnmarshalJSON(
 				src, dst)
 			if err != nil {
 				return fmt.Errorf(
-					"unable to unmarshal ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog.Data: %w", err)
+					"unable to unmarshal ListComponentsProjectComponentsComponent.Attributes: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalListAuditLogsAuditLogsAuditLogsPageItemsAuditLog struct {
+type __premarshalListComponentsProjectComponentsComponent struct {
 	Id string `json:"id"`
 
-	OccurredAt time.Time `json:"occurredAt"`
+	Name string `json:"name"`
 
-	Type string `json:"type"`
+	Description string `json:"description"`
 
-	Source string `json:"source"`
+	Attributes json.RawMessage `json:"attributes"`
 
-	Subject string `json:"subject"`
+	Position *ListComponentsProjectComponentsComponentPosition `json:"position"`
 
-	Data json.RawMessage `json:"data"`
+	CreatedAt time.Time `json:"createdAt"`
 
-	Actor ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor `json:"actor"`
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	OciRepo ListComponentsProjectComponentsComponentOciRepo `json:"ociRepo"`
 }
 
-func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) MarshalJSON() ([]byte, error) {
+func (v *ListComponentsProjectComponentsComponent) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -15507,125 +17662,298 @@ func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) MarshalJSON() ([]byte
 	return json.Marshal(premarshaled)
 }
 
-func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog) __premarshalJSON() (*__premarshalListAuditLogsAuditLogsAuditLogsPageItemsAuditLog, error) {
-	var retval __premarshalListAuditLogsAuditLogsAuditLogsPageItemsAuditLog
+func (v *ListComponentsProjectComponentsComponent) __premarshalJSON() (*__premarshalListComponentsProjectComponentsComponent, error) {
+	var retval __premarshalListComponentsProjectComponentsComponent
 
 	retval.Id = v.Id
-	retval.OccurredAt = v.OccurredAt
-	retval.Type = v.Type
-	retval.Source = v.Source
-	retval.Subject = v.Subject
+	retval.Name = v.Name
+	retval.Description = v.Description
 	{
 
-		dst := &retval.Data
-		src := v.Data
+		dst := &retval.Attributes
+		src := v.Attributes
 		var err error
 		*dst, err = scalars.MarshalJSON(
 			&src)
 		if err != nil {
 			return nil, fmt.Errorf(
-				"unable to marshal ListAuditLogsAuditLogsAuditLogsPageItemsAuditLog.Data: %w", err)
+				"unable to marshal ListComponentsProjectComponentsComponent.Attributes: %w", err)
 		}
 	}
-	retval.Actor = v.Actor
+	retval.Position = v.Position
+	retval.CreatedAt = v.CreatedAt
+	retval.UpdatedAt = v.UpdatedAt
+	retval.OciRepo = v.OciRepo
 	return &retval, nil
 }
 
-// ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor includes the requested fields of the GraphQL type AuditLogActor.
+// ListComponentsProjectComponentsComponentOciRepo includes the requested fields of the GraphQL type OciRepo.
 // The GraphQL type's documentation follows.
 //
-// The actor that performed an audit-logged action.
+// An OCI repository in your organization's bundle catalog.
 //
-// Identifies who or what triggered the event. The `type` field tells you the category
-// of actor, and `name` provides a human-readable label:
+// An OCI repository is the container for all published versions of a single
+// infrastructure-as-code package. It is analogous to a Docker image repository
+// but for Massdriver bundles.
 //
-// - **`ACCOUNT`** — `name` is the user's email address.
-// - **`SERVICE_ACCOUNT`** — `name` is the service account's name.
-// - **`DEPLOYMENT`** — `name` is a reference like `deployment:<id>`.
-// - **`SYSTEM`** — `name` is `system` for internal actions.
+// Each repository has a unique `name` (e.g., `aws-aurora-postgres`) and contains:
 //
-// If the original actor has been deleted, the `name` will indicate this (e.g., "deleted account").
-type ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor struct {
-	// Unique identifier of the actor that performed the action.
+// - **Tags** -- the individual published versions (`1.0.0`, `1.1.0`, `1.2.3`, etc.)
+// - **Release channels** -- auto-resolving version constraints (`latest`, `~1`, `~1.2`)
+// that always point to the newest matching tag
+//
+// To fetch a specific bundle version from a repository, use the `bundle` query
+// with a `BundleId` like `aws-aurora-postgres@1.2.3` or `aws-aurora-postgres@~1`.
+type ListComponentsProjectComponentsComponentOciRepo struct {
 	Id string `json:"id"`
-	// What kind of entity performed the action (human, service account, deployment, or system).
-	Type AuditLogActorType `json:"type"`
-	// Human-readable label for the actor. Email address for users, name for service accounts, or a reference identifier for deployments and system actions.
+	// Repository name, unique within your organization (e.g., `aws-aurora-postgres`).
 	Name string `json:"name"`
+	// The bare [OCI reference](https://github.com/opencontainers/distribution-spec/blob/main/spec.md#pulling-manifests)
+	// for this repository: `<registry>/<org>/<repo>` (for example,
+	// `api.massdriver.cloud/acme/aws-aurora-postgres`).
+	//
+	// Append `:<tag>` or `@<digest>` to address a specific manifest and use the
+	// result directly with `oras`, `docker`, or any OCI-compliant client:
+	//
+	// ```bash
+	// oras pull api.massdriver.cloud/acme/aws-aurora-postgres:1.2.3
+	// ```
+	Reference string `json:"reference"`
 }
 
-// GetId returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor.Id, and is useful for accessing the field via an interface.
-func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor) GetId() string { return v.Id }
+// GetId returns ListComponentsProjectComponentsComponentOciRepo.Id, and is useful for accessing the field via an interface.
+func (v *ListComponentsProjectComponentsComponentOciRepo) GetId() string { return v.Id }
 
-// GetType returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor.Type, and is useful for accessing the field via an interface.
-func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor) GetType() AuditLogActorType {
-	return v.Type
+// GetName returns ListComponentsProjectComponentsComponentOciRepo.Name, and is useful for accessing the field via an interface.
+func (v *ListComponentsProjectComponentsComponentOciRepo) GetName() string { return v.Name }
+
+// GetReference returns ListComponentsProjectComponentsComponentOciRepo.Reference, and is useful for accessing the field via an interface.
+func (v *ListComponentsProjectComponentsComponentOciRepo) GetReference() string { return v.Reference }
+
+// ListComponentsProjectComponentsComponentPosition includes the requested fields of the GraphQL type ComponentPosition.
+// The GraphQL type's documentation follows.
+//
+// A component's position on the visual canvas, in pixel coordinates.
+type ListComponentsProjectComponentsComponentPosition struct {
+	// Horizontal offset in pixels from the canvas origin.
+	X *int `json:"x"`
+	// Vertical offset in pixels from the canvas origin.
+	Y *int `json:"y"`
 }
 
-// GetName returns ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor.Name, and is useful for accessing the field via an interface.
-func (v *ListAuditLogsAuditLogsAuditLogsPageItemsAuditLogActor) GetName() string { return v.Name }
+// GetX returns ListComponentsProjectComponentsComponentPosition.X, and is useful for accessing the field via an interface.
+func (v *ListComponentsProjectComponentsComponentPosition) GetX() *int { return v.X }
 
-// ListAuditLogsResponse is returned by ListAuditLogs on success.
-type ListAuditLogsResponse struct {
-	// List audit log events for your organization.
-	//
-	// Returns a paginated, filterable, sortable list of audit log events. By default, events are
-	// sorted newest first (`occurredAt` descending).
-	//
-	// **Filtering** — Use the `filter` argument to narrow results by time range, event type,
-	// actor type, or specific actor. All filters are combined with AND logic.
+// GetY returns ListComponentsProjectComponentsComponentPosition.Y, and is useful for accessing the field via an interface.
+func (v *ListComponentsProjectComponentsComponentPosition) GetY() *int { return v.Y }
+
+// ListComponentsResponse is returned by ListComponents on success.
+type ListComponentsResponse struct {
+	// Fetch a single project by its identifier.
+	Project ListComponentsProject `json:"project"`
+}
+
+// GetProject returns ListComponentsResponse.Project, and is useful for accessing the field via an interface.
+func (v *ListComponentsResponse) GetProject() ListComponentsProject { return v.Project }
+
+// ListCustomAttributesOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// The top-level account that owns all your infrastructure, projects, and team members.
+//
+// An organization is the root of the Massdriver resource hierarchy. Everything you build
+// and deploy lives under an organization: **Projects** contain your infrastructure designs,
+// **Environments** (like staging and production) are where those designs come to life, and
+// **Instances** are the actual running cloud resources.
+//
+// ```mermaid
+// graph TD
+// O["Organization"] --> P1["Project"]
+// O --> P2["Project"]
+// P1 --> E1["Environment: staging"]
+// P1 --> E2["Environment: production"]
+// E1 --> I1["Instance"]
+// E1 --> I2["Instance"]
+// ```
+//
+// Members access resources through **group memberships** with role-based permissions.
+// Custom attributes defined at the organization level govern attribute metadata across all child resources.
+//
+// Administrative fields (`billing`, `members`, `customAttributes`) resolve to `null` for
+// callers who lack the corresponding ABAC action; in that case a top-level `FORBIDDEN`
+// error is added to the response while the rest of the organization still resolves.
+type ListCustomAttributesOrganization struct {
+	Id string `json:"id"`
+	// Paginated list of custom attributes that govern attribute metadata across this organization.
 	//
-	// **Example:**
+	// Requires the `organization:manageCustomAttributes` action.
+	CustomAttributes ListCustomAttributesOrganizationCustomAttributesCustomAttributesPage `json:"customAttributes"`
+}
+
+// GetId returns ListCustomAttributesOrganization.Id, and is useful for accessing the field via an interface.
+func (v *ListCustomAttributesOrganization) GetId() string { return v.Id }
+
+// GetCustomAttributes returns ListCustomAttributesOrganization.CustomAttributes, and is useful for accessing the field via an interface.
+func (v *ListCustomAttributesOrganization) GetCustomAttributes() ListCustomAttributesOrganizationCustomAttributesCustomAttributesPage {
+	return v.CustomAttributes
+}
+
+// ListCustomAttributesOrganizationCustomAttributesCustomAttributesPage includes the requested fields of the GraphQL type CustomAttributesPage.
+type ListCustomAttributesOrganizationCustomAttributesCustomAttributesPage struct {
+	// Pagination cursors for navigating between pages.
+	Cursor ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageCursorPaginationCursor `json:"cursor"`
+	// A list of type custom_attribute.
+	Items []ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageItemsCustomAttribute `json:"items"`
+}
+
+// GetCursor returns ListCustomAttributesOrganizationCustomAttributesCustomAttributesPage.Cursor, and is useful for accessing the field via an interface.
+func (v *ListCustomAttributesOrganizationCustomAttributesCustomAttributesPage) GetCursor() ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageCursorPaginationCursor {
+	return v.Cursor
+}
+
+// GetItems returns ListCustomAttributesOrganizationCustomAttributesCustomAttributesPage.Items, and is useful for accessing the field via an interface.
+func (v *ListCustomAttributesOrganizationCustomAttributesCustomAttributesPage) GetItems() []ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageItemsCustomAttribute {
+	return v.Items
+}
+
+// ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageCursorPaginationCursor includes the requested fields of the GraphQL type PaginationCursor.
+// The GraphQL type's documentation follows.
+//
+// Pagination cursors returned with every paginated response.
+//
+// Contains opaque cursor strings for navigating forward and backward through results.
+// A `null` value for `next` indicates you have reached the last page; a `null` value
+// for `previous` indicates you are on the first page.
+type ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageCursorPaginationCursor struct {
+	// Cursor for the next page. `null` if there are no more results.
+	Next string `json:"next"`
+	// Cursor for the previous page. `null` if this is the first page.
+	Previous string `json:"previous"`
+}
+
+// GetNext returns ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageCursorPaginationCursor.Next, and is useful for accessing the field via an interface.
+func (v *ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageCursorPaginationCursor) GetNext() string {
+	return v.Next
+}
+
+// GetPrevious returns ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageCursorPaginationCursor.Previous, and is useful for accessing the field via an interface.
+func (v *ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageCursorPaginationCursor) GetPrevious() string {
+	return v.Previous
+}
+
+// ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageItemsCustomAttribute includes the requested fields of the GraphQL type CustomAttribute.
+// The GraphQL type's documentation follows.
+//
+// A user-declared attribute key, the resource scope where it applies, and whether it is required.
+//
+// Custom attributes enforce consistent metadata across your organization. When a custom
+// attribute is marked as **required**, any resource created at the specified scope must
+// include the attribute key. Optional custom attributes define allowed keys without
+// mandating them.
+//
+// System attributes (`md-*`) are auto-injected by Massdriver and are not declared here —
+// only user-defined keys live in this list.
+//
+// Use the `customAttributeSchema` query to generate a JSON Schema document narrowed
+// to the values your policies permit for a given action — useful for client-side
+// validation that mirrors what the API will accept on write. Use
+// `customAttributeValues` to fetch just the closed set for a single key.
+//
+// **Example:** A custom attribute with `key: "TEAM"`, `scope: PROJECT`, `required: true`
+// means every project must have a `TEAM` attribute set at creation time.
+type ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageItemsCustomAttribute struct {
+	// Unique identifier for this custom attribute.
+	Id string `json:"id"`
+	// The attribute key name (e.g., `TEAM`, `COST_CENTER`, `DOMAIN`). Case-sensitive.
+	Key string `json:"key"`
+	// The resource level where this attribute must or may be set.
+	Scope AttributeScope `json:"scope"`
+	// When `true`, resources created at the specified scope must include this attribute. When `false`, the attribute is allowed but optional.
+	Required bool `json:"required"`
+	// The closed set of values this attribute may take. Resource attribute writes and policy conditions referencing this key must use one of these values.
+	Values []string `json:"values"`
+	// When this custom attribute was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+	// When this custom attribute was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetId returns ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageItemsCustomAttribute.Id, and is useful for accessing the field via an interface.
+func (v *ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageItemsCustomAttribute) GetId() string {
+	return v.Id
+}
+
+// GetKey returns ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageItemsCustomAttribute.Key, and is useful for accessing the field via an interface.
+func (v *ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageItemsCustomAttribute) GetKey() string {
+	return v.Key
+}
+
+// GetScope returns ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageItemsCustomAttribute.Scope, and is useful for accessing the field via an interface.
+func (v *ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageItemsCustomAttribute) GetScope() AttributeScope {
+	return v.Scope
+}
+
+// GetRequired returns ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageItemsCustomAttribute.Required, and is useful for accessing the field via an interface.
+func (v *ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageItemsCustomAttribute) GetRequired() bool {
+	return v.Required
+}
+
+// GetValues returns ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageItemsCustomAttribute.Values, and is useful for accessing the field via an interface.
+func (v *ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageItemsCustomAttribute) GetValues() []string {
+	return v.Values
+}
+
+// GetCreatedAt returns ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageItemsCustomAttribute.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageItemsCustomAttribute) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetUpdatedAt returns ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageItemsCustomAttribute.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ListCustomAttributesOrganizationCustomAttributesCustomAttributesPageItemsCustomAttribute) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
+
+// ListCustomAttributesResponse is returned by ListCustomAttributes on success.
+type ListCustomAttributesResponse struct {
+	// Fetch your organization's details, including custom attributes and logo.
 	//
 	// ```graphql
 	// query {
-	// auditLogs(
-	// organizationId: "my-org"
-	// filter: {
-	// occurredAt: { gte: "2025-01-01T00:00:00Z" }
-	// type: { eq: "project.created" }
-	// actorType: { in: [ACCOUNT, SERVICE_ACCOUNT] }
-	// actor: { search: "alice" }
-	// }
-	// ) {
-	// items {
+	// organization(organizationId: "my-org") {
 	// id
-	// occurredAt
-	// type
-	// subject
-	// actor { type name }
-	// }
-	// cursor { next }
+	// name
+	// subscriptionStatus
+	// customAttributes { items { key scope required } }
 	// }
 	// }
 	// ```
-	AuditLogs ListAuditLogsAuditLogsAuditLogsPage `json:"auditLogs"`
+	Organization ListCustomAttributesOrganization `json:"organization"`
 }
 
-// GetAuditLogs returns ListAuditLogsResponse.AuditLogs, and is useful for accessing the field via an interface.
-func (v *ListAuditLogsResponse) GetAuditLogs() ListAuditLogsAuditLogsAuditLogsPage {
-	return v.AuditLogs
+// GetOrganization returns ListCustomAttributesResponse.Organization, and is useful for accessing the field via an interface.
+func (v *ListCustomAttributesResponse) GetOrganization() ListCustomAttributesOrganization {
+	return v.Organization
 }
 
-// ListBundlesBundlesBundlesPage includes the requested fields of the GraphQL type BundlesPage.
-type ListBundlesBundlesBundlesPage struct {
+// ListDeploymentsDeploymentsDeploymentsPage includes the requested fields of the GraphQL type DeploymentsPage.
+type ListDeploymentsDeploymentsDeploymentsPage struct {
 	// Pagination cursors for navigating between pages.
-	Cursor ListBundlesBundlesBundlesPageCursorPaginationCursor `json:"cursor"`
-	// A list of type bundle.
-	Items []ListBundlesBundlesBundlesPageItemsBundle `json:"items"`
+	Cursor ListDeploymentsDeploymentsDeploymentsPageCursorPaginationCursor `json:"cursor"`
+	// A list of type deployment.
+	Items []ListDeploymentsDeploymentsDeploymentsPageItemsDeployment `json:"items"`
 }
 
-// GetCursor returns ListBundlesBundlesBundlesPage.Cursor, and is useful for accessing the field via an interface.
-func (v *ListBundlesBundlesBundlesPage) GetCursor() ListBundlesBundlesBundlesPageCursorPaginationCursor {
+// GetCursor returns ListDeploymentsDeploymentsDeploymentsPage.Cursor, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPage) GetCursor() ListDeploymentsDeploymentsDeploymentsPageCursorPaginationCursor {
 	return v.Cursor
 }
 
-// GetItems returns ListBundlesBundlesBundlesPage.Items, and is useful for accessing the field via an interface.
-func (v *ListBundlesBundlesBundlesPage) GetItems() []ListBundlesBundlesBundlesPageItemsBundle {
+// GetItems returns ListDeploymentsDeploymentsDeploymentsPage.Items, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPage) GetItems() []ListDeploymentsDeploymentsDeploymentsPageItemsDeployment {
 	return v.Items
 }
 
-// ListBundlesBundlesBundlesPageCursorPaginationCursor includes the requested fields of the GraphQL type PaginationCursor.
+// ListDeploymentsDeploymentsDeploymentsPageCursorPaginationCursor includes the requested fields of the GraphQL type PaginationCursor.
 // The GraphQL type's documentation follows.
 //
 // Pagination cursors returned with every paginated response.
@@ -15633,20 +17961,176 @@ func (v *ListBundlesBundlesBundlesPage) GetItems() []ListBundlesBundlesBundlesPa
 // Contains opaque cursor strings for navigating forward and backward through results.
 // A `null` value for `next` indicates you have reached the last page; a `null` value
 // for `previous` indicates you are on the first page.
-type ListBundlesBundlesBundlesPageCursorPaginationCursor struct {
+type ListDeploymentsDeploymentsDeploymentsPageCursorPaginationCursor struct {
 	// Cursor for the next page. `null` if there are no more results.
 	Next string `json:"next"`
 	// Cursor for the previous page. `null` if this is the first page.
 	Previous string `json:"previous"`
 }
 
-// GetNext returns ListBundlesBundlesBundlesPageCursorPaginationCursor.Next, and is useful for accessing the field via an interface.
-func (v *ListBundlesBundlesBundlesPageCursorPaginationCursor) GetNext() string { return v.Next }
+// GetNext returns ListDeploymentsDeploymentsDeploymentsPageCursorPaginationCursor.Next, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageCursorPaginationCursor) GetNext() string {
+	return v.Next
+}
 
-// GetPrevious returns ListBundlesBundlesBundlesPageCursorPaginationCursor.Previous, and is useful for accessing the field via an interface.
-func (v *ListBundlesBundlesBundlesPageCursorPaginationCursor) GetPrevious() string { return v.Previous }
+// GetPrevious returns ListDeploymentsDeploymentsDeploymentsPageCursorPaginationCursor.Previous, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageCursorPaginationCursor) GetPrevious() string {
+	return v.Previous
+}
 
-// ListBundlesBundlesBundlesPageItemsBundle includes the requested fields of the GraphQL type Bundle.
+// ListDeploymentsDeploymentsDeploymentsPageItemsDeployment includes the requested fields of the GraphQL type Deployment.
+// The GraphQL type's documentation follows.
+//
+// A record of an infrastructure provisioning operation.
+//
+// Each deployment tracks a single action (`PROVISION`, `DECOMMISSION`, or `PLAN`) against
+// an instance. Deployments are immutable once created — you cannot modify a deployment,
+// only create new ones.
+//
+// Use the `status` field to monitor progress and `elapsed_time` to track duration.
+// The `deployed_by` field identifies the user or service account that initiated the operation.
+type ListDeploymentsDeploymentsDeploymentsPageItemsDeployment struct {
+	// Unique identifier for this deployment.
+	Id string `json:"id"`
+	// Current lifecycle state of this deployment.
+	Status DeploymentStatus `json:"status"`
+	// The infrastructure operation this deployment performs.
+	Action DeploymentAction `json:"action"`
+	// The bundle version used for this deployment (e.g., `1.2.0`).
+	Version string `json:"version"`
+	// An optional message describing the purpose of this deployment, similar to a commit message.
+	Message string `json:"message"`
+	// Wall-clock duration of the deployment in seconds.
+	//
+	// For `RUNNING` deployments, this is a live timer counting from creation.
+	// For terminal states, this is the total time from creation to the final status transition.
+	// Returns `0` for deployments that have not yet executed (`PENDING`, `PROPOSED`, `APPROVED`).
+	ElapsedTime int `json:"elapsedTime"`
+	// The name of the user or service account that initiated this deployment. Null if the initiator has been removed.
+	DeployedBy string `json:"deployedBy"`
+	// When this deployment was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+	// When this deployment record was last updated (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
+	// When the deployment last changed status (UTC). Null if the deployment is still `PENDING`.
+	LastTransitionedAt time.Time `json:"lastTransitionedAt"`
+	// The instance that this deployment operates on.
+	Instance ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance `json:"instance"`
+}
+
+// GetId returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.Id, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetId() string { return v.Id }
+
+// GetStatus returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.Status, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetStatus() DeploymentStatus {
+	return v.Status
+}
+
+// GetAction returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.Action, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetAction() DeploymentAction {
+	return v.Action
+}
+
+// GetVersion returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.Version, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetVersion() string {
+	return v.Version
+}
+
+// GetMessage returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.Message, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetMessage() string {
+	return v.Message
+}
+
+// GetElapsedTime returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.ElapsedTime, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetElapsedTime() int {
+	return v.ElapsedTime
+}
+
+// GetDeployedBy returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.DeployedBy, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetDeployedBy() string {
+	return v.DeployedBy
+}
+
+// GetCreatedAt returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetUpdatedAt returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
+
+// GetLastTransitionedAt returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.LastTransitionedAt, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetLastTransitionedAt() time.Time {
+	return v.LastTransitionedAt
+}
+
+// GetInstance returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.Instance, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetInstance() ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance {
+	return v.Instance
+}
+
+// ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance includes the requested fields of the GraphQL type Instance.
+// The GraphQL type's documentation follows.
+//
+// A deployed piece of infrastructure in an environment.
+//
+// An instance is the **runtime representation** of a component. When you add a
+// "database" component to your blueprint and deploy it to the `staging`
+// environment, Massdriver creates an instance that tracks the database's
+// configuration, deployment state, costs, and produced resources.
+//
+// **Lifecycle:** Instances progress through a well-defined set of states:
+//
+// ```mermaid
+// stateDiagram-v2
+// [*] --> INITIALIZED: "Component added to environment"
+// INITIALIZED --> PROVISIONED: "Deployment succeeds"
+// INITIALIZED --> FAILED: "Deployment fails"
+// PROVISIONED --> PROVISIONED: "Redeploy / update"
+// PROVISIONED --> DECOMMISSIONED: "Decommission succeeds"
+// PROVISIONED --> FAILED: "Deployment fails"
+// FAILED --> PROVISIONED: "Retry succeeds"
+// FAILED --> DECOMMISSIONED: "Decommission"
+// ```
+//
+// **Version resolution:** Each instance has a `version` constraint (e.g., `~1.0`)
+// and a `releaseStrategy` (stable or development). Together these determine
+// the `resolvedVersion` that will be used on the next deployment. Compare
+// `resolvedVersion` with `deployedVersion` to see if a redeployment is needed,
+// or check `availableUpgrade` for newer matching releases.
+type ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance struct {
+	Id string `json:"id"`
+	// Name of the instance.
+	Name string `json:"name"`
+	// The environment this instance is deployed in.
+	Environment ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceEnvironment `json:"environment"`
+	// The bundle release currently resolved for this instance.
+	Bundle ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceBundle `json:"bundle"`
+}
+
+// GetId returns ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance.Id, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance) GetId() string {
+	return v.Id
+}
+
+// GetName returns ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance.Name, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance) GetName() string {
+	return v.Name
+}
+
+// GetEnvironment returns ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance.Environment, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance) GetEnvironment() ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceEnvironment {
+	return v.Environment
+}
+
+// GetBundle returns ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance.Bundle, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance) GetBundle() ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceBundle {
+	return v.Bundle
+}
+
+// ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceBundle includes the requested fields of the GraphQL type Bundle.
 // The GraphQL type's documentation follows.
 //
 // A versioned infrastructure-as-code package.
@@ -15672,88 +18156,55 @@ func (v *ListBundlesBundlesBundlesPageCursorPaginationCursor) GetPrevious() stri
 // B --> D2["Dependency: aws-vpc"]
 // B --> RES["Resource: aurora-cluster"]
 // ```
-type ListBundlesBundlesBundlesPageItemsBundle struct {
+type ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceBundle struct {
 	// Composite identifier in `name@version` format (e.g., `aws-aurora-postgres@1.2.3`). Always contains the fully resolved semver version.
 	Id string `json:"id"`
 	// OCI repository name this bundle belongs to (e.g., `aws-aurora-postgres`).
 	Name string `json:"name"`
-	// Fully resolved semantic version of this bundle (e.g., `1.2.3`).
-	Version string `json:"version"`
-	// Short summary of what this bundle provisions.
-	Description string `json:"description"`
-	// URL to the bundle's display icon.
-	Icon string `json:"icon"`
-	// URL to the bundle's source code repository, if published by the author.
-	SourceUrl string `json:"sourceUrl"`
-	// OCI repository name for this bundle (e.g., `aws-aurora-postgres`). Equivalent to `name`.
-	Repo string `json:"repo"`
-	// Timestamp when this bundle version was first published (UTC).
-	CreatedAt time.Time `json:"createdAt"`
-	// Timestamp when this bundle version was last modified (UTC).
-	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-// GetId returns ListBundlesBundlesBundlesPageItemsBundle.Id, and is useful for accessing the field via an interface.
-func (v *ListBundlesBundlesBundlesPageItemsBundle) GetId() string { return v.Id }
-
-// GetName returns ListBundlesBundlesBundlesPageItemsBundle.Name, and is useful for accessing the field via an interface.
-func (v *ListBundlesBundlesBundlesPageItemsBundle) GetName() string { return v.Name }
-
-// GetVersion returns ListBundlesBundlesBundlesPageItemsBundle.Version, and is useful for accessing the field via an interface.
-func (v *ListBundlesBundlesBundlesPageItemsBundle) GetVersion() string { return v.Version }
-
-// GetDescription returns ListBundlesBundlesBundlesPageItemsBundle.Description, and is useful for accessing the field via an interface.
-func (v *ListBundlesBundlesBundlesPageItemsBundle) GetDescription() string { return v.Description }
-
-// GetIcon returns ListBundlesBundlesBundlesPageItemsBundle.Icon, and is useful for accessing the field via an interface.
-func (v *ListBundlesBundlesBundlesPageItemsBundle) GetIcon() string { return v.Icon }
-
-// GetSourceUrl returns ListBundlesBundlesBundlesPageItemsBundle.SourceUrl, and is useful for accessing the field via an interface.
-func (v *ListBundlesBundlesBundlesPageItemsBundle) GetSourceUrl() string { return v.SourceUrl }
-
-// GetRepo returns ListBundlesBundlesBundlesPageItemsBundle.Repo, and is useful for accessing the field via an interface.
-func (v *ListBundlesBundlesBundlesPageItemsBundle) GetRepo() string { return v.Repo }
-
-// GetCreatedAt returns ListBundlesBundlesBundlesPageItemsBundle.CreatedAt, and is useful for accessing the field via an interface.
-func (v *ListBundlesBundlesBundlesPageItemsBundle) GetCreatedAt() time.Time { return v.CreatedAt }
-
-// GetUpdatedAt returns ListBundlesBundlesBundlesPageItemsBundle.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *ListBundlesBundlesBundlesPageItemsBundle) GetUpdatedAt() time.Time { return v.UpdatedAt }
-
-// ListBundlesResponse is returned by ListBundles on success.
-type ListBundlesResponse struct {
-	// List published bundle versions in your organization's catalog.
-	//
-	// Returns a paginated list of all bundle releases across all OCI repositories.
-	// Each item is a specific version (e.g., `aws-aurora-postgres@1.2.3`), not just
-	// the repository.
-	//
-	// Use `filter` to narrow results by repository name, resource type produced,
-	// dependency type consumed, or full-text search. When `search` is active, results
-	// are ranked by relevance unless an explicit `sort` is provided.
-	//
-	// ```graphql
-	// query {
-	// bundles(organizationId: "your-org-id", filter: { resourceType: { eq: "aws-iam-role" } }) {
-	// items {
-	// id
-	// name
-	// version
-	// description
-	// dependencies { name resourceType { id } }
-	// resources { name resourceType { id } }
-	// }
-	// cursor { next }
-	// }
-	// }
-	// ```
-	Bundles ListBundlesBundlesBundlesPage `json:"bundles"`
+// GetId returns ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceBundle.Id, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceBundle) GetId() string {
+	return v.Id
 }
 
-// GetBundles returns ListBundlesResponse.Bundles, and is useful for accessing the field via an interface.
-func (v *ListBundlesResponse) GetBundles() ListBundlesBundlesBundlesPage { return v.Bundles }
+// GetName returns ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceBundle.Name, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceBundle) GetName() string {
+	return v.Name
+}
 
-// ListComponentsProject includes the requested fields of the GraphQL type Project.
+// ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceEnvironment includes the requested fields of the GraphQL type Environment.
+// The GraphQL type's documentation follows.
+//
+// A deployment target within a project where blueprint components become live infrastructure.
+//
+// Each project can have multiple environments (e.g., `staging`, `production`). When you deploy
+// to an environment, every component in the project's blueprint is realized as an **Instance** --
+// a running piece of cloud infrastructure with its own configuration, state, and cost data.
+//
+// Environments inherit attributes from their parent project. You can also set environment-scoped attributes
+// that cascade down to all instances within the environment. **Defaults** let you pre-assign
+// resources (like a shared VPC or DNS zone) so that new instances automatically receive them.
+//
+// Before deleting an environment, all instances must be decommissioned. Use the `deletable`
+// field to check for blocking constraints.
+type ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceEnvironment struct {
+	Id string `json:"id"`
+	// The parent project that this environment belongs to.
+	Project ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceEnvironmentProject `json:"project"`
+}
+
+// GetId returns ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceEnvironment) GetId() string {
+	return v.Id
+}
+
+// GetProject returns ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceEnvironment.Project, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceEnvironment) GetProject() ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceEnvironmentProject {
+	return v.Project
+}
+
+// ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceEnvironmentProject includes the requested fields of the GraphQL type Project.
 // The GraphQL type's documentation follows.
 //
 // A project organizes related infrastructure under a single blueprint.
@@ -15773,226 +18224,406 @@ func (v *ListBundlesResponse) GetBundles() ListBundlesBundlesBundlesPage { retur
 // ```
 //
 // Attributes set on a project are inherited by all environments and instances within it.
-type ListComponentsProject struct {
+type ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceEnvironmentProject struct {
 	Id string `json:"id"`
-	// Components that make up the project's infrastructure architecture.
-	Components []ListComponentsProjectComponentsComponent `json:"components"`
 }
 
-// GetId returns ListComponentsProject.Id, and is useful for accessing the field via an interface.
-func (v *ListComponentsProject) GetId() string { return v.Id }
+// GetId returns ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceEnvironmentProject.Id, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstanceEnvironmentProject) GetId() string {
+	return v.Id
+}
 
-// GetComponents returns ListComponentsProject.Components, and is useful for accessing the field via an interface.
-func (v *ListComponentsProject) GetComponents() []ListComponentsProjectComponentsComponent {
-	return v.Components
+// ListDeploymentsResponse is returned by ListDeployments on success.
+type ListDeploymentsResponse struct {
+	// List deployments across all projects and environments you have access to.
+	//
+	// Returns a cursor-paginated list, sorted by newest first by default. Use `filter`
+	// to narrow results by instance, status, or action type.
+	//
+	// ```graphql
+	// query {
+	// deployments(organizationId: "my-org", filter: { status: { eq: RUNNING } }) {
+	// items {
+	// id
+	// status
+	// action
+	// version
+	// elapsedTime
+	// deployedBy
+	// instance { id }
+	// }
+	// cursor { next }
+	// }
+	// }
+	// ```
+	Deployments ListDeploymentsDeploymentsDeploymentsPage `json:"deployments"`
 }
 
-// ListComponentsProjectComponentsComponent includes the requested fields of the GraphQL type Component.
+// GetDeployments returns ListDeploymentsResponse.Deployments, and is useful for accessing the field via an interface.
+func (v *ListDeploymentsResponse) GetDeployments() ListDeploymentsDeploymentsDeploymentsPage {
+	return v.Deployments
+}
+
+// ListEnvironmentConnectionsEnvironment includes the requested fields of the GraphQL type Environment.
 // The GraphQL type's documentation follows.
 //
-// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+// A deployment target within a project where blueprint components become live infrastructure.
 //
-// A component is the **design-time** building block of your architecture. It says
-// "I want a database here" or "I need a Kubernetes cluster there." The component
-// defines *what* to deploy; the actual running infrastructure lives in **instances**
-// -- one per environment the component is deployed to.
+// Each project can have multiple environments (e.g., `staging`, `production`). When you deploy
+// to an environment, every component in the project's blueprint is realized as an **Instance** --
+// a running piece of cloud infrastructure with its own configuration, state, and cost data.
 //
-// Components are connected to each other via **links**, which declare that one
-// component's output (e.g., a connection string) should be wired into another
-// component's input.
-type ListComponentsProjectComponentsComponent struct {
+// Environments inherit attributes from their parent project. You can also set environment-scoped attributes
+// that cascade down to all instances within the environment. **Defaults** let you pre-assign
+// resources (like a shared VPC or DNS zone) so that new instances automatically receive them.
+//
+// Before deleting an environment, all instances must be decommissioned. Use the `deletable`
+// field to check for blocking constraints.
+type ListEnvironmentConnectionsEnvironment struct {
 	Id string `json:"id"`
-	// Human-readable display name shown in the UI.
-	Name string `json:"name"`
-	// Optional free-text description of this component's purpose.
-	Description string `json:"description"`
-	// Key-value attributes assigned directly to this component.
-	Attributes map[string]any `json:"-"`
-	// When this component was created (UTC).
-	CreatedAt time.Time `json:"createdAt"`
-	// When this component was last modified (UTC).
-	UpdatedAt time.Time `json:"updatedAt"`
-	// The OCI repository (bundle) this component is based on.
-	OciRepo ListComponentsProjectComponentsComponentOciRepo `json:"ociRepo"`
+	// The realized infrastructure for this environment showing deployed instances and their connections.
+	Blueprint ListEnvironmentConnectionsEnvironmentBlueprint `json:"blueprint"`
 }
 
-// GetId returns ListComponentsProjectComponentsComponent.Id, and is useful for accessing the field via an interface.
-func (v *ListComponentsProjectComponentsComponent) GetId() string { return v.Id }
+// GetId returns ListEnvironmentConnectionsEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironment) GetId() string { return v.Id }
 
-// GetName returns ListComponentsProjectComponentsComponent.Name, and is useful for accessing the field via an interface.
-func (v *ListComponentsProjectComponentsComponent) GetName() string { return v.Name }
+// GetBlueprint returns ListEnvironmentConnectionsEnvironment.Blueprint, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironment) GetBlueprint() ListEnvironmentConnectionsEnvironmentBlueprint {
+	return v.Blueprint
+}
 
-// GetDescription returns ListComponentsProjectComponentsComponent.Description, and is useful for accessing the field via an interface.
-func (v *ListComponentsProjectComponentsComponent) GetDescription() string { return v.Description }
+// ListEnvironmentConnectionsEnvironmentBlueprint includes the requested fields of the GraphQL type EnvironmentBlueprint.
+// The GraphQL type's documentation follows.
+//
+// An environment's realized infrastructure graph.
+//
+// The environment blueprint is the **runtime counterpart** of the project's
+// design-time blueprint. It contains the **instances** (deployed infrastructure)
+// and **connections** (runtime wiring) that show exactly how your infrastructure
+// is running in this environment.
+//
+// While the project blueprint defines the architecture once, each environment
+// has its own environment blueprint with independent instances and connections.
+// This means `staging` and `production` can run different versions, different
+// configurations, or even different subsets of the full blueprint.
+type ListEnvironmentConnectionsEnvironmentBlueprint struct {
+	// Paginated list of connections between instances in this environment.
+	//
+	// Each connection represents a runtime data flow from one instance's output
+	// to another instance's input. Defaults to chronological order by creation time.
+	Connections ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPage `json:"connections"`
+}
 
-// GetAttributes returns ListComponentsProjectComponentsComponent.Attributes, and is useful for accessing the field via an interface.
-func (v *ListComponentsProjectComponentsComponent) GetAttributes() map[string]any {
-	return v.Attributes
+// GetConnections returns ListEnvironmentConnectionsEnvironmentBlueprint.Connections, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprint) GetConnections() ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPage {
+	return v.Connections
 }
 
-// GetCreatedAt returns ListComponentsProjectComponentsComponent.CreatedAt, and is useful for accessing the field via an interface.
-func (v *ListComponentsProjectComponentsComponent) GetCreatedAt() time.Time { return v.CreatedAt }
+// ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPage includes the requested fields of the GraphQL type ConnectionsPage.
+type ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPage struct {
+	// Pagination cursors for navigating between pages.
+	Cursor ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageCursorPaginationCursor `json:"cursor"`
+	// A list of type connection.
+	Items []ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection `json:"items"`
+}
 
-// GetUpdatedAt returns ListComponentsProjectComponentsComponent.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *ListComponentsProjectComponentsComponent) GetUpdatedAt() time.Time { return v.UpdatedAt }
+// GetCursor returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPage.Cursor, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPage) GetCursor() ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageCursorPaginationCursor {
+	return v.Cursor
+}
 
-// GetOciRepo returns ListComponentsProjectComponentsComponent.OciRepo, and is useful for accessing the field via an interface.
-func (v *ListComponentsProjectComponentsComponent) GetOciRepo() ListComponentsProjectComponentsComponentOciRepo {
-	return v.OciRepo
+// GetItems returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPage.Items, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPage) GetItems() []ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection {
+	return v.Items
 }
 
-func (v *ListComponentsProjectComponentsComponent) UnmarshalJSON(b []byte) error {
+// ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageCursorPaginationCursor includes the requested fields of the GraphQL type PaginationCursor.
+// The GraphQL type's documentation follows.
+//
+// Pagination cursors returned with every paginated response.
+//
+// Contains opaque cursor strings for navigating forward and backward through results.
+// A `null` value for `next` indicates you have reached the last page; a `null` value
+// for `previous` indicates you are on the first page.
+type ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageCursorPaginationCursor struct {
+	// Cursor for the next page. `null` if there are no more results.
+	Next string `json:"next"`
+	// Cursor for the previous page. `null` if this is the first page.
+	Previous string `json:"previous"`
+}
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetNext returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageCursorPaginationCursor.Next, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageCursorPaginationCursor) GetNext() string {
+	return v.Next
+}
 
-	var firstPass struct {
-		*ListComponentsProjectComponentsComponent
-		Attributes json.RawMessage `json:"attributes"`
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.ListComponentsProjectComponentsComponent = v
+// GetPrevious returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageCursorPaginationCursor.Previous, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageCursorPaginationCursor) GetPrevious() string {
+	return v.Previous
+}
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection includes the requested fields of the GraphQL type Connection.
+// The GraphQL type's documentation follows.
+//
+// A runtime wiring between two instances in an environment.
+//
+// A connection is the **runtime realization** of a blueprint link. Where a link
+// says "the database component's `authentication` output goes to the app
+// component's `database` input," the connection in each environment carries the
+// *actual* resource data (e.g., a connection string) from the source instance
+// to the destination instance.
+//
+// Connections are created automatically when instances are deployed and a
+// matching blueprint link exists.
+type ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection struct {
+	// Unique identifier for this connection.
+	Id string `json:"id"`
+	// The output field name on the source instance that produces the resource.
+	FromField string `json:"fromField"`
+	// The input field name on the destination instance that consumes the resource.
+	ToField string `json:"toField"`
+	// When this connection was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+	// When this connection was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
+	// The source instance that produces the resource wired through this connection.
+	FromInstance *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionFromInstance `json:"fromInstance"`
+	// The destination instance that consumes the resource wired through this connection.
+	ToInstance *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionToInstance `json:"toInstance"`
+	// The blueprint link that this connection realizes. Null if the link has since been removed.
+	Link *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionLink `json:"link"`
+}
 
-	{
-		dst := &v.Attributes
-		src := firstPass.Attributes
-		if len(src) != 0 && string(src) != "null" {
-			err = scalars.UnmarshalJSON(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal ListComponentsProjectComponentsComponent.Attributes: %w", err)
-			}
-		}
-	}
-	return nil
+// GetId returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection.Id, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection) GetId() string {
+	return v.Id
 }
 
-type __premarshalListComponentsProjectComponentsComponent struct {
-	Id string `json:"id"`
+// GetFromField returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection.FromField, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection) GetFromField() string {
+	return v.FromField
+}
 
-	Name string `json:"name"`
+// GetToField returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection.ToField, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection) GetToField() string {
+	return v.ToField
+}
 
-	Description string `json:"description"`
+// GetCreatedAt returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
 
-	Attributes json.RawMessage `json:"attributes"`
+// GetUpdatedAt returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
 
-	CreatedAt time.Time `json:"createdAt"`
+// GetFromInstance returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection.FromInstance, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection) GetFromInstance() *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionFromInstance {
+	return v.FromInstance
+}
 
-	UpdatedAt time.Time `json:"updatedAt"`
+// GetToInstance returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection.ToInstance, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection) GetToInstance() *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionToInstance {
+	return v.ToInstance
+}
 
-	OciRepo ListComponentsProjectComponentsComponentOciRepo `json:"ociRepo"`
+// GetLink returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection.Link, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnection) GetLink() *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionLink {
+	return v.Link
+}
+
+// ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionFromInstance includes the requested fields of the GraphQL type Instance.
+// The GraphQL type's documentation follows.
+//
+// A deployed piece of infrastructure in an environment.
+//
+// An instance is the **runtime representation** of a component. When you add a
+// "database" component to your blueprint and deploy it to the `staging`
+// environment, Massdriver creates an instance that tracks the database's
+// configuration, deployment state, costs, and produced resources.
+//
+// **Lifecycle:** Instances progress through a well-defined set of states:
+//
+// ```mermaid
+// stateDiagram-v2
+// [*] --> INITIALIZED: "Component added to environment"
+// INITIALIZED --> PROVISIONED: "Deployment succeeds"
+// INITIALIZED --> FAILED: "Deployment fails"
+// PROVISIONED --> PROVISIONED: "Redeploy / update"
+// PROVISIONED --> DECOMMISSIONED: "Decommission succeeds"
+// PROVISIONED --> FAILED: "Deployment fails"
+// FAILED --> PROVISIONED: "Retry succeeds"
+// FAILED --> DECOMMISSIONED: "Decommission"
+// ```
+//
+// **Version resolution:** Each instance has a `version` constraint (e.g., `~1.0`)
+// and a `releaseStrategy` (stable or development). Together these determine
+// the `resolvedVersion` that will be used on the next deployment. Compare
+// `resolvedVersion` with `deployedVersion` to see if a redeployment is needed,
+// or check `availableUpgrade` for newer matching releases.
+type ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionFromInstance struct {
+	Id string `json:"id"`
+	// Name of the instance.
+	Name string `json:"name"`
+}
+
+// GetId returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionFromInstance.Id, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionFromInstance) GetId() string {
+	return v.Id
+}
+
+// GetName returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionFromInstance.Name, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionFromInstance) GetName() string {
+	return v.Name
+}
+
+// ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionLink includes the requested fields of the GraphQL type Link.
+// The GraphQL type's documentation follows.
+//
+// A design-time dependency between two components in a blueprint.
+//
+// A link declares that one component's output should be wired into another
+// component's input. For example, a link from a database component's
+// `authentication` output to an application component's `database` input
+// ensures the app receives the database connection string.
+//
+// At deploy time, each link is realized as a **connection** in the environment,
+// wiring the actual instance outputs to instance inputs.
+type ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionLink struct {
+	// Unique identifier for this link.
+	Id string `json:"id"`
+	// The output field name on the source component (e.g., `authentication`).
+	FromField string `json:"fromField"`
+	// The input field name on the destination component (e.g., `database`).
+	ToField string `json:"toField"`
 }
 
-func (v *ListComponentsProjectComponentsComponent) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetId returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionLink.Id, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionLink) GetId() string {
+	return v.Id
 }
 
-func (v *ListComponentsProjectComponentsComponent) __premarshalJSON() (*__premarshalListComponentsProjectComponentsComponent, error) {
-	var retval __premarshalListComponentsProjectComponentsComponent
-
-	retval.Id = v.Id
-	retval.Name = v.Name
-	retval.Description = v.Description
-	{
+// GetFromField returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionLink.FromField, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionLink) GetFromField() string {
+	return v.FromField
+}
 
-		dst := &retval.Attributes
-		src := v.Attributes
-		var err error
-		*dst, err = scalars.MarshalJSON(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal ListComponentsProjectComponentsComponent.Attributes: %w", err)
-		}
-	}
-	retval.CreatedAt = v.CreatedAt
-	retval.UpdatedAt = v.UpdatedAt
-	retval.OciRepo = v.OciRepo
-	return &retval, nil
+// GetToField returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionLink.ToField, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionLink) GetToField() string {
+	return v.ToField
 }
 
-// ListComponentsProjectComponentsComponentOciRepo includes the requested fields of the GraphQL type OciRepo.
+// ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionToInstance includes the requested fields of the GraphQL type Instance.
 // The GraphQL type's documentation follows.
 //
-// An OCI repository in your organization's bundle catalog.
+// A deployed piece of infrastructure in an environment.
 //
-// An OCI repository is the container for all published versions of a single
-// infrastructure-as-code package. It is analogous to a Docker image repository
-// but for Massdriver bundles.
+// An instance is the **runtime representation** of a component. When you add a
+// "database" component to your blueprint and deploy it to the `staging`
+// environment, Massdriver creates an instance that tracks the database's
+// configuration, deployment state, costs, and produced resources.
 //
-// Each repository has a unique `name` (e.g., `aws-aurora-postgres`) and contains:
+// **Lifecycle:** Instances progress through a well-defined set of states:
 //
-// - **Tags** -- the individual published versions (`1.0.0`, `1.1.0`, `1.2.3`, etc.)
-// - **Release channels** -- auto-resolving version constraints (`latest`, `~1`, `~1.2`)
-// that always point to the newest matching tag
+// ```mermaid
+// stateDiagram-v2
+// [*] --> INITIALIZED: "Component added to environment"
+// INITIALIZED --> PROVISIONED: "Deployment succeeds"
+// INITIALIZED --> FAILED: "Deployment fails"
+// PROVISIONED --> PROVISIONED: "Redeploy / update"
+// PROVISIONED --> DECOMMISSIONED: "Decommission succeeds"
+// PROVISIONED --> FAILED: "Deployment fails"
+// FAILED --> PROVISIONED: "Retry succeeds"
+// FAILED --> DECOMMISSIONED: "Decommission"
+// ```
 //
-// To fetch a specific bundle version from a repository, use the `bundle` query
-// with a `BundleId` like `aws-aurora-postgres@1.2.3` or `aws-aurora-postgres@~1`.
-type ListComponentsProjectComponentsComponentOciRepo struct {
+// **Version resolution:** Each instance has a `version` constraint (e.g., `~1.0`)
+// and a `releaseStrategy` (stable or development). Together these determine
+// the `resolvedVersion` that will be used on the next deployment. Compare
+// `resolvedVersion` with `deployedVersion` to see if a redeployment is needed,
+// or check `availableUpgrade` for newer matching releases.
+type ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionToInstance struct {
 	Id string `json:"id"`
-	// Repository name, unique within your organization (e.g., `aws-aurora-postgres`).
+	// Name of the instance.
 	Name string `json:"name"`
-	// The bare [OCI reference](https://github.com/opencontainers/distribution-spec/blob/main/spec.md#pulling-manifests)
-	// for this repository: `<registry>/<org>/<repo>` (for example,
-	// `api.massdriver.cloud/acme/aws-aurora-postgres`).
-	//
-	// Append `:<tag>` or `@<digest>` to address a specific manifest and use the
-	// result directly with `oras`, `docker`, or any OCI-compliant client:
-	//
-	// ```bash
-	// oras pull api.massdriver.cloud/acme/aws-aurora-postgres:1.2.3
-	// ```
-	Reference string `json:"reference"`
 }
 
-// GetId returns ListComponentsProjectComponentsComponentOciRepo.Id, and is useful for accessing the field via an interface.
-func (v *ListComponentsProjectComponentsComponentOciRepo) GetId() string { return v.Id }
+// GetId returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionToInstance.Id, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionToInstance) GetId() string {
+	return v.Id
+}
 
-// GetName returns ListComponentsProjectComponentsComponentOciRepo.Name, and is useful for accessing the field via an interface.
-func (v *ListComponentsProjectComponentsComponentOciRepo) GetName() string { return v.Name }
+// GetName returns ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionToInstance.Name, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsEnvironmentBlueprintConnectionsConnectionsPageItemsConnectionToInstance) GetName() string {
+	return v.Name
+}
 
-// GetReference returns ListComponentsProjectComponentsComponentOciRepo.Reference, and is useful for accessing the field via an interface.
-func (v *ListComponentsProjectComponentsComponentOciRepo) GetReference() string { return v.Reference }
+// ListEnvironmentConnectionsResponse is returned by ListEnvironmentConnections on success.
+type ListEnvironmentConnectionsResponse struct {
+	// Fetch a single environment by its identifier.
+	Environment ListEnvironmentConnectionsEnvironment `json:"environment"`
+}
 
-// ListComponentsResponse is returned by ListComponents on success.
-type ListComponentsResponse struct {
-	// Fetch a single project by its identifier.
-	Project ListComponentsProject `json:"project"`
+// GetEnvironment returns ListEnvironmentConnectionsResponse.Environment, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentConnectionsResponse) GetEnvironment() ListEnvironmentConnectionsEnvironment {
+	return v.Environment
 }
 
-// GetProject returns ListComponentsResponse.Project, and is useful for accessing the field via an interface.
-func (v *ListComponentsResponse) GetProject() ListComponentsProject { return v.Project }
+// ListEnvironmentDefaultsEnvironment includes the requested fields of the GraphQL type Environment.
+// The GraphQL type's documentation follows.
+//
+// A deployment target within a project where blueprint components become live infrastructure.
+//
+// Each project can have multiple environments (e.g., `staging`, `production`). When you deploy
+// to an environment, every component in the project's blueprint is realized as an **Instance** --
+// a running piece of cloud infrastructure with its own configuration, state, and cost data.
+//
+// Environments inherit attributes from their parent project. You can also set environment-scoped attributes
+// that cascade down to all instances within the environment. **Defaults** let you pre-assign
+// resources (like a shared VPC or DNS zone) so that new instances automatically receive them.
+//
+// Before deleting an environment, all instances must be decommissioned. Use the `deletable`
+// field to check for blocking constraints.
+type ListEnvironmentDefaultsEnvironment struct {
+	Id string `json:"id"`
+	// Paginated list of default resources for this environment.
+	//
+	// Defaults are pre-assigned resources (like a shared VPC or DNS zone) that instances
+	// automatically inherit when they require a matching resource type. Only one default
+	// per resource type is allowed.
+	Defaults ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPage `json:"defaults"`
+}
 
-// ListDeploymentsDeploymentsDeploymentsPage includes the requested fields of the GraphQL type DeploymentsPage.
-type ListDeploymentsDeploymentsDeploymentsPage struct {
+// GetId returns ListEnvironmentDefaultsEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentDefaultsEnvironment) GetId() string { return v.Id }
+
+// GetDefaults returns ListEnvironmentDefaultsEnvironment.Defaults, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentDefaultsEnvironment) GetDefaults() ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPage {
+	return v.Defaults
+}
+
+// ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPage includes the requested fields of the GraphQL type EnvironmentDefaultsPage.
+type ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPage struct {
 	// Pagination cursors for navigating between pages.
-	Cursor ListDeploymentsDeploymentsDeploymentsPageCursorPaginationCursor `json:"cursor"`
-	// A list of type deployment.
-	Items []ListDeploymentsDeploymentsDeploymentsPageItemsDeployment `json:"items"`
+	Cursor ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageCursorPaginationCursor `json:"cursor"`
+	// A list of type environment_default.
+	Items []ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault `json:"items"`
 }
 
-// GetCursor returns ListDeploymentsDeploymentsDeploymentsPage.Cursor, and is useful for accessing the field via an interface.
-func (v *ListDeploymentsDeploymentsDeploymentsPage) GetCursor() ListDeploymentsDeploymentsDeploymentsPageCursorPaginationCursor {
+// GetCursor returns ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPage.Cursor, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPage) GetCursor() ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageCursorPaginationCursor {
 	return v.Cursor
 }
 
-// GetItems returns ListDeploymentsDeploymentsDeploymentsPage.Items, and is useful for accessing the field via an interface.
-func (v *ListDeploymentsDeploymentsDeploymentsPage) GetItems() []ListDeploymentsDeploymentsDeploymentsPageItemsDeployment {
+// GetItems returns ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPage.Items, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPage) GetItems() []ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault {
 	return v.Items
 }
 
-// ListDeploymentsDeploymentsDeploymentsPageCursorPaginationCursor includes the requested fields of the GraphQL type PaginationCursor.
+// ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageCursorPaginationCursor includes the requested fields of the GraphQL type PaginationCursor.
 // The GraphQL type's documentation follows.
 //
 // Pagination cursors returned with every paginated response.
@@ -16000,190 +18631,141 @@ func (v *ListDeploymentsDeploymentsDeploymentsPage) GetItems() []ListDeployments
 // Contains opaque cursor strings for navigating forward and backward through results.
 // A `null` value for `next` indicates you have reached the last page; a `null` value
 // for `previous` indicates you are on the first page.
-type ListDeploymentsDeploymentsDeploymentsPageCursorPaginationCursor struct {
+type ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageCursorPaginationCursor struct {
 	// Cursor for the next page. `null` if there are no more results.
 	Next string `json:"next"`
 	// Cursor for the previous page. `null` if this is the first page.
 	Previous string `json:"previous"`
 }
 
-// GetNext returns ListDeploymentsDeploymentsDeploymentsPageCursorPaginationCursor.Next, and is useful for accessing the field via an interface.
-func (v *ListDeploymentsDeploymentsDeploymentsPageCursorPaginationCursor) GetNext() string {
+// GetNext returns ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageCursorPaginationCursor.Next, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageCursorPaginationCursor) GetNext() string {
 	return v.Next
 }
 
-// GetPrevious returns ListDeploymentsDeploymentsDeploymentsPageCursorPaginationCursor.Previous, and is useful for accessing the field via an interface.
-func (v *ListDeploymentsDeploymentsDeploymentsPageCursorPaginationCursor) GetPrevious() string {
+// GetPrevious returns ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageCursorPaginationCursor.Previous, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageCursorPaginationCursor) GetPrevious() string {
 	return v.Previous
 }
 
-// ListDeploymentsDeploymentsDeploymentsPageItemsDeployment includes the requested fields of the GraphQL type Deployment.
+// ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault includes the requested fields of the GraphQL type EnvironmentDefault.
 // The GraphQL type's documentation follows.
 //
-// A record of an infrastructure provisioning operation.
-//
-// Each deployment tracks a single action (`PROVISION`, `DECOMMISSION`, or `PLAN`) against
-// an instance. Deployments are immutable once created — you cannot modify a deployment,
-// only create new ones.
+// An environment default that automatically provides a resource to instances.
 //
-// Use the `status` field to monitor progress and `elapsed_time` to track duration.
-// The `deployed_by` field identifies the user or service account that initiated the operation.
-type ListDeploymentsDeploymentsDeploymentsPageItemsDeployment struct {
-	// Unique identifier for this deployment.
+// When an instance in the environment requires a resource type that matches this default,
+// the resource is automatically connected without manual configuration. Only one default
+// per resource type is allowed per environment -- remove the existing default before
+// setting a new one.
+type ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault struct {
+	// Unique identifier for this environment default.
 	Id string `json:"id"`
-	// Current lifecycle state of this deployment.
-	Status DeploymentStatus `json:"status"`
-	// The infrastructure operation this deployment performs.
-	Action DeploymentAction `json:"action"`
-	// The bundle version used for this deployment (e.g., `1.2.0`).
-	Version string `json:"version"`
-	// An optional message describing the purpose of this deployment, similar to a commit message.
-	Message string `json:"message"`
-	// Wall-clock duration of the deployment in seconds.
-	//
-	// For `RUNNING` deployments, this is a live timer counting from creation.
-	// For terminal states, this is the total time from creation to the final status transition.
-	// Returns `0` for deployments that have not yet executed (`PENDING`, `PROPOSED`, `APPROVED`).
-	ElapsedTime int `json:"elapsedTime"`
-	// The name of the user or service account that initiated this deployment. Null if the initiator has been removed.
-	DeployedBy string `json:"deployedBy"`
-	// When this deployment was created (UTC).
+	// When this default was first set (UTC).
 	CreatedAt time.Time `json:"createdAt"`
-	// When this deployment record was last updated (UTC).
+	// When this default was last modified (UTC).
 	UpdatedAt time.Time `json:"updatedAt"`
-	// When the deployment last changed status (UTC). Null if the deployment is still `PENDING`.
-	LastTransitionedAt time.Time `json:"lastTransitionedAt"`
-	// The instance that this deployment operates on.
-	Instance ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance `json:"instance"`
-}
-
-// GetId returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.Id, and is useful for accessing the field via an interface.
-func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetId() string { return v.Id }
-
-// GetStatus returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.Status, and is useful for accessing the field via an interface.
-func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetStatus() DeploymentStatus {
-	return v.Status
-}
-
-// GetAction returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.Action, and is useful for accessing the field via an interface.
-func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetAction() DeploymentAction {
-	return v.Action
+	// The resource that is set as the default for its type.
+	Resource ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource `json:"resource"`
 }
 
-// GetVersion returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.Version, and is useful for accessing the field via an interface.
-func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetVersion() string {
-	return v.Version
+// GetId returns ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault.Id, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault) GetId() string {
+	return v.Id
 }
 
-// GetMessage returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.Message, and is useful for accessing the field via an interface.
-func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetMessage() string {
-	return v.Message
+// GetCreatedAt returns ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault) GetCreatedAt() time.Time {
+	return v.CreatedAt
 }
 
-// GetElapsedTime returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.ElapsedTime, and is useful for accessing the field via an interface.
-func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetElapsedTime() int {
-	return v.ElapsedTime
+// GetUpdatedAt returns ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
 }
 
-// GetDeployedBy returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.DeployedBy, and is useful for accessing the field via an interface.
-func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetDeployedBy() string {
-	return v.DeployedBy
+// GetResource returns ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault.Resource, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefault) GetResource() ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource {
+	return v.Resource
 }
 
-// GetCreatedAt returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.CreatedAt, and is useful for accessing the field via an interface.
-func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetCreatedAt() time.Time {
-	return v.CreatedAt
+// ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource includes the requested fields of the GraphQL type EnvironmentDefaultResource.
+// The GraphQL type's documentation follows.
+//
+// A resource referenced by an environment default.
+//
+// This represents the actual cloud resource (e.g., a VPC or DNS zone) that has been
+// designated as the default for its resource type within an environment.
+type ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource struct {
+	// The resource's unique identifier.
+	Id string `json:"id"`
+	// Human-readable name of the resource.
+	Name string `json:"name"`
+	// The resource type (e.g., `massdriver/aws-vpc`) that this resource conforms to.
+	ResourceType ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResourceResourceType `json:"resourceType"`
 }
 
-// GetUpdatedAt returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetUpdatedAt() time.Time {
-	return v.UpdatedAt
+// GetId returns ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource.Id, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource) GetId() string {
+	return v.Id
 }
 
-// GetLastTransitionedAt returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.LastTransitionedAt, and is useful for accessing the field via an interface.
-func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetLastTransitionedAt() time.Time {
-	return v.LastTransitionedAt
+// GetName returns ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource.Name, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource) GetName() string {
+	return v.Name
 }
 
-// GetInstance returns ListDeploymentsDeploymentsDeploymentsPageItemsDeployment.Instance, and is useful for accessing the field via an interface.
-func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeployment) GetInstance() ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance {
-	return v.Instance
+// GetResourceType returns ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource.ResourceType, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResource) GetResourceType() ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResourceResourceType {
+	return v.ResourceType
 }
 
-// ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance includes the requested fields of the GraphQL type Instance.
+// ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResourceResourceType includes the requested fields of the GraphQL type ResourceType.
 // The GraphQL type's documentation follows.
 //
-// A deployed piece of infrastructure in an environment.
-//
-// An instance is the **runtime representation** of a component. When you add a
-// "database" component to your blueprint and deploy it to the `staging`
-// environment, Massdriver creates an instance that tracks the database's
-// configuration, deployment state, costs, and produced resources.
-//
-// **Lifecycle:** Instances progress through a well-defined set of states:
-//
-// ```mermaid
-// stateDiagram-v2
-// [*] --> INITIALIZED: "Component added to environment"
-// INITIALIZED --> PROVISIONED: "Deployment succeeds"
-// INITIALIZED --> FAILED: "Deployment fails"
-// PROVISIONED --> PROVISIONED: "Redeploy / update"
-// PROVISIONED --> DECOMMISSIONED: "Decommission succeeds"
-// PROVISIONED --> FAILED: "Deployment fails"
-// FAILED --> PROVISIONED: "Retry succeeds"
-// FAILED --> DECOMMISSIONED: "Decommission"
-// ```
+// A resource type that defines what kind of infrastructure a resource represents.
 //
-// **Version resolution:** Each instance has a `version` constraint (e.g., `~1.0`)
-// and a `releaseStrategy` (stable or development). Together these determine
-// the `resolvedVersion` that will be used on the next deployment. Compare
-// `resolvedVersion` with `deployedVersion` to see if a redeployment is needed,
-// or check `availableUpgrade` for newer matching releases.
-type ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance struct {
+// Resource types are the schema layer for Massdriver's connection system. Every
+// dependency a bundle declares and every resource a bundle produces references a
+// resource type. This is what makes bundles composable -- a database bundle that
+// produces an `aws-rds-instance` resource can be connected to any application
+// bundle that declares an `aws-rds-instance` dependency.
+//
+// Resource types include both public types provided by Massdriver (e.g.,
+// `aws-iam-role`, `kubernetes-cluster`) and private types defined by your
+// organization for custom infrastructure.
+type ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResourceResourceType struct {
+	// Unique identifier in kebab-case (e.g., `aws-iam-role`, `kubernetes-cluster`).
 	Id string `json:"id"`
-	// Name of the instance.
+	// Human-readable display name (e.g., "AWS IAM Role", "Kubernetes Cluster").
 	Name string `json:"name"`
+	// URL to the icon representing this resource type, if available.
+	Icon string `json:"icon"`
 }
 
-// GetId returns ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance.Id, and is useful for accessing the field via an interface.
-func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance) GetId() string {
+// GetId returns ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResourceResourceType.Id, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResourceResourceType) GetId() string {
 	return v.Id
 }
 
-// GetName returns ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance.Name, and is useful for accessing the field via an interface.
-func (v *ListDeploymentsDeploymentsDeploymentsPageItemsDeploymentInstance) GetName() string {
+// GetName returns ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResourceResourceType.Name, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResourceResourceType) GetName() string {
 	return v.Name
 }
 
-// ListDeploymentsResponse is returned by ListDeployments on success.
-type ListDeploymentsResponse struct {
-	// List deployments across all projects and environments you have access to.
-	//
-	// Returns a cursor-paginated list, sorted by newest first by default. Use `filter`
-	// to narrow results by instance, status, or action type.
-	//
-	// ```graphql
-	// query {
-	// deployments(organizationId: "my-org", filter: { status: { eq: RUNNING } }) {
-	// items {
-	// id
-	// status
-	// action
-	// version
-	// elapsedTime
-	// deployedBy
-	// instance { id }
-	// }
-	// cursor { next }
-	// }
-	// }
-	// ```
-	Deployments ListDeploymentsDeploymentsDeploymentsPage `json:"deployments"`
+// GetIcon returns ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResourceResourceType.Icon, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentDefaultsEnvironmentDefaultsEnvironmentDefaultsPageItemsEnvironmentDefaultResourceResourceType) GetIcon() string {
+	return v.Icon
 }
 
-// GetDeployments returns ListDeploymentsResponse.Deployments, and is useful for accessing the field via an interface.
-func (v *ListDeploymentsResponse) GetDeployments() ListDeploymentsDeploymentsDeploymentsPage {
-	return v.Deployments
+// ListEnvironmentDefaultsResponse is returned by ListEnvironmentDefaults on success.
+type ListEnvironmentDefaultsResponse struct {
+	// Fetch a single environment by its identifier.
+	Environment ListEnvironmentDefaultsEnvironment `json:"environment"`
+}
+
+// GetEnvironment returns ListEnvironmentDefaultsResponse.Environment, and is useful for accessing the field via an interface.
+func (v *ListEnvironmentDefaultsResponse) GetEnvironment() ListEnvironmentDefaultsEnvironment {
+	return v.Environment
 }
 
 // ListEnvironmentsEnvironmentsEnvironmentsPage includes the requested fields of the GraphQL type EnvironmentsPage.
@@ -20638,6 +23220,159 @@ func (v *RemoveInstanceSecretResponse) GetRemoveInstanceSecret() RemoveInstanceS
 	return v.RemoveInstanceSecret
 }
 
+// Remove a remote reference from an instance. The reference can only be removed if no provisioned instances are connected through it.
+type RemoveRemoteReferenceInput struct {
+	// The resource field to remove the reference from
+	Field string `json:"field"`
+}
+
+// GetField returns RemoveRemoteReferenceInput.Field, and is useful for accessing the field via an interface.
+func (v *RemoveRemoteReferenceInput) GetField() string { return v.Field }
+
+// RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload includes the requested fields of the GraphQL type RemoteReferencePayload.
+type RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadResultRemoteReference `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload.Result, and is useful for accessing the field via an interface.
+func (v *RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload) GetResult() RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadResultRemoteReference {
+	return v.Result
+}
+
+// GetSuccessful returns RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload.Successful, and is useful for accessing the field via an interface.
+func (v *RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload) GetSuccessful() bool {
+	return v.Successful
+}
+
+// GetMessages returns RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload.Messages, and is useful for accessing the field via an interface.
+func (v *RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload) GetMessages() []RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadResultRemoteReference includes the requested fields of the GraphQL type RemoteReference.
+// The GraphQL type's documentation follows.
+//
+// A per-instance override of a single connection slot. The blueprint Link wires
+// a slot from a sibling package's output; a remote reference overrides that
+// wiring on one instance, pointing the slot at a resource from another project
+// (or an imported resource) instead.
+//
+// Remote references enable cross-project infrastructure sharing. For example, a
+// networking team provisions a VPC in one project, and application teams override
+// the `vpc` connection slot on their database/cache/etc. instances to point at
+// that shared VPC.
+//
+// Each remote reference binds a specific `field` on the instance — a key in the
+// instance's bundle's `connectionsSchema` — to the target resource. The override
+// takes priority over any blueprint-level Link on the same slot, and reverts to
+// the Link (or environment default) when removed.
+type RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadResultRemoteReference struct {
+	// Unique identifier for this remote reference.
+	Id string `json:"id"`
+	// The name of the resource field on the instance that this reference satisfies (e.g., `aws_authentication` or `vpc`).
+	Field string `json:"field"`
+}
+
+// GetId returns RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadResultRemoteReference.Id, and is useful for accessing the field via an interface.
+func (v *RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadResultRemoteReference) GetId() string {
+	return v.Id
+}
+
+// GetField returns RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadResultRemoteReference.Field, and is useful for accessing the field via an interface.
+func (v *RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayloadResultRemoteReference) GetField() string {
+	return v.Field
+}
+
+// RemoveRemoteReferenceResponse is returned by RemoveRemoteReference on success.
+type RemoveRemoteReferenceResponse struct {
+	// Remove a per-instance remote-reference override. The slot reverts to its
+	// blueprint Link (if any) or the environment default at the next deploy.
+	//
+	// The instance must **not** be in `PROVISIONED` or `FAILED` status — taking
+	// an override off a deployed instance would change the resolved connection
+	// map under the running deployment.
+	//
+	// ```graphql
+	// mutation {
+	// removeRemoteReference(
+	// organizationId: "my-org"
+	// instanceId: "my-app"
+	// input: { field: "aws_authentication" }
+	// ) {
+	// result { id field }
+	// successful
+	// messages { field message }
+	// }
+	// }
+	// ```
+	RemoveRemoteReference RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload `json:"removeRemoteReference"`
+}
+
+// GetRemoveRemoteReference returns RemoveRemoteReferenceResponse.RemoveRemoteReference, and is useful for accessing the field via an interface.
+func (v *RemoveRemoteReferenceResponse) GetRemoveRemoteReference() RemoveRemoteReferenceRemoveRemoteReferenceRemoteReferencePayload {
+	return v.RemoveRemoteReference
+}
+
 // RemoveServiceAccountFromGroupRemoveServiceAccountFromGroupServiceAccountGroupPayload includes the requested fields of the GraphQL type ServiceAccountGroupPayload.
 type RemoveServiceAccountFromGroupRemoveServiceAccountFromGroupServiceAccountGroupPayload struct {
 	// The object created/updated/deleted by the mutation. May be null if mutation failed.
@@ -21072,9 +23807,171 @@ const (
 	ServiceAccountsSortFieldCreatedAt ServiceAccountsSortField = "CREATED_AT"
 )
 
-var AllServiceAccountsSortField = []ServiceAccountsSortField{
-	ServiceAccountsSortFieldName,
-	ServiceAccountsSortFieldCreatedAt,
+var AllServiceAccountsSortField = []ServiceAccountsSortField{
+	ServiceAccountsSortFieldName,
+	ServiceAccountsSortFieldCreatedAt,
+}
+
+// Set the position of a component on the canvas.
+type SetComponentPositionInput struct {
+	// Horizontal position in pixels
+	X int `json:"x"`
+	// Vertical position in pixels
+	Y int `json:"y"`
+}
+
+// GetX returns SetComponentPositionInput.X, and is useful for accessing the field via an interface.
+func (v *SetComponentPositionInput) GetX() int { return v.X }
+
+// GetY returns SetComponentPositionInput.Y, and is useful for accessing the field via an interface.
+func (v *SetComponentPositionInput) GetY() int { return v.Y }
+
+// SetComponentPositionResponse is returned by SetComponentPosition on success.
+type SetComponentPositionResponse struct {
+	// Set the pixel position of a component on the visual canvas.
+	SetComponentPosition SetComponentPositionSetComponentPositionComponentPayload `json:"setComponentPosition"`
+}
+
+// GetSetComponentPosition returns SetComponentPositionResponse.SetComponentPosition, and is useful for accessing the field via an interface.
+func (v *SetComponentPositionResponse) GetSetComponentPosition() SetComponentPositionSetComponentPositionComponentPayload {
+	return v.SetComponentPosition
+}
+
+// SetComponentPositionSetComponentPositionComponentPayload includes the requested fields of the GraphQL type ComponentPayload.
+type SetComponentPositionSetComponentPositionComponentPayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result SetComponentPositionSetComponentPositionComponentPayloadResultComponent `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []SetComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns SetComponentPositionSetComponentPositionComponentPayload.Result, and is useful for accessing the field via an interface.
+func (v *SetComponentPositionSetComponentPositionComponentPayload) GetResult() SetComponentPositionSetComponentPositionComponentPayloadResultComponent {
+	return v.Result
+}
+
+// GetSuccessful returns SetComponentPositionSetComponentPositionComponentPayload.Successful, and is useful for accessing the field via an interface.
+func (v *SetComponentPositionSetComponentPositionComponentPayload) GetSuccessful() bool {
+	return v.Successful
+}
+
+// GetMessages returns SetComponentPositionSetComponentPositionComponentPayload.Messages, and is useful for accessing the field via an interface.
+func (v *SetComponentPositionSetComponentPositionComponentPayload) GetMessages() []SetComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// SetComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type SetComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns SetComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *SetComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns SetComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *SetComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns SetComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *SetComponentPositionSetComponentPositionComponentPayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// SetComponentPositionSetComponentPositionComponentPayloadResultComponent includes the requested fields of the GraphQL type Component.
+// The GraphQL type's documentation follows.
+//
+// A bundle placed in a project's blueprint, representing a slot for deployable infrastructure.
+//
+// A component is the **design-time** building block of your architecture. It says
+// "I want a database here" or "I need a Kubernetes cluster there." The component
+// defines *what* to deploy; the actual running infrastructure lives in **instances**
+// -- one per environment the component is deployed to.
+//
+// Components are connected to each other via **links**, which declare that one
+// component's output (e.g., a connection string) should be wired into another
+// component's input.
+type SetComponentPositionSetComponentPositionComponentPayloadResultComponent struct {
+	Id string `json:"id"`
+	// Human-readable display name shown in the UI.
+	Name string `json:"name"`
+	// Position on the visual canvas. Null if never placed.
+	Position *SetComponentPositionSetComponentPositionComponentPayloadResultComponentPosition `json:"position"`
+}
+
+// GetId returns SetComponentPositionSetComponentPositionComponentPayloadResultComponent.Id, and is useful for accessing the field via an interface.
+func (v *SetComponentPositionSetComponentPositionComponentPayloadResultComponent) GetId() string {
+	return v.Id
+}
+
+// GetName returns SetComponentPositionSetComponentPositionComponentPayloadResultComponent.Name, and is useful for accessing the field via an interface.
+func (v *SetComponentPositionSetComponentPositionComponentPayloadResultComponent) GetName() string {
+	return v.Name
+}
+
+// GetPosition returns SetComponentPositionSetComponentPositionComponentPayloadResultComponent.Position, and is useful for accessing the field via an interface.
+func (v *SetComponentPositionSetComponentPositionComponentPayloadResultComponent) GetPosition() *SetComponentPositionSetComponentPositionComponentPayloadResultComponentPosition {
+	return v.Position
+}
+
+// SetComponentPositionSetComponentPositionComponentPayloadResultComponentPosition includes the requested fields of the GraphQL type ComponentPosition.
+// The GraphQL type's documentation follows.
+//
+// A component's position on the visual canvas, in pixel coordinates.
+type SetComponentPositionSetComponentPositionComponentPayloadResultComponentPosition struct {
+	// Horizontal offset in pixels from the canvas origin.
+	X *int `json:"x"`
+	// Vertical offset in pixels from the canvas origin.
+	Y *int `json:"y"`
+}
+
+// GetX returns SetComponentPositionSetComponentPositionComponentPayloadResultComponentPosition.X, and is useful for accessing the field via an interface.
+func (v *SetComponentPositionSetComponentPositionComponentPayloadResultComponentPosition) GetX() *int {
+	return v.X
+}
+
+// GetY returns SetComponentPositionSetComponentPositionComponentPayloadResultComponentPosition.Y, and is useful for accessing the field via an interface.
+func (v *SetComponentPositionSetComponentPositionComponentPayloadResultComponentPosition) GetY() *int {
+	return v.Y
 }
 
 // SetEnvironmentDefaultResponse is returned by SetEnvironmentDefault on success.
@@ -21428,6 +24325,215 @@ func (v *SetInstanceSecretSetInstanceSecretInstanceSecretPayloadResultInstanceSe
 	return v.UpdatedAt
 }
 
+// Link an instance's resource field to a resource from another project or an imported resource. The instance must not be in a provisioned or failed state.
+type SetRemoteReferenceInput struct {
+	// The resource field to assign the reference to
+	Field string `json:"field"`
+}
+
+// GetField returns SetRemoteReferenceInput.Field, and is useful for accessing the field via an interface.
+func (v *SetRemoteReferenceInput) GetField() string { return v.Field }
+
+// SetRemoteReferenceResponse is returned by SetRemoteReference on success.
+type SetRemoteReferenceResponse struct {
+	// Override one of an instance's connection slots with a resource from another
+	// project (or an imported resource).
+	//
+	// The instance must **not** be in `PROVISIONED` or `FAILED` status — like
+	// other configuration changes, overrides cannot be set on a deployed instance.
+	//
+	// The override takes priority over any blueprint-level Link wired into the
+	// same slot. Removing the override reverts to the Link (or environment default).
+	//
+	// ```graphql
+	// mutation {
+	// setRemoteReference(
+	// organizationId: "my-org"
+	// instanceId: "my-app"
+	// resourceId: "shared-creds-abc123"
+	// input: { field: "aws_authentication" }
+	// ) {
+	// result { id field resource { id name } }
+	// successful
+	// messages { field message }
+	// }
+	// }
+	// ```
+	SetRemoteReference SetRemoteReferenceSetRemoteReferenceRemoteReferencePayload `json:"setRemoteReference"`
+}
+
+// GetSetRemoteReference returns SetRemoteReferenceResponse.SetRemoteReference, and is useful for accessing the field via an interface.
+func (v *SetRemoteReferenceResponse) GetSetRemoteReference() SetRemoteReferenceSetRemoteReferenceRemoteReferencePayload {
+	return v.SetRemoteReference
+}
+
+// SetRemoteReferenceSetRemoteReferenceRemoteReferencePayload includes the requested fields of the GraphQL type RemoteReferencePayload.
+type SetRemoteReferenceSetRemoteReferenceRemoteReferencePayload struct {
+	// The object created/updated/deleted by the mutation. May be null if mutation failed.
+	Result SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference `json:"result"`
+	// Indicates if the mutation completed successfully or not.
+	Successful bool `json:"successful"`
+	// A list of failed validations. May be blank or null if mutation succeeded.
+	Messages []SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage `json:"messages"`
+}
+
+// GetResult returns SetRemoteReferenceSetRemoteReferenceRemoteReferencePayload.Result, and is useful for accessing the field via an interface.
+func (v *SetRemoteReferenceSetRemoteReferenceRemoteReferencePayload) GetResult() SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference {
+	return v.Result
+}
+
+// GetSuccessful returns SetRemoteReferenceSetRemoteReferenceRemoteReferencePayload.Successful, and is useful for accessing the field via an interface.
+func (v *SetRemoteReferenceSetRemoteReferenceRemoteReferencePayload) GetSuccessful() bool {
+	return v.Successful
+}
+
+// GetMessages returns SetRemoteReferenceSetRemoteReferenceRemoteReferencePayload.Messages, and is useful for accessing the field via an interface.
+func (v *SetRemoteReferenceSetRemoteReferenceRemoteReferencePayload) GetMessages() []SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage {
+	return v.Messages
+}
+
+// SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage includes the requested fields of the GraphQL type ValidationMessage.
+// The GraphQL type's documentation follows.
+//
+// Validation messages are returned when mutation input does not meet the requirements.
+// While client-side validation is highly recommended to provide the best User Experience,
+// All inputs will always be validated server-side.
+//
+// Some examples of validations are:
+//
+// * Username must be at least 10 characters
+// * Email field does not contain an email address
+// * Birth Date is required
+//
+// While GraphQL has support for required values, mutation data fields are always
+// set to optional in our API. This allows 'required field' messages
+// to be returned in the same manner as other validations. The only exceptions
+// are id fields, which may be required to perform updates or deletes.
+type SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage struct {
+	// A unique error code for the type of validation used.
+	Code string `json:"code"`
+	// The input field that the error applies to. The field can be used to
+	// identify which field the error message should be displayed next to in the
+	// presentation layer.
+	//
+	// If there are multiple errors to display for a field, multiple validation
+	// messages will be in the result.
+	//
+	// This field may be null in cases where an error cannot be applied to a specific field.
+	Field string `json:"field"`
+	// A friendly error message, appropriate for display to the end user.
+	//
+	// The message is interpolated to include the appropriate variables.
+	//
+	// Example: `Username must be at least 10 characters`
+	//
+	// This message may change without notice, so we do not recommend you match against the text.
+	// Instead, use the *code* field for matching.
+	Message string `json:"message"`
+}
+
+// GetCode returns SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage.Code, and is useful for accessing the field via an interface.
+func (v *SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage) GetCode() string {
+	return v.Code
+}
+
+// GetField returns SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage.Field, and is useful for accessing the field via an interface.
+func (v *SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage) GetField() string {
+	return v.Field
+}
+
+// GetMessage returns SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage.Message, and is useful for accessing the field via an interface.
+func (v *SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadMessagesValidationMessage) GetMessage() string {
+	return v.Message
+}
+
+// SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference includes the requested fields of the GraphQL type RemoteReference.
+// The GraphQL type's documentation follows.
+//
+// A per-instance override of a single connection slot. The blueprint Link wires
+// a slot from a sibling package's output; a remote reference overrides that
+// wiring on one instance, pointing the slot at a resource from another project
+// (or an imported resource) instead.
+//
+// Remote references enable cross-project infrastructure sharing. For example, a
+// networking team provisions a VPC in one project, and application teams override
+// the `vpc` connection slot on their database/cache/etc. instances to point at
+// that shared VPC.
+//
+// Each remote reference binds a specific `field` on the instance — a key in the
+// instance's bundle's `connectionsSchema` — to the target resource. The override
+// takes priority over any blueprint-level Link on the same slot, and reverts to
+// the Link (or environment default) when removed.
+type SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference struct {
+	// Unique identifier for this remote reference.
+	Id string `json:"id"`
+	// The name of the resource field on the instance that this reference satisfies (e.g., `aws_authentication` or `vpc`).
+	Field string `json:"field"`
+	// When this remote reference was created (UTC).
+	CreatedAt time.Time `json:"createdAt"`
+	// When this remote reference was last modified (UTC).
+	UpdatedAt time.Time `json:"updatedAt"`
+	// The resource from another project (or an imported resource) that this reference points to.
+	Resource SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReferenceResource `json:"resource"`
+}
+
+// GetId returns SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference.Id, and is useful for accessing the field via an interface.
+func (v *SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference) GetId() string {
+	return v.Id
+}
+
+// GetField returns SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference.Field, and is useful for accessing the field via an interface.
+func (v *SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference) GetField() string {
+	return v.Field
+}
+
+// GetCreatedAt returns SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference.CreatedAt, and is useful for accessing the field via an interface.
+func (v *SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetUpdatedAt returns SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
+
+// GetResource returns SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference.Resource, and is useful for accessing the field via an interface.
+func (v *SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReference) GetResource() SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReferenceResource {
+	return v.Resource
+}
+
+// SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReferenceResource includes the requested fields of the GraphQL type Resource.
+// The GraphQL type's documentation follows.
+//
+// A cloud credential, database connection string, network configuration, or other
+// infrastructure output produced by (or imported into) Massdriver.
+//
+// Resources are the connective tissue between instances. When an instance is deployed, it
+// produces resources as outputs. Other instances can consume those resources as inputs,
+// creating a dependency graph of your infrastructure.
+//
+// Resources have two origins:
+// - **Imported** — created directly through the API (e.g., uploading existing AWS credentials).
+// You have full CRUD control over these resources.
+// - **Provisioned** — created automatically when an instance is deployed. These are read-only
+// and managed entirely by the owning instance's lifecycle.
+type SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReferenceResource struct {
+	// Unique identifier for this resource.
+	Id string `json:"id"`
+	// Human-readable display name for this resource.
+	Name string `json:"name"`
+}
+
+// GetId returns SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReferenceResource.Id, and is useful for accessing the field via an interface.
+func (v *SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReferenceResource) GetId() string {
+	return v.Id
+}
+
+// GetName returns SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReferenceResource.Name, and is useful for accessing the field via an interface.
+func (v *SetRemoteReferenceSetRemoteReferenceRemoteReferencePayloadResultRemoteReferenceResource) GetName() string {
+	return v.Name
+}
+
 // Sort direction for ordering paginated results.
 //
 // Applied via the `sort` argument on any list query. When combined with a sort field,
@@ -25020,6 +28126,22 @@ func (v *__ApproveDeploymentInput) GetOrganizationId() string { return v.Organiz
 // GetId returns __ApproveDeploymentInput.Id, and is useful for accessing the field via an interface.
 func (v *__ApproveDeploymentInput) GetId() string { return v.Id }
 
+// __CompareDeploymentsInput is used internally by genqlient
+type __CompareDeploymentsInput struct {
+	OrganizationId string `json:"organizationId"`
+	SourceId       string `json:"sourceId"`
+	TargetId       string `json:"targetId"`
+}
+
+// GetOrganizationId returns __CompareDeploymentsInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__CompareDeploymentsInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetSourceId returns __CompareDeploymentsInput.SourceId, and is useful for accessing the field via an interface.
+func (v *__CompareDeploymentsInput) GetSourceId() string { return v.SourceId }
+
+// GetTargetId returns __CompareDeploymentsInput.TargetId, and is useful for accessing the field via an interface.
+func (v *__CompareDeploymentsInput) GetTargetId() string { return v.TargetId }
+
 // __CopyInstanceInput is used internally by genqlient
 type __CopyInstanceInput struct {
 	OrganizationId string            `json:"organizationId"`
@@ -25564,6 +28686,18 @@ func (v *__GetDeploymentLogsInput) GetOrganizationId() string { return v.Organiz
 // GetId returns __GetDeploymentLogsInput.Id, and is useful for accessing the field via an interface.
 func (v *__GetDeploymentLogsInput) GetId() string { return v.Id }
 
+// __GetEnvironmentGraphInput is used internally by genqlient
+type __GetEnvironmentGraphInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
+}
+
+// GetOrganizationId returns __GetEnvironmentGraphInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__GetEnvironmentGraphInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __GetEnvironmentGraphInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetEnvironmentGraphInput) GetId() string { return v.Id }
+
 // __GetEnvironmentInput is used internally by genqlient
 type __GetEnvironmentInput struct {
 	OrganizationId string `json:"organizationId"`
@@ -25624,6 +28758,30 @@ func (v *__GetInstanceInput) GetOrganizationId() string { return v.OrganizationI
 // GetId returns __GetInstanceInput.Id, and is useful for accessing the field via an interface.
 func (v *__GetInstanceInput) GetId() string { return v.Id }
 
+// __GetInstanceRemoteReferencesInput is used internally by genqlient
+type __GetInstanceRemoteReferencesInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
+}
+
+// GetOrganizationId returns __GetInstanceRemoteReferencesInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__GetInstanceRemoteReferencesInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __GetInstanceRemoteReferencesInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetInstanceRemoteReferencesInput) GetId() string { return v.Id }
+
+// __GetInstanceSecretFieldsInput is used internally by genqlient
+type __GetInstanceSecretFieldsInput struct {
+	OrganizationId string `json:"organizationId"`
+	Id             string `json:"id"`
+}
+
+// GetOrganizationId returns __GetInstanceSecretFieldsInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__GetInstanceSecretFieldsInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __GetInstanceSecretFieldsInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetInstanceSecretFieldsInput) GetId() string { return v.Id }
+
 // __GetOciRepoInput is used internally by genqlient
 type __GetOciRepoInput struct {
 	OrganizationId string `json:"organizationId"`
@@ -25760,6 +28918,18 @@ func (v *__ListBundlesInput) GetSort() *BundlesSort { return v.Sort }
 // GetCursor returns __ListBundlesInput.Cursor, and is useful for accessing the field via an interface.
 func (v *__ListBundlesInput) GetCursor() *scalars.Cursor { return v.Cursor }
 
+// __ListComponentLinksInput is used internally by genqlient
+type __ListComponentLinksInput struct {
+	OrganizationId string `json:"organizationId"`
+	ProjectId      string `json:"projectId"`
+}
+
+// GetOrganizationId returns __ListComponentLinksInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__ListComponentLinksInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetProjectId returns __ListComponentLinksInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__ListComponentLinksInput) GetProjectId() string { return v.ProjectId }
+
 // __ListComponentsInput is used internally by genqlient
 type __ListComponentsInput struct {
 	OrganizationId string `json:"organizationId"`
@@ -25772,6 +28942,22 @@ func (v *__ListComponentsInput) GetOrganizationId() string { return v.Organizati
 // GetProjectId returns __ListComponentsInput.ProjectId, and is useful for accessing the field via an interface.
 func (v *__ListComponentsInput) GetProjectId() string { return v.ProjectId }
 
+// __ListCustomAttributesInput is used internally by genqlient
+type __ListCustomAttributesInput struct {
+	OrganizationId string                `json:"organizationId"`
+	Sort           *CustomAttributesSort `json:"sort,omitempty"`
+	Cursor         *scalars.Cursor       `json:"cursor,omitempty"`
+}
+
+// GetOrganizationId returns __ListCustomAttributesInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__ListCustomAttributesInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetSort returns __ListCustomAttributesInput.Sort, and is useful for accessing the field via an interface.
+func (v *__ListCustomAttributesInput) GetSort() *CustomAttributesSort { return v.Sort }
+
+// GetCursor returns __ListCustomAttributesInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__ListCustomAttributesInput) GetCursor() *scalars.Cursor { return v.Cursor }
+
 // __ListDeploymentsInput is used internally by genqlient
 type __ListDeploymentsInput struct {
 	OrganizationId string             `json:"organizationId"`
@@ -25792,6 +28978,46 @@ func (v *__ListDeploymentsInput) GetSort() *DeploymentsSort { return v.Sort }
 // GetCursor returns __ListDeploymentsInput.Cursor, and is useful for accessing the field via an interface.
 func (v *__ListDeploymentsInput) GetCursor() *scalars.Cursor { return v.Cursor }
 
+// __ListEnvironmentConnectionsInput is used internally by genqlient
+type __ListEnvironmentConnectionsInput struct {
+	OrganizationId string             `json:"organizationId"`
+	Id             string             `json:"id"`
+	Filter         *ConnectionsFilter `json:"filter,omitempty"`
+	Sort           *ConnectionsSort   `json:"sort,omitempty"`
+	Cursor         *scalars.Cursor    `json:"cursor,omitempty"`
+}
+
+// GetOrganizationId returns __ListEnvironmentConnectionsInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__ListEnvironmentConnectionsInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __ListEnvironmentConnectionsInput.Id, and is useful for accessing the field via an interface.
+func (v *__ListEnvironmentConnectionsInput) GetId() string { return v.Id }
+
+// GetFilter returns __ListEnvironmentConnectionsInput.Filter, and is useful for accessing the field via an interface.
+func (v *__ListEnvironmentConnectionsInput) GetFilter() *ConnectionsFilter { return v.Filter }
+
+// GetSort returns __ListEnvironmentConnectionsInput.Sort, and is useful for accessing the field via an interface.
+func (v *__ListEnvironmentConnectionsInput) GetSort() *ConnectionsSort { return v.Sort }
+
+// GetCursor returns __ListEnvironmentConnectionsInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__ListEnvironmentConnectionsInput) GetCursor() *scalars.Cursor { return v.Cursor }
+
+// __ListEnvironmentDefaultsInput is used internally by genqlient
+type __ListEnvironmentDefaultsInput struct {
+	OrganizationId string          `json:"organizationId"`
+	Id             string          `json:"id"`
+	Cursor         *scalars.Cursor `json:"cursor,omitempty"`
+}
+
+// GetOrganizationId returns __ListEnvironmentDefaultsInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__ListEnvironmentDefaultsInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __ListEnvironmentDefaultsInput.Id, and is useful for accessing the field via an interface.
+func (v *__ListEnvironmentDefaultsInput) GetId() string { return v.Id }
+
+// GetCursor returns __ListEnvironmentDefaultsInput.Cursor, and is useful for accessing the field via an interface.
+func (v *__ListEnvironmentDefaultsInput) GetCursor() *scalars.Cursor { return v.Cursor }
+
 // __ListEnvironmentsInput is used internally by genqlient
 type __ListEnvironmentsInput struct {
 	OrganizationId string              `json:"organizationId"`
@@ -26048,6 +29274,22 @@ func (v *__RemoveInstanceSecretInput) GetId() string { return v.Id }
 // GetName returns __RemoveInstanceSecretInput.Name, and is useful for accessing the field via an interface.
 func (v *__RemoveInstanceSecretInput) GetName() string { return v.Name }
 
+// __RemoveRemoteReferenceInput is used internally by genqlient
+type __RemoveRemoteReferenceInput struct {
+	OrganizationId string                     `json:"organizationId"`
+	InstanceId     string                     `json:"instanceId"`
+	Input          RemoveRemoteReferenceInput `json:"input"`
+}
+
+// GetOrganizationId returns __RemoveRemoteReferenceInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__RemoveRemoteReferenceInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetInstanceId returns __RemoveRemoteReferenceInput.InstanceId, and is useful for accessing the field via an interface.
+func (v *__RemoveRemoteReferenceInput) GetInstanceId() string { return v.InstanceId }
+
+// GetInput returns __RemoveRemoteReferenceInput.Input, and is useful for accessing the field via an interface.
+func (v *__RemoveRemoteReferenceInput) GetInput() RemoveRemoteReferenceInput { return v.Input }
+
 // __RemoveServiceAccountFromGroupInput is used internally by genqlient
 type __RemoveServiceAccountFromGroupInput struct {
 	OrganizationId   string `json:"organizationId"`
@@ -26078,6 +29320,22 @@ func (v *__RevokeAccessTokenInput) GetOrganizationId() string { return v.Organiz
 // GetId returns __RevokeAccessTokenInput.Id, and is useful for accessing the field via an interface.
 func (v *__RevokeAccessTokenInput) GetId() string { return v.Id }
 
+// __SetComponentPositionInput is used internally by genqlient
+type __SetComponentPositionInput struct {
+	OrganizationId string                    `json:"organizationId"`
+	Id             string                    `json:"id"`
+	Input          SetComponentPositionInput `json:"input"`
+}
+
+// GetOrganizationId returns __SetComponentPositionInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__SetComponentPositionInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetId returns __SetComponentPositionInput.Id, and is useful for accessing the field via an interface.
+func (v *__SetComponentPositionInput) GetId() string { return v.Id }
+
+// GetInput returns __SetComponentPositionInput.Input, and is useful for accessing the field via an interface.
+func (v *__SetComponentPositionInput) GetInput() SetComponentPositionInput { return v.Input }
+
 // __SetEnvironmentDefaultInput is used internally by genqlient
 type __SetEnvironmentDefaultInput struct {
 	OrganizationId string `json:"organizationId"`
@@ -26110,6 +29368,26 @@ func (v *__SetInstanceSecretInput) GetId() string { return v.Id }
 // GetInput returns __SetInstanceSecretInput.Input, and is useful for accessing the field via an interface.
 func (v *__SetInstanceSecretInput) GetInput() SetInstanceSecretInput { return v.Input }
 
+// __SetRemoteReferenceInput is used internally by genqlient
+type __SetRemoteReferenceInput struct {
+	OrganizationId string                  `json:"organizationId"`
+	InstanceId     string                  `json:"instanceId"`
+	ResourceId     string                  `json:"resourceId"`
+	Input          SetRemoteReferenceInput `json:"input"`
+}
+
+// GetOrganizationId returns __SetRemoteReferenceInput.OrganizationId, and is useful for accessing the field via an interface.
+func (v *__SetRemoteReferenceInput) GetOrganizationId() string { return v.OrganizationId }
+
+// GetInstanceId returns __SetRemoteReferenceInput.InstanceId, and is useful for accessing the field via an interface.
+func (v *__SetRemoteReferenceInput) GetInstanceId() string { return v.InstanceId }
+
+// GetResourceId returns __SetRemoteReferenceInput.ResourceId, and is useful for accessing the field via an interface.
+func (v *__SetRemoteReferenceInput) GetResourceId() string { return v.ResourceId }
+
+// GetInput returns __SetRemoteReferenceInput.Input, and is useful for accessing the field via an interface.
+func (v *__SetRemoteReferenceInput) GetInput() SetRemoteReferenceInput { return v.Input }
+
 // __UnlinkComponentsInput is used internally by genqlient
 type __UnlinkComponentsInput struct {
 	OrganizationId string `json:"organizationId"`
@@ -26564,6 +29842,74 @@ func ApproveDeployment(
 	return data_, err_
 }
 
+// The query executed by CompareDeployments.
+const CompareDeployments_Operation = `
+query CompareDeployments ($organizationId: ID!, $sourceId: UUID!, $targetId: UUID!) {
+	compareDeployments(organizationId: $organizationId, sourceId: $sourceId, targetId: $targetId) {
+		source {
+			id
+			status
+			action
+			version
+			createdAt
+		}
+		target {
+			id
+			status
+			action
+			version
+			createdAt
+		}
+		version {
+			source
+			target
+			equal
+		}
+		params {
+			path
+			source {
+				present
+				value
+			}
+			target {
+				present
+				value
+			}
+			equal
+		}
+	}
+}
+`
+
+func CompareDeployments(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	sourceId string,
+	targetId string,
+) (data_ *CompareDeploymentsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CompareDeployments",
+		Query:  CompareDeployments_Operation,
+		Variables: &__CompareDeploymentsInput{
+			OrganizationId: organizationId,
+			SourceId:       sourceId,
+			TargetId:       targetId,
+		},
+	}
+
+	data_ = &CompareDeploymentsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The mutation executed by CopyInstance.
 const CopyInstance_Operation = `
 mutation CopyInstance ($organizationId: ID!, $sourceId: ID!, $destinationId: ID!, $input: CopyInstanceInput!) {
@@ -28378,6 +31724,7 @@ query GetBundle ($organizationId: ID!, $id: BundleId!) {
 			resourceType {
 				id
 				name
+				connectionOrientation
 			}
 		}
 		resources {
@@ -28386,6 +31733,7 @@ query GetBundle ($organizationId: ID!, $id: BundleId!) {
 			resourceType {
 				id
 				name
+				connectionOrientation
 			}
 		}
 	}
@@ -28427,6 +31775,10 @@ query GetComponent ($organizationId: ID!, $id: ID!) {
 		name
 		description
 		attributes
+		position {
+			x
+			y
+		}
 		createdAt
 		updatedAt
 		ociRepo {
@@ -28668,6 +32020,109 @@ func GetEnvironment(
 	return data_, err_
 }
 
+// The query executed by GetEnvironmentGraph.
+const GetEnvironmentGraph_Operation = `
+query GetEnvironmentGraph ($organizationId: ID!, $id: ID!) {
+	environment(organizationId: $organizationId, id: $id) {
+		id
+		name
+		cost {
+			lastMonth {
+				amount
+				currency
+			}
+			monthlyAverage {
+				amount
+				currency
+			}
+			lastDay {
+				amount
+				currency
+			}
+			dailyAverage {
+				amount
+				currency
+			}
+		}
+		instances {
+			id
+			name
+			status
+			version
+			resolvedVersion
+			deployedVersion
+			cost {
+				lastMonth {
+					amount
+					currency
+				}
+				monthlyAverage {
+					amount
+					currency
+				}
+				lastDay {
+					amount
+					currency
+				}
+				dailyAverage {
+					amount
+					currency
+				}
+			}
+			bundle {
+				id
+				name
+				version
+			}
+			component {
+				id
+				name
+			}
+		}
+		connections {
+			id
+			fromField
+			toField
+			fromInstance {
+				id
+				name
+			}
+			toInstance {
+				id
+				name
+			}
+		}
+	}
+}
+`
+
+func GetEnvironmentGraph(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+) (data_ *GetEnvironmentGraphResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetEnvironmentGraph",
+		Query:  GetEnvironmentGraph_Operation,
+		Variables: &__GetEnvironmentGraphInput{
+			OrganizationId: organizationId,
+			Id:             id,
+		},
+	}
+
+	data_ = &GetEnvironmentGraphResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The query executed by GetGroup.
 const GetGroup_Operation = `
 query GetGroup ($organizationId: ID!, $id: UUID!) {
@@ -28762,6 +32217,7 @@ query GetInstance ($organizationId: ID!, $id: ID!) {
 		name
 		status
 		version
+		releaseStrategy
 		resolvedVersion
 		deployedVersion
 		availableUpgrade
@@ -28930,6 +32386,106 @@ func GetInstanceAlarm(
 	return data_, err_
 }
 
+// The query executed by GetInstanceRemoteReferences.
+const GetInstanceRemoteReferences_Operation = `
+query GetInstanceRemoteReferences ($organizationId: ID!, $id: ID!) {
+	instance(organizationId: $organizationId, id: $id) {
+		id
+		dependencies {
+			field
+			source {
+				__typename
+				... on RemoteReference {
+					id
+					field
+					createdAt
+					updatedAt
+					resource {
+						id
+						name
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+// Multi-line operation: genqlient v0.8.1 can't associate a `for:` directive
+// with a single-line operation — the parser attributes the comment to the
+// first variable definition on the same line and rejects `for:` with
+// "for is only applicable to operations and arguments". Every `for:`-bearing
+// mutation in this file is multi-line for the same reason.
+func GetInstanceRemoteReferences(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+) (data_ *GetInstanceRemoteReferencesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetInstanceRemoteReferences",
+		Query:  GetInstanceRemoteReferences_Operation,
+		Variables: &__GetInstanceRemoteReferencesInput{
+			OrganizationId: organizationId,
+			Id:             id,
+		},
+	}
+
+	data_ = &GetInstanceRemoteReferencesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetInstanceSecretFields.
+const GetInstanceSecretFields_Operation = `
+query GetInstanceSecretFields ($organizationId: ID!, $id: ID!) {
+	instance(organizationId: $organizationId, id: $id) {
+		id
+		secretFields {
+			name
+			required
+			title
+			description
+			sha256
+		}
+	}
+}
+`
+
+func GetInstanceSecretFields(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+) (data_ *GetInstanceSecretFieldsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetInstanceSecretFields",
+		Query:  GetInstanceSecretFields_Operation,
+		Variables: &__GetInstanceSecretFieldsInput{
+			OrganizationId: organizationId,
+			Id:             id,
+		},
+	}
+
+	data_ = &GetInstanceSecretFieldsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The query executed by GetOciRepo.
 const GetOciRepo_Operation = `
 query GetOciRepo ($organizationId: ID!, $id: ID!) {
@@ -29069,6 +32625,10 @@ query GetProject ($organizationId: ID!, $id: ID!) {
 			name
 			description
 			attributes
+			position {
+				x
+				y
+			}
 			createdAt
 			updatedAt
 			ociRepo {
@@ -29548,7 +33108,111 @@ func ListBundles(
 		},
 	}
 
-	data_ = &ListBundlesResponse{}
+	data_ = &ListBundlesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ListComponentLinks.
+const ListComponentLinks_Operation = `
+query ListComponentLinks ($organizationId: ID!, $projectId: ID!) {
+	project(organizationId: $organizationId, id: $projectId) {
+		id
+		links {
+			id
+			fromField
+			toField
+			createdAt
+			updatedAt
+			fromComponent {
+				id
+				name
+			}
+			toComponent {
+				id
+				name
+			}
+		}
+	}
+}
+`
+
+func ListComponentLinks(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	projectId string,
+) (data_ *ListComponentLinksResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListComponentLinks",
+		Query:  ListComponentLinks_Operation,
+		Variables: &__ListComponentLinksInput{
+			OrganizationId: organizationId,
+			ProjectId:      projectId,
+		},
+	}
+
+	data_ = &ListComponentLinksResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ListComponents.
+const ListComponents_Operation = `
+query ListComponents ($organizationId: ID!, $projectId: ID!) {
+	project(organizationId: $organizationId, id: $projectId) {
+		id
+		components {
+			id
+			name
+			description
+			attributes
+			position {
+				x
+				y
+			}
+			createdAt
+			updatedAt
+			ociRepo {
+				id
+				name
+				reference
+			}
+		}
+	}
+}
+`
+
+func ListComponents(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	projectId string,
+) (data_ *ListComponentsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListComponents",
+		Query:  ListComponents_Operation,
+		Variables: &__ListComponentsInput{
+			OrganizationId: organizationId,
+			ProjectId:      projectId,
+		},
+	}
+
+	data_ = &ListComponentsResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -29560,44 +33224,48 @@ func ListBundles(
 	return data_, err_
 }
 
-// The query executed by ListComponents.
-const ListComponents_Operation = `
-query ListComponents ($organizationId: ID!, $projectId: ID!) {
-	project(organizationId: $organizationId, id: $projectId) {
+// The query executed by ListCustomAttributes.
+const ListCustomAttributes_Operation = `
+query ListCustomAttributes ($organizationId: ID!, $sort: CustomAttributesSort, $cursor: Cursor) {
+	organization(organizationId: $organizationId) {
 		id
-		components {
-			id
-			name
-			description
-			attributes
-			createdAt
-			updatedAt
-			ociRepo {
+		customAttributes(sort: $sort, cursor: $cursor) {
+			cursor {
+				next
+				previous
+			}
+			items {
 				id
-				name
-				reference
+				key
+				scope
+				required
+				values
+				createdAt
+				updatedAt
 			}
 		}
 	}
 }
 `
 
-func ListComponents(
+func ListCustomAttributes(
 	ctx_ context.Context,
 	client_ graphql.Client,
 	organizationId string,
-	projectId string,
-) (data_ *ListComponentsResponse, err_ error) {
+	sort *CustomAttributesSort,
+	cursor *scalars.Cursor,
+) (data_ *ListCustomAttributesResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "ListComponents",
-		Query:  ListComponents_Operation,
-		Variables: &__ListComponentsInput{
+		OpName: "ListCustomAttributes",
+		Query:  ListCustomAttributes_Operation,
+		Variables: &__ListCustomAttributesInput{
 			OrganizationId: organizationId,
-			ProjectId:      projectId,
+			Sort:           sort,
+			Cursor:         cursor,
 		},
 	}
 
-	data_ = &ListComponentsResponse{}
+	data_ = &ListCustomAttributesResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -29631,6 +33299,16 @@ query ListDeployments ($organizationId: ID!, $filter: DeploymentsFilter, $sort:
 			instance {
 				id
 				name
+				environment {
+					id
+					project {
+						id
+					}
+				}
+				bundle {
+					id
+					name
+				}
 			}
 		}
 	}
@@ -29668,6 +33346,134 @@ func ListDeployments(
 	return data_, err_
 }
 
+// The query executed by ListEnvironmentConnections.
+const ListEnvironmentConnections_Operation = `
+query ListEnvironmentConnections ($organizationId: ID!, $id: ID!, $filter: ConnectionsFilter, $sort: ConnectionsSort, $cursor: Cursor) {
+	environment(organizationId: $organizationId, id: $id) {
+		id
+		blueprint {
+			connections(filter: $filter, sort: $sort, cursor: $cursor) {
+				cursor {
+					next
+					previous
+				}
+				items {
+					id
+					fromField
+					toField
+					createdAt
+					updatedAt
+					fromInstance {
+						id
+						name
+					}
+					toInstance {
+						id
+						name
+					}
+					link {
+						id
+						fromField
+						toField
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func ListEnvironmentConnections(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+	filter *ConnectionsFilter,
+	sort *ConnectionsSort,
+	cursor *scalars.Cursor,
+) (data_ *ListEnvironmentConnectionsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListEnvironmentConnections",
+		Query:  ListEnvironmentConnections_Operation,
+		Variables: &__ListEnvironmentConnectionsInput{
+			OrganizationId: organizationId,
+			Id:             id,
+			Filter:         filter,
+			Sort:           sort,
+			Cursor:         cursor,
+		},
+	}
+
+	data_ = &ListEnvironmentConnectionsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ListEnvironmentDefaults.
+const ListEnvironmentDefaults_Operation = `
+query ListEnvironmentDefaults ($organizationId: ID!, $id: ID!, $cursor: Cursor) {
+	environment(organizationId: $organizationId, id: $id) {
+		id
+		defaults(cursor: $cursor) {
+			cursor {
+				next
+				previous
+			}
+			items {
+				id
+				createdAt
+				updatedAt
+				resource {
+					id
+					name
+					resourceType {
+						id
+						name
+						icon
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func ListEnvironmentDefaults(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+	cursor *scalars.Cursor,
+) (data_ *ListEnvironmentDefaultsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListEnvironmentDefaults",
+		Query:  ListEnvironmentDefaults_Operation,
+		Variables: &__ListEnvironmentDefaultsInput{
+			OrganizationId: organizationId,
+			Id:             id,
+			Cursor:         cursor,
+		},
+	}
+
+	data_ = &ListEnvironmentDefaultsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The query executed by ListEnvironments.
 const ListEnvironments_Operation = `
 query ListEnvironments ($organizationId: ID!, $filter: EnvironmentsFilter, $sort: EnvironmentsSort, $cursor: Cursor) {
@@ -30588,6 +34394,53 @@ func RemoveInstanceSecret(
 	return data_, err_
 }
 
+// The mutation executed by RemoveRemoteReference.
+const RemoveRemoteReference_Operation = `
+mutation RemoveRemoteReference ($organizationId: ID!, $instanceId: ID!, $input: RemoveRemoteReferenceInput!) {
+	removeRemoteReference(organizationId: $organizationId, instanceId: $instanceId, input: $input) {
+		result {
+			id
+			field
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func RemoveRemoteReference(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	instanceId string,
+	input RemoveRemoteReferenceInput,
+) (data_ *RemoveRemoteReferenceResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "RemoveRemoteReference",
+		Query:  RemoveRemoteReference_Operation,
+		Variables: &__RemoveRemoteReferenceInput{
+			OrganizationId: organizationId,
+			InstanceId:     instanceId,
+			Input:          input,
+		},
+	}
+
+	data_ = &RemoveRemoteReferenceResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The mutation executed by RemoveServiceAccountFromGroup.
 const RemoveServiceAccountFromGroup_Operation = `
 mutation RemoveServiceAccountFromGroup ($organizationId: ID!, $serviceAccountId: UUID!, $groupId: UUID!) {
@@ -30682,6 +34535,57 @@ func RevokeAccessToken(
 	return data_, err_
 }
 
+// The mutation executed by SetComponentPosition.
+const SetComponentPosition_Operation = `
+mutation SetComponentPosition ($organizationId: ID!, $id: ID!, $input: SetComponentPositionInput!) {
+	setComponentPosition(organizationId: $organizationId, id: $id, input: $input) {
+		result {
+			id
+			name
+			position {
+				x
+				y
+			}
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func SetComponentPosition(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	id string,
+	input SetComponentPositionInput,
+) (data_ *SetComponentPositionResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SetComponentPosition",
+		Query:  SetComponentPosition_Operation,
+		Variables: &__SetComponentPositionInput{
+			OrganizationId: organizationId,
+			Id:             id,
+			Input:          input,
+		},
+	}
+
+	data_ = &SetComponentPositionResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The mutation executed by SetEnvironmentDefault.
 const SetEnvironmentDefault_Operation = `
 mutation SetEnvironmentDefault ($organizationId: ID!, $environmentId: ID!, $resourceId: ID!) {
@@ -30788,6 +34692,61 @@ func SetInstanceSecret(
 	return data_, err_
 }
 
+// The mutation executed by SetRemoteReference.
+const SetRemoteReference_Operation = `
+mutation SetRemoteReference ($organizationId: ID!, $instanceId: ID!, $resourceId: ID!, $input: SetRemoteReferenceInput!) {
+	setRemoteReference(organizationId: $organizationId, instanceId: $instanceId, resourceId: $resourceId, input: $input) {
+		result {
+			id
+			field
+			createdAt
+			updatedAt
+			resource {
+				id
+				name
+			}
+		}
+		successful
+		messages {
+			code
+			field
+			message
+		}
+	}
+}
+`
+
+func SetRemoteReference(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	organizationId string,
+	instanceId string,
+	resourceId string,
+	input SetRemoteReferenceInput,
+) (data_ *SetRemoteReferenceResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SetRemoteReference",
+		Query:  SetRemoteReference_Operation,
+		Variables: &__SetRemoteReferenceInput{
+			OrganizationId: organizationId,
+			InstanceId:     instanceId,
+			ResourceId:     resourceId,
+			Input:          input,
+		},
+	}
+
+	data_ = &SetRemoteReferenceResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The mutation executed by UnlinkComponents.
 const UnlinkComponents_Operation = `
 mutation UnlinkComponents ($organizationId: ID!, $id: UUID!) {
@@ -31073,11 +35032,6 @@ mutation UpdateInstance ($organizationId: ID!, $id: ID!, $input: UpdateInstanceI
 }
 `
 
-// Multi-line operation: genqlient v0.8.1 can't associate a `for:` directive
-// with a single-line operation — the parser attributes the comment to the
-// first variable definition on the same line and rejects `for:` with
-// "for is only applicable to operations and arguments". Every `for:`-bearing
-// mutation in this file is multi-line for the same reason.
 func UpdateInstance(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -0,0 +1,29 @@
+// Package iteropt holds the resolved form of the public paging options.
+// It sits apart from both paging packages so the public one can keep the
+// type out of its API while the iterator machinery reads it.
+package iteropt
+
+// Config is the resolved set of paging options for one Iter call.
+type Config struct {
+	// Prefetch is the number of pages fetched ahead of the consumer.
+	Prefetch int
+	// Resume reports whether iteration starts at ResumeCursor, skipping
+	// its first ResumeOffset items, instead of the input's After cursor.
+	Resume       bool
+	ResumeCursor string
+	ResumeOffset int
+	// Track, when non-nil, receives the position that resumes after each
+	// item, just before that item is yielded.
+	Track func(cursor string, offset int)
+}
+
+// Apply resolves opts into a [Config]. Nil options are skipped.
+func Apply[O ~func(*Config)](opts ...O) Config {
+	var c Config
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&c)
+		}
+	}
+	return c
+}
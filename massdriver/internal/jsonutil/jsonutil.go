@@ -0,0 +1,41 @@
+// Package jsonutil compares loosely-typed values as the JSON they encode
+// to, so a YAML int, a json.Number from a file, and a float64 from the
+// API all match.
+package jsonutil
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// Normalize round-trips v through encoding/json. A value that doesn't
+// marshal is returned unchanged.
+func Normalize(v any) any {
+	if v == nil {
+		return nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var out any
+	if err := json.Unmarshal(b, &out); err != nil {
+		return v
+	}
+	return out
+}
+
+// Equal reports whether a and b encode to the same JSON value. Nil and
+// an empty object are equal: the API reports unset maps either way.
+func Equal(a, b any) bool {
+	na, nb := Normalize(a), Normalize(b)
+	if empty(na) && empty(nb) {
+		return true
+	}
+	return reflect.DeepEqual(na, nb)
+}
+
+func empty(v any) bool {
+	m, ok := v.(map[string]any)
+	return v == nil || ok && len(m) == 0
+}
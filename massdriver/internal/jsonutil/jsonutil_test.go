@@ -0,0 +1,30 @@
+package jsonutil_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/jsonutil"
+)
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b any
+		want bool
+	}{
+		{"int and float", map[string]any{"size": 3}, map[string]any{"size": 3.0}, true},
+		{"json.Number", map[string]any{"size": json.Number("3")}, map[string]any{"size": 3.0}, true},
+		{"nested", map[string]any{"db": map[string]any{"replicas": 2}}, map[string]any{"db": map[string]any{"replicas": 3}}, false},
+		{"nil and empty", map[string]any(nil), map[string]any{}, true},
+		{"nil and value", nil, map[string]any{"a": "b"}, false},
+		{"scalars", "us-east-1", "us-east-1", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jsonutil.Equal(tt.a, tt.b); got != tt.want {
+				t.Errorf("Equal(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -8,9 +8,15 @@ import (
 	"context"
 	"iter"
 
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/iteropt"
+	pub "github.com/massdriver-cloud/massdriver-sdk-go/massdriver/paging"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
 )
 
+// Option is the public per-call iteration option, re-exported so service
+// packages can name it without importing two packages called paging.
+type Option = pub.Option
+
 // FetchFunc retrieves a single page for an entity, given an opaque "after"
 // cursor ("" selects the first page). It returns the page (whose Next drives
 // further iteration) or an error.
@@ -18,27 +24,141 @@ type FetchFunc[T any] func(ctx context.Context, after string) (types.Page[T], er
 
 // Iter returns a lazy iterator over every item matching a request, fetching
 // pages on demand via fetch starting from the after cursor. The yielded error
-// is non-nil exactly once, when a page fetch fails, after which iteration
-// stops. Breaking out of the range loop stops requesting further pages.
-func Iter[T any](ctx context.Context, after string, fetch FetchFunc[T]) iter.Seq2[T, error] {
+// is non-nil exactly once, when a page fetch fails or ctx ends before the
+// last page, after which iteration stops. Breaking out of the range loop
+// stops requesting further pages.
+//
+// opts may enable read-ahead ([pub.Prefetch]), start from a checkpoint
+// ([pub.ResumeFrom]), or report checkpoints as items are yielded.
+func Iter[T any](ctx context.Context, after string, fetch FetchFunc[T], opts ...Option) iter.Seq2[T, error] {
+	cfg := iteropt.Apply(opts...)
+	skip := 0
+	if cfg.Resume {
+		after, skip = cfg.ResumeCursor, cfg.ResumeOffset
+	}
 	return func(yield func(T, error) bool) {
-		cursor := after
+		next, stop := pages(ctx, after, fetch, cfg.Prefetch)
+		defer stop()
+		last := false
 		for {
-			page, err := fetch(ctx, cursor)
-			if err != nil {
+			f, ok := next()
+			if !ok {
+				// The pages ended without the last one or an error: ctx
+				// cut them short, and a truncated listing must not look
+				// complete.
+				if err := ctx.Err(); err != nil && !last {
+					var zero T
+					yield(zero, err)
+				}
+				return
+			}
+			if f.err != nil {
 				var zero T
-				yield(zero, err)
+				yield(zero, f.err)
 				return
 			}
-			for _, item := range page.Items {
-				if !yield(item, nil) {
+			items := f.page.Items
+			for i := skip; i < len(items); i++ {
+				if cfg.Track != nil {
+					cfg.Track(positionAfter(f.cursor, f.page, i))
+				}
+				if !yield(items[i], nil) {
 					return
 				}
 			}
-			if page.Next == "" {
+			skip = 0
+			last = f.page.Next == ""
+		}
+	}
+}
+
+// positionAfter is the cursor and offset that resume just after item i
+// of page (fetched with cursor). Past a page's last item it points at the
+// start of the next page, so a resume doesn't refetch a spent page.
+func positionAfter[T any](cursor string, page types.Page[T], i int) (string, int) {
+	if i+1 == len(page.Items) && page.Next != "" {
+		return page.Next, 0
+	}
+	return cursor, i + 1
+}
+
+// fetched is one page (or the error fetching it) together with the
+// cursor that fetched it.
+type fetched[T any] struct {
+	cursor string
+	page   types.Page[T]
+	err    error
+}
+
+// pages returns a pull function over successive pages starting at after,
+// and a stop function the caller must defer. next reports false once the
+// last page (or an error) has been delivered. With prefetch > 0 a
+// goroutine runs up to that many pages ahead.
+func pages[T any](ctx context.Context, after string, fetch FetchFunc[T], prefetch int) (next func() (fetched[T], bool), stop func()) {
+	if prefetch > 0 {
+		return prefetchPages(ctx, after, fetch, prefetch)
+	}
+	cursor, done := after, false
+	next = func() (fetched[T], bool) {
+		if done {
+			return fetched[T]{}, false
+		}
+		page, err := fetch(ctx, cursor)
+		f := fetched[T]{cursor: cursor, page: page, err: err}
+		done = err != nil || page.Next == ""
+		cursor = page.Next
+		return f, true
+	}
+	return next, func() {}
+}
+
+// prefetchPages runs fetch on a producer goroutine up to n pages ahead.
+// The channel buffer bounds memory at n+2 pages (n buffered, one in
+// flight, one being consumed); stop cancels the producer mid-request
+// and waits for it to exit, so no goroutine outlives the loop.
+//
+// Only stop abandons pages. When ctx ends the producer still hands over
+// the failed fetch, so the consumer sees the error instead of a short
+// listing.
+func prefetchPages[T any](ctx context.Context, after string, fetch FetchFunc[T], n int) (next func() (fetched[T], bool), stop func()) {
+	fetchCtx, cancel := context.WithCancel(ctx)
+	quit := make(chan struct{})
+	ch := make(chan fetched[T], n)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		defer close(ch)
+		cursor := after
+		for {
+			var f fetched[T]
+			if err := ctx.Err(); err != nil {
+				f = fetched[T]{cursor: cursor, err: err}
+			} else {
+				page, err := fetch(fetchCtx, cursor)
+				f = fetched[T]{cursor: cursor, page: page, err: err}
+			}
+			select {
+			case ch <- f:
+			case <-quit:
+				return
+			}
+			page, err := f.page, f.err
+			if err != nil || page.Next == "" {
 				return
 			}
 			cursor = page.Next
 		}
+	}()
+
+	next = func() (fetched[T], bool) {
+		f, ok := <-ch
+		return f, ok
+	}
+	stop = func() {
+		close(quit)
+		cancel()
+		<-done
 	}
+	return next, stop
 }
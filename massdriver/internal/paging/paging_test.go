@@ -0,0 +1,132 @@
+package paging_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/paging"
+	pub "github.com/massdriver-cloud/massdriver-sdk-go/massdriver/paging"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// numbers serves pages of size items counting up from 0, ending after
+// pages pages. It ignores ctx, like a fetch whose request already
+// completed when ctx ended.
+func numbers(size, pages int) paging.FetchFunc[int] {
+	return func(_ context.Context, after string) (types.Page[int], error) {
+		n := 0
+		if after != "" {
+			n, _ = strconv.Atoi(after)
+		}
+		var p types.Page[int]
+		for i := range size {
+			p.Items = append(p.Items, n*size+i)
+		}
+		if n+1 < pages {
+			p.Next = strconv.Itoa(n + 1)
+		}
+		return p, nil
+	}
+}
+
+func TestIter(t *testing.T) {
+	for _, prefetch := range []int{0, 2} {
+		t.Run(fmt.Sprintf("prefetch=%d", prefetch), func(t *testing.T) {
+			var got []int
+			for v, err := range paging.Iter(t.Context(), "", numbers(3, 3), pub.Prefetch(prefetch)) {
+				if err != nil {
+					t.Fatalf("Iter: %v", err)
+				}
+				got = append(got, v)
+			}
+			if want := []int{0, 1, 2, 3, 4, 5, 6, 7, 8}; !slices.Equal(got, want) {
+				t.Errorf("items = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestIter_FetchError(t *testing.T) {
+	boom := errors.New("boom")
+	fetch := func(ctx context.Context, after string) (types.Page[int], error) {
+		if after == "1" {
+			return types.Page[int]{}, boom
+		}
+		return numbers(2, 3)(ctx, after)
+	}
+	var got []int
+	var gotErr error
+	for v, err := range paging.Iter(t.Context(), "", fetch, pub.Prefetch(1)) {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		got = append(got, v)
+	}
+	if !errors.Is(gotErr, boom) || !slices.Equal(got, []int{0, 1}) {
+		t.Errorf("items %v, err %v; want [0 1] then boom", got, gotErr)
+	}
+}
+
+// TestIter_PrefetchCancelled confirms a cancelled ctx ends a prefetching
+// iteration with an error rather than quietly, so a truncated listing
+// can't pass for a complete one.
+func TestIter_PrefetchCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	var got []int
+	var gotErr error
+	for v, err := range paging.Iter(ctx, "", numbers(2, 100), pub.Prefetch(3)) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		got = append(got, v)
+		if len(got) == 3 {
+			cancel()
+		}
+	}
+	if !errors.Is(gotErr, context.Canceled) {
+		t.Errorf("err = %v after %d items, want context.Canceled", gotErr, len(got))
+	}
+	if len(got) >= 200 {
+		t.Errorf("read all %d items despite the cancel", len(got))
+	}
+
+	// Already cancelled before the first page.
+	gotErr = nil
+	for _, err := range paging.Iter(ctx, "", numbers(2, 100), pub.Prefetch(3)) {
+		gotErr = err
+		break
+	}
+	if !errors.Is(gotErr, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", gotErr)
+	}
+}
+
+// TestIter_BreakStopsPrefetch confirms breaking out of the loop stops the
+// producer: it fetches at most the read-ahead bound past the last page
+// consumed.
+func TestIter_BreakStopsPrefetch(t *testing.T) {
+	fetches := 0
+	fetch := func(ctx context.Context, after string) (types.Page[int], error) {
+		fetches++
+		return numbers(1, 1000)(ctx, after)
+	}
+	for v, err := range paging.Iter(t.Context(), "", fetch, pub.Prefetch(2)) {
+		if err != nil {
+			t.Fatalf("Iter: %v", err)
+		}
+		if v == 1 {
+			break
+		}
+	}
+	// Two consumed, two buffered, one in flight.
+	if fetches > 5 {
+		t.Errorf("fetches = %d, want at most 5", fetches)
+	}
+}
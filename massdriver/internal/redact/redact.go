@@ -0,0 +1,125 @@
+// Package redact scrubs credentials and secret material out of values
+// before the SDK logs them. It is deliberately conservative: when a key
+// looks sensitive its value is replaced, even if that occasionally hides
+// something harmless.
+package redact
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+// Placeholder replaces every redacted value.
+const Placeholder = "[REDACTED]"
+
+// sensitiveFragments mark an object key as sensitive wherever they appear
+// in it, so "db_password", "apiToken", "client_secret", and
+// "privateKeyPem" are all caught. Keys are compared lowercased with "_",
+// "-", and "." removed.
+var sensitiveFragments = []string{
+	"apikey",
+	"authorization",
+	"credential",
+	"passphrase",
+	"passwd",
+	"password",
+	"privatekey",
+	"secret",
+	"token",
+}
+
+// sensitiveKeys are object keys whose values are always replaced,
+// compared the same way but whole. "payload" covers resource payloads,
+// which routinely carry connection strings and cloud credentials.
+var sensitiveKeys = map[string]bool{
+	"payload": true,
+}
+
+// sensitiveParams are URL query parameters replaced by [URL].
+var sensitiveParams = []string{"token", "api_key"}
+
+// Variables returns a copy of a GraphQL operation's variables, decoded
+// to plain maps and slices, with sensitive values replaced. Operations
+// whose name mentions "Secret" also have every "value" key redacted —
+// that is where instance secret values travel.
+//
+// vars may be anything that marshals to JSON (genqlient passes a
+// generated struct). If it doesn't marshal, the placeholder is returned
+// rather than risking a raw dump.
+func Variables(op string, vars any) any {
+	if vars == nil {
+		return nil
+	}
+	body, err := json.Marshal(vars)
+	if err != nil {
+		return Placeholder
+	}
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return Placeholder
+	}
+	return walk(v, strings.Contains(op, "Secret"))
+}
+
+func walk(v any, secretOp bool) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, child := range t {
+			if sensitive(k) || (secretOp && strings.EqualFold(k, "value")) {
+				t[k] = Placeholder
+				continue
+			}
+			t[k] = walk(child, secretOp)
+		}
+		return t
+	case []any:
+		for i, child := range t {
+			t[i] = walk(child, secretOp)
+		}
+		return t
+	default:
+		return v
+	}
+}
+
+// sensitive reports whether values under key k are redacted.
+func sensitive(k string) bool {
+	k = strings.NewReplacer("_", "", "-", "", ".", "").Replace(strings.ToLower(k))
+	if sensitiveKeys[k] {
+		return true
+	}
+	for _, f := range sensitiveFragments {
+		if strings.Contains(k, f) {
+			return true
+		}
+	}
+	return false
+}
+
+// URL returns raw with the values of credential-bearing query
+// parameters (the socket's token, for one) replaced. Unparseable input
+// is returned as the placeholder.
+func URL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return Placeholder
+	}
+	q := u.Query()
+	changed := false
+	for _, p := range sensitiveParams {
+		if q.Has(p) {
+			q.Set(p, Placeholder)
+			changed = true
+		}
+	}
+	if u.User != nil {
+		u.User = url.User(u.User.Username())
+		changed = true
+	}
+	if !changed {
+		return raw
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
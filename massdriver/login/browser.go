@@ -0,0 +1,118 @@
+package login
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"sync/atomic"
+	"time"
+)
+
+// callbackPath is where the loopback listener expects the server to
+// redirect after sign-in.
+const callbackPath = "/callback"
+
+// callbackPage is served to the browser once the callback is handled.
+const callbackPage = `<!doctype html><title>Massdriver</title><p>Signed in. You can close this window and return to your terminal.</p>`
+
+// browserFlow listens on a loopback port, sends the user to the login
+// URL with that port as redirect_uri, and waits for the callback to
+// deliver the session credential. Callbacks without the expected state
+// are answered 400 and ignored; the wait ends at the first callback that
+// carries it, or when ctx is done.
+func browserFlow(ctx context.Context, loginURL, state string, open func(context.Context, string) error, out io.Writer) (string, error) {
+	var lc net.ListenConfig
+	ln, err := lc.Listen(ctx, "tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", fmt.Errorf("listen for login callback: %w", err)
+	}
+	redirectURI := "http://" + ln.Addr().String() + callbackPath
+
+	type result struct {
+		token string
+		err   error
+	}
+	results := make(chan result, 1)
+	var rejected atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc(callbackPath, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		// A request without our state isn't the callback — a stray or
+		// forged hit on the port. Turn it away and keep waiting.
+		if subtle.ConstantTimeCompare([]byte(q.Get("state")), []byte(state)) != 1 {
+			rejected.Add(1)
+			http.Error(w, ErrStateMismatch.Error(), http.StatusBadRequest)
+			return
+		}
+		var res result
+		switch {
+		case q.Get("error") != "":
+			res.err = fmt.Errorf("server reported login error: %s", q.Get("error"))
+		case q.Get("token") == "":
+			res.err = errors.New("login callback carried no token")
+		default:
+			res.token = q.Get("token")
+		}
+		if res.err != nil {
+			http.Error(w, res.err.Error(), http.StatusBadRequest)
+		} else {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = io.WriteString(w, callbackPage)
+		}
+		select {
+		case results <- res:
+		default:
+		}
+	})
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() { _ = srv.Serve(ln) }()
+	defer srv.Close()
+
+	target, err := withParams(loginURL, redirectURI, state)
+	if err != nil {
+		return "", err
+	}
+	if open == nil {
+		open = openBrowser
+	}
+	fmt.Fprintf(out, "Opening your browser to sign in. If it doesn't open, visit:\n\n  %s\n\n", target)
+	if err := open(ctx, target); err != nil {
+		// The URL is already printed; the user can still finish by hand.
+		fmt.Fprintf(out, "Could not open a browser: %v\n", err)
+	}
+
+	select {
+	case res := <-results:
+		return res.token, res.err
+	case <-ctx.Done():
+		if n := rejected.Load(); n > 0 {
+			return "", fmt.Errorf("%w (after rejecting %d callback(s): %w)", ctx.Err(), n, ErrStateMismatch)
+		}
+		return "", ctx.Err()
+	}
+}
+
+// openBrowser hands url to the operating system's default handler.
+func openBrowser(ctx context.Context, url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.CommandContext(ctx, "open", url)
+	case "windows":
+		cmd = exec.CommandContext(ctx, "rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.CommandContext(ctx, "xdg-open", url)
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	// Reap the launcher; it exits as soon as it has handed off the URL.
+	go func() { _ = cmd.Wait() }()
+	return nil
+}
@@ -0,0 +1,285 @@
+// Package login signs a person in to Massdriver interactively and
+// persists a freshly minted personal access token (PAT) into a profile
+// in ~/.config/massdriver/config.yaml, so later [massdriver.NewClient]
+// calls pick it up without any copy-and-paste.
+//
+// The flow:
+//
+//  1. Read the server's login methods via the unauthenticated `server`
+//     query ([server.Service.Get]).
+//  2. Send the user to the chosen SSO provider's loginUrl (or the web
+//     app's login page when no SSO provider is configured). With
+//     [FlowBrowser] the SDK listens on a loopback port and the server
+//     redirects back to it; with [FlowCode] the user copies the one-time
+//     code the web app shows after sign-in and pastes it at the prompt —
+//     the shape to use over SSH or in a container without a browser.
+//  3. Use that short-lived session credential to create a PAT with the
+//     requested expiry ([accesstokens.Service.Create]).
+//  4. Write the PAT to the named profile ([config.SaveProfile]), keeping
+//     the profile's fields other than organization, key, and URL.
+//
+// [FlowBrowser] relies on the server honouring two query parameters on
+// the login URL: `redirect_uri` and `state`. After sign-in the server
+// redirects to redirect_uri with `token` and the echoed `state`; the SDK
+// answers callbacks whose state doesn't match with 400 and keeps waiting
+// for the real one. [FlowCode] sends neither: the pasted code comes
+// straight from the user, so there is no callback to check.
+package login
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/config"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/client"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/accesstokens"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/server"
+)
+
+// DefaultExpiry is how long the minted token stays valid when
+// [Options.ExpiresIn] is zero.
+const DefaultExpiry = 90 * 24 * time.Hour
+
+// Flow selects how the session credential travels back to the SDK.
+type Flow string
+
+const (
+	// FlowBrowser opens the login URL in a browser and receives the
+	// session credential on a loopback HTTP listener. The default.
+	FlowBrowser Flow = "browser"
+	// FlowCode prints the login URL and reads the one-time code the web
+	// app displays after sign-in from [Options.In]. Use it where no
+	// browser can reach the loopback address (SSH sessions, containers).
+	FlowCode Flow = "code"
+)
+
+// ErrStateMismatch marks a login callback carrying a state value other
+// than the one the SDK generated — the request belongs to a different
+// login attempt (or was forged) and is discarded. [Login] only returns
+// it, wrapped alongside ctx's error, when ctx ends after such callbacks
+// and before a valid one.
+var ErrStateMismatch = errors.New("login callback state mismatch")
+
+// ErrNoLoginMethod is returned when the server advertises neither an SSO
+// provider nor an app URL to sign in at.
+var ErrNoLoginMethod = errors.New("server advertises no login method")
+
+// Options configures [Login]. Only OrganizationID is required.
+type Options struct {
+	// OrganizationID is the organization the token (and profile) is for.
+	OrganizationID string
+	// URL is the Massdriver API base URL. Empty selects
+	// [config.DefaultURL].
+	URL string
+	// Profile is the config-file profile to write. Empty selects
+	// "default".
+	Profile string
+	// Provider picks an SSO provider by name (e.g. "google"). Empty
+	// uses the first provider the server lists, or the web app's login
+	// page when none are configured.
+	Provider string
+	// Flow selects browser or code login. Empty selects [FlowBrowser].
+	Flow Flow
+
+	// TokenName labels the minted token. Empty generates
+	// "massdriver-sdk login (<hostname>)".
+	TokenName string
+	// ExpiresIn is how long the minted token stays valid, rounded down
+	// to whole minutes. Zero selects [DefaultExpiry].
+	ExpiresIn time.Duration
+
+	// OpenBrowser is called with the login URL in [FlowBrowser]. Nil
+	// uses the platform's default URL handler (open, xdg-open,
+	// rundll32).
+	OpenBrowser func(ctx context.Context, url string) error
+	// Out receives human-readable instructions. Nil selects os.Stderr.
+	Out io.Writer
+	// In is read for the pasted code in [FlowCode]. Nil selects
+	// os.Stdin.
+	In io.Reader
+}
+
+// Result is what [Login] returns.
+type Result struct {
+	// Token is the minted access token, including the raw bearer value
+	// that was written to the profile.
+	Token *accesstokens.Created
+	// Profile is the profile name the token was saved under.
+	Profile string
+}
+
+// Login runs the interactive sign-in described in the package docs and
+// saves the minted PAT to opts.Profile. It blocks until the callback
+// arrives, the code is pasted, or ctx is cancelled — callers typically
+// bound it with a few-minute deadline.
+func Login(ctx context.Context, opts Options) (*Result, error) {
+	if opts.OrganizationID == "" {
+		return nil, errors.New("login: organization ID is required")
+	}
+	baseURL := cmp.Or(opts.URL, config.DefaultURL)
+	profile := cmp.Or(opts.Profile, "default")
+	// Read up front so an unreadable file fails before a token is minted.
+	// Only the organization, key, and URL are replaced; the profile's other
+	// fields, such as its templates path, are kept.
+	saved, _, err := config.ReadProfile(profile)
+	if err != nil {
+		return nil, fmt.Errorf("login: read profile %s: %w", profile, err)
+	}
+	out := opts.Out
+	if out == nil {
+		out = os.Stderr
+	}
+
+	anon := client.NewWithConfig(config.Config{URL: baseURL, OrganizationID: opts.OrganizationID}, client.DefaultTimeout)
+	srv, err := server.New(anon).Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("login: %w", err)
+	}
+	loginURL, err := pickLoginURL(srv, opts.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("login: %w", err)
+	}
+
+	state, err := randomState()
+	if err != nil {
+		return nil, fmt.Errorf("login: %w", err)
+	}
+
+	var session string
+	switch cmp.Or(opts.Flow, FlowBrowser) {
+	case FlowBrowser:
+		session, err = browserFlow(ctx, loginURL, state, opts.OpenBrowser, out)
+	case FlowCode:
+		session, err = codeFlow(ctx, loginURL, opts.In, out)
+	default:
+		err = fmt.Errorf("unknown flow %q", opts.Flow)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("login: %w", err)
+	}
+
+	authed := client.NewWithConfig(config.Config{
+		URL:            baseURL,
+		OrganizationID: opts.OrganizationID,
+		Credentials: config.Credentials{
+			Method:          config.AuthPAT,
+			Secret:          session,
+			AuthHeaderValue: "Bearer " + session,
+		},
+	}, client.DefaultTimeout)
+	expires := cmp.Or(opts.ExpiresIn, DefaultExpiry)
+	created, err := accesstokens.New(authed).Create(ctx, accesstokens.CreateInput{
+		Name:             cmp.Or(opts.TokenName, defaultTokenName()),
+		Scopes:           []string{"*"},
+		ExpiresInMinutes: int(expires / time.Minute),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("login: %w", err)
+	}
+
+	saved.OrganizationID = opts.OrganizationID
+	saved.APIKey = created.Token
+	saved.URL = opts.URL
+	if err := config.SaveProfile(profile, saved); err != nil {
+		return nil, fmt.Errorf("login: save profile %s: %w", profile, err)
+	}
+	return &Result{Token: created, Profile: profile}, nil
+}
+
+// codeFlow prints the login URL and reads the pasted code from in.
+func codeFlow(ctx context.Context, loginURL string, in io.Reader, out io.Writer) (string, error) {
+	if in == nil {
+		in = os.Stdin
+	}
+	target, err := withParams(loginURL, "", "")
+	if err != nil {
+		return "", err
+	}
+	fmt.Fprintf(out, "Sign in at:\n\n  %s\n\nthen paste the code shown after sign-in: ", target)
+
+	type line struct {
+		text string
+		err  error
+	}
+	lines := make(chan line, 1)
+	go func() {
+		text, rerr := bufio.NewReader(in).ReadString('\n')
+		lines <- line{text: text, err: rerr}
+	}()
+	select {
+	case l := <-lines:
+		code := strings.TrimSpace(l.text)
+		if code == "" {
+			if l.err != nil {
+				return "", fmt.Errorf("read code: %w", l.err)
+			}
+			return "", errors.New("no code entered")
+		}
+		return code, nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// pickLoginURL chooses where to send the user: the named SSO provider,
+// the first listed provider, or the web app's login page.
+func pickLoginURL(srv *server.Server, provider string) (string, error) {
+	for _, p := range srv.SsoProviders {
+		if provider == "" || strings.EqualFold(p.Name, provider) {
+			return p.LoginURL, nil
+		}
+	}
+	if provider != "" {
+		return "", fmt.Errorf("server has no SSO provider named %q", provider)
+	}
+	if srv.AppURL == "" {
+		return "", ErrNoLoginMethod
+	}
+	return strings.TrimRight(srv.AppURL, "/") + "/login", nil
+}
+
+// withParams appends redirect_uri and state (each when non-empty) to the
+// login URL, preserving any query the server already put on it.
+func withParams(loginURL, redirectURI, state string) (string, error) {
+	u, err := url.Parse(loginURL)
+	if err != nil {
+		return "", fmt.Errorf("parse login URL: %w", err)
+	}
+	q := u.Query()
+	if redirectURI != "" {
+		q.Set("redirect_uri", redirectURI)
+	}
+	if state != "" {
+		q.Set("state", state)
+	}
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// randomState returns 16 bytes of hex-encoded entropy for the CSRF
+// state parameter.
+func randomState() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("generate state: %w", err)
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+func defaultTokenName() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		return "massdriver-sdk login"
+	}
+	return "massdriver-sdk login (" + host + ")"
+}
@@ -0,0 +1,239 @@
+package login_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/config"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/login"
+)
+
+// standIn is a local stand-in for the Massdriver API: it answers the
+// GetServer and CreateAccessToken operations on /api/v2 and plays the
+// SSO provider on /sso/google, redirecting back with a session token.
+type standIn struct {
+	*httptest.Server
+
+	mu sync.Mutex
+	// createAuth records the Authorization header CreateAccessToken saw.
+	createAuth string
+	// createInput records the CreateAccessToken input variables.
+	createInput map[string]any
+}
+
+func (s *standIn) created() (string, map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.createAuth, s.createInput
+}
+
+func newStandIn(t *testing.T) *standIn {
+	t.Helper()
+	s := &standIn{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			OperationName string         `json:"operationName"`
+			Variables     map[string]any `json:"variables"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		switch req.OperationName {
+		case "GetServer":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"server": map[string]any{
+				"appUrl":       s.URL,
+				"version":      "1.0.0",
+				"mode":         "self_hosted",
+				"ssoProviders": []map[string]any{{"name": "google", "loginUrl": s.URL + "/sso/google"}},
+			}}})
+		case "CreateAccessToken":
+			s.mu.Lock()
+			s.createAuth = r.Header.Get("Authorization")
+			s.createInput, _ = req.Variables["input"].(map[string]any)
+			s.mu.Unlock()
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"createAccessToken": map[string]any{
+				"successful": true,
+				"result": map[string]any{
+					"id": "tok-1", "name": "laptop", "token": "mds_minted", "prefix": "mds_mint", "scopes": []string{"*"},
+				},
+			}}})
+		default:
+			http.Error(w, "unexpected op "+req.OperationName, http.StatusBadRequest)
+		}
+	})
+	mux.HandleFunc("/sso/google", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		back, _ := url.Parse(q.Get("redirect_uri"))
+		bq := back.Query()
+		bq.Set("token", "session-abc")
+		bq.Set("state", q.Get("state"))
+		back.RawQuery = bq.Encode()
+		http.Redirect(w, r, back.String(), http.StatusFound)
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+// isolateHome points the config file at a temp directory.
+func isolateHome(t *testing.T) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", "")
+	for _, k := range []string{"MASSDRIVER_API_KEY", "MASSDRIVER_ORGANIZATION_ID", "MASSDRIVER_ORG_ID", "MASSDRIVER_URL", "MASSDRIVER_PROFILE", "MASSDRIVER_DEPLOYMENT_ID", "MASSDRIVER_TOKEN"} {
+		t.Setenv(k, "")
+	}
+}
+
+// followInBrowser plays the browser: it GETs the login URL and follows
+// the stand-in's redirect to the loopback callback.
+func followInBrowser(ctx context.Context, u string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+func TestLogin_Browser(t *testing.T) {
+	isolateHome(t)
+	srv := newStandIn(t)
+
+	res, err := login.Login(t.Context(), login.Options{
+		OrganizationID: "ecomm",
+		URL:            srv.URL,
+		Profile:        "work",
+		TokenName:      "laptop",
+		OpenBrowser:    followInBrowser,
+		Out:            io.Discard,
+	})
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if res.Token.Token != "mds_minted" || res.Profile != "work" {
+		t.Errorf("Result = %+v, want mds_minted saved to work", res)
+	}
+
+	// The PAT was minted with the session credential from the callback.
+	auth, input := srv.created()
+	if auth != "Bearer session-abc" {
+		t.Errorf("CreateAccessToken Authorization = %q, want Bearer session-abc", auth)
+	}
+	if got := input["expiresInMinutes"]; got != float64(login.DefaultExpiry.Minutes()) {
+		t.Errorf("expiresInMinutes = %v, want %v", got, login.DefaultExpiry.Minutes())
+	}
+
+	// The profile resolves to the new PAT.
+	cfg, err := config.Load(config.Overrides{Profile: "work"})
+	if err != nil {
+		t.Fatalf("config.Load: %v", err)
+	}
+	if cfg.Credentials.Secret != "mds_minted" || cfg.OrganizationID != "ecomm" || cfg.URL != srv.URL {
+		t.Errorf("profile = %+v, want mds_minted/ecomm/%s", cfg, srv.URL)
+	}
+}
+
+func TestLogin_Code(t *testing.T) {
+	isolateHome(t)
+	srv := newStandIn(t)
+	if err := config.SaveProfile("default", config.Profile{OrganizationID: "old", APIKey: "md_old", TemplatesPath: "/srv/templates"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var out strings.Builder
+	_, err := login.Login(t.Context(), login.Options{
+		OrganizationID: "ecomm",
+		URL:            srv.URL,
+		Flow:           login.FlowCode,
+		In:             strings.NewReader("pasted-session\n"),
+		Out:            &out,
+	})
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if auth, _ := srv.created(); auth != "Bearer pasted-session" {
+		t.Errorf("CreateAccessToken Authorization = %q, want Bearer pasted-session", auth)
+	}
+	// The code flow has no callback, so it sends neither a loopback
+	// redirect nor a state it could never check.
+	if strings.Contains(out.String(), "redirect_uri") || strings.Contains(out.String(), "state=") {
+		t.Errorf("code flow printed a redirect_uri or state: %s", out.String())
+	}
+	if _, err := config.Load(config.Overrides{}); err != nil {
+		t.Errorf("default profile not usable: %v", err)
+	}
+	if p, _, _ := config.ReadProfile("default"); p.TemplatesPath != "/srv/templates" || p.OrganizationID != "ecomm" {
+		t.Errorf("profile = %+v, want the new organization and the templates path kept", p)
+	}
+}
+
+// TestLogin_StateMismatch confirms a callback carrying someone else's
+// state is turned away without ending the login: the real callback that
+// follows still completes it.
+func TestLogin_StateMismatch(t *testing.T) {
+	isolateHome(t)
+	srv := newStandIn(t)
+
+	var forgedStatus int
+	forgeThenFollow := func(ctx context.Context, u string) error {
+		parsed, _ := url.Parse(u)
+		back, _ := url.Parse(parsed.Query().Get("redirect_uri"))
+		back.RawQuery = url.Values{"token": {"evil"}, "state": {"not-it"}}.Encode()
+		resp, err := http.Get(back.String())
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		forgedStatus = resp.StatusCode
+		return followInBrowser(ctx, u)
+	}
+	_, err := login.Login(t.Context(), login.Options{
+		OrganizationID: "ecomm",
+		URL:            srv.URL,
+		OpenBrowser:    forgeThenFollow,
+		Out:            io.Discard,
+	})
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if forgedStatus != http.StatusBadRequest {
+		t.Errorf("forged callback status = %d, want 400", forgedStatus)
+	}
+	if auth, _ := srv.created(); auth != "Bearer session-abc" {
+		t.Errorf("CreateAccessToken Authorization = %q, want Bearer session-abc", auth)
+	}
+
+	// With no valid callback, the wait ends with ctx and says why.
+	ctx, cancel := context.WithTimeout(t.Context(), 200*time.Millisecond)
+	defer cancel()
+	forgeOnly := func(ctx context.Context, u string) error {
+		parsed, _ := url.Parse(u)
+		back, _ := url.Parse(parsed.Query().Get("redirect_uri"))
+		back.RawQuery = url.Values{"token": {"evil"}, "state": {"not-it"}}.Encode()
+		return followInBrowser(ctx, back.String())
+	}
+	_, err = login.Login(ctx, login.Options{
+		OrganizationID: "ecomm",
+		URL:            srv.URL,
+		OpenBrowser:    forgeOnly,
+		Out:            io.Discard,
+	})
+	if !errors.Is(err, login.ErrStateMismatch) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want DeadlineExceeded and ErrStateMismatch", err)
+	}
+}
@@ -0,0 +1,390 @@
+// Package logparse extracts structure from deployment logs: diagnostics
+// and resource changes from Terraform/OpenTofu machine-readable output
+// (the JSON lines `-json` produces, keyed by `@level`, `@message` and
+// `type`), and release status and errors from Helm.
+//
+// Feed it lines from any source — [deployments.Service.GetLogs],
+// [deployments.Service.StreamLogs], or an archive read back with
+// [deployments.ReadLogArchive]:
+//
+//	logs, err := c.Deployments.GetLogs(ctx, id)
+//	if err != nil {
+//	    return err
+//	}
+//	res := logparse.ParseString(logs)
+//	for _, d := range res.Errors() {
+//	    fmt.Printf("%s: %s (%s:%d)\n", d.Address, d.Summary, d.File, d.Line)
+//	}
+//	fmt.Println(res.Changes())
+//
+// Lines that aren't recognized are ignored, so mixed provisioner output
+// is fine.
+package logparse
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Source is the tool a [Diagnostic] came from.
+type Source string
+
+const (
+	SourceTerraform Source = "terraform" // Terraform or OpenTofu JSON output
+	SourceHelm      Source = "helm"
+	// SourceText is a human-readable "Error:" / "Warning:" line from a tool
+	// that couldn't be identified.
+	SourceText Source = "text"
+)
+
+// Severity ranks a [Diagnostic].
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Diagnostic is one error or warning reported in the logs.
+type Diagnostic struct {
+	Source   Source   `json:"source"`
+	Severity Severity `json:"severity"`
+	Summary  string   `json:"summary"`
+	Detail   string   `json:"detail,omitempty"`
+	// Address is the resource the diagnostic is about, when the tool says
+	// (e.g. "aws_db_instance.main").
+	Address string `json:"address,omitempty"`
+	// File and Line locate the offending configuration, when known.
+	File string `json:"file,omitempty"`
+	Line int    `json:"line,omitempty"`
+	// Timestamp is the tool's own timestamp when it has one, else the
+	// log line's.
+	Timestamp time.Time `json:"timestamp,omitzero"`
+}
+
+// Action is what a change does to a resource, using Terraform's names.
+type Action string
+
+const (
+	ActionCreate  Action = "create"
+	ActionRead    Action = "read"
+	ActionUpdate  Action = "update"
+	ActionReplace Action = "replace"
+	ActionDelete  Action = "delete"
+	ActionNoop    Action = "noop"
+	ActionMove    Action = "move"
+	ActionImport  Action = "import"
+	ActionForget  Action = "forget"
+)
+
+// ResourceChange is one resource's planned, applied, or failed change.
+type ResourceChange struct {
+	// Address is the full resource address, including any module path
+	// (e.g. "module.db.aws_db_instance.main").
+	Address string `json:"address"`
+	Type    string `json:"type,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Module  string `json:"module,omitempty"`
+	Action  Action `json:"action"`
+	// Elapsed is how long an applied change took; zero for planned ones.
+	Elapsed time.Duration `json:"elapsed,omitempty"`
+}
+
+// ChangeSummary counts resource changes, as in Terraform's
+// "Plan: 1 to add, 2 to change, 0 to destroy."
+type ChangeSummary struct {
+	// Operation is "plan", "apply" or "destroy"; empty when the summary was
+	// computed from planned changes rather than reported by the tool.
+	Operation string `json:"operation,omitempty"`
+	Add       int    `json:"add"`
+	Change    int    `json:"change"`
+	Remove    int    `json:"remove"`
+	Import    int    `json:"import"`
+}
+
+func (s ChangeSummary) String() string {
+	return fmt.Sprintf("%d to add, %d to change, %d to destroy, %d to import", s.Add, s.Change, s.Remove, s.Import)
+}
+
+// HelmRelease is the release status Helm printed, if any.
+type HelmRelease struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Status    string `json:"status,omitempty"`
+	Revision  int    `json:"revision,omitempty"`
+}
+
+// Result is everything recognized in a log.
+type Result struct {
+	Diagnostics []Diagnostic `json:"diagnostics,omitempty"`
+	// Planned, Applied and Failed list Terraform resource changes in log
+	// order. Drifted lists resources that changed outside Terraform.
+	Planned []ResourceChange `json:"planned,omitempty"`
+	Applied []ResourceChange `json:"applied,omitempty"`
+	Failed  []ResourceChange `json:"failed,omitempty"`
+	Drifted []ResourceChange `json:"drifted,omitempty"`
+	// Summaries are the change summaries the tool reported, in order —
+	// typically one for the plan and one for the apply.
+	Summaries []ChangeSummary `json:"summaries,omitempty"`
+	Helm      *HelmRelease    `json:"helm,omitempty"`
+}
+
+// Errors returns the error-severity diagnostics.
+func (r *Result) Errors() []Diagnostic {
+	var out []Diagnostic
+	for _, d := range r.Diagnostics {
+		if d.Severity == SeverityError {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// Changes returns the most authoritative change summary: the last one the
+// tool reported, or one counted from Planned when it reported none.
+func (r *Result) Changes() ChangeSummary {
+	if n := len(r.Summaries); n > 0 {
+		return r.Summaries[n-1]
+	}
+	var s ChangeSummary
+	for _, c := range r.Planned {
+		switch c.Action {
+		case ActionCreate:
+			s.Add++
+		case ActionUpdate:
+			s.Change++
+		case ActionDelete:
+			s.Remove++
+		case ActionReplace:
+			s.Add++
+			s.Remove++
+		case ActionImport:
+			s.Import++
+		case ActionRead, ActionNoop, ActionMove, ActionForget:
+		}
+	}
+	return s
+}
+
+// Parser accumulates a [Result] one line at a time. The zero value is ready
+// to use; it is not safe for concurrent use.
+type Parser struct {
+	res Result
+}
+
+// Result returns what has been recognized so far.
+func (p *Parser) Result() *Result { return &p.res }
+
+// Line feeds one log line. ts is the line's timestamp if known (zero
+// otherwise); a timestamp embedded in the line takes precedence.
+func (p *Parser) Line(ts time.Time, line string) {
+	line = strings.TrimRight(line, "\r\n")
+	trimmed := strings.TrimSpace(line)
+	if strings.HasPrefix(trimmed, "{") && strings.Contains(trimmed, `"@level"`) {
+		if p.terraform(ts, trimmed) {
+			return
+		}
+	}
+	p.text(ts, trimmed)
+}
+
+// Parse reads r line by line.
+func Parse(r io.Reader) (*Result, error) {
+	var p Parser
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for sc.Scan() {
+		p.Line(time.Time{}, sc.Text())
+	}
+	if err := sc.Err(); err != nil {
+		return p.Result(), fmt.Errorf("parse logs: %w", err)
+	}
+	return p.Result(), nil
+}
+
+// ParseString parses a whole log held in memory, such as the output of
+// [deployments.Service.GetLogs].
+func ParseString(logs string) *Result {
+	var p Parser
+	for line := range strings.Lines(logs) {
+		p.Line(time.Time{}, line)
+	}
+	return p.Result()
+}
+
+// tfMessage is the subset of Terraform's machine-readable UI message
+// this package reads.
+type tfMessage struct {
+	Level      string    `json:"@level"`
+	Message    string    `json:"@message"`
+	Timestamp  time.Time `json:"@timestamp"`
+	Type       string    `json:"type"`
+	Diagnostic *struct {
+		Severity string `json:"severity"`
+		Summary  string `json:"summary"`
+		Detail   string `json:"detail"`
+		Address  string `json:"address"`
+		Range    *struct {
+			Filename string `json:"filename"`
+			Start    struct {
+				Line int `json:"line"`
+			} `json:"start"`
+		} `json:"range"`
+	} `json:"diagnostic"`
+	Change *struct {
+		Resource tfResource `json:"resource"`
+		Action   string     `json:"action"`
+	} `json:"change"`
+	Hook *struct {
+		Resource       tfResource `json:"resource"`
+		Action         string     `json:"action"`
+		ElapsedSeconds float64    `json:"elapsed_seconds"`
+	} `json:"hook"`
+	Changes *struct {
+		Add       int    `json:"add"`
+		Change    int    `json:"change"`
+		Remove    int    `json:"remove"`
+		Import    int    `json:"import"`
+		Operation string `json:"operation"`
+	} `json:"changes"`
+}
+
+type tfResource struct {
+	Addr         string `json:"addr"`
+	Module       string `json:"module"`
+	ResourceType string `json:"resource_type"`
+	ResourceName string `json:"resource_name"`
+}
+
+func (r tfResource) change(action string) ResourceChange {
+	return ResourceChange{Address: r.Addr, Type: r.ResourceType, Name: r.ResourceName, Module: r.Module, Action: Action(action)}
+}
+
+// terraform handles one machine-readable line, reporting whether it was
+// one.
+func (p *Parser) terraform(ts time.Time, line string) bool {
+	var m tfMessage
+	if err := json.Unmarshal([]byte(line), &m); err != nil || m.Level == "" {
+		return false
+	}
+	if !m.Timestamp.IsZero() {
+		ts = m.Timestamp
+	}
+	switch m.Type {
+	case "diagnostic":
+		if m.Diagnostic == nil {
+			return true
+		}
+		d := Diagnostic{
+			Source:    SourceTerraform,
+			Severity:  severity(m.Diagnostic.Severity),
+			Summary:   m.Diagnostic.Summary,
+			Detail:    m.Diagnostic.Detail,
+			Address:   m.Diagnostic.Address,
+			Timestamp: ts,
+		}
+		if rg := m.Diagnostic.Range; rg != nil {
+			d.File, d.Line = rg.Filename, rg.Start.Line
+		}
+		p.res.Diagnostics = append(p.res.Diagnostics, d)
+	case "planned_change":
+		if m.Change != nil {
+			p.res.Planned = append(p.res.Planned, m.Change.Resource.change(m.Change.Action))
+		}
+	case "resource_drift":
+		if m.Change != nil {
+			p.res.Drifted = append(p.res.Drifted, m.Change.Resource.change(m.Change.Action))
+		}
+	case "apply_complete":
+		if m.Hook != nil {
+			c := m.Hook.Resource.change(m.Hook.Action)
+			c.Elapsed = time.Duration(m.Hook.ElapsedSeconds * float64(time.Second))
+			p.res.Applied = append(p.res.Applied, c)
+		}
+	case "apply_errored":
+		if m.Hook != nil {
+			c := m.Hook.Resource.change(m.Hook.Action)
+			c.Elapsed = time.Duration(m.Hook.ElapsedSeconds * float64(time.Second))
+			p.res.Failed = append(p.res.Failed, c)
+		}
+	case "change_summary":
+		if c := m.Changes; c != nil {
+			p.res.Summaries = append(p.res.Summaries, ChangeSummary{
+				Operation: c.Operation, Add: c.Add, Change: c.Change, Remove: c.Remove, Import: c.Import,
+			})
+		}
+	}
+	return true
+}
+
+func severity(s string) Severity {
+	if strings.EqualFold(s, "warning") {
+		return SeverityWarning
+	}
+	return SeverityError
+}
+
+var (
+	// helmFailed matches Helm's fatal errors, e.g.
+	// "Error: UPGRADE FAILED: timed out waiting for the condition".
+	helmFailed = regexp.MustCompile(`^Error: ((?:INSTALLATION|UPGRADE|ROLLBACK|UNINSTALLATION) FAILED): (.*)$`)
+	// helmReleaseLine matches "Release "web" has been upgraded. Happy Helming!"
+	// and "Release "web" does not exist. Installing it now."
+	helmReleaseLine = regexp.MustCompile(`^Release "([^"]+)" (has been upgraded|does not exist|uninstalled)`)
+	// helmField matches the NAME:/NAMESPACE:/STATUS:/REVISION: block Helm
+	// prints after install and upgrade.
+	helmField = regexp.MustCompile(`^(NAME|NAMESPACE|STATUS|REVISION):\s+(.+)$`)
+	// textDiag matches generic "Error: ..." / "Warning: ..." lines,
+	// including Terraform's boxed human-readable form ("│ Error: ...").
+	textDiag = regexp.MustCompile(`^(?:│\s*)?(Error|Warning): (.+)$`)
+)
+
+// text handles human-readable lines.
+func (p *Parser) text(ts time.Time, line string) {
+	if m := helmFailed.FindStringSubmatch(line); m != nil {
+		p.res.Diagnostics = append(p.res.Diagnostics, Diagnostic{
+			Source: SourceHelm, Severity: SeverityError, Summary: m[1], Detail: m[2], Timestamp: ts,
+		})
+		return
+	}
+	if m := helmReleaseLine.FindStringSubmatch(line); m != nil {
+		p.helm().Name = m[1]
+		return
+	}
+	if m := helmField.FindStringSubmatch(line); m != nil {
+		h := p.helm()
+		switch m[1] {
+		case "NAME":
+			h.Name = m[2]
+		case "NAMESPACE":
+			h.Namespace = m[2]
+		case "STATUS":
+			h.Status = m[2]
+		case "REVISION":
+			h.Revision, _ = strconv.Atoi(m[2])
+		}
+		return
+	}
+	if m := textDiag.FindStringSubmatch(line); m != nil {
+		sev := SeverityError
+		if m[1] == "Warning" {
+			sev = SeverityWarning
+		}
+		p.res.Diagnostics = append(p.res.Diagnostics, Diagnostic{
+			Source: SourceText, Severity: sev, Summary: m[2], Timestamp: ts,
+		})
+	}
+}
+
+func (p *Parser) helm() *HelmRelease {
+	if p.res.Helm == nil {
+		p.res.Helm = &HelmRelease{}
+	}
+	return p.res.Helm
+}
@@ -0,0 +1,107 @@
+package logparse_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/logparse"
+)
+
+const terraformLog = `Initializing provisioner
+{"@level":"info","@message":"Terraform 1.9.0","@module":"terraform.ui","@timestamp":"2026-01-15T10:00:00Z","terraform":"1.9.0","type":"version","ui":"1.2"}
+{"@level":"info","@message":"aws_db_instance.main: Plan to update","@module":"terraform.ui","@timestamp":"2026-01-15T10:00:01Z","change":{"resource":{"addr":"aws_db_instance.main","module":"","resource":"aws_db_instance.main","implied_provider":"aws","resource_type":"aws_db_instance","resource_name":"main","resource_key":null},"action":"update"},"type":"planned_change"}
+{"@level":"info","@message":"module.net.aws_subnet.a: Plan to create","@module":"terraform.ui","@timestamp":"2026-01-15T10:00:01Z","change":{"resource":{"addr":"module.net.aws_subnet.a","module":"module.net","resource":"aws_subnet.a","implied_provider":"aws","resource_type":"aws_subnet","resource_name":"a","resource_key":null},"action":"create"},"type":"planned_change"}
+{"@level":"info","@message":"Plan: 1 to add, 1 to change, 0 to destroy.","@module":"terraform.ui","@timestamp":"2026-01-15T10:00:02Z","changes":{"add":1,"change":1,"import":0,"remove":0,"operation":"plan"},"type":"change_summary"}
+{"@level":"info","@message":"module.net.aws_subnet.a: Creation complete after 2s [id=subnet-1]","@module":"terraform.ui","@timestamp":"2026-01-15T10:00:05Z","hook":{"resource":{"addr":"module.net.aws_subnet.a","module":"module.net","resource":"aws_subnet.a","implied_provider":"aws","resource_type":"aws_subnet","resource_name":"a","resource_key":null},"action":"create","id_key":"id","id_value":"subnet-1","elapsed_seconds":2},"type":"apply_complete"}
+{"@level":"error","@message":"aws_db_instance.main: Modifying... failed","@module":"terraform.ui","@timestamp":"2026-01-15T10:00:09Z","hook":{"resource":{"addr":"aws_db_instance.main","module":"","resource":"aws_db_instance.main","implied_provider":"aws","resource_type":"aws_db_instance","resource_name":"main","resource_key":null},"action":"update","elapsed_seconds":4},"type":"apply_errored"}
+{"@level":"error","@message":"Error: updating RDS DB Instance","@module":"terraform.ui","@timestamp":"2026-01-15T10:00:09Z","diagnostic":{"severity":"error","summary":"updating RDS DB Instance","detail":"InvalidParameterCombination: storage too small","address":"aws_db_instance.main","range":{"filename":"main.tf","start":{"line":12,"column":1,"byte":200},"end":{"line":12,"column":30,"byte":229}}},"type":"diagnostic"}
+{"@level":"warn","@message":"Warning: Argument is deprecated","@module":"terraform.ui","@timestamp":"2026-01-15T10:00:09Z","diagnostic":{"severity":"warning","summary":"Argument is deprecated","detail":""},"type":"diagnostic"}
+{"@level":"info","@message":"Apply complete! Resources: 1 added, 0 changed, 0 destroyed.","@module":"terraform.ui","@timestamp":"2026-01-15T10:00:10Z","changes":{"add":1,"change":0,"import":0,"remove":0,"operation":"apply"},"type":"change_summary"}
+`
+
+func TestParse_Terraform(t *testing.T) {
+	res, err := logparse.Parse(strings.NewReader(terraformLog))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	if len(res.Planned) != 2 || res.Planned[1].Address != "module.net.aws_subnet.a" || res.Planned[1].Module != "module.net" {
+		t.Errorf("Planned = %+v", res.Planned)
+	}
+	if len(res.Applied) != 1 || res.Applied[0].Action != logparse.ActionCreate || res.Applied[0].Elapsed != 2*time.Second {
+		t.Errorf("Applied = %+v", res.Applied)
+	}
+	if len(res.Failed) != 1 || res.Failed[0].Address != "aws_db_instance.main" {
+		t.Errorf("Failed = %+v", res.Failed)
+	}
+
+	errs := res.Errors()
+	if len(errs) != 1 {
+		t.Fatalf("Errors() = %+v, want 1", errs)
+	}
+	want := logparse.Diagnostic{
+		Source:    logparse.SourceTerraform,
+		Severity:  logparse.SeverityError,
+		Summary:   "updating RDS DB Instance",
+		Detail:    "InvalidParameterCombination: storage too small",
+		Address:   "aws_db_instance.main",
+		File:      "main.tf",
+		Line:      12,
+		Timestamp: time.Date(2026, 1, 15, 10, 0, 9, 0, time.UTC),
+	}
+	if errs[0] != want {
+		t.Errorf("error diagnostic = %+v,\n want %+v", errs[0], want)
+	}
+	if len(res.Diagnostics) != 2 || res.Diagnostics[1].Severity != logparse.SeverityWarning {
+		t.Errorf("Diagnostics = %+v, want error then warning", res.Diagnostics)
+	}
+
+	if got := res.Changes(); got.Operation != "apply" || got.Add != 1 {
+		t.Errorf("Changes() = %+v, want the apply summary", got)
+	}
+}
+
+func TestResult_ChangesCountsPlanWithoutSummary(t *testing.T) {
+	res := &logparse.Result{Planned: []logparse.ResourceChange{
+		{Action: logparse.ActionCreate},
+		{Action: logparse.ActionReplace},
+		{Action: logparse.ActionNoop},
+	}}
+	got := res.Changes()
+	if got.Add != 2 || got.Remove != 1 || got.Change != 0 {
+		t.Errorf("Changes() = %+v, want 2 add, 1 remove", got)
+	}
+}
+
+func TestParse_Helm(t *testing.T) {
+	res := logparse.ParseString(`Release "web" does not exist. Installing it now.
+NAME: web
+LAST DEPLOYED: Thu Jan 15 10:00:00 2026
+NAMESPACE: storefront
+STATUS: failed
+REVISION: 1
+Error: INSTALLATION FAILED: context deadline exceeded
+`)
+	want := logparse.HelmRelease{Name: "web", Namespace: "storefront", Status: "failed", Revision: 1}
+	if res.Helm == nil || *res.Helm != want {
+		t.Errorf("Helm = %+v, want %+v", res.Helm, want)
+	}
+	errs := res.Errors()
+	if len(errs) != 1 || errs[0].Source != logparse.SourceHelm || errs[0].Summary != "INSTALLATION FAILED" || errs[0].Detail != "context deadline exceeded" {
+		t.Errorf("Errors() = %+v", errs)
+	}
+}
+
+func TestParse_PlainTextDiagnostics(t *testing.T) {
+	res := logparse.ParseString("│ Error: Unsupported argument\nsome other line\nWarning: something odd\n{not json\n")
+	if len(res.Diagnostics) != 2 {
+		t.Fatalf("Diagnostics = %+v, want 2", res.Diagnostics)
+	}
+	if d := res.Diagnostics[0]; d.Source != logparse.SourceText || d.Summary != "Unsupported argument" {
+		t.Errorf("Diagnostics[0] = %+v", d)
+	}
+	if d := res.Diagnostics[1]; d.Severity != logparse.SeverityWarning {
+		t.Errorf("Diagnostics[1] = %+v, want a warning", d)
+	}
+}
@@ -1,9 +1,11 @@
 package massdriver
 
 import (
+	"log/slog"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/cache"
 )
 
 // Option configures a [*Client] built by [NewClient]. Options
@@ -28,6 +30,12 @@ type options struct {
 	baseURL        string
 	profile        string
 	gqlClient      graphql.Client
+	logger         *slog.Logger
+
+	rateLimit      float64
+	burst          int
+	maxConcurrency int
+	cache          *cache.Options
 
 	timeout    time.Duration
 	timeoutSet bool
@@ -86,3 +94,55 @@ func WithGQLClient(c graphql.Client) Option {
 func WithTimeout(d time.Duration) Option {
 	return func(o *options) { o.timeout = d; o.timeoutSet = true }
 }
+
+// WithLogger sends debug-level transport logs to l: every GraphQL
+// operation (name and variables), every REST call, and streaming-socket
+// lifecycle events (dial, join, heartbeat timeout, close, subscribe).
+//
+// Credentials never reach the logger. Authorization headers aren't
+// logged at all, the socket's token query parameter is replaced, and
+// variables named like credentials — token, password, secret, apiKey —
+// plus resource payloads and instance secret values are replaced with
+// "[REDACTED]".
+func WithLogger(l *slog.Logger) Option {
+	return func(o *options) { o.logger = l }
+}
+
+// WithRateLimit caps the client at rps requests per second, allowing
+// bursts of up to burst (minimum 1). The limit is shared by every
+// service and goroutine using the client, across GraphQL and REST.
+//
+// When the server answers 429 Too Many Requests the client halves its
+// rate and pauses for the Retry-After interval, then climbs back to rps
+// as requests succeed. The 429 itself is still returned to the caller.
+// Requests waiting for a slot give up when their context is cancelled.
+//
+// Ignored with [WithGQLClient], which bypasses the HTTP transport.
+func WithRateLimit(rps float64, burst int) Option {
+	return func(o *options) { o.rateLimit = rps; o.burst = burst }
+}
+
+// WithMaxConcurrency caps the number of requests in flight at once
+// across every service using the client. A request holds its slot until
+// its response has been read. Requests waiting for a slot give up when
+// their context is cancelled.
+//
+// Ignored with [WithGQLClient], which bypasses the HTTP transport.
+func WithMaxConcurrency(n int) Option {
+	return func(o *options) { o.maxConcurrency = n }
+}
+
+// WithCache puts a read-through cache in front of the Get and ListPage
+// calls of projects, environments and bundles, keyed by operation and
+// variables. Other reads always reach the server. The cache is reachable
+// as [Client.Cache] for hit/miss stats and manual invalidation.
+//
+// With PAT credentials the client also subscribes to the organization
+// event stream, and to the streams of the projects and environments it
+// caches, and evicts entries as they change; call [Client.Close] to end
+// those subscriptions.
+// Otherwise entries simply expire after opts.TTL. See package
+// [cache] for the invalidation rules.
+func WithCache(opts cache.Options) Option {
+	return func(o *options) { o.cache = &opts }
+}
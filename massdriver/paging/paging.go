@@ -0,0 +1,116 @@
+// Package paging holds the options every platform service's Iter method
+// accepts, controlling how result pages are fetched:
+//
+//	for d, err := range c.Deployments.Iter(ctx, deployments.ListInput{}, paging.Prefetch(2)) {
+//	    ...
+//	}
+//
+// Iterators are lazy and cursor-driven: each page's cursor comes from the
+// page before it, so pages are always requested in order. [Prefetch]
+// changes when that happens relative to the consumer, never which items
+// are yielded or in what order.
+//
+// # Checkpoints
+//
+// Long exports can survive a crash by recording where they got to.
+// [WithCheckpoints] pairs each item with a [Checkpoint]; persist it once
+// the item is handled, and on restart pass it back with [ResumeFrom]:
+//
+//	seq := paging.WithCheckpoints(func(opts ...paging.Option) iter.Seq2[auditlogs.AuditLog, error] {
+//	    return c.AuditLogs.Iter(ctx, input, opts...)
+//	}, paging.ResumeFrom(saved))
+//	for item, err := range seq {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    export(item.Value)
+//	    save(item.Checkpoint)
+//	}
+//
+// Resume with the same input (filter and sort) the checkpoint was taken
+// under. A checkpoint is a page cursor plus an offset into that page, so
+// rows inserted into or removed from an already-visited page between runs
+// shift the resume point; time-ordered data such as audit logs and
+// deployment history is unaffected.
+package paging
+
+import (
+	"iter"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/iteropt"
+)
+
+// Option configures one Iter call. Construct with [Prefetch],
+// [ResumeFrom], or through [WithCheckpoints].
+type Option func(*iteropt.Config)
+
+// Checkpoint marks a position in an iteration: the page fetched with
+// Cursor, with its first Offset items already consumed. The zero value is
+// the start. Checkpoints are plain data — marshal them as JSON to store.
+type Checkpoint struct {
+	// Cursor is the opaque cursor that fetches the page holding the next
+	// item; "" is the first page.
+	Cursor string `json:"cursor"`
+	// Offset is the number of items of that page already consumed.
+	Offset int `json:"offset"`
+}
+
+// Checkpointed is an item paired with the checkpoint that resumes
+// iteration just after it.
+type Checkpointed[T any] struct {
+	Value      T
+	Checkpoint Checkpoint
+}
+
+// Prefetch reads up to pages pages ahead of the consumer: while the loop
+// body works through one page, a background goroutine requests the next
+// ones. Walks that do real work per item (or cross tens of thousands of
+// rows) stop waiting on the network between pages.
+//
+// Memory stays bounded at pages+2 pages held at once: up to pages
+// buffered, one being fetched, and the one the loop is working through.
+// Breaking out of the loop cancels the in-flight request and stops the
+// goroutine before Iter returns. A failed fetch, or the context ending
+// before the last page, is still yielded once, after every item from
+// earlier pages. Zero or negative disables read-ahead (the default).
+func Prefetch(pages int) Option {
+	return func(c *iteropt.Config) { c.Prefetch = pages }
+}
+
+// ResumeFrom starts iteration at cp rather than at the input's After
+// cursor, skipping the items of cp's page that were already consumed.
+func ResumeFrom(cp Checkpoint) Option {
+	return func(c *iteropt.Config) {
+		c.Resume, c.ResumeCursor, c.ResumeOffset = true, cp.Cursor, cp.Offset
+	}
+}
+
+// WithCheckpoints runs the iterator open returns and yields each item
+// with its [Checkpoint]. open receives the options to forward to a
+// service's Iter method — opts plus the tracking option — and is called
+// once per range loop:
+//
+//	paging.WithCheckpoints(func(opts ...paging.Option) iter.Seq2[deployments.Deployment, error] {
+//	    return c.Deployments.Iter(ctx, input, opts...)
+//	}, paging.Prefetch(2))
+//
+// The yielded error is non-nil exactly once, on a failed page fetch,
+// with the zero Checkpointed.
+func WithCheckpoints[T any](open func(opts ...Option) iter.Seq2[T, error], opts ...Option) iter.Seq2[Checkpointed[T], error] {
+	return func(yield func(Checkpointed[T], error) bool) {
+		var last Checkpoint
+		track := func(c *iteropt.Config) {
+			c.Track = func(cursor string, offset int) { last = Checkpoint{Cursor: cursor, Offset: offset} }
+		}
+		all := append(append([]Option{}, opts...), track)
+		for v, err := range open(all...) {
+			if err != nil {
+				yield(Checkpointed[T]{}, err)
+				return
+			}
+			if !yield(Checkpointed[T]{Value: v, Checkpoint: last}, nil) {
+				return
+			}
+		}
+	}
+}
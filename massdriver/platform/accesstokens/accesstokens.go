@@ -16,6 +16,13 @@
 // metadata is retained — the row remains queryable in [Service.Iter]
 // with Status=Revoked so the audit trail is preserved.
 //
+// # Rotation
+//
+// [Service.Rotate] swaps a token for a fresh one without a window where
+// neither works: the replacement is stored through a [Sink], verified,
+// and only then is the old token revoked. [Service.IterExpiring] finds
+// the tokens due for rotation.
+//
 // Construct a [*Service] with [New] passing the low-level client, or use
 // the pre-wired [massdriver.Client.AccessTokens] field on the top-level
 // SDK client.
@@ -123,8 +130,8 @@ type Created struct {
 // non-nil exactly once, on a failed page fetch, after which iteration stops.
 //
 // To buffer every match into a slice, wrap with [types.Collect].
-func (s *Service) Iter(ctx context.Context, input ListInput) iter.Seq2[AccessToken, error] {
-	return paging.Iter(ctx, input.After, s.page(input))
+func (s *Service) Iter(ctx context.Context, input ListInput, opts ...paging.Option) iter.Seq2[AccessToken, error] {
+	return paging.Iter(ctx, input.After, s.page(input), opts...)
 }
 
 // ListPage returns a single page of access tokens matching input.
@@ -0,0 +1,246 @@
+package accesstokens
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"iter"
+	"os"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/config"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/paging"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/viewer"
+)
+
+// Sink receives the replacement token during [Service.Rotate] and
+// persists it wherever the old one lived — a file, a config profile,
+// a secret store.
+//
+// Store returns an [Undo] that puts the previous value back; Rotate
+// calls it if a later step fails. Return a nil Undo when there is
+// nothing to restore.
+type Sink interface {
+	Store(ctx context.Context, token *Created) (Undo, error)
+}
+
+// Undo reverses a [Sink.Store].
+type Undo func(ctx context.Context) error
+
+// SinkFunc adapts a plain callback (e.g. a write to your secret store)
+// to [Sink]. It has no undo step.
+type SinkFunc func(ctx context.Context, token *Created) error
+
+// Store implements [Sink].
+func (f SinkFunc) Store(ctx context.Context, token *Created) (Undo, error) {
+	return nil, f(ctx, token)
+}
+
+// FileSink writes the raw token, newline-terminated, to path with 0600
+// permissions. Undo restores the previous contents (or removes the
+// file if there was none).
+func FileSink(path string) Sink { return fileSink(path) }
+
+type fileSink string
+
+func (f fileSink) Store(_ context.Context, token *Created) (Undo, error) {
+	path := string(f)
+	prev, err := os.ReadFile(path)
+	existed := err == nil
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, []byte(token.Token+"\n"), 0o600); err != nil {
+		return nil, fmt.Errorf("write %s: %w", path, err)
+	}
+	return func(context.Context) error {
+		if !existed {
+			return os.Remove(path)
+		}
+		return os.WriteFile(path, prev, 0o600)
+	}, nil
+}
+
+// ProfileSink replaces the API key of an existing profile in
+// ~/.config/massdriver/config.yaml (see [config.SaveProfile]). Undo
+// writes the previous key back.
+func ProfileSink(profile string) Sink { return profileSink(profile) }
+
+type profileSink string
+
+func (p profileSink) Store(_ context.Context, token *Created) (Undo, error) {
+	name := string(p)
+	prev, ok, err := config.ReadProfile(name)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found", name)
+	}
+	next := prev
+	next.APIKey = token.Token
+	if err := config.SaveProfile(name, next); err != nil {
+		return nil, err
+	}
+	return func(context.Context) error { return config.SaveProfile(name, prev) }, nil
+}
+
+// RotateInput is the input for [Service.Rotate].
+type RotateInput struct {
+	// Sink persists the replacement token. Required.
+	Sink Sink
+	// Name labels the replacement token. Empty reuses the old name.
+	Name string
+	// ExpiresInMinutes sets the replacement's lifetime. Zero reuses the
+	// old token's lifetime (ExpiresAt − CreatedAt). The API has no
+	// never-expiring tokens, so rotating a token without an expiry
+	// requires it: Rotate returns an error rather than fall back to the
+	// server's one-hour default.
+	ExpiresInMinutes int
+	// Verify checks that the replacement works before the old token is
+	// revoked. Nil calls [viewer.Service.Get] authenticated with the
+	// replacement.
+	Verify func(ctx context.Context, token *Created) error
+}
+
+// Rotated is what [Service.Rotate] returns.
+type Rotated struct {
+	// New is the replacement token, including its raw bearer value.
+	New *Created
+	// Old is the revoked token's metadata.
+	Old *AccessToken
+}
+
+// Rotate replaces the access token id with a fresh one carrying the
+// same scopes:
+//
+//  1. Create the replacement.
+//  2. Hand it to input.Sink.
+//  3. Verify it authenticates (input.Verify).
+//  4. Revoke the old token.
+//
+// If step 2 or 3 fails, Rotate rolls back — it runs the sink's [Undo]
+// and revokes the replacement — so the old token stays the live one.
+// Rollback failures are joined onto the returned error.
+//
+// Like every operation in this package, Rotate works on the caller's own
+// tokens. To rotate a service account's key, use
+// [serviceaccounts.Service.RotateKey], which runs Rotate as the service
+// account.
+func (s *Service) Rotate(ctx context.Context, id string, input RotateInput) (*Rotated, error) {
+	if input.Sink == nil {
+		return nil, fmt.Errorf("rotate access token %s: a sink is required", id)
+	}
+	old, err := s.find(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("rotate access token %s: %w", id, err)
+	}
+	if !old.RevokedAt.IsZero() {
+		return nil, fmt.Errorf("rotate access token %s: token is already revoked", id)
+	}
+
+	name := input.Name
+	if name == "" {
+		name = old.Name
+	}
+	minutes := input.ExpiresInMinutes
+	if minutes == 0 {
+		if old.ExpiresAt.IsZero() || old.CreatedAt.IsZero() {
+			return nil, fmt.Errorf("rotate access token %s: token has no expiry to carry over; set ExpiresInMinutes", id)
+		}
+		minutes = int(old.ExpiresAt.Sub(old.CreatedAt) / time.Minute)
+	}
+	created, err := s.Create(ctx, CreateInput{Name: name, Scopes: old.Scopes, ExpiresInMinutes: minutes})
+	if err != nil {
+		return nil, fmt.Errorf("rotate access token %s: %w", id, err)
+	}
+
+	undo, err := input.Sink.Store(ctx, created)
+	if err != nil {
+		return nil, s.rollback(ctx, id, "store replacement", err, created, nil)
+	}
+	verify := input.Verify
+	if verify == nil {
+		verify = s.verifyWithViewer
+	}
+	if err := verify(ctx, created); err != nil {
+		return nil, s.rollback(ctx, id, "verify replacement", err, created, undo)
+	}
+
+	revoked, err := s.Revoke(ctx, id)
+	if err != nil {
+		// The replacement is live and stored; only the cleanup failed.
+		// Don't roll back — report so the caller can retry the revoke.
+		return &Rotated{New: created, Old: old}, fmt.Errorf("rotate access token %s: revoke old token: %w", id, err)
+	}
+	return &Rotated{New: created, Old: revoked}, nil
+}
+
+// rollback undoes a partially-applied rotation and returns the error
+// describing the step that failed, joined with any rollback failures.
+func (s *Service) rollback(ctx context.Context, id, step string, cause error, created *Created, undo Undo) error {
+	errs := []error{fmt.Errorf("rotate access token %s: %s: %w", id, step, cause)}
+	if undo != nil {
+		if err := undo(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("rollback: restore sink: %w", err))
+		}
+	}
+	if _, err := s.Revoke(ctx, created.ID); err != nil {
+		errs = append(errs, fmt.Errorf("rollback: revoke replacement %s: %w", created.ID, err))
+	}
+	return errors.Join(errs...)
+}
+
+// verifyWithViewer authenticates as the replacement token against the
+// same server and asks who it is.
+func (s *Service) verifyWithViewer(ctx context.Context, token *Created) error {
+	_, err := viewer.New(s.client.WithBearer(token.Token)).Get(ctx)
+	return err
+}
+
+// find looks up a token's metadata by ID. There is no single-token
+// query, so it scans the caller's tokens.
+func (s *Service) find(ctx context.Context, id string) (*AccessToken, error) {
+	for t, err := range s.Iter(ctx, ListInput{}) {
+		if err != nil {
+			return nil, err
+		}
+		if t.ID == id {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("access token %s not found", id)
+}
+
+// IterExpiring returns a lazy [iter.Seq2] over the caller's active tokens
+// that expire within the given window from now, soonest first. Tokens
+// without an expiry are skipped. Use it to drive rotation before
+// credentials lapse:
+//
+//	for t, err := range c.AccessTokens.IterExpiring(ctx, 14*24*time.Hour) {
+//	    if err != nil { return err }
+//	    fmt.Printf("%s expires %s\n", t.Name, t.ExpiresAt)
+//	}
+func (s *Service) IterExpiring(ctx context.Context, within time.Duration, opts ...paging.Option) iter.Seq2[AccessToken, error] {
+	return func(yield func(AccessToken, error) bool) {
+		cutoff := time.Now().Add(within)
+		seq := s.Iter(ctx, ListInput{Status: StatusActive, SortBy: SortByExpiresAt, SortOrder: SortAsc}, opts...)
+		for t, err := range seq {
+			if err != nil {
+				yield(AccessToken{}, err)
+				return
+			}
+			if t.ExpiresAt.IsZero() {
+				continue
+			}
+			// Sorted soonest-first: everything after this is later still.
+			if t.ExpiresAt.After(cutoff) {
+				return
+			}
+			if !yield(t, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package accesstokens_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/accesstokens"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+func listOne(token map[string]any) gqltest.Response {
+	return gqltest.RespondWithData(map[string]any{
+		"accessTokens": map[string]any{"cursor": map[string]any{}, "items": []map[string]any{token}},
+	})
+}
+
+func createdToken(id, raw string) gqltest.Response {
+	return gqltest.RespondWithData(map[string]any{
+		"createAccessToken": map[string]any{
+			"result":     map[string]any{"id": id, "name": "ci", "token": raw, "prefix": raw[:7], "scopes": []string{"*"}},
+			"successful": true,
+		},
+	})
+}
+
+func revokedToken(id string) gqltest.Response {
+	return gqltest.RespondWithData(map[string]any{
+		"revokeAccessToken": map[string]any{
+			"result":     map[string]any{"id": id, "name": "ci", "prefix": "md_x", "revokedAt": "2026-05-08T11:00:00Z"},
+			"successful": true,
+		},
+	})
+}
+
+var oldToken = map[string]any{
+	"id": "t-old", "name": "ci", "prefix": "md_old", "scopes": []string{"*"},
+	"createdAt": "2026-01-01T00:00:00Z", "expiresAt": "2026-01-31T00:00:00Z",
+}
+
+func TestRotate(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		listOne(oldToken),
+		createdToken("t-new", "md_NEW_VALUE"),
+		revokedToken("t-old"),
+	)
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("md_OLD_VALUE\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var verified string
+	got, err := newService(gqlClient).Rotate(t.Context(), "t-old", accesstokens.RotateInput{
+		Sink: accesstokens.FileSink(path),
+		Verify: func(_ context.Context, tok *accesstokens.Created) error {
+			verified = tok.Token
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Rotate: %v", err)
+	}
+	if got.New.ID != "t-new" || got.Old.RevokedAt.IsZero() {
+		t.Errorf("Rotated = %+v, want t-new with t-old revoked", got)
+	}
+	if verified != "md_NEW_VALUE" {
+		t.Errorf("verified %q, want md_NEW_VALUE", verified)
+	}
+	if b, _ := os.ReadFile(path); string(b) != "md_NEW_VALUE\n" {
+		t.Errorf("file = %q, want the new token", b)
+	}
+
+	// The replacement inherits the old name, scopes and 30-day lifetime.
+	reqs := gqlClient.Requests()
+	input, _ := reqs[1].Variables["input"].(map[string]any)
+	if input["name"] != "ci" || input["expiresInMinutes"] != float64(30*24*60) {
+		t.Errorf("create input = %v, want name ci and 43200 minutes", input)
+	}
+	if reqs[2].Variables["id"] != "t-old" {
+		t.Errorf("revoked %v, want t-old", reqs[2].Variables["id"])
+	}
+}
+
+// TestRotate_VerifyFailureRollsBack confirms a replacement that fails
+// verification is revoked and the sink is restored, leaving the old
+// token live.
+func TestRotate_VerifyFailureRollsBack(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		listOne(oldToken),
+		createdToken("t-new", "md_NEW_VALUE"),
+		revokedToken("t-new"),
+	)
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("md_OLD_VALUE\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	errDenied := errors.New("denied")
+	_, err := newService(gqlClient).Rotate(t.Context(), "t-old", accesstokens.RotateInput{
+		Sink:   accesstokens.FileSink(path),
+		Verify: func(context.Context, *accesstokens.Created) error { return errDenied },
+	})
+	if !errors.Is(err, errDenied) {
+		t.Fatalf("err = %v, want errDenied", err)
+	}
+	if b, _ := os.ReadFile(path); string(b) != "md_OLD_VALUE\n" {
+		t.Errorf("file = %q, want the old token restored", b)
+	}
+	reqs := gqlClient.Requests()
+	if len(reqs) != 3 || reqs[2].Variables["id"] != "t-new" {
+		t.Errorf("requests = %d, want the replacement revoked last", len(reqs))
+	}
+}
+
+// TestRotate_NoExpiry confirms a token without an expiry isn't quietly
+// replaced by a one-hour token: Rotate asks for an explicit lifetime and
+// creates nothing.
+func TestRotate_NoExpiry(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		listOne(map[string]any{"id": "t-old", "name": "ci", "prefix": "md_old", "scopes": []string{"*"}, "createdAt": "2026-01-01T00:00:00Z"}),
+	)
+	_, err := newService(gqlClient).Rotate(t.Context(), "t-old", accesstokens.RotateInput{
+		Sink: accesstokens.SinkFunc(func(context.Context, *accesstokens.Created) error { return nil }),
+	})
+	if err == nil || !strings.Contains(err.Error(), "ExpiresInMinutes") {
+		t.Fatalf("err = %v, want a request for ExpiresInMinutes", err)
+	}
+	if n := len(gqlClient.Requests()); n != 1 {
+		t.Errorf("made %d requests, want just the lookup", n)
+	}
+}
+
+func TestIterExpiring(t *testing.T) {
+	soon := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	later := time.Now().Add(60 * 24 * time.Hour).UTC().Format(time.RFC3339)
+	gqlClient := gqltest.NewClient(
+		gqltest.RespondWithData(map[string]any{
+			"accessTokens": map[string]any{
+				"cursor": map[string]any{"next": "more"},
+				"items": []map[string]any{
+					{"id": "t-never", "name": "forever"},
+					{"id": "t-soon", "name": "soon", "expiresAt": soon},
+					{"id": "t-later", "name": "later", "expiresAt": later},
+				},
+			},
+		}),
+	)
+
+	got, err := types.Collect(newService(gqlClient).IterExpiring(t.Context(), 7*24*time.Hour))
+	if err != nil {
+		t.Fatalf("IterExpiring: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != "t-soon" {
+		t.Fatalf("got %+v, want only t-soon", got)
+	}
+	// Stops at the first token past the window without fetching more.
+	if n := len(gqlClient.Requests()); n != 1 {
+		t.Errorf("requests = %d, want 1", n)
+	}
+	sort, _ := gqlClient.Requests()[0].Variables["sort"].(map[string]any)
+	if sort["field"] != "EXPIRES_AT" || sort["order"] != "ASC" {
+		t.Errorf("sort = %v, want EXPIRES_AT ASC", sort)
+	}
+}
@@ -1,7 +1,12 @@
 package auditlogs_test
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"iter"
+	"slices"
 	"testing"
 	"time"
 
@@ -9,6 +14,7 @@ import (
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/client"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/paging"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/auditlogs"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
 )
@@ -252,6 +258,116 @@ func TestIter_StopsEarly(t *testing.T) {
 	}
 }
 
+// auditPages scripts pages n..total of a walk over total pages of
+// perPage events each (evt-1, evt-2, …), chained by cursor.
+func auditPages(n, total, perPage int) []gqltest.Response {
+	var pages []gqltest.Response
+	for p := n; p <= total; p++ {
+		cursor := map[string]any{}
+		if p < total {
+			cursor["next"] = fmt.Sprintf("page-%d", p+1)
+		}
+		var items []map[string]any
+		for i := range perPage {
+			items = append(items, map[string]any{"id": fmt.Sprintf("evt-%d", (p-1)*perPage+i+1), "type": "project.updated"})
+		}
+		pages = append(pages, gqltest.RespondWithData(map[string]any{
+			"auditLogs": map[string]any{"cursor": cursor, "items": items},
+		}))
+	}
+	return pages
+}
+
+// TestIter_PrefetchYieldsSameSequence confirms read-ahead changes only
+// when pages are fetched, not what is yielded or in what order.
+func TestIter_PrefetchYieldsSameSequence(t *testing.T) {
+	gqlClient := gqltest.NewClient(auditPages(1, 4, 1)...)
+
+	got, err := types.Collect(newService(gqlClient).Iter(t.Context(), auditlogs.ListInput{}, paging.Prefetch(2)))
+	if err != nil {
+		t.Fatalf("Iter: %v", err)
+	}
+	var ids []string
+	for _, ev := range got {
+		ids = append(ids, ev.ID)
+	}
+	if want := []string{"evt-1", "evt-2", "evt-3", "evt-4"}; !slices.Equal(ids, want) {
+		t.Errorf("ids = %v, want %v", ids, want)
+	}
+	// Cursors still chain page to page.
+	reqs := gqlClient.Requests()
+	if after, _ := reqs[3].Variables["cursor"].(map[string]any); after["next"] != "page-4" {
+		t.Errorf("4th request cursor = %v, want next=page-4", after)
+	}
+}
+
+// TestIter_PrefetchBreakIsBounded confirms that breaking out stops the
+// read-ahead: at most the consumed page, the buffered page, and one
+// in-hand page are ever requested.
+func TestIter_PrefetchBreakIsBounded(t *testing.T) {
+	gqlClient := gqltest.NewClient(auditPages(1, 6, 1)...)
+
+	for _, err := range newService(gqlClient).Iter(t.Context(), auditlogs.ListInput{}, paging.Prefetch(1)) {
+		if err != nil {
+			t.Fatalf("iter err: %v", err)
+		}
+		break
+	}
+	// Iter has returned, so the producer has exited; the count is final.
+	if got := len(gqlClient.Requests()); got > 3 {
+		t.Errorf("issued %d requests, want <= 3 with Prefetch(1)", got)
+	}
+}
+
+// TestIter_ResumeFromCheckpoint simulates an export that dies midway
+// through a page and resumes from its last saved checkpoint without
+// repeating or skipping an event.
+func TestIter_ResumeFromCheckpoint(t *testing.T) {
+	svc := newService(gqltest.NewClient(auditPages(1, 3, 2)...))
+	open := func(ctx context.Context) func(...paging.Option) iter.Seq2[auditlogs.AuditLog, error] {
+		return func(opts ...paging.Option) iter.Seq2[auditlogs.AuditLog, error] {
+			return svc.Iter(ctx, auditlogs.ListInput{}, opts...)
+		}
+	}
+
+	var saved paging.Checkpoint
+	for item, err := range paging.WithCheckpoints(open(t.Context())) {
+		if err != nil {
+			t.Fatalf("iter err: %v", err)
+		}
+		saved = item.Checkpoint
+		if item.Value.ID == "evt-3" {
+			break // "crash" after handling the first event of page 2
+		}
+	}
+	if saved != (paging.Checkpoint{Cursor: "page-2", Offset: 1}) {
+		t.Fatalf("checkpoint = %+v, want page-2 offset 1", saved)
+	}
+
+	// The checkpoint survives a JSON round trip.
+	body, _ := json.Marshal(saved)
+	var restored paging.Checkpoint
+	if err := json.Unmarshal(body, &restored); err != nil {
+		t.Fatal(err)
+	}
+
+	gqlClient := gqltest.NewClient(auditPages(2, 3, 2)...)
+	svc = newService(gqlClient)
+	var ids []string
+	for ev, err := range svc.Iter(t.Context(), auditlogs.ListInput{}, paging.ResumeFrom(restored)) {
+		if err != nil {
+			t.Fatalf("iter err: %v", err)
+		}
+		ids = append(ids, ev.ID)
+	}
+	if want := []string{"evt-4", "evt-5", "evt-6"}; !slices.Equal(ids, want) {
+		t.Errorf("resumed ids = %v, want %v", ids, want)
+	}
+	if cursor, _ := gqlClient.Requests()[0].Variables["cursor"].(map[string]any); cursor["next"] != "page-2" {
+		t.Errorf("first resumed request cursor = %v, want next=page-2", cursor)
+	}
+}
+
 // TestIter_TransportErrorYieldsOnce confirms a transport error is
 // surfaced through the yielded error and the iterator stops.
 func TestIter_TransportErrorYieldsOnce(t *testing.T) {
@@ -113,8 +113,8 @@ func (s *Service) Get(ctx context.Context, id string) (*Bundle, error) {
 // Returned [Bundle]s do not include dependencies/resources — call [Service.Get]
 // for the full per-version shape. To buffer every match into a slice, wrap with
 // [types.Collect].
-func (s *Service) Iter(ctx context.Context, input ListInput) iter.Seq2[Bundle, error] {
-	return paging.Iter(ctx, input.After, s.page(input))
+func (s *Service) Iter(ctx context.Context, input ListInput, opts ...paging.Option) iter.Seq2[Bundle, error] {
+	return paging.Iter(ctx, input.After, s.page(input), opts...)
 }
 
 // ListPage returns a single page of bundles matching input. input.PageSize
@@ -0,0 +1,388 @@
+package components
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/instances"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// ErrIncompatibleLink is returned (wrapped) by [Service.ValidateLink] when
+// the source bundle's output can't satisfy the destination bundle's input.
+// Match with [errors.Is].
+var ErrIncompatibleLink = errors.New("incompatible link")
+
+// orientationDefault marks resource types supplied by environment defaults
+// rather than drawn links.
+const orientationDefault = "ENVIRONMENT_DEFAULT"
+
+// ValidateLink checks input against the bundles behind its two components
+// without creating anything: FromField must be a resource the source
+// bundle produces, ToField a dependency the destination bundle declares,
+// and both must be the same resource type. Bundles are resolved at
+// input.FromVersion and input.ToVersion; empty resolves the bare repo
+// name — the latest stable release, or the latest dev build when there is
+// no stable one.
+//
+// A nil return means [Service.AddLink] should accept the link on
+// compatibility grounds. Otherwise the error wraps [ErrIncompatibleLink],
+// or the lookup error when a component or bundle can't be read.
+func (s *Service) ValidateLink(ctx context.Context, input AddLinkInput) error {
+	r := s.resolver()
+	from, err := r.bundleOf(ctx, input.FromComponentID, input.FromVersion)
+	if err != nil {
+		return err
+	}
+	to, err := r.bundleOf(ctx, input.ToComponentID, input.ToVersion)
+	if err != nil {
+		return err
+	}
+	return checkLink(from, input.FromField, to, input.ToField)
+}
+
+// LinkCandidate is a link [Service.SuggestLinks] found to be valid but not
+// yet drawn.
+type LinkCandidate struct {
+	FromComponentID string
+	FromField       string
+	ToComponentID   string
+	ToField         string
+	// ResourceType is the type ID both fields carry.
+	ResourceType string
+	// FromVersion and ToVersion are the exact bundle versions the
+	// candidate was checked at.
+	FromVersion string
+	ToVersion   string
+}
+
+// Input returns the candidate as an [AddLinkInput] at the versions
+// SuggestLinks checked.
+func (c LinkCandidate) Input() AddLinkInput {
+	return AddLinkInput{
+		FromComponentID: c.FromComponentID,
+		FromField:       c.FromField,
+		FromVersion:     c.FromVersion,
+		ToComponentID:   c.ToComponentID,
+		ToField:         c.ToField,
+		ToVersion:       c.ToVersion,
+	}
+}
+
+// UnsatisfiedDependency is a required dependency no link feeds.
+type UnsatisfiedDependency struct {
+	ComponentID  string
+	Field        string
+	ResourceType string
+	// Candidates are the links that would satisfy it; empty when no
+	// component in the project produces the type.
+	Candidates []LinkCandidate
+}
+
+// InvalidLink is an existing link the bundles no longer support, such as
+// after an upgrade renamed a field.
+type InvalidLink struct {
+	Link Link
+	Err  error
+}
+
+// UnresolvedComponent is a component whose bundle couldn't be read. It is
+// left out of every other part of the [LinkSuggestions].
+type UnresolvedComponent struct {
+	ComponentID string
+	Err         error
+}
+
+// LinkSuggestions is the result of [Service.SuggestLinks]. Every slice is
+// sorted by component ID and field.
+type LinkSuggestions struct {
+	// Candidates are all valid links not yet drawn, including ones for
+	// optional dependencies and ones for dependencies already fed by
+	// another link.
+	Candidates []LinkCandidate
+	// Unsatisfied are required dependencies no link feeds.
+	Unsatisfied []UnsatisfiedDependency
+	// Invalid are existing links that fit neither the components'
+	// current bundles nor the versions deployed in any environment.
+	Invalid []InvalidLink
+	// Unresolved are components whose bundle lookup failed.
+	Unresolved []UnresolvedComponent
+}
+
+// SuggestLinks checks a whole blueprint against its bundles' metadata:
+// every pairing of one component's resources with another's dependencies
+// of the same type that isn't linked yet, every required dependency left
+// unfed, and every existing link that no longer fits.
+//
+// Each component's bundle is resolved by bare repo name — the latest
+// stable release, or the latest dev build when there is none. A
+// component whose bundle can't be read is reported in Unresolved and
+// skipped; the rest of the blueprint is still checked.
+//
+// Links don't record the versions they were drawn at, so an existing
+// link that doesn't fit the current bundles is checked again against the
+// versions deployed in each environment of the project (Instances.Iter),
+// and only reported Invalid when it fits none of them. With a caching
+// client, pass a context from cache.Bypass to read those versions live.
+//
+// Dependencies whose resource type is supplied by environment defaults
+// (connection orientation ENVIRONMENT_DEFAULT) are never reported as
+// unsatisfied or suggested; they're wired per environment, not on the
+// canvas.
+func (s *Service) SuggestLinks(ctx context.Context, projectID string) (*LinkSuggestions, error) {
+	comps, err := s.List(ctx, ListInput{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	links, err := s.ListLinks(ctx, ListInput{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(comps, func(a, b Component) int { return strings.Compare(a.ID, b.ID) })
+
+	out := &LinkSuggestions{}
+	r := s.resolver()
+	bundleByComp := map[string]*types.Bundle{}
+	for _, c := range comps {
+		if c.OciRepo == nil {
+			continue
+		}
+		b, err := r.bundle(ctx, c.OciRepo.Name, "")
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			out.Unresolved = append(out.Unresolved, UnresolvedComponent{ComponentID: c.ID, Err: err})
+			continue
+		}
+		bundleByComp[c.ID] = b
+	}
+
+	fed := map[string]bool{}
+	var deployed []map[string]string // per environment: component ID → version
+	for _, l := range links {
+		if l.FromComponent == nil || l.ToComponent == nil {
+			continue
+		}
+		fed[l.ToComponent.ID+"."+l.ToField] = true
+		from, to := bundleByComp[l.FromComponent.ID], bundleByComp[l.ToComponent.ID]
+		if from == nil || to == nil {
+			continue
+		}
+		linkErr := checkLink(from, l.FromField, to, l.ToField)
+		if linkErr == nil {
+			continue
+		}
+		if deployed == nil {
+			if deployed, err = s.deployedVersions(ctx, projectID); err != nil {
+				return nil, err
+			}
+		}
+		if !r.fitsDeployed(ctx, l, from, to, deployed) {
+			out.Invalid = append(out.Invalid, InvalidLink{Link: l, Err: linkErr})
+		}
+	}
+
+	for _, to := range comps {
+		tb := bundleByComp[to.ID]
+		if tb == nil {
+			continue
+		}
+		deps := slices.Clone(tb.Dependencies)
+		slices.SortFunc(deps, func(a, b types.BundleDependency) int { return strings.Compare(a.Name, b.Name) })
+		for _, dep := range deps {
+			typ := resourceTypeID(dep.ResourceType)
+			if typ == "" || dep.ResourceType.ConnectionOrientation == orientationDefault {
+				continue
+			}
+			var candidates []LinkCandidate
+			for _, from := range comps {
+				fb := bundleByComp[from.ID]
+				if fb == nil || from.ID == to.ID {
+					continue
+				}
+				for _, res := range fb.Resources {
+					if resourceTypeID(res.ResourceType) != typ {
+						continue
+					}
+					candidates = append(candidates, LinkCandidate{
+						FromComponentID: from.ID,
+						FromField:       res.Name,
+						ToComponentID:   to.ID,
+						ToField:         dep.Name,
+						ResourceType:    typ,
+						FromVersion:     versionOf(fb),
+						ToVersion:       versionOf(tb),
+					})
+				}
+			}
+			slices.SortFunc(candidates, func(a, b LinkCandidate) int {
+				return cmp.Or(strings.Compare(a.FromComponentID, b.FromComponentID), strings.Compare(a.FromField, b.FromField))
+			})
+			for _, c := range candidates {
+				if !linked(links, c) {
+					out.Candidates = append(out.Candidates, c)
+				}
+			}
+			if dep.Required && !fed[to.ID+"."+dep.Name] {
+				out.Unsatisfied = append(out.Unsatisfied, UnsatisfiedDependency{
+					ComponentID:  to.ID,
+					Field:        dep.Name,
+					ResourceType: typ,
+					Candidates:   candidates,
+				})
+			}
+		}
+	}
+	return out, nil
+}
+
+// checkLink reports why from's fromField can't feed to's toField, or nil.
+func checkLink(from *types.Bundle, fromField string, to *types.Bundle, toField string) error {
+	var produced *types.BundleResource
+	for i := range from.Resources {
+		if from.Resources[i].Name == fromField {
+			produced = &from.Resources[i]
+		}
+	}
+	if produced == nil {
+		return fmt.Errorf("%w: %s produces no resource %q", ErrIncompatibleLink, from.ID, fromField)
+	}
+	var needed *types.BundleDependency
+	for i := range to.Dependencies {
+		if to.Dependencies[i].Name == toField {
+			needed = &to.Dependencies[i]
+		}
+	}
+	if needed == nil {
+		return fmt.Errorf("%w: %s declares no dependency %q", ErrIncompatibleLink, to.ID, toField)
+	}
+	have, want := resourceTypeID(produced.ResourceType), resourceTypeID(needed.ResourceType)
+	if have != want {
+		return fmt.Errorf("%w: %s.%s is %s, %s.%s needs %s", ErrIncompatibleLink, from.ID, fromField, have, to.ID, toField, want)
+	}
+	return nil
+}
+
+func resourceTypeID(rt *types.ResourceType) string {
+	if rt == nil {
+		return ""
+	}
+	return rt.ID
+}
+
+func linked(links []Link, c LinkCandidate) bool {
+	return slices.ContainsFunc(links, func(l Link) bool {
+		return l.FromComponent != nil && l.ToComponent != nil &&
+			l.FromComponent.ID == c.FromComponentID && l.FromField == c.FromField &&
+			l.ToComponent.ID == c.ToComponentID && l.ToField == c.ToField
+	})
+}
+
+// bundleResolver fetches each component's bundle once per call.
+type bundleResolver struct {
+	s     *Service
+	cache map[string]*types.Bundle
+}
+
+func (s *Service) resolver() *bundleResolver {
+	return &bundleResolver{s: s, cache: map[string]*types.Bundle{}}
+}
+
+// bundleOf resolves the bundle behind componentID at version.
+func (r *bundleResolver) bundleOf(ctx context.Context, componentID, version string) (*types.Bundle, error) {
+	c, err := r.s.Get(ctx, componentID)
+	if err != nil {
+		return nil, err
+	}
+	if c.OciRepo == nil {
+		return nil, fmt.Errorf("validate link: component %s has no OCI repo", componentID)
+	}
+	return r.bundle(ctx, c.OciRepo.Name, version)
+}
+
+// bundle resolves repo at version, or by bare name when version is
+// empty.
+func (r *bundleResolver) bundle(ctx context.Context, repo, version string) (*types.Bundle, error) {
+	id := repo
+	if version != "" {
+		id += "@" + version
+	}
+	if b, ok := r.cache[id]; ok {
+		return b, nil
+	}
+	b, err := r.s.bundles.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r.cache[id] = b
+	r.cache[b.ID] = b
+	return b, nil
+}
+
+// versionOf returns the resolved version of b from its "name@version" ID.
+func versionOf(b *types.Bundle) string {
+	_, v, _ := strings.Cut(b.ID, "@")
+	return v
+}
+
+// repoOf returns the repo name of b from its "name@version" ID.
+func repoOf(b *types.Bundle) string {
+	name, _, _ := strings.Cut(b.ID, "@")
+	return name
+}
+
+// deployedVersions maps, for each environment of the project, every
+// instance's component ID to the bundle version it is deployed at, or
+// would deploy at when it never has been.
+func (s *Service) deployedVersions(ctx context.Context, projectID string) ([]map[string]string, error) {
+	byEnv := map[string]map[string]string{}
+	for inst, err := range s.instances.Iter(ctx, instances.ListInput{ProjectID: projectID}) {
+		if err != nil {
+			return nil, err
+		}
+		if inst.Environment == nil || inst.Component == nil {
+			continue
+		}
+		env := byEnv[inst.Environment.ID]
+		if env == nil {
+			env = map[string]string{}
+			byEnv[inst.Environment.ID] = env
+		}
+		env[inst.Component.ID] = cmp.Or(inst.DeployedVersion, inst.ResolvedVersion)
+	}
+	out := make([]map[string]string, 0, len(byEnv))
+	for _, id := range slices.Sorted(maps.Keys(byEnv)) {
+		out = append(out, byEnv[id])
+	}
+	return out, nil
+}
+
+// fitsDeployed reports whether l fits the bundle versions both its ends
+// run in some environment. Versions that can't be read count as no fit.
+func (r *bundleResolver) fitsDeployed(ctx context.Context, l Link, from, to *types.Bundle, deployed []map[string]string) bool {
+	for _, env := range deployed {
+		fv, fok := env[l.FromComponent.ID]
+		tv, tok := env[l.ToComponent.ID]
+		if !fok || !tok || fv == "" || tv == "" {
+			continue
+		}
+		fb, err := r.bundle(ctx, repoOf(from), fv)
+		if err != nil {
+			continue
+		}
+		tb, err := r.bundle(ctx, repoOf(to), tv)
+		if err != nil {
+			continue
+		}
+		if checkLink(fb, l.FromField, tb, l.ToField) == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+package components_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/components"
+)
+
+func field(name, typ string, required bool) map[string]any {
+	return map[string]any{"name": name, "required": required, "resourceType": map[string]any{"id": typ, "connectionOrientation": "LINK"}}
+}
+
+func auroraBundle() gqltest.Response {
+	return gqltest.RespondWithData(map[string]any{"bundle": map[string]any{
+		"id": "aws-aurora-postgres@1.2.3",
+		"dependencies": []map[string]any{
+			field("network", "aws-vpc", true),
+		},
+		"resources": []map[string]any{field("authentication", "postgresql-authentication", false)},
+	}})
+}
+
+func appBundle() gqltest.Response {
+	return gqltest.RespondWithData(map[string]any{"bundle": map[string]any{
+		"id": "aws-ecs-service@2.0.0",
+		"dependencies": []map[string]any{
+			field("database", "postgresql-authentication", true),
+			field("cache", "redis-authentication", true),
+			{"name": "cloud", "required": true, "resourceType": map[string]any{"id": "aws-iam-role", "connectionOrientation": "ENVIRONMENT_DEFAULT"}},
+		},
+	}})
+}
+
+func TestValidateLink(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		gqltest.RespondWithData(map[string]any{"component": map[string]any{"id": "ecomm-db", "ociRepo": map[string]any{"name": "aws-aurora-postgres"}}}),
+		auroraBundle(),
+		gqltest.RespondWithData(map[string]any{"component": map[string]any{"id": "ecomm-app", "ociRepo": map[string]any{"name": "aws-ecs-service"}}}),
+		appBundle(),
+	)
+
+	err := newService(gqlClient).ValidateLink(t.Context(), components.AddLinkInput{
+		FromComponentID: "ecomm-db", FromField: "authentication", FromVersion: "~1",
+		ToComponentID: "ecomm-app", ToField: "cache",
+	})
+	if !errors.Is(err, components.ErrIncompatibleLink) {
+		t.Fatalf("err = %v, want ErrIncompatibleLink", err)
+	}
+	want := "incompatible link: aws-aurora-postgres@1.2.3.authentication is postgresql-authentication, aws-ecs-service@2.0.0.cache needs redis-authentication"
+	if err.Error() != want {
+		t.Errorf("err = %q, want %q", err, want)
+	}
+	reqs := gqlClient.Requests()
+	if reqs[1].Variables["id"] != "aws-aurora-postgres@~1" || reqs[3].Variables["id"] != "aws-ecs-service" {
+		t.Errorf("bundle ids = %v, %v", reqs[1].Variables["id"], reqs[3].Variables["id"])
+	}
+}
+
+func TestSuggestLinks(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		gqltest.RespondWithData(map[string]any{
+			"project": map[string]any{"id": "ecomm", "components": []map[string]any{
+				{"id": "ecomm-db", "ociRepo": map[string]any{"name": "aws-aurora-postgres"}},
+				{"id": "ecomm-app", "ociRepo": map[string]any{"name": "aws-ecs-service"}},
+			}},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"project": map[string]any{"id": "ecomm", "links": []map[string]any{
+				{"id": "l-1", "fromField": "authentication", "toField": "cache", "fromComponent": map[string]any{"id": "ecomm-db"}, "toComponent": map[string]any{"id": "ecomm-app"}},
+			}},
+		}),
+		appBundle(),
+		auroraBundle(),
+		// l-1 doesn't fit the current bundles, so the deployed versions are
+		// checked too; they're the same ones.
+		gqltest.RespondWithData(map[string]any{"instances": map[string]any{"cursor": map[string]any{}, "items": []map[string]any{
+			{"id": "ecomm-prod-db", "deployedVersion": "1.2.3", "environment": map[string]any{"id": "ecomm-prod"}, "component": map[string]any{"id": "ecomm-db"}},
+			{"id": "ecomm-prod-app", "deployedVersion": "2.0.0", "environment": map[string]any{"id": "ecomm-prod"}, "component": map[string]any{"id": "ecomm-app"}},
+		}}}),
+	)
+
+	got, err := newService(gqlClient).SuggestLinks(t.Context(), "ecomm")
+	if err != nil {
+		t.Fatalf("SuggestLinks: %v", err)
+	}
+	if len(got.Candidates) != 1 || got.Candidates[0] != (components.LinkCandidate{
+		FromComponentID: "ecomm-db", FromField: "authentication", ToComponentID: "ecomm-app", ToField: "database", ResourceType: "postgresql-authentication",
+		FromVersion: "1.2.3", ToVersion: "2.0.0",
+	}) {
+		t.Errorf("Candidates = %+v", got.Candidates)
+	}
+	// app.cache is fed (if wrongly), app.cloud comes from a default, so
+	// only app.database and db.network are unsatisfied.
+	if len(got.Unsatisfied) != 2 ||
+		got.Unsatisfied[0].ComponentID != "ecomm-app" || got.Unsatisfied[0].Field != "database" || len(got.Unsatisfied[0].Candidates) != 1 ||
+		got.Unsatisfied[1].ComponentID != "ecomm-db" || got.Unsatisfied[1].Field != "network" || len(got.Unsatisfied[1].Candidates) != 0 {
+		t.Errorf("Unsatisfied = %+v", got.Unsatisfied)
+	}
+	if len(got.Invalid) != 1 || got.Invalid[0].Link.ID != "l-1" || !errors.Is(got.Invalid[0].Err, components.ErrIncompatibleLink) {
+		t.Errorf("Invalid = %+v", got.Invalid)
+	}
+	if in := got.Candidates[0].Input(); in.FromVersion != "1.2.3" || in.ToVersion != "2.0.0" || in.ToField != "database" {
+		t.Errorf("Input() = %+v", in)
+	}
+}
+
+func TestSuggestLinks_DeployedVersionsAndUnresolved(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		gqltest.RespondWithData(map[string]any{
+			"project": map[string]any{"id": "ecomm", "components": []map[string]any{
+				{"id": "ecomm-app", "ociRepo": map[string]any{"name": "aws-ecs-service"}},
+				{"id": "ecomm-cache", "ociRepo": map[string]any{"name": "aws-elasticache"}},
+				{"id": "ecomm-db", "ociRepo": map[string]any{"name": "aws-aurora-postgres"}},
+			}},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"project": map[string]any{"id": "ecomm", "links": []map[string]any{
+				{"id": "l-1", "fromField": "authentication", "toField": "db", "fromComponent": map[string]any{"id": "ecomm-db"}, "toComponent": map[string]any{"id": "ecomm-app"}},
+			}},
+		}),
+		appBundle(),
+		gqltest.RespondWithError("bundle not found"),
+		auroraBundle(),
+		gqltest.RespondWithData(map[string]any{"instances": map[string]any{"cursor": map[string]any{}, "items": []map[string]any{
+			{"id": "ecomm-prod-db", "deployedVersion": "1.2.3", "environment": map[string]any{"id": "ecomm-prod"}, "component": map[string]any{"id": "ecomm-db"}},
+			{"id": "ecomm-prod-app", "deployedVersion": "1.9.0", "environment": map[string]any{"id": "ecomm-prod"}, "component": map[string]any{"id": "ecomm-app"}},
+		}}}),
+		// Before 2.0.0 the app's database dependency was called db.
+		gqltest.RespondWithData(map[string]any{"bundle": map[string]any{
+			"id":           "aws-ecs-service@1.9.0",
+			"dependencies": []map[string]any{field("db", "postgresql-authentication", true)},
+		}}),
+	)
+
+	got, err := newService(gqlClient).SuggestLinks(t.Context(), "ecomm")
+	if err != nil {
+		t.Fatalf("SuggestLinks: %v", err)
+	}
+	if len(got.Unresolved) != 1 || got.Unresolved[0].ComponentID != "ecomm-cache" || got.Unresolved[0].Err == nil {
+		t.Errorf("Unresolved = %+v", got.Unresolved)
+	}
+	if len(got.Invalid) != 0 {
+		t.Errorf("Invalid = %+v, want l-1 accepted at the deployed versions", got.Invalid)
+	}
+	reqs := gqlClient.Requests()
+	if id := reqs[len(reqs)-1].Variables["id"]; id != "aws-ecs-service@1.9.0" {
+		t.Errorf("last bundle id = %v, want aws-ecs-service@1.9.0", id)
+	}
+}
@@ -7,8 +7,9 @@
 // to another component's input. At deploy time, each link is realized as a
 // runtime [Connection] in the environment.
 //
-// To list every component or link in a project, use projects.Get and read the
-// embedded Components/Links slices — there is no top-level list query.
+// To list every component or link in a project, use [Service.List] and
+// [Service.ListLinks], or projects.Get and read the embedded Components/Links
+// slices — there is no top-level list query.
 //
 // # Verbs
 //
@@ -30,21 +31,29 @@ import (
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/client"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/decode"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/gen"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/bundles"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/instances"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
 )
 
 // Service is the receiver for component operations. Construct with [New];
 // for the typical case you'll use the [massdriver.Client.Components] field.
 type Service struct {
-	client *client.Client
+	client    *client.Client
+	bundles   *bundles.Service
+	instances *instances.Service
 }
 
-// New returns a [*Service] bound to the given low-level client.
+// New returns a [*Service] bound to the given low-level client. bun
+// resolves the bundles behind components for [Service.ValidateLink] and
+// [Service.SuggestLinks]; inst reads the versions a project has deployed.
 //
 // Most callers should use [massdriver.New] instead, which constructs the
 // low-level client and pre-wires every service. Use [New] only when you
 // need a single service in isolation or for tests with a custom client.
-func New(c *client.Client) *Service { return &Service{client: c} }
+func New(c *client.Client, bun *bundles.Service, inst *instances.Service) *Service {
+	return &Service{client: c, bundles: bun, instances: inst}
+}
 
 // Component is a Massdriver project component — alias of [types.Component].
 type Component = types.Component
@@ -136,6 +145,28 @@ func (s *Service) List(ctx context.Context, input ListInput) ([]Component, error
 	return out, nil
 }
 
+// ListLinks returns every link in the named project's blueprint, with slim
+// (id/name) FromComponent and ToComponent refs. Returns [gql.ErrNotFound]
+// (wrapped) if the project does not exist.
+func (s *Service) ListLinks(ctx context.Context, input ListInput) ([]Link, error) {
+	resp, err := gen.ListComponentLinks(ctx, s.client.GQLv2, s.client.Config.OrganizationID, input.ProjectID)
+	if err != nil {
+		return nil, gql.ClassifyError(fmt.Errorf("list links in project %s: %w", input.ProjectID, err))
+	}
+	if resp.Project.Id == "" {
+		return nil, fmt.Errorf("list links in project %s: %w", input.ProjectID, gql.ErrNotFound)
+	}
+	out := make([]Link, 0, len(resp.Project.Links))
+	for _, item := range resp.Project.Links {
+		l, lerr := toLink(item)
+		if lerr != nil {
+			return nil, lerr
+		}
+		out = append(out, *l)
+	}
+	return out, nil
+}
+
 // Add adds a new component to a project's blueprint, sourcing it from
 // [AddInput.OciRepoName]'s latest published bundle.
 func (s *Service) Add(ctx context.Context, projectID string, input AddInput) (*Component, error) {
@@ -171,6 +202,22 @@ func (s *Service) Update(ctx context.Context, id string, input UpdateInput) (*Co
 	return toComponent(resp.UpdateComponent.Result)
 }
 
+// SetPosition moves a component on the blueprint canvas. Positions are
+// purely visual; they don't affect deployments.
+func (s *Service) SetPosition(ctx context.Context, id string, pos types.Position) (*Component, error) {
+	resp, err := gen.SetComponentPosition(ctx, s.client.GQLv2, s.client.Config.OrganizationID, id, gen.SetComponentPositionInput{
+		X: pos.X,
+		Y: pos.Y,
+	})
+	if err != nil {
+		return nil, gql.ClassifyError(fmt.Errorf("set component position %s: %w", id, err))
+	}
+	if err := gql.CheckMutation("set component position", resp.SetComponentPosition.Successful, resp.SetComponentPosition.Messages); err != nil {
+		return nil, err
+	}
+	return toComponent(resp.SetComponentPosition.Result)
+}
+
 // Remove removes a component from its project's blueprint, along with all of
 // its links. Any deployed instances must be decommissioned first.
 func (s *Service) Remove(ctx context.Context, id string) (*Component, error) {
@@ -8,7 +8,9 @@ import (
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/client"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/bundles"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/components"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/instances"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
 )
 
@@ -16,10 +18,11 @@ import (
 // preconfigured with an organization ID so the wrapper has something to
 // substitute into request variables.
 func newService(gqlClient *gqltest.Client) *components.Service {
-	return components.New(&client.Client{
+	c := &client.Client{
 		Config: config.Config{OrganizationID: "my-org"},
 		GQLv2:  gqlClient,
-	})
+	}
+	return components.New(c, bundles.New(c), instances.New(c))
 }
 
 func TestGet(t *testing.T) {
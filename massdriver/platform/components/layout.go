@@ -0,0 +1,232 @@
+package components
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// Default spacing between [Service.AutoLayout] columns and rows, in canvas
+// pixels. They leave room for the UI's component cards.
+const (
+	DefaultColumnSpacing = 400
+	DefaultRowSpacing    = 200
+)
+
+// Position is a component's place on the canvas — alias of
+// [types.Position].
+type Position = types.Position
+
+// AutoLayoutOptions controls [Service.AutoLayout].
+type AutoLayoutOptions struct {
+	// ColumnSpacing and RowSpacing are the distances between layers and
+	// between components within a layer. Zero = the defaults.
+	ColumnSpacing int
+	RowSpacing    int
+	// Origin is where the first component of the first layer goes.
+	Origin Position
+	// UnplacedOnly moves only components that have no position yet,
+	// leaving anything arranged by hand alone. Placed components still
+	// shape the layout, and a new component whose slot would overlap one
+	// moves down its column to the next free slot.
+	UnplacedOnly bool
+	// DryRun computes the layout without saving it.
+	DryRun bool
+}
+
+// AutoLayout arranges a project's components in layers from its links:
+// components nothing feeds into sit in the leftmost column, and each
+// consumer sits one column right of its rightmost producer. Within a column,
+// components are ordered by the average row of their producers, then by ID,
+// so wires cross as little as a single pass allows. A cycle is broken at one
+// of its links, chosen deterministically.
+//
+// Positions are saved with [Service.SetPosition], one component at a
+// time, skipping components already where the layout puts them. The
+// returned map holds the computed position of every component (keyed by
+// component ID), including ones left unmoved by opts.UnplacedOnly — those
+// keep their current position. On error the map covers the components
+// saved so far.
+func (s *Service) AutoLayout(ctx context.Context, projectID string, opts AutoLayoutOptions) (map[string]Position, error) {
+	comps, err := s.List(ctx, ListInput{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	links, err := s.ListLinks(ctx, ListInput{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	layout := Layout(comps, links, opts)
+
+	out := map[string]Position{}
+	for _, c := range comps {
+		want := layout[c.ID]
+		switch {
+		case c.Position != nil && *c.Position == want, opts.DryRun:
+			out[c.ID] = want
+			continue
+		}
+		if _, err := s.SetPosition(ctx, c.ID, want); err != nil {
+			return out, err
+		}
+		out[c.ID] = want
+	}
+	return out, nil
+}
+
+// Layout computes the positions [Service.AutoLayout] would assign, without
+// calling the API. Links naming components not in comps are ignored. With
+// opts.UnplacedOnly, placed components keep their current positions.
+func Layout(comps []Component, links []Link, opts AutoLayoutOptions) map[string]Position {
+	colSpacing := cmp.Or(opts.ColumnSpacing, DefaultColumnSpacing)
+	rowSpacing := cmp.Or(opts.RowSpacing, DefaultRowSpacing)
+
+	ids := make([]string, 0, len(comps))
+	known := map[string]bool{}
+	placed := map[string]Position{}
+	for _, c := range comps {
+		ids = append(ids, c.ID)
+		known[c.ID] = true
+		if opts.UnplacedOnly && c.Position != nil {
+			placed[c.ID] = *c.Position
+		}
+	}
+	slices.Sort(ids)
+
+	producers := map[string][]string{}
+	consumers := map[string][]string{}
+	indegree := map[string]int{}
+	for _, l := range links {
+		if l.FromComponent == nil || l.ToComponent == nil {
+			continue
+		}
+		from, to := l.FromComponent.ID, l.ToComponent.ID
+		if !known[from] || !known[to] || from == to || slices.Contains(producers[to], from) {
+			continue
+		}
+		producers[to] = append(producers[to], from)
+		consumers[from] = append(consumers[from], to)
+		indegree[to]++
+	}
+
+	// Longest-path layering, Kahn style. When only components in cycles
+	// are left, the one feeding the most others (out-degree minus
+	// in-degree among those left, then lowest ID) is released, so the
+	// result stays deterministic.
+	layer := map[string]int{}
+	done := map[string]bool{}
+	var queue []string
+	for _, id := range ids {
+		if indegree[id] == 0 {
+			queue = append(queue, id)
+		}
+	}
+	for len(done) < len(ids) {
+		if len(queue) == 0 {
+			best, bestScore := "", 0
+			for _, id := range ids {
+				if done[id] {
+					continue
+				}
+				score := 0
+				for _, c := range consumers[id] {
+					if !done[c] {
+						score++
+					}
+				}
+				for _, p := range producers[id] {
+					if !done[p] {
+						score--
+					}
+				}
+				if best == "" || score > bestScore {
+					best, bestScore = id, score
+				}
+			}
+			queue = append(queue, best)
+		}
+		id := queue[0]
+		queue = queue[1:]
+		if done[id] {
+			continue
+		}
+		done[id] = true
+		for _, p := range producers[id] {
+			if done[p] {
+				layer[id] = max(layer[id], layer[p]+1)
+			}
+		}
+		for _, c := range consumers[id] {
+			indegree[c]--
+			if indegree[c] == 0 && !done[c] {
+				queue = append(queue, c)
+			}
+		}
+	}
+
+	columns := map[int][]string{}
+	depth := 0
+	for _, id := range ids {
+		columns[layer[id]] = append(columns[layer[id]], id)
+		depth = max(depth, layer[id])
+	}
+
+	// Two cards overlap when they're closer than a column apart
+	// horizontally and a row apart vertically.
+	occupied := func(p Position) bool {
+		for _, q := range placed {
+			if abs(p.X-q.X) < colSpacing && abs(p.Y-q.Y) < rowSpacing {
+				return true
+			}
+		}
+		return false
+	}
+
+	row := map[string]int{}
+	out := make(map[string]Position, len(ids))
+	for col := 0; col <= depth; col++ {
+		members := columns[col]
+		bary := map[string]float64{}
+		for _, id := range members {
+			sum, n := 0, 0
+			for _, p := range producers[id] {
+				if layer[p] < col {
+					sum += row[p]
+					n++
+				}
+			}
+			if n > 0 {
+				bary[id] = float64(sum) / float64(n)
+			}
+		}
+		slices.SortStableFunc(members, func(a, b string) int {
+			return cmp.Or(cmp.Compare(bary[a], bary[b]), strings.Compare(a, b))
+		})
+		slot := 0
+		for i, id := range members {
+			row[id] = i
+			if p, ok := placed[id]; ok {
+				out[id] = p
+				continue
+			}
+			pos := Position{X: opts.Origin.X + col*colSpacing, Y: opts.Origin.Y + slot*rowSpacing}
+			for occupied(pos) {
+				slot++
+				pos.Y += rowSpacing
+			}
+			slot++
+			out[id] = pos
+		}
+	}
+	return out
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
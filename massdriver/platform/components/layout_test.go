@@ -0,0 +1,112 @@
+package components_test
+
+import (
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/components"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+func link(from, to string) types.Link {
+	return types.Link{FromComponent: &types.Component{ID: from}, ToComponent: &types.Component{ID: to}}
+}
+
+func TestLayout(t *testing.T) {
+	comps := []components.Component{
+		{ID: "p-app"}, {ID: "p-cache"}, {ID: "p-db"}, {ID: "p-network"}, {ID: "p-worker"},
+	}
+	links := []components.Link{
+		link("p-network", "p-db"),
+		link("p-network", "p-cache"),
+		link("p-db", "p-app"),
+		link("p-cache", "p-app"),
+		link("p-db", "p-worker"),
+		// A cycle between app and worker must not break layering.
+		link("p-app", "p-worker"),
+		link("p-worker", "p-app"),
+		link("p-db", "p-gone"),
+	}
+
+	got := components.Layout(comps, links, components.AutoLayoutOptions{Origin: components.Position{X: 10}})
+	want := map[string]components.Position{
+		"p-network": {X: 10, Y: 0},
+		"p-cache":   {X: 410, Y: 0},
+		"p-db":      {X: 410, Y: 200},
+		"p-app":     {X: 810, Y: 0},
+		"p-worker":  {X: 1210, Y: 0},
+	}
+	for id, pos := range want {
+		if got[id] != pos {
+			t.Errorf("%s = %+v, want %+v", id, got[id], pos)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("got %d positions, want %d", len(got), len(want))
+	}
+}
+
+func TestLayout_UnplacedOnlySkipsOccupiedSlots(t *testing.T) {
+	comps := []components.Component{
+		{ID: "p-network", Position: &components.Position{X: 0, Y: 0}},
+		{ID: "p-db", Position: &components.Position{X: 400, Y: 0}},
+		{ID: "p-cache"},
+		{ID: "p-queue"},
+	}
+	links := []components.Link{
+		link("p-network", "p-db"),
+		link("p-network", "p-cache"),
+		link("p-network", "p-queue"),
+	}
+
+	got := components.Layout(comps, links, components.AutoLayoutOptions{UnplacedOnly: true})
+	want := map[string]components.Position{
+		"p-network": {X: 0, Y: 0},
+		"p-db":      {X: 400, Y: 0},
+		// Row 0 of the second column is taken by p-db.
+		"p-cache": {X: 400, Y: 200},
+		"p-queue": {X: 400, Y: 400},
+	}
+	for id, pos := range want {
+		if got[id] != pos {
+			t.Errorf("%s = %+v, want %+v", id, got[id], pos)
+		}
+	}
+}
+
+func TestAutoLayout(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		gqltest.RespondWithData(map[string]any{
+			"project": map[string]any{"id": "p", "components": []map[string]any{
+				{"id": "p-app"},
+				{"id": "p-db", "position": map[string]any{"x": 0, "y": 0}},
+				{"id": "p-cache", "position": map[string]any{"x": 50, "y": 50}},
+			}},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"project": map[string]any{"id": "p", "links": []map[string]any{
+				{"id": "l-1", "fromField": "auth", "toField": "db", "fromComponent": map[string]any{"id": "p-db"}, "toComponent": map[string]any{"id": "p-app"}},
+			}},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"setComponentPosition": map[string]any{"result": map[string]any{"id": "p-app"}, "successful": true},
+		}),
+	)
+
+	got, err := newService(gqlClient).AutoLayout(t.Context(), "p", components.AutoLayoutOptions{UnplacedOnly: true})
+	if err != nil {
+		t.Fatalf("AutoLayout: %v", err)
+	}
+	// p-app's first slot, (400, 0), and the next would overlap p-cache.
+	if got["p-app"] != (components.Position{X: 400, Y: 400}) || got["p-cache"] != (components.Position{X: 50, Y: 50}) {
+		t.Errorf("positions = %+v", got)
+	}
+	reqs := gqlClient.Requests()
+	if len(reqs) != 3 || reqs[2].Variables["id"] != "p-app" {
+		t.Fatalf("requests = %+v, want one setComponentPosition for p-app", reqs)
+	}
+	input, _ := reqs[2].Variables["input"].(map[string]any)
+	if input["x"] != float64(400) || input["y"] != float64(400) {
+		t.Errorf("setComponentPosition input = %v", input)
+	}
+}
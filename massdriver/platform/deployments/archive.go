@@ -0,0 +1,139 @@
+package deployments
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/gen"
+)
+
+// LogFormat selects how [Service.DownloadLogs] encodes a deployment's logs.
+type LogFormat string
+
+const (
+	// LogFormatNDJSONGzip is gzip-compressed NDJSON, one [LogLine] per
+	// line — the archival format. The default.
+	LogFormatNDJSONGzip LogFormat = "ndjson.gz"
+	// LogFormatNDJSON is uncompressed NDJSON.
+	LogFormatNDJSON LogFormat = "ndjson"
+	// LogFormatText is plain text, each line prefixed with its RFC 3339
+	// timestamp.
+	LogFormatText LogFormat = "text"
+)
+
+// LogLine is one line of a deployment's logs, the record [Service.DownloadLogs]
+// writes for the NDJSON formats. Lines split from the same provisioner
+// flush share its timestamp; Seq orders them within the deployment.
+type LogLine struct {
+	DeploymentID string    `json:"deploymentId"`
+	Seq          int       `json:"seq"`
+	Timestamp    time.Time `json:"timestamp"`
+	Message      string    `json:"message"`
+}
+
+// DownloadLogs writes every log line the deployment has produced so far to
+// w in the given format (empty means [LogFormatNDJSONGzip]). Batches are
+// split on newlines so each archived record is one line, searchable with
+// ordinary tools:
+//
+//	f, _ := os.Create(id + ".ndjson.gz")
+//	defer f.Close()
+//	err := c.Deployments.DownloadLogs(ctx, id, f, deployments.LogFormatNDJSONGzip)
+//
+// Read an archive back with [ReadLogArchive].
+func (s *Service) DownloadLogs(ctx context.Context, id string, w io.Writer, format LogFormat) error {
+	if format == "" {
+		format = LogFormatNDJSONGzip
+	}
+	switch format {
+	case LogFormatNDJSONGzip, LogFormatNDJSON, LogFormatText:
+	default:
+		return fmt.Errorf("download logs for deployment %s: unknown format %q", id, format)
+	}
+
+	resp, err := gen.GetDeploymentLogs(ctx, s.client.GQLv2, s.client.Config.OrganizationID, id)
+	if err != nil {
+		return gql.ClassifyError(fmt.Errorf("download logs for deployment %s: %w", id, err))
+	}
+	if resp.Deployment.Id == "" {
+		return fmt.Errorf("download logs for deployment %s: %w", id, gql.ErrNotFound)
+	}
+
+	out := w
+	var zw *gzip.Writer
+	if format == LogFormatNDJSONGzip {
+		zw = gzip.NewWriter(w)
+		zw.Name = id + ".ndjson"
+		out = zw
+	}
+	bw := bufio.NewWriter(out)
+	enc := json.NewEncoder(bw)
+	seq := 0
+	for _, batch := range resp.Deployment.Logs {
+		for line := range strings.Lines(batch.Message) {
+			l := LogLine{DeploymentID: id, Seq: seq, Timestamp: batch.Timestamp, Message: strings.TrimRight(line, "\r\n")}
+			seq++
+			if format == LogFormatText {
+				_, err = fmt.Fprintf(bw, "%s %s\n", l.Timestamp.Format(time.RFC3339Nano), l.Message)
+			} else {
+				err = enc.Encode(l)
+			}
+			if err != nil {
+				return fmt.Errorf("download logs for deployment %s: %w", id, err)
+			}
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("download logs for deployment %s: %w", id, err)
+	}
+	if zw != nil {
+		if err := zw.Close(); err != nil {
+			return fmt.Errorf("download logs for deployment %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// ReadLogArchive returns a lazy [iter.Seq2] over the [LogLine]s in an
+// archive written by [Service.DownloadLogs] in either NDJSON format; gzip
+// is detected automatically. The yielded error is non-nil at most once,
+// after which iteration stops.
+func ReadLogArchive(r io.Reader) iter.Seq2[LogLine, error] {
+	return func(yield func(LogLine, error) bool) {
+		br := bufio.NewReader(r)
+		var src io.Reader = br
+		if magic, _ := br.Peek(2); bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+			zr, err := gzip.NewReader(br)
+			if err != nil {
+				yield(LogLine{}, fmt.Errorf("read log archive: %w", err))
+				return
+			}
+			defer zr.Close()
+			src = zr
+		}
+		dec := json.NewDecoder(src)
+		for {
+			var l LogLine
+			err := dec.Decode(&l)
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				yield(LogLine{}, fmt.Errorf("read log archive: %w", err))
+				return
+			}
+			if !yield(l, nil) {
+				return
+			}
+		}
+	}
+}
@@ -15,6 +15,11 @@
 //   - [Service.Approve] / [Service.Reject] — release or discard a proposal.
 //     [Service.Abort] cancels any pending/approved/running deployment.
 //
+// For approval bots, [Service.IterPending] lists proposals, [Service.Review]
+// diffs one against the instance's live deployment, and
+// [Service.ApproveIf] / [Service.RejectIf] act on every proposal a
+// predicate accepts, returning an audit trail of [Decision]s.
+//
 // Logs are accessed separately via [Service.GetLogs] to keep the standard
 // [Service.Get]/[Service.Iter] payloads small.
 //
@@ -93,7 +98,9 @@ const (
 	// ActionDecommission tears down all infrastructure managed by the instance.
 	ActionDecommission Action = "DECOMMISSION"
 	// ActionPlan generates a dry-run preview without applying changes.
-	// Not valid for [Propose]; use [Create] for plans.
+	// Not valid for [Propose]; use [Create] for plans. The API doesn't
+	// document a plan as saving the params it carries on the instance, so
+	// don't rely on one to change them.
 	ActionPlan Action = "PLAN"
 )
 
@@ -120,6 +127,9 @@ const (
 type ListInput struct {
 	// InstanceID limits results to one instance.
 	InstanceID string
+	// InstanceIDs limits results to any of the named instances. Ignored
+	// when InstanceID is set.
+	InstanceIDs []string
 	// Status limits results to deployments in that lifecycle state.
 	Status Status
 	// Action limits results to that infrastructure operation type.
@@ -210,11 +220,11 @@ func (s *Service) GetLogs(ctx context.Context, id string) (string, error) {
 // the loop stops requesting further pages. The yielded error is non-nil exactly
 // once, on a failed page fetch, after which iteration stops.
 //
-// Returned [Deployment]s carry a slim instance ref (id+name only) and no
-// params/logs — call [Service.Get] / [Service.GetLogs] for those. To buffer
+// Returned [Deployment]s carry a slim instance ref (id, name, and the ids of
+// its environment, project and bundle) and no params/logs — call [Service.Get] / [Service.GetLogs] for those. To buffer
 // every match into a slice, wrap with [types.Collect].
-func (s *Service) Iter(ctx context.Context, input ListInput) iter.Seq2[Deployment, error] {
-	return paging.Iter(ctx, input.After, s.page(input))
+func (s *Service) Iter(ctx context.Context, input ListInput, opts ...paging.Option) iter.Seq2[Deployment, error] {
+	return paging.Iter(ctx, input.After, s.page(input), opts...)
 }
 
 // ListPage returns a single page of deployments matching input. input.PageSize
@@ -345,9 +355,13 @@ func toDeployment(v any) (*Deployment, error) {
 func buildListFilter(input ListInput) *gen.DeploymentsFilter {
 	filter := &gen.DeploymentsFilter{}
 	set := false
-	if input.InstanceID != "" {
+	switch {
+	case input.InstanceID != "":
 		filter.InstanceId = &gen.IdFilter{Eq: input.InstanceID}
 		set = true
+	case len(input.InstanceIDs) > 0:
+		filter.InstanceId = &gen.IdFilter{In: input.InstanceIDs}
+		set = true
 	}
 	if input.Status != "" {
 		filter.Status = &gen.DeploymentStatusFilter{Eq: gen.DeploymentStatus(input.Status)}
@@ -1,8 +1,11 @@
 package deployments_test
 
 import (
+	"bytes"
 	"errors"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/config"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql"
@@ -402,3 +405,51 @@ func TestApproveRejectAbort(t *testing.T) {
 		})
 	}
 }
+
+func TestDownloadLogs_GzipNDJSONRoundTrip(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		gqltest.RespondWithData(map[string]any{
+			"deployment": map[string]any{
+				"id": "dep-uuid-1",
+				"logs": []map[string]any{
+					{"timestamp": "2026-01-15T10:00:00Z", "message": "Initializing\nLoading state\n"},
+					{"timestamp": "2026-01-15T10:00:30Z", "message": "Apply complete"},
+				},
+			},
+		}),
+	)
+
+	var buf bytes.Buffer
+	if err := newService(gqlClient).DownloadLogs(t.Context(), "dep-uuid-1", &buf, deployments.LogFormatNDJSONGzip); err != nil {
+		t.Fatalf("DownloadLogs: %v", err)
+	}
+	if b := buf.Bytes(); len(b) < 2 || b[0] != 0x1f || b[1] != 0x8b {
+		t.Fatalf("output is not gzip")
+	}
+
+	lines, err := types.Collect(deployments.ReadLogArchive(&buf))
+	if err != nil {
+		t.Fatalf("ReadLogArchive: %v", err)
+	}
+	want := []deployments.LogLine{
+		{DeploymentID: "dep-uuid-1", Seq: 0, Timestamp: time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC), Message: "Initializing"},
+		{DeploymentID: "dep-uuid-1", Seq: 1, Timestamp: time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC), Message: "Loading state"},
+		{DeploymentID: "dep-uuid-1", Seq: 2, Timestamp: time.Date(2026, 1, 15, 10, 0, 30, 0, time.UTC), Message: "Apply complete"},
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("got %d lines, want %d: %+v", len(lines), len(want), lines)
+	}
+	for i := range want {
+		if !lines[i].Timestamp.Equal(want[i].Timestamp) || lines[i].Message != want[i].Message || lines[i].Seq != want[i].Seq {
+			t.Errorf("line %d = %+v, want %+v", i, lines[i], want[i])
+		}
+	}
+}
+
+func TestDownloadLogs_NotFound(t *testing.T) {
+	gqlClient := gqltest.NewClient(gqltest.RespondWithData(map[string]any{"deployment": map[string]any{}}))
+	err := newService(gqlClient).DownloadLogs(t.Context(), "missing", io.Discard, "")
+	if !errors.Is(err, gql.ErrNotFound) {
+		t.Errorf("err = %v, want ErrNotFound", err)
+	}
+}
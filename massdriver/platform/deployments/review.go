@@ -0,0 +1,207 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/decode"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/gen"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/paging"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/viewer"
+)
+
+// Comparison is a side-by-side diff of two deployments — alias of
+// [types.DeploymentComparison].
+type Comparison = types.DeploymentComparison
+
+// Review is a PROPOSED deployment together with what it would change.
+type Review struct {
+	// Proposal is the deployment awaiting a decision.
+	Proposal Deployment `json:"proposal"`
+	// Baseline is the instance's last COMPLETED provision — what's live.
+	// Nil when the instance has never been provisioned successfully.
+	Baseline *Deployment `json:"baseline,omitempty"`
+	// Diff compares Baseline (source) with Proposal (target). Nil when
+	// Baseline is.
+	Diff *Comparison `json:"diff,omitempty"`
+}
+
+// Changed returns the param leaves the proposal would change, or nil when
+// there is no baseline to compare against.
+func (r *Review) Changed() []types.ParamComparison {
+	if r.Diff == nil {
+		return nil
+	}
+	return r.Diff.Changed()
+}
+
+// Verdict is the outcome recorded in a [Decision].
+type Verdict string
+
+const (
+	VerdictApproved Verdict = "approved"
+	VerdictRejected Verdict = "rejected"
+)
+
+// Decision is one audit-trail entry from [Service.ApproveIf] or
+// [Service.RejectIf]: what was decided, about which proposal and diff, by
+// whom, and when. It marshals to JSON for shipping to a log or ticket.
+type Decision struct {
+	Review  Review  `json:"review"`
+	Verdict Verdict `json:"verdict"`
+	// By names the identity that made the call — the account's email or
+	// the service account's name — and ByID is its ID.
+	By   string    `json:"by"`
+	ByID string    `json:"byId"`
+	At   time.Time `json:"at"`
+	// Error is set when the approve/reject call failed; the proposal is
+	// then still PROPOSED.
+	Error string `json:"error,omitempty"`
+}
+
+// Compare diffs two deployments' bundle version and snapshotted params.
+// Source is the "before" side, target the "after".
+func (s *Service) Compare(ctx context.Context, sourceID, targetID string) (*Comparison, error) {
+	resp, err := gen.CompareDeployments(ctx, s.client.GQLv2, s.client.Config.OrganizationID, sourceID, targetID)
+	if err != nil {
+		return nil, gql.ClassifyError(fmt.Errorf("compare deployments %s and %s: %w", sourceID, targetID, err))
+	}
+	c := Comparison{}
+	if err := decode.Decode(resp.CompareDeployments, &c); err != nil {
+		return nil, fmt.Errorf("decode deployment comparison: %w", err)
+	}
+	return &c, nil
+}
+
+// IterPending returns a lazy [iter.Seq2] over PROPOSED deployments
+// matching filter, oldest first unless filter sets a sort. filter.Status
+// is ignored.
+func (s *Service) IterPending(ctx context.Context, filter ListInput, opts ...paging.Option) iter.Seq2[Deployment, error] {
+	filter.Status = StatusProposed
+	if filter.SortBy == "" && filter.SortOrder == "" {
+		filter.SortBy, filter.SortOrder = SortByCreatedAt, SortAsc
+	}
+	return s.Iter(ctx, filter, opts...)
+}
+
+// Review pairs a proposal with the instance's last successful provision
+// and the diff between them.
+func (s *Service) Review(ctx context.Context, proposal Deployment) (*Review, error) {
+	r := &Review{Proposal: proposal}
+	if proposal.Instance == nil || proposal.Instance.ID == "" {
+		return nil, fmt.Errorf("review deployment %s: no instance ref", proposal.ID)
+	}
+	page, err := s.ListPage(ctx, ListInput{
+		InstanceID: proposal.Instance.ID,
+		Status:     StatusCompleted,
+		Action:     ActionProvision,
+		SortBy:     SortByCreatedAt,
+		SortOrder:  SortDesc,
+		PageSize:   1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("review deployment %s: %w", proposal.ID, err)
+	}
+	if len(page.Items) == 0 {
+		return r, nil
+	}
+	r.Baseline = &page.Items[0]
+	if r.Diff, err = s.Compare(ctx, r.Baseline.ID, proposal.ID); err != nil {
+		return nil, fmt.Errorf("review deployment %s: %w", proposal.ID, err)
+	}
+	return r, nil
+}
+
+// IterReviews is [Service.IterPending] with each proposal wrapped in its
+// [Review]. A failed review is yielded as an error and ends iteration.
+func (s *Service) IterReviews(ctx context.Context, filter ListInput, opts ...paging.Option) iter.Seq2[*Review, error] {
+	return func(yield func(*Review, error) bool) {
+		for d, err := range s.IterPending(ctx, filter, opts...) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			r, err := s.Review(ctx, d)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(r, nil) {
+				return
+			}
+		}
+	}
+}
+
+// ApproveIf reviews every proposal matching filter and approves those for
+// which pred returns true. It returns one [Decision] per proposal acted on
+// — the audit trail — in the order they were processed.
+//
+// Every matching proposal is reviewed before any is acted on, so the
+// returned error — a failure to list, review, or identify the caller —
+// means nothing was approved. A failed approval is recorded in its
+// Decision and processing continues.
+//
+//	trail, err := c.Deployments.ApproveIf(ctx, deployments.ListInput{OciRepoName: "aws-rds"},
+//	    func(r *deployments.Review) bool {
+//	        return r.Diff != nil && r.Diff.Version.Equal && len(r.Changed()) <= 2
+//	    })
+func (s *Service) ApproveIf(ctx context.Context, filter ListInput, pred func(*Review) bool) ([]Decision, error) {
+	return s.decideIf(ctx, filter, pred, VerdictApproved, s.Approve)
+}
+
+// RejectIf is [Service.ApproveIf] for rejection. Rejection is terminal —
+// rejected proposals never run.
+func (s *Service) RejectIf(ctx context.Context, filter ListInput, pred func(*Review) bool) ([]Decision, error) {
+	return s.decideIf(ctx, filter, pred, VerdictRejected, s.Reject)
+}
+
+func (s *Service) decideIf(
+	ctx context.Context,
+	filter ListInput,
+	pred func(*Review) bool,
+	verdict Verdict,
+	act func(context.Context, string) (*Deployment, error),
+) ([]Decision, error) {
+	me, err := viewer.New(s.client).Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("identify reviewer: %w", err)
+	}
+	by := me.Email
+	if me.Kind == types.ViewerKindServiceAccount {
+		by = me.Name
+	}
+
+	// Collect first: acting while paging would shift the PROPOSED set
+	// under the cursor.
+	var matched []*Review
+	for r, err := range s.IterReviews(ctx, filter) {
+		if err != nil {
+			return nil, err
+		}
+		if pred(r) {
+			matched = append(matched, r)
+		}
+	}
+
+	trail := make([]Decision, 0, len(matched))
+	for _, r := range matched {
+		d := Decision{Review: *r, Verdict: verdict, By: by, ByID: me.ID}
+		updated, err := act(ctx, r.Proposal.ID)
+		d.At = time.Now().UTC()
+		if err != nil {
+			d.Error = err.Error()
+		} else {
+			if updated.Instance == nil {
+				updated.Instance = r.Proposal.Instance
+			}
+			d.Review.Proposal = *updated
+		}
+		trail = append(trail, d)
+	}
+	return trail, nil
+}
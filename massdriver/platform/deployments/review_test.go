@@ -0,0 +1,121 @@
+package deployments_test
+
+import (
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/deployments"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+func deploymentsPage(items ...map[string]any) gqltest.Response {
+	return gqltest.RespondWithData(map[string]any{
+		"deployments": map[string]any{"cursor": map[string]any{}, "items": items},
+	})
+}
+
+func TestReview(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		deploymentsPage(map[string]any{"id": "dep-live", "status": "COMPLETED", "action": "PROVISION", "version": "1.2.0"}),
+		gqltest.RespondWithData(map[string]any{
+			"compareDeployments": map[string]any{
+				"source":  map[string]any{"id": "dep-live", "status": "COMPLETED", "action": "PROVISION", "version": "1.2.0"},
+				"target":  map[string]any{"id": "dep-prop", "status": "PROPOSED", "action": "PROVISION", "version": "1.2.0"},
+				"version": map[string]any{"source": "1.2.0", "target": "1.2.0", "equal": true},
+				"params": []map[string]any{
+					{
+						"path":   ".database.instance_class",
+						"source": map[string]any{"present": true, "value": "db.t3.medium"},
+						"target": map[string]any{"present": true, "value": "db.r6g.large"},
+						"equal":  false,
+					},
+					{
+						"path":   ".database.port",
+						"source": map[string]any{"present": true, "value": "5432"},
+						"target": map[string]any{"present": true, "value": "5432"},
+						"equal":  true,
+					},
+				},
+			},
+		}),
+	)
+
+	proposal := deployments.Deployment{ID: "dep-prop", Status: "PROPOSED", Instance: &types.Instance{ID: "ecomm-prod-db"}}
+	got, err := newService(gqlClient).Review(t.Context(), proposal)
+	if err != nil {
+		t.Fatalf("Review: %v", err)
+	}
+	if got.Baseline == nil || got.Baseline.ID != "dep-live" {
+		t.Fatalf("Baseline = %+v, want dep-live", got.Baseline)
+	}
+	changed := got.Changed()
+	if len(changed) != 1 || changed[0].Path != ".database.instance_class" || changed[0].Target.Value != "db.r6g.large" {
+		t.Errorf("Changed() = %+v, want only instance_class", changed)
+	}
+
+	reqs := gqlClient.Requests()
+	filter, _ := reqs[0].Variables["filter"].(map[string]any)
+	if st, _ := filter["status"].(map[string]any); st["eq"] != "COMPLETED" {
+		t.Errorf("baseline filter.status = %v, want COMPLETED", filter["status"])
+	}
+	if reqs[1].Variables["sourceId"] != "dep-live" || reqs[1].Variables["targetId"] != "dep-prop" {
+		t.Errorf("compare vars = %v, want dep-live → dep-prop", reqs[1].Variables)
+	}
+}
+
+func TestApproveIf(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		gqltest.RespondWithData(map[string]any{
+			"viewer": map[string]any{"__typename": "ServiceAccountViewer", "id": "sa-1", "name": "approval-bot"},
+		}),
+		deploymentsPage(
+			map[string]any{"id": "dep-a", "status": "PROPOSED", "action": "PROVISION", "instance": map[string]any{"id": "ecomm-prod-db"}},
+			map[string]any{"id": "dep-b", "status": "PROPOSED", "action": "PROVISION", "instance": map[string]any{"id": "ecomm-prod-cache"}},
+		),
+		deploymentsPage(map[string]any{"id": "dep-live", "status": "COMPLETED", "action": "PROVISION"}),
+		gqltest.RespondWithData(map[string]any{
+			"compareDeployments": map[string]any{
+				"source":  map[string]any{"id": "dep-live"},
+				"target":  map[string]any{"id": "dep-a"},
+				"version": map[string]any{"equal": true},
+				"params":  []map[string]any{},
+			},
+		}),
+		// dep-b's instance was never provisioned: no baseline, no diff.
+		deploymentsPage(),
+		gqltest.RespondWithData(map[string]any{
+			"approveDeployment": map[string]any{
+				"result":     map[string]any{"id": "dep-a", "status": "APPROVED", "action": "PROVISION"},
+				"successful": true,
+			},
+		}),
+	)
+
+	trail, err := newService(gqlClient).ApproveIf(t.Context(), deployments.ListInput{},
+		func(r *deployments.Review) bool { return r.Diff != nil && len(r.Changed()) == 0 })
+	if err != nil {
+		t.Fatalf("ApproveIf: %v", err)
+	}
+	if len(trail) != 1 {
+		t.Fatalf("got %d decisions, want 1", len(trail))
+	}
+	d := trail[0]
+	if d.Verdict != deployments.VerdictApproved || d.By != "approval-bot" || d.ByID != "sa-1" || d.Error != "" {
+		t.Errorf("decision = %+v, want approved by approval-bot", d)
+	}
+	if d.Review.Proposal.Status != "APPROVED" || d.Review.Proposal.Instance == nil {
+		t.Errorf("Proposal = %+v, want APPROVED with its instance ref kept", d.Review.Proposal)
+	}
+	if d.At.IsZero() {
+		t.Error("At is zero")
+	}
+
+	reqs := gqlClient.Requests()
+	filter, _ := reqs[1].Variables["filter"].(map[string]any)
+	if st, _ := filter["status"].(map[string]any); st["eq"] != "PROPOSED" {
+		t.Errorf("pending filter.status = %v, want PROPOSED", filter["status"])
+	}
+	if gqlClient.Pending() != 0 {
+		t.Errorf("%d responses unused", gqlClient.Pending())
+	}
+}
@@ -0,0 +1,187 @@
+package environments
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/scalars"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/decode"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/gen"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/paging"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// Connection is the runtime wiring between two instances — alias of
+// [types.Connection].
+type Connection = types.Connection
+
+// ListDefaultsInput controls a [Service.IterDefaults]/[Service.ListDefaultsPage]
+// call.
+type ListDefaultsInput struct {
+	// EnvironmentID is the environment whose defaults are listed. Required.
+	EnvironmentID string
+
+	PageSize int
+	// After is the opaque cursor from a prior [types.Page].Next, selecting
+	// which page to start from. Empty starts at the first page.
+	After string
+}
+
+// ListConnectionsInput controls a
+// [Service.IterConnections]/[Service.ListConnectionsPage] call. The zero
+// value (plus EnvironmentID) lists every connection in the environment,
+// oldest first.
+type ListConnectionsInput struct {
+	// EnvironmentID is the environment whose connections are listed.
+	// Required.
+	EnvironmentID string
+	// FromInstanceIDs limits results to connections out of these instances.
+	FromInstanceIDs []string
+	// ToInstanceIDs limits results to connections into these instances.
+	ToInstanceIDs []string
+
+	// SortOrder orders by creation time, the only sort connections
+	// support. Empty = ASC.
+	SortOrder SortOrder
+
+	PageSize int
+	// After is the opaque cursor from a prior [types.Page].Next, selecting
+	// which page to start from. Empty starts at the first page.
+	After string
+}
+
+// IterDefaults returns a lazy [iter.Seq2] over an environment's default
+// resources, fetching pages on demand. Each default carries a slim resource
+// (id, name, resourceType); call platform/resources.Get for the rest.
+// The yielded error is non-nil exactly once, on a failed page fetch —
+// wrapping [gql.ErrNotFound] when the environment doesn't exist — after
+// which iteration stops.
+//
+// To find which environments pin a shared resource:
+//
+//	for env, err := range c.Environments.Iter(ctx, environments.ListInput{}) {
+//	    if err != nil { return err }
+//	    for d, err := range c.Environments.IterDefaults(ctx, environments.ListDefaultsInput{EnvironmentID: env.ID}) {
+//	        if err != nil { return err }
+//	        if d.Resource.ID == sharedVPC {
+//	            fmt.Println(env.ID)
+//	        }
+//	    }
+//	}
+func (s *Service) IterDefaults(ctx context.Context, input ListDefaultsInput, opts ...paging.Option) iter.Seq2[types.EnvironmentDefault, error] {
+	return paging.Iter(ctx, input.After, s.defaultsPage(input), opts...)
+}
+
+// ListDefaultsPage returns a single page of an environment's defaults.
+// input.PageSize bounds the page and input.After (an opaque cursor from a
+// prior page's Next) selects which page.
+func (s *Service) ListDefaultsPage(ctx context.Context, input ListDefaultsInput) (types.Page[types.EnvironmentDefault], error) {
+	return s.defaultsPage(input)(ctx, input.After)
+}
+
+// defaultsPage builds the single-page fetcher shared by IterDefaults and
+// ListDefaultsPage.
+func (s *Service) defaultsPage(input ListDefaultsInput) paging.FetchFunc[types.EnvironmentDefault] {
+	id := input.EnvironmentID
+	limit := input.PageSize
+	return func(ctx context.Context, after string) (types.Page[types.EnvironmentDefault], error) {
+		resp, err := gen.ListEnvironmentDefaults(ctx, s.client.GQLv2, s.client.Config.OrganizationID, id, scalars.NewCursor(limit, after))
+		if err != nil {
+			return types.Page[types.EnvironmentDefault]{}, gql.ClassifyError(fmt.Errorf("list environment %s defaults: %w", id, err))
+		}
+		if resp.Environment.Id == "" {
+			return types.Page[types.EnvironmentDefault]{}, fmt.Errorf("list environment %s defaults: %w", id, gql.ErrNotFound)
+		}
+		items := make([]types.EnvironmentDefault, 0, len(resp.Environment.Defaults.Items))
+		for _, item := range resp.Environment.Defaults.Items {
+			d := types.EnvironmentDefault{}
+			if err := decode.Decode(item, &d); err != nil {
+				return types.Page[types.EnvironmentDefault]{}, fmt.Errorf("decode environment default: %w", err)
+			}
+			items = append(items, d)
+		}
+		return types.Page[types.EnvironmentDefault]{
+			Items:    items,
+			Next:     resp.Environment.Defaults.Cursor.Next,
+			Previous: resp.Environment.Defaults.Cursor.Previous,
+		}, nil
+	}
+}
+
+// IterConnections returns a lazy [iter.Seq2] over the connections in an
+// environment matching input, fetching pages on demand. Each connection
+// carries slim (id/name) FromInstance and ToInstance refs and the slim
+// blueprint Link it realizes. The yielded error is non-nil exactly once, on
+// a failed page fetch — wrapping [gql.ErrNotFound] when the environment
+// doesn't exist — after which iteration stops.
+//
+// For the whole graph in one call, instances included, use [Service.Graph].
+func (s *Service) IterConnections(ctx context.Context, input ListConnectionsInput, opts ...paging.Option) iter.Seq2[Connection, error] {
+	return paging.Iter(ctx, input.After, s.connectionsPage(input), opts...)
+}
+
+// ListConnectionsPage returns a single page of connections matching input.
+// input.PageSize bounds the page and input.After (an opaque cursor from a
+// prior page's Next) selects which page.
+func (s *Service) ListConnectionsPage(ctx context.Context, input ListConnectionsInput) (types.Page[Connection], error) {
+	return s.connectionsPage(input)(ctx, input.After)
+}
+
+// connectionsPage builds the single-page fetcher shared by IterConnections
+// and ListConnectionsPage.
+func (s *Service) connectionsPage(input ListConnectionsInput) paging.FetchFunc[Connection] {
+	id := input.EnvironmentID
+	filter := buildConnectionsFilter(input)
+	sort := buildConnectionsSort(input)
+	limit := input.PageSize
+	return func(ctx context.Context, after string) (types.Page[Connection], error) {
+		resp, err := gen.ListEnvironmentConnections(ctx, s.client.GQLv2, s.client.Config.OrganizationID, id, filter, sort, scalars.NewCursor(limit, after))
+		if err != nil {
+			return types.Page[Connection]{}, gql.ClassifyError(fmt.Errorf("list environment %s connections: %w", id, err))
+		}
+		if resp.Environment.Id == "" {
+			return types.Page[Connection]{}, fmt.Errorf("list environment %s connections: %w", id, gql.ErrNotFound)
+		}
+		page := resp.Environment.Blueprint.Connections
+		items := make([]Connection, 0, len(page.Items))
+		for _, item := range page.Items {
+			c := Connection{}
+			if err := decode.Decode(item, &c); err != nil {
+				return types.Page[Connection]{}, fmt.Errorf("decode connection: %w", err)
+			}
+			items = append(items, c)
+		}
+		return types.Page[Connection]{
+			Items:    items,
+			Next:     page.Cursor.Next,
+			Previous: page.Cursor.Previous,
+		}, nil
+	}
+}
+
+func buildConnectionsFilter(input ListConnectionsInput) *gen.ConnectionsFilter {
+	if len(input.FromInstanceIDs) == 0 && len(input.ToInstanceIDs) == 0 {
+		return nil
+	}
+	filter := &gen.ConnectionsFilter{}
+	if len(input.FromInstanceIDs) > 0 {
+		filter.FromInstanceId = &gen.IdFilter{In: input.FromInstanceIDs}
+	}
+	if len(input.ToInstanceIDs) > 0 {
+		filter.ToInstanceId = &gen.IdFilter{In: input.ToInstanceIDs}
+	}
+	return filter
+}
+
+func buildConnectionsSort(input ListConnectionsInput) *gen.ConnectionsSort {
+	if input.SortOrder == "" {
+		return nil
+	}
+	order := gen.SortOrderAsc
+	if input.SortOrder == SortDesc {
+		order = gen.SortOrderDesc
+	}
+	return &gen.ConnectionsSort{Field: gen.ConnectionsSortFieldCreatedAt, Order: order}
+}
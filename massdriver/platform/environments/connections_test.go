@@ -0,0 +1,91 @@
+package environments_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/environments"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+func TestIterDefaults(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		gqltest.RespondWithData(map[string]any{
+			"environment": map[string]any{"id": "ecomm-prod", "defaults": map[string]any{
+				"cursor": map[string]any{"next": "page-2"},
+				"items": []map[string]any{
+					{"id": "def-1", "resource": map[string]any{"id": "net-prod", "name": "VPC", "resourceType": map[string]any{"id": "aws-vpc"}}},
+				},
+			}},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"environment": map[string]any{"id": "ecomm-prod", "defaults": map[string]any{
+				"cursor": map[string]any{},
+				"items": []map[string]any{
+					{"id": "def-2", "resource": map[string]any{"id": "zone-prod", "name": "DNS", "resourceType": map[string]any{"id": "aws-route53"}}},
+				},
+			}},
+		}),
+	)
+
+	got, err := types.Collect(newService(gqlClient).IterDefaults(t.Context(), environments.ListDefaultsInput{EnvironmentID: "ecomm-prod"}))
+	if err != nil {
+		t.Fatalf("IterDefaults: %v", err)
+	}
+	if len(got) != 2 || got[0].Resource.ID != "net-prod" || got[1].Resource.ResourceType == nil || got[1].Resource.ResourceType.ID != "aws-route53" {
+		t.Errorf("defaults = %+v", got)
+	}
+	if after, _ := gqlClient.Requests()[1].Variables["cursor"].(map[string]any)["next"]; after != "page-2" {
+		t.Errorf("second request cursor = %v, want page-2", gqlClient.Requests()[1].Variables["cursor"])
+	}
+}
+
+func TestIterConnections(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		gqltest.RespondWithData(map[string]any{
+			"environment": map[string]any{"id": "ecomm-prod", "blueprint": map[string]any{"connections": map[string]any{
+				"cursor": map[string]any{},
+				"items": []map[string]any{{
+					"id": "conn-1", "fromField": "authentication", "toField": "database",
+					"createdAt":    "2026-01-02T03:04:05Z",
+					"fromInstance": map[string]any{"id": "ecomm-prod-db", "name": "db"},
+					"toInstance":   map[string]any{"id": "ecomm-prod-app", "name": "app"},
+					"link":         map[string]any{"id": "link-1", "fromField": "authentication", "toField": "database"},
+				}},
+			}}},
+		}),
+	)
+
+	got, err := types.Collect(newService(gqlClient).IterConnections(t.Context(), environments.ListConnectionsInput{
+		EnvironmentID:   "ecomm-prod",
+		FromInstanceIDs: []string{"ecomm-prod-db"},
+		SortOrder:       environments.SortDesc,
+	}))
+	if err != nil {
+		t.Fatalf("IterConnections: %v", err)
+	}
+	if len(got) != 1 || got[0].FromInstance.ID != "ecomm-prod-db" || got[0].ToInstance.ID != "ecomm-prod-app" ||
+		got[0].Link == nil || got[0].Link.ID != "link-1" || got[0].CreatedAt.Year() != 2026 {
+		t.Errorf("connections = %+v", got)
+	}
+
+	vars := gqlClient.Requests()[0].Variables
+	filter, _ := vars["filter"].(map[string]any)
+	if _, ok := filter["toInstanceId"]; ok {
+		t.Errorf("filter = %v, want toInstanceId omitted", filter)
+	}
+	if sort, _ := vars["sort"].(map[string]any); sort["field"] != "CREATED_AT" || sort["order"] != "DESC" {
+		t.Errorf("sort = %v, want CREATED_AT DESC", vars["sort"])
+	}
+}
+
+func TestIterConnections_NotFound(t *testing.T) {
+	gqlClient := gqltest.NewClient(gqltest.RespondWithData(map[string]any{"environment": nil}))
+
+	_, err := types.Collect(newService(gqlClient).IterConnections(t.Context(), environments.ListConnectionsInput{EnvironmentID: "nope"}))
+	if !errors.Is(err, gql.ErrNotFound) {
+		t.Errorf("err = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,146 @@
+package environments
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/instances"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// CopyOptions selects what [Service.CopyConfiguration] copies. The zero
+// value copies nothing.
+type CopyOptions struct {
+	// Params copies each instance's params, overwriting the destination's
+	// and creating a plan deployment there.
+	Params bool
+	// Secrets copies each instance's secret values along with its params.
+	// Values are copied server-side and never reach the caller. Requires
+	// Params.
+	Secrets bool
+	// RemoteReferences copies each instance's remote resource references
+	// along with its params. Requires Params.
+	RemoteReferences bool
+	// EnvironmentDefaults copies the source's default resource connections,
+	// replacing the destination's default of the same resource type.
+	EnvironmentDefaults bool
+	// Overrides maps component ID ("db") to params deep-merged onto that
+	// instance's source params before they're written. Requires Params.
+	Overrides map[string]map[string]any
+}
+
+// CopyReport is what [Service.CopyConfiguration] did.
+type CopyReport struct {
+	// Instances lists the destination instances copied into, in component
+	// order.
+	Instances []string
+	// Defaults lists the resource type IDs whose default was set.
+	Defaults []string
+	// Skipped lists source components with no instance in the
+	// destination.
+	Skipped []string
+}
+
+// CopyConfiguration copies configuration from one environment into another
+// in the same project — the post-fork equivalent of the [ForkInput] Copy*
+// flags, for environments that already exist.
+//
+// Instances are paired by component and copied with Instances.Copy, which
+// always writes the source's params (minus fields the bundle marks
+// non-copyable) and creates a plan deployment on the destination. Secrets
+// and remote references can only travel with that call, so setting Secrets,
+// RemoteReferences, or Overrides without Params is an error rather than a
+// silent overwrite of the destination's params.
+//
+// An error ends the copy with the report so far; instances already copied
+// keep their new configuration, and copying again leaves them the same.
+func (s *Service) CopyConfiguration(ctx context.Context, fromID, toID string, opts CopyOptions) (*CopyReport, error) {
+	if !opts.Params && (opts.Secrets || opts.RemoteReferences || len(opts.Overrides) > 0) {
+		return nil, fmt.Errorf("copy environment %s → %s: secrets, remote references, and overrides are copied with params; set Params", fromID, toID)
+	}
+	from, err := s.Get(ctx, fromID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.Get(ctx, toID)
+	if err != nil {
+		return nil, err
+	}
+	if from.Project == nil || to.Project == nil || from.Project.ID != to.Project.ID {
+		return nil, fmt.Errorf("copy environment %s → %s: environments must be in the same project", fromID, toID)
+	}
+	report := &CopyReport{}
+
+	if opts.EnvironmentDefaults {
+		current := map[string]types.EnvironmentDefault{}
+		for _, d := range to.Defaults {
+			if d.Resource.ResourceType != nil {
+				current[d.Resource.ResourceType.ID] = d
+			}
+		}
+		for _, d := range from.Defaults {
+			if d.Resource.ResourceType == nil {
+				continue
+			}
+			typ := d.Resource.ResourceType.ID
+			cur, ok := current[typ]
+			if ok && cur.Resource.ID == d.Resource.ID {
+				continue
+			}
+			if ok {
+				if _, err := s.RemoveDefault(ctx, cur.ID); err != nil {
+					return report, err
+				}
+			}
+			if _, err := s.SetDefault(ctx, to.ID, d.Resource.ID); err != nil {
+				return report, err
+			}
+			report.Defaults = append(report.Defaults, typ)
+		}
+	}
+
+	if !opts.Params {
+		return report, nil
+	}
+	inst := instances.New(s.client)
+	source, err := instancesByComponent(ctx, inst, from.ID)
+	if err != nil {
+		return report, err
+	}
+	dest, err := instancesByComponent(ctx, inst, to.ID)
+	if err != nil {
+		return report, err
+	}
+	for _, component := range slices.Sorted(maps.Keys(source)) {
+		destID, ok := dest[component]
+		if !ok {
+			report.Skipped = append(report.Skipped, component)
+			continue
+		}
+		if _, err := inst.Copy(ctx, source[component], destID, instances.CopyInput{
+			Overrides:            opts.Overrides[component],
+			CopySecrets:          opts.Secrets,
+			CopyRemoteReferences: opts.RemoteReferences,
+		}); err != nil {
+			return report, err
+		}
+		report.Instances = append(report.Instances, destID)
+	}
+	return report, nil
+}
+
+// instancesByComponent maps each of an environment's instances' component
+// ID to the instance ID ("db" → "ecomm-prod-db").
+func instancesByComponent(ctx context.Context, inst *instances.Service, environmentID string) (map[string]string, error) {
+	out := map[string]string{}
+	for i, err := range inst.Iter(ctx, instances.ListInput{EnvironmentID: environmentID}) {
+		if err != nil {
+			return nil, err
+		}
+		out[strings.TrimPrefix(i.ID, environmentID+"-")] = i.ID
+	}
+	return out, nil
+}
@@ -0,0 +1,121 @@
+package environments_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/environments"
+)
+
+func envWithDefaults(id, project string, defaults ...[3]string) gqltest.Response {
+	items := make([]map[string]any, 0, len(defaults))
+	for _, d := range defaults {
+		items = append(items, map[string]any{
+			"id":       d[0],
+			"resource": map[string]any{"id": d[1], "resourceType": map[string]any{"id": d[2]}},
+		})
+	}
+	return gqltest.RespondWithData(map[string]any{
+		"environment": map[string]any{
+			"id":       id,
+			"project":  map[string]any{"id": project},
+			"defaults": map[string]any{"items": items},
+		},
+	})
+}
+
+func instanceList(ids ...string) gqltest.Response {
+	items := make([]map[string]any, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, map[string]any{"id": id})
+	}
+	return gqltest.RespondWithData(map[string]any{
+		"instances": map[string]any{"cursor": map[string]any{}, "items": items},
+	})
+}
+
+func copied(id string) gqltest.Response {
+	return gqltest.RespondWithData(map[string]any{
+		"copyInstance": map[string]any{"result": map[string]any{"id": id}, "successful": true},
+	})
+}
+
+func TestCopyConfiguration(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		envWithDefaults("ecomm-prod", "ecomm",
+			[3]string{"def-1", "net-prod", "aws-vpc"},
+			[3]string{"def-2", "zone-prod", "aws-route53"},
+		),
+		envWithDefaults("ecomm-pr1", "ecomm",
+			[3]string{"def-3", "net-other", "aws-vpc"},
+			[3]string{"def-4", "zone-prod", "aws-route53"},
+		),
+		gqltest.RespondWithData(map[string]any{
+			"removeEnvironmentDefault": map[string]any{"result": map[string]any{"id": "def-3"}, "successful": true},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"setEnvironmentDefault": map[string]any{"result": map[string]any{"id": "def-5"}, "successful": true},
+		}),
+		instanceList("ecomm-prod-db", "ecomm-prod-app", "ecomm-prod-cache"),
+		instanceList("ecomm-pr1-db", "ecomm-pr1-app"),
+		copied("ecomm-pr1-app"),
+		copied("ecomm-pr1-db"),
+	)
+
+	report, err := newService(gqlClient).CopyConfiguration(t.Context(), "ecomm-prod", "ecomm-pr1", environments.CopyOptions{
+		Params:              true,
+		Secrets:             true,
+		EnvironmentDefaults: true,
+		Overrides:           map[string]map[string]any{"db": {"size": "small"}},
+	})
+	if err != nil {
+		t.Fatalf("CopyConfiguration: %v", err)
+	}
+	if strings.Join(report.Defaults, ",") != "aws-vpc" {
+		t.Errorf("Defaults = %v, want [aws-vpc]", report.Defaults)
+	}
+	if strings.Join(report.Instances, ",") != "ecomm-pr1-app,ecomm-pr1-db" {
+		t.Errorf("Instances = %v, want app then db", report.Instances)
+	}
+	if strings.Join(report.Skipped, ",") != "cache" {
+		t.Errorf("Skipped = %v, want [cache]", report.Skipped)
+	}
+
+	reqs := gqlClient.Requests()
+	if reqs[2].Variables["id"] != "def-3" || reqs[3].Variables["resourceId"] != "net-prod" {
+		t.Errorf("default requests = %v, %v", reqs[2].Variables, reqs[3].Variables)
+	}
+	db := reqs[7].Variables
+	input, _ := db["input"].(map[string]any)
+	if db["sourceId"] != "ecomm-prod-db" || db["destinationId"] != "ecomm-pr1-db" || input["copySecrets"] != true || input["copyRemoteReferences"] != false {
+		t.Errorf("copyInstance variables = %v", db)
+	}
+	// The Map scalar travels as an encoded JSON string.
+	if input["overrides"] != `{"size":"small"}` {
+		t.Errorf("overrides = %v, want the db override", input["overrides"])
+	}
+	if gqlClient.Pending() != 0 {
+		t.Errorf("%d responses unused", gqlClient.Pending())
+	}
+}
+
+func TestCopyConfiguration_DifferentProjects(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		envWithDefaults("ecomm-prod", "ecomm"),
+		envWithDefaults("billing-prod", "billing"),
+	)
+
+	_, err := newService(gqlClient).CopyConfiguration(t.Context(), "ecomm-prod", "billing-prod", environments.CopyOptions{Params: true})
+	if err == nil || !strings.Contains(err.Error(), "same project") {
+		t.Errorf("err = %v, want a same-project error", err)
+	}
+}
+
+func TestCopyConfiguration_SecretsRequireParams(t *testing.T) {
+	gqlClient := gqltest.NewClient()
+	_, err := newService(gqlClient).CopyConfiguration(t.Context(), "ecomm-prod", "ecomm-pr1", environments.CopyOptions{Secrets: true})
+	if err == nil || len(gqlClient.Requests()) != 0 {
+		t.Errorf("err = %v after %d requests, want an error before any request", err, len(gqlClient.Requests()))
+	}
+}
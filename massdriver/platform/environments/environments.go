@@ -144,6 +144,10 @@ type ForkInput struct {
 	// CopyEnvironmentDefaults, when true, copies the parent's default
 	// resource connections into the fork.
 	CopyEnvironmentDefaults bool
+	// DecommissionProtection, when true, blocks [Service.Decommission] and
+	// per-instance DECOMMISSION deployments against the fork until it's
+	// turned off.
+	DecommissionProtection bool
 }
 
 // EnvironmentDefault is a resource pre-assigned to an environment so that
@@ -173,6 +177,22 @@ func (s *Service) Get(ctx context.Context, id string) (*Environment, error) {
 	return toEnvironment(resp.Environment)
 }
 
+// Graph retrieves an environment with its instances and the connections
+// between them — the runtime counterpart of a project's blueprint. Instances
+// carry status, versions, cost, and slim bundle and component refs;
+// connections carry slim FromInstance/ToInstance refs. Other embedded
+// fields (Project, Defaults) are left nil; call [Service.Get] for those.
+func (s *Service) Graph(ctx context.Context, id string) (*Environment, error) {
+	resp, err := gen.GetEnvironmentGraph(ctx, s.client.GQLv2, s.client.Config.OrganizationID, id)
+	if err != nil {
+		return nil, gql.ClassifyError(fmt.Errorf("get environment graph %s: %w", id, err))
+	}
+	if resp.Environment.Id == "" {
+		return nil, fmt.Errorf("get environment graph %s: %w", id, gql.ErrNotFound)
+	}
+	return toEnvironment(resp.Environment)
+}
+
 // Iter returns a lazy [iter.Seq2] over environments matching input, fetching
 // pages on demand. It is the recommended way to list: ranging the sequence
 // streams results without buffering the whole match set, and breaking out of
@@ -180,8 +200,8 @@ func (s *Service) Get(ctx context.Context, id string) (*Environment, error) {
 // once, on a failed page fetch, after which iteration stops.
 //
 // To buffer every match into a slice, wrap with [types.Collect].
-func (s *Service) Iter(ctx context.Context, input ListInput) iter.Seq2[Environment, error] {
-	return paging.Iter(ctx, input.After, s.page(input))
+func (s *Service) Iter(ctx context.Context, input ListInput, opts ...paging.Option) iter.Seq2[Environment, error] {
+	return paging.Iter(ctx, input.After, s.page(input), opts...)
 }
 
 // ListPage returns a single page of environments matching input. input.PageSize
@@ -322,6 +342,10 @@ func (s *Service) Delete(ctx context.Context, id string) (*Environment, error) {
 // [ForkInput.Copy*] flags (so it acts as a desired-state converge).
 // Re-forking with the same ID but a different parent is rejected — a
 // fork's parent is immutable.
+//
+// Secrets and remote references are copied only when asked for. To copy
+// them between environments that already exist, use
+// [Service.CopyConfiguration].
 func (s *Service) Fork(ctx context.Context, parentID string, input ForkInput) (*Environment, error) {
 	resp, err := gen.ForkEnvironment(ctx, s.client.GQLv2, s.client.Config.OrganizationID, parentID, gen.ForkEnvironmentInput{
 		Id:                      input.ID,
@@ -331,6 +355,7 @@ func (s *Service) Fork(ctx context.Context, parentID string, input ForkInput) (*
 		CopySecrets:             input.CopySecrets,
 		CopyRemoteReferences:    input.CopyRemoteReferences,
 		CopyEnvironmentDefaults: input.CopyEnvironmentDefaults,
+		DecommissionProtection:  input.DecommissionProtection,
 	})
 	if err != nil {
 		return nil, gql.ClassifyError(fmt.Errorf("fork environment from %s: %w", parentID, err))
@@ -129,6 +129,35 @@ func TestGet_NotFound(t *testing.T) {
 	}
 }
 
+func TestGraph(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		gqltest.RespondWithData(map[string]any{
+			"environment": map[string]any{
+				"id":   "ecomm-prod",
+				"name": "Production",
+				"instances": []map[string]any{
+					{"id": "ecomm-prod-db", "status": "PROVISIONED", "deployedVersion": "1.2.3", "bundle": map[string]any{"name": "aws-rds"}, "component": map[string]any{"id": "ecomm-db"}},
+					{"id": "ecomm-prod-app", "status": "INITIALIZED", "component": map[string]any{"id": "ecomm-app"}},
+				},
+				"connections": []map[string]any{
+					{"id": "conn-1", "fromField": "authentication", "toField": "database", "fromInstance": map[string]any{"id": "ecomm-prod-db"}, "toInstance": map[string]any{"id": "ecomm-prod-app"}},
+				},
+			},
+		}),
+	)
+
+	got, err := newService(gqlClient).Graph(t.Context(), "ecomm-prod")
+	if err != nil {
+		t.Fatalf("Graph: %v", err)
+	}
+	if len(got.Instances) != 2 || got.Instances[0].Bundle == nil || got.Instances[0].Bundle.Name != "aws-rds" || got.Instances[1].Bundle != nil {
+		t.Errorf("Instances = %+v", got.Instances)
+	}
+	if len(got.Connections) != 1 || got.Connections[0].FromInstance == nil || got.Connections[0].ToInstance.ID != "ecomm-prod-app" {
+		t.Errorf("Connections = %+v", got.Connections)
+	}
+}
+
 func TestList(t *testing.T) {
 	gqlClient := gqltest.NewClient(
 		gqltest.RespondWithData(map[string]any{
@@ -330,9 +359,10 @@ func TestFork(t *testing.T) {
 	)
 
 	got, err := newService(gqlClient).Fork(t.Context(), "ecomm-prod", environments.ForkInput{
-		ID:          "ecomm-pr-123",
-		Name:        "PR-123 preview",
-		CopySecrets: true,
+		ID:                     "ecomm-pr-123",
+		Name:                   "PR-123 preview",
+		CopySecrets:            true,
+		DecommissionProtection: true,
 	})
 	if err != nil {
 		t.Fatalf("Fork: %v", err)
@@ -340,6 +370,10 @@ func TestFork(t *testing.T) {
 	if got.ID != "ecomm-pr-123" {
 		t.Errorf("ID = %q, want ecomm-pr-123", got.ID)
 	}
+	input, _ := gqlClient.Requests()[0].Variables["input"].(map[string]any)
+	if input["copySecrets"] != true || input["decommissionProtection"] != true || input["copyRemoteReferences"] != false {
+		t.Errorf("input = %v, want copySecrets and decommissionProtection only", input)
+	}
 }
 
 // TestFork_Idempotent confirms a re-fork returns the same environment
@@ -19,6 +19,7 @@ const environmentEventsSubscription = `subscription environmentEvents($organizat
       environmentDefault {
         id
         resource { id name }
+        environment { id }
       }
     }
     ... on InstanceEvent {
@@ -35,7 +36,13 @@ const environmentEventsSubscription = `subscription environmentEvents($organizat
       }
     }
     ... on DeploymentEvent {
-      deployment { id status action elapsedTime }
+      deployment {
+        id
+        status
+        action
+        elapsedTime
+        instance { id name }
+      }
     }
   }
 }`
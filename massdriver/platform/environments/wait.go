@@ -0,0 +1,439 @@
+package environments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/cache"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/deployments"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/instances"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/streaming"
+)
+
+// Defaults applied to a zero [WaitOptions].
+const (
+	DefaultPollInterval = 10 * time.Second
+	DefaultSettleAfter  = 30 * time.Second
+	DefaultLogTailLines = 20
+)
+
+// baselinePageSize is the page size used to read every deployment that
+// predates a wave.
+const baselinePageSize = 100
+
+// InstanceState is an instance's position in a wave tracked by
+// [Service.DeployAndWait] or [Service.DecommissionAndWait].
+type InstanceState string
+
+const (
+	// InstancePending means the wave hasn't reached the instance yet, or its
+	// deployment is queued.
+	InstancePending InstanceState = "pending"
+	// InstanceRunning means the instance's deployment is executing.
+	InstanceRunning InstanceState = "running"
+	// InstanceSucceeded means the instance's deployment COMPLETED.
+	InstanceSucceeded InstanceState = "succeeded"
+	// InstanceFailed means the instance's deployment FAILED, or was ABORTED
+	// or REJECTED.
+	InstanceFailed InstanceState = "failed"
+)
+
+// InstanceProgress is one instance's state within a wave.
+type InstanceProgress struct {
+	InstanceID   string
+	InstanceName string
+	State        InstanceState
+	// Deployment is the instance's deployment in this wave; nil until the
+	// wave creates one. Event-sourced updates carry id, status, action,
+	// elapsedTime and the instance ref only.
+	Deployment *types.Deployment
+}
+
+// Progress is a snapshot of a wave, passed to [WaitOptions.OnProgress] and
+// returned when the wave ends.
+type Progress struct {
+	// Instances is every instance in the wave, in name order.
+	Instances []InstanceProgress
+	// Counts per state, summing to len(Instances).
+	Pending   int
+	Running   int
+	Succeeded int
+	Failed    int
+}
+
+// WaitOptions configures [Service.DeployAndWait] and
+// [Service.DecommissionAndWait]. The zero value is usable.
+type WaitOptions struct {
+	// OnProgress is called with a fresh snapshot whenever an instance
+	// changes state, and once at the start. Called from the waiting
+	// goroutine; keep it quick.
+	OnProgress func(Progress)
+	// PollInterval is how often deployments are re-listed. Events make
+	// progress immediate when streaming is available (PAT credentials);
+	// polling catches anything a reconnect dropped, and is the only source
+	// otherwise. Zero selects [DefaultPollInterval].
+	PollInterval time.Duration
+	// SettleAfter ends the wait when nothing has been running or queued
+	// for this long, even if some instances were never reached — the
+	// dependents of a failed instance, or instances the server skipped.
+	// It only applies once the wave has produced a deployment; until then
+	// ctx alone bounds the wait. Zero selects [DefaultSettleAfter].
+	SettleAfter time.Duration
+	// LogTailLines is how many trailing log lines of each failed
+	// deployment [WaveError] carries. Zero selects [DefaultLogTailLines];
+	// negative skips fetching logs.
+	LogTailLines int
+}
+
+// WaveError is returned by [Service.DeployAndWait] and
+// [Service.DecommissionAndWait] when any instance's deployment failed.
+type WaveError struct {
+	EnvironmentID string
+	// Total is the number of instances in the wave.
+	Total  int
+	Failed []FailedInstance
+}
+
+// FailedInstance is one failed instance in a [WaveError].
+type FailedInstance struct {
+	InstanceID   string
+	InstanceName string
+	Deployment   types.Deployment
+	// LogTail is the last lines of the deployment's logs. Empty when logs
+	// were skipped or unavailable; LogErr says why in the latter case.
+	LogTail string
+	LogErr  error
+}
+
+func (e *WaveError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "environment %s: %d of %d instances failed", e.EnvironmentID, len(e.Failed), e.Total)
+	for _, f := range e.Failed {
+		fmt.Fprintf(&sb, "\n  %s (deployment %s, %s)", f.InstanceID, f.Deployment.ID, f.Deployment.Status)
+		if f.LogErr != nil {
+			fmt.Fprintf(&sb, "\n    logs unavailable: %v", f.LogErr)
+		}
+		for line := range strings.Lines(f.LogTail) {
+			sb.WriteString("\n    | ")
+			sb.WriteString(strings.TrimRight(line, "\n"))
+		}
+	}
+	return sb.String()
+}
+
+// DeployAndWait calls [Service.Deploy] and then follows the resulting wave
+// until every instance's deployment reaches a terminal status, reporting
+// per-instance progress through opts.OnProgress.
+//
+// It returns the final [Progress] and, when any deployment failed, a
+// [*WaveError] listing the failed instances with the tails of their logs.
+// When ctx ends first it returns the progress so far and ctx's error; the
+// deployments themselves keep running.
+//
+//	progress, err := c.Environments.DeployAndWait(ctx, "ecomm-prod", environments.WaitOptions{
+//	    OnProgress: func(p environments.Progress) {
+//	        fmt.Printf("%d/%d done, %d running\n", p.Succeeded+p.Failed, len(p.Instances), p.Running)
+//	    },
+//	})
+//
+// Progress comes from the environment's event stream when the client has
+// PAT credentials, backed by polling every [WaitOptions.PollInterval].
+func (s *Service) DeployAndWait(ctx context.Context, id string, opts WaitOptions) (*Progress, error) {
+	return s.runWave(ctx, id, deployments.ActionProvision, s.Deploy, opts)
+}
+
+// DecommissionAndWait is [Service.DeployAndWait] for [Service.Decommission].
+// Instances that were never provisioned, or are already decommissioned,
+// are left out of the wave.
+func (s *Service) DecommissionAndWait(ctx context.Context, id string, opts WaitOptions) (*Progress, error) {
+	return s.runWave(ctx, id, deployments.ActionDecommission, s.Decommission, opts)
+}
+
+func (s *Service) runWave(
+	ctx context.Context,
+	id string,
+	action deployments.Action,
+	start func(context.Context, string) (*Environment, error),
+	opts WaitOptions,
+) (*Progress, error) {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultPollInterval
+	}
+	if opts.SettleAfter <= 0 {
+		opts.SettleAfter = DefaultSettleAfter
+	}
+	if opts.LogTailLines == 0 {
+		opts.LogTailLines = DefaultLogTailLines
+	}
+	// Every read below is a poll; none may come from a cache.
+	ctx, cancel := context.WithCancel(cache.Bypass(ctx))
+	defer cancel()
+
+	// Subscribe before starting so no early transition is missed.
+	events, err := s.StreamEvents(ctx, id)
+	if err != nil && !errors.Is(err, streaming.ErrRequiresPAT) {
+		return nil, fmt.Errorf("wait for environment %s: %w", id, err)
+	}
+
+	deps := deployments.New(s.client)
+	w, err := s.newWave(ctx, id, action, deps)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := start(ctx, id); err != nil {
+		return nil, err
+	}
+	w.lastChange = time.Now()
+	report := func() {
+		if opts.OnProgress != nil {
+			opts.OnProgress(w.snapshot())
+		}
+	}
+	report()
+
+	ticker := time.NewTicker(opts.PollInterval)
+	defer ticker.Stop()
+	poll := true // reconcile straight away, then on every tick
+	for !w.done(opts.SettleAfter) {
+		if poll {
+			changed, err := w.poll(ctx, deps)
+			if err != nil {
+				p := w.snapshot()
+				return &p, fmt.Errorf("wait for environment %s: %w", id, err)
+			}
+			if changed {
+				report()
+			}
+			poll = false
+			continue
+		}
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				events = nil // stream gone; polling carries on
+				continue
+			}
+			if de, isDeploy := ev.(*types.DeploymentEvent); isDeploy && w.observe(de.Deployment) {
+				report()
+			}
+		case <-ticker.C:
+			poll = true
+		case <-ctx.Done():
+			p := w.snapshot()
+			return &p, fmt.Errorf("wait for environment %s: %w", id, ctx.Err())
+		}
+	}
+
+	p := w.snapshot()
+	if p.Failed == 0 {
+		return &p, nil
+	}
+	return &p, w.failure(ctx, deps, opts.LogTailLines)
+}
+
+// wave tracks one environment-wide deployment run.
+type wave struct {
+	environmentID string
+	action        deployments.Action
+	order         []string
+	instances     map[string]*InstanceProgress
+	// baseline holds deployment IDs that predate the wave; they are never
+	// attributed to it. superseded holds wave deployments an instance has
+	// since replaced with a newer one (a retry).
+	baseline   map[string]bool
+	superseded map[string]bool
+	started    bool
+	lastChange time.Time
+}
+
+func (s *Service) newWave(ctx context.Context, id string, action deployments.Action, deps *deployments.Service) (*wave, error) {
+	w := &wave{
+		environmentID: id,
+		action:        action,
+		instances:     make(map[string]*InstanceProgress),
+		baseline:      make(map[string]bool),
+		superseded:    make(map[string]bool),
+	}
+	for inst, err := range instances.New(s.client).Iter(ctx, instances.ListInput{EnvironmentID: id}) {
+		if err != nil {
+			return nil, fmt.Errorf("wait for environment %s: %w", id, err)
+		}
+		if action == deployments.ActionDecommission &&
+			(inst.Status == string(instances.StatusInitialized) || inst.Status == string(instances.StatusDecommissioned)) {
+			continue
+		}
+		w.order = append(w.order, inst.ID)
+		w.instances[inst.ID] = &InstanceProgress{InstanceID: inst.ID, InstanceName: inst.Name, State: InstancePending}
+	}
+	if len(w.order) == 0 {
+		return w, nil
+	}
+	// Every page, not just the first: an environment with a long history
+	// would otherwise have older deployments mistaken for the wave's.
+	for d, err := range deps.Iter(ctx, w.listInput(baselinePageSize)) {
+		if err != nil {
+			return nil, fmt.Errorf("wait for environment %s: %w", id, err)
+		}
+		w.baseline[d.ID] = true
+	}
+	return w, nil
+}
+
+func (w *wave) listInput(pageSize int) deployments.ListInput {
+	return deployments.ListInput{
+		InstanceIDs: w.order,
+		Action:      w.action,
+		SortBy:      deployments.SortByCreatedAt,
+		SortOrder:   deployments.SortDesc,
+		PageSize:    pageSize,
+	}
+}
+
+// poll lists the wave's deployments newest-first, down to the first one
+// that predates the wave, and observes the latest per instance.
+func (w *wave) poll(ctx context.Context, deps *deployments.Service) (bool, error) {
+	if len(w.order) == 0 {
+		return false, nil
+	}
+	latest := make(map[string]types.Deployment)
+	var newestFirst []string
+	for d, err := range deps.Iter(ctx, w.listInput(0)) {
+		if err != nil {
+			return false, err
+		}
+		if w.baseline[d.ID] {
+			break
+		}
+		if d.Instance == nil {
+			continue
+		}
+		if _, seen := latest[d.Instance.ID]; !seen {
+			latest[d.Instance.ID] = d
+			newestFirst = append(newestFirst, d.Instance.ID)
+		}
+	}
+	changed := false
+	for _, instID := range newestFirst {
+		if w.observe(latest[instID]) {
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+// observe records d against its instance and reports whether anything
+// changed.
+func (w *wave) observe(d types.Deployment) bool {
+	if d.Instance == nil || w.baseline[d.ID] || w.superseded[d.ID] || (d.Action != "" && d.Action != string(w.action)) {
+		return false
+	}
+	p, ok := w.instances[d.Instance.ID]
+	if !ok {
+		return false
+	}
+	if cur := p.Deployment; cur != nil {
+		// A poll can race an event; never move one deployment backwards.
+		if cur.ID == d.ID && (deployments.IsTerminal(cur.Status) || cur.Status == d.Status) {
+			return false
+		}
+		if cur.ID != d.ID {
+			w.superseded[cur.ID] = true
+		}
+	}
+	p.Deployment = &d
+	p.State = stateOf(d.Status)
+	w.started = true
+	w.lastChange = time.Now()
+	return true
+}
+
+// done reports whether the wave is over: every instance finished, or
+// the wave has started and nothing has been queued or running for
+// settle.
+func (w *wave) done(settle time.Duration) bool {
+	finished := true
+	for _, p := range w.instances {
+		switch p.State {
+		case InstanceRunning:
+			return false
+		case InstancePending:
+			if p.Deployment != nil {
+				return false
+			}
+			finished = false
+		case InstanceSucceeded, InstanceFailed:
+		}
+	}
+	return finished || (w.started && time.Since(w.lastChange) >= settle)
+}
+
+func (w *wave) snapshot() Progress {
+	p := Progress{Instances: make([]InstanceProgress, 0, len(w.order))}
+	for _, id := range w.order {
+		ip := *w.instances[id]
+		if ip.Deployment != nil {
+			d := *ip.Deployment
+			ip.Deployment = &d
+		}
+		p.Instances = append(p.Instances, ip)
+		switch ip.State {
+		case InstancePending:
+			p.Pending++
+		case InstanceRunning:
+			p.Running++
+		case InstanceSucceeded:
+			p.Succeeded++
+		case InstanceFailed:
+			p.Failed++
+		}
+	}
+	return p
+}
+
+// failure builds the [WaveError] for the wave's failed instances.
+func (w *wave) failure(ctx context.Context, deps *deployments.Service, tailLines int) error {
+	werr := &WaveError{EnvironmentID: w.environmentID, Total: len(w.order)}
+	for _, id := range w.order {
+		p := w.instances[id]
+		if p.State != InstanceFailed {
+			continue
+		}
+		f := FailedInstance{InstanceID: p.InstanceID, InstanceName: p.InstanceName, Deployment: *p.Deployment}
+		if tailLines > 0 {
+			logs, err := deps.GetLogs(ctx, p.Deployment.ID)
+			f.LogTail, f.LogErr = tail(logs, tailLines), err
+		}
+		werr.Failed = append(werr.Failed, f)
+	}
+	return werr
+}
+
+func stateOf(status string) InstanceState {
+	switch deployments.Status(status) {
+	case deployments.StatusCompleted:
+		return InstanceSucceeded
+	case deployments.StatusFailed, deployments.StatusAborted, deployments.StatusRejected:
+		return InstanceFailed
+	case deployments.StatusRunning:
+		return InstanceRunning
+	case deployments.StatusProposed, deployments.StatusApproved, deployments.StatusPending:
+	}
+	return InstancePending
+}
+
+// tail returns the last n lines of s.
+func tail(s string, n int) string {
+	s = strings.TrimRight(s, "\n")
+	if s == "" {
+		return ""
+	}
+	lines := strings.Split(s, "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
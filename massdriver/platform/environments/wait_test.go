@@ -0,0 +1,182 @@
+package environments_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/environments"
+)
+
+func waveInstances() gqltest.Response {
+	return gqltest.RespondWithData(map[string]any{
+		"instances": map[string]any{
+			"cursor": map[string]any{},
+			"items": []map[string]any{
+				{"id": "ecomm-prod-app", "name": "app", "status": "PROVISIONED"},
+				{"id": "ecomm-prod-db", "name": "db", "status": "PROVISIONED"},
+			},
+		},
+	})
+}
+
+// waveDeployments is a newest-first deployments page; each entry is
+// {deploymentID, instanceID, status}.
+func waveDeployments(rows ...[3]string) gqltest.Response {
+	items := make([]map[string]any, 0, len(rows))
+	for _, r := range rows {
+		items = append(items, map[string]any{
+			"id":       r[0],
+			"status":   r[2],
+			"action":   "PROVISION",
+			"instance": map[string]any{"id": r[1]},
+		})
+	}
+	return gqltest.RespondWithData(map[string]any{
+		"deployments": map[string]any{"cursor": map[string]any{}, "items": items},
+	})
+}
+
+func deployed() gqltest.Response {
+	return gqltest.RespondWithData(map[string]any{
+		"deployEnvironment": map[string]any{
+			"result":     map[string]any{"id": "ecomm-prod"},
+			"successful": true,
+		},
+	})
+}
+
+var fastWait = environments.WaitOptions{PollInterval: time.Millisecond, SettleAfter: time.Hour}
+
+func TestDeployAndWait(t *testing.T) {
+	old := [3]string{"dep-old", "ecomm-prod-db", "COMPLETED"}
+	gqlClient := gqltest.NewClient(
+		waveInstances(),
+		waveDeployments(old),
+		deployed(),
+		waveDeployments([3]string{"dep-db", "ecomm-prod-db", "RUNNING"}, old),
+		waveDeployments([3]string{"dep-app", "ecomm-prod-app", "PENDING"}, [3]string{"dep-db", "ecomm-prod-db", "COMPLETED"}, old),
+		waveDeployments([3]string{"dep-app", "ecomm-prod-app", "COMPLETED"}, [3]string{"dep-db", "ecomm-prod-db", "COMPLETED"}, old),
+	)
+
+	opts := fastWait
+	var seen []environments.Progress
+	opts.OnProgress = func(p environments.Progress) { seen = append(seen, p) }
+	got, err := newService(gqlClient).DeployAndWait(t.Context(), "ecomm-prod", opts)
+	if err != nil {
+		t.Fatalf("DeployAndWait: %v", err)
+	}
+	if got.Succeeded != 2 || got.Failed != 0 || got.Pending != 0 || got.Running != 0 {
+		t.Errorf("final progress = %+v, want 2 succeeded", got)
+	}
+	if got.Instances[1].Deployment == nil || got.Instances[1].Deployment.ID != "dep-db" {
+		t.Errorf("db deployment = %+v, want dep-db (not the pre-wave dep-old)", got.Instances[1].Deployment)
+	}
+	if len(seen) != 4 {
+		t.Fatalf("OnProgress called %d times, want 4", len(seen))
+	}
+	if seen[0].Pending != 2 || seen[1].Running != 1 {
+		t.Errorf("early progress = %+v, %+v; want 2 pending then 1 running", seen[0], seen[1])
+	}
+
+	reqs := gqlClient.Requests()
+	filter, _ := reqs[1].Variables["filter"].(map[string]any)
+	instID, _ := filter["instanceId"].(map[string]any)
+	if in, _ := instID["in"].([]any); len(in) != 2 {
+		t.Errorf("filter.instanceId.in = %v, want both instances", instID["in"])
+	}
+	if reqs[2].OpName != "DeployEnvironment" {
+		t.Errorf("request 2 = %s, want DeployEnvironment", reqs[2].OpName)
+	}
+	if gqlClient.Pending() != 0 {
+		t.Errorf("%d responses unused", gqlClient.Pending())
+	}
+}
+
+func TestDeployAndWait_FailureCarriesLogTail(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		waveInstances(),
+		waveDeployments(),
+		deployed(),
+		waveDeployments([3]string{"dep-app", "ecomm-prod-app", "FAILED"}, [3]string{"dep-db", "ecomm-prod-db", "COMPLETED"}),
+		gqltest.RespondWithData(map[string]any{
+			"deployment": map[string]any{
+				"id": "dep-app",
+				"logs": []map[string]any{
+					{"message": "Planning...\n"},
+					{"message": "Error: quota exceeded\nexit status 1\n"},
+				},
+			},
+		}),
+	)
+
+	opts := fastWait
+	opts.LogTailLines = 2
+	got, err := newService(gqlClient).DeployAndWait(t.Context(), "ecomm-prod", opts)
+	var werr *environments.WaveError
+	if !errors.As(err, &werr) {
+		t.Fatalf("err = %v, want *WaveError", err)
+	}
+	if got.Failed != 1 || got.Succeeded != 1 {
+		t.Errorf("final progress = %+v, want 1 failed, 1 succeeded", got)
+	}
+	if len(werr.Failed) != 1 || werr.Failed[0].InstanceID != "ecomm-prod-app" {
+		t.Fatalf("Failed = %+v, want ecomm-prod-app", werr.Failed)
+	}
+	if want := "Error: quota exceeded\nexit status 1\n"; werr.Failed[0].LogTail != want {
+		t.Errorf("LogTail = %q, want %q", werr.Failed[0].LogTail, want)
+	}
+}
+
+func TestDeployAndWait_SettlesWhenDependentsNeverStart(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		waveInstances(),
+		waveDeployments(),
+		deployed(),
+		waveDeployments([3]string{"dep-db", "ecomm-prod-db", "FAILED"}),
+		waveDeployments([3]string{"dep-db", "ecomm-prod-db", "FAILED"}),
+		waveDeployments([3]string{"dep-db", "ecomm-prod-db", "FAILED"}),
+	)
+
+	got, err := newService(gqlClient).DeployAndWait(t.Context(), "ecomm-prod", environments.WaitOptions{
+		PollInterval: 5 * time.Millisecond,
+		SettleAfter:  time.Millisecond,
+		LogTailLines: -1,
+	})
+	var werr *environments.WaveError
+	if !errors.As(err, &werr) {
+		t.Fatalf("err = %v, want *WaveError", err)
+	}
+	if got.Pending != 1 || got.Failed != 1 {
+		t.Errorf("final progress = %+v, want app still pending, db failed", got)
+	}
+}
+
+// TestDeployAndWait_BaselineReadsEveryPage confirms the pre-wave baseline
+// covers an environment's whole deployment history, not just its first
+// page, so no older deployment can pass for the wave's.
+func TestDeployAndWait_BaselineReadsEveryPage(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		waveInstances(),
+		gqltest.RespondWithData(map[string]any{"deployments": map[string]any{
+			"cursor": map[string]any{"next": "page-2"},
+			"items":  []map[string]any{{"id": "dep-old1", "status": "COMPLETED", "action": "PROVISION", "instance": map[string]any{"id": "ecomm-prod-app"}}},
+		}}),
+		waveDeployments([3]string{"dep-old2", "ecomm-prod-db", "COMPLETED"}),
+		deployed(),
+		waveDeployments([3]string{"dep-app", "ecomm-prod-app", "COMPLETED"}, [3]string{"dep-db", "ecomm-prod-db", "COMPLETED"}, [3]string{"dep-old1", "ecomm-prod-app", "COMPLETED"}),
+	)
+
+	got, err := newService(gqlClient).DeployAndWait(t.Context(), "ecomm-prod", fastWait)
+	if err != nil {
+		t.Fatalf("DeployAndWait: %v", err)
+	}
+	if got.Succeeded != 2 {
+		t.Errorf("final progress = %+v, want 2 succeeded", got)
+	}
+	reqs := gqlClient.Requests()
+	if reqs[2].OpName != "ListDeployments" || reqs[3].OpName != "DeployEnvironment" {
+		t.Errorf("requests 2, 3 = %s, %s; want the second baseline page, then the deploy", reqs[2].OpName, reqs[3].OpName)
+	}
+}
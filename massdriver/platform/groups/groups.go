@@ -118,8 +118,8 @@ func (s *Service) Get(ctx context.Context, id string) (*Group, error) {
 // a failed page fetch, after which iteration stops.
 //
 // To buffer every match into a slice, wrap with [types.Collect].
-func (s *Service) Iter(ctx context.Context, input ListInput) iter.Seq2[Group, error] {
-	return paging.Iter(ctx, input.After, s.page(input))
+func (s *Service) Iter(ctx context.Context, input ListInput, opts ...paging.Option) iter.Seq2[Group, error] {
+	return paging.Iter(ctx, input.After, s.page(input), opts...)
 }
 
 // ListPage returns a single page of groups matching input. input.PageSize
@@ -127,8 +127,8 @@ func (s *Service) GetAlarm(ctx context.Context, id string) (*Alarm, error) {
 //	    if err != nil { return err }
 //	    process(a)
 //	}
-func (s *Service) IterAlarms(ctx context.Context, input ListAlarmsInput) iter.Seq2[Alarm, error] {
-	return paging.Iter(ctx, input.After, s.alarmsPage(input))
+func (s *Service) IterAlarms(ctx context.Context, input ListAlarmsInput, opts ...paging.Option) iter.Seq2[Alarm, error] {
+	return paging.Iter(ctx, input.After, s.alarmsPage(input), opts...)
 }
 
 // ListAlarmsPage returns a single page of alarms matching input. input.PageSize
@@ -10,7 +10,10 @@
 //
 // Sub-resources fold into this package by file: alarms.go, secrets.go,
 // resources.go. They share the same client and follow the same wrapper
-// shape as the core instance operations.
+// shape as the core instance operations. [Service.PatchParams] (patch.go)
+// edits configuration in place, validating it against the instance's
+// params schema before saving, and [Service.SyncSecrets] (secretsync.go)
+// reconciles secrets against a dotenv or JSON source.
 //
 // Construct a [*Service] with [New] passing the low-level client, or use the
 // pre-wired [massdriver.Client.Instances] field on the top-level SDK client.
@@ -223,8 +226,8 @@ func (s *Service) Get(ctx context.Context, id string) (*Instance, error) {
 //	    if err != nil { return err }
 //	    process(inst)
 //	}
-func (s *Service) Iter(ctx context.Context, input ListInput) iter.Seq2[Instance, error] {
-	return paging.Iter(ctx, input.After, s.page(input))
+func (s *Service) Iter(ctx context.Context, input ListInput, opts ...paging.Option) iter.Seq2[Instance, error] {
+	return paging.Iter(ctx, input.After, s.page(input), opts...)
 }
 
 // ListPage returns a single page of instances matching input. input.PageSize
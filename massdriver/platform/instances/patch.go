@@ -0,0 +1,217 @@
+package instances
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/cache"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/deployments"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// ErrConflict is returned (wrapped, match with [errors.Is]) by
+// [Service.PatchParams] when the instance's UpdatedAt no longer matches
+// [Patch].IfUpdatedAt.
+var ErrConflict = errors.New("instance changed since read")
+
+// PatchType selects how a [Patch] body is interpreted.
+type PatchType string
+
+const (
+	// PatchMerge is an RFC 7396 JSON Merge Patch: objects merge
+	// recursively, null deletes a key, and everything else replaces.
+	PatchMerge PatchType = "merge"
+	// PatchJSON is an RFC 6902 JSON Patch: an array of add, remove,
+	// replace, move, copy, and test operations.
+	PatchJSON PatchType = "json-patch"
+)
+
+// Patch is the input for [Service.PatchParams].
+type Patch struct {
+	// Type is the patch format. Empty = [PatchMerge].
+	Type PatchType
+	// Body is the patch document as JSON.
+	Body []byte
+	// Action is the deployment that carries the patched params. Required:
+	// [deployments.ActionProvision] or [deployments.ActionPlan].
+	Action deployments.Action
+	// Message is an optional description recorded on the deployment.
+	Message string
+	// IfUpdatedAt, when set, is the instance UpdatedAt the caller last
+	// saw. The patch fails with [ErrConflict] if the instance has moved
+	// on since. The check is best-effort: the API has no conditional
+	// write, so a change landing between PatchParams' read and its
+	// deployment goes undetected.
+	IfUpdatedAt time.Time
+}
+
+// FieldError is one schema violation in patched params.
+type FieldError struct {
+	// Path is the JSON Pointer to the offending value ("/database/size");
+	// empty for the params object itself.
+	Path string
+	// Message describes the violation, e.g. "missing property 'region'".
+	Message string
+}
+
+// ValidationError is returned by [Service.PatchParams] when the patched
+// params don't satisfy the instance's ParamsSchema. Nothing is written.
+type ValidationError struct {
+	InstanceID string
+	Fields     []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		path := f.Path
+		if path == "" {
+			path = "/"
+		}
+		msgs = append(msgs, path+": "+f.Message)
+	}
+	return fmt.Sprintf("instance %s params invalid: %s", e.InstanceID, strings.Join(msgs, "; "))
+}
+
+// PatchParams applies patch to an instance's current params, validates the
+// result locally against the instance's ParamsSchema, and sends it in a
+// deployment of [Patch].Action. Instances have no params-only mutation;
+// a deployment is how new configuration reaches them.
+//
+// Schema violations come back as a [*ValidationError] listing every
+// offending field, without a round trip to the server. Instances with no
+// ParamsSchema skip validation.
+//
+// The patch applies to a fresh read of the instance, never a cached one.
+// With [Patch].IfUpdatedAt set, that read must still be at the caller's
+// UpdatedAt or PatchParams fails with [ErrConflict] and writes nothing.
+func (s *Service) PatchParams(ctx context.Context, id string, patch Patch) (*types.Deployment, error) {
+	if patch.Action == "" {
+		return nil, fmt.Errorf("patch instance %s params: an action is required (PROVISION or PLAN)", id)
+	}
+	inst, err := s.Get(cache.Bypass(ctx), id)
+	if err != nil {
+		return nil, err
+	}
+	if !patch.IfUpdatedAt.IsZero() && !inst.UpdatedAt.Equal(patch.IfUpdatedAt) {
+		return nil, conflict(id, patch.IfUpdatedAt, inst.UpdatedAt)
+	}
+
+	patched, err := applyPatch(inst.Params, patch)
+	if err != nil {
+		return nil, fmt.Errorf("patch instance %s params: %w", id, err)
+	}
+	if len(inst.ParamsSchema) > 0 {
+		fields, verr := validateParams(inst.ParamsSchema, patched)
+		if verr != nil {
+			return nil, fmt.Errorf("patch instance %s params: %w", id, verr)
+		}
+		if len(fields) > 0 {
+			return nil, &ValidationError{InstanceID: id, Fields: fields}
+		}
+	}
+
+	params := map[string]any{}
+	dec := json.NewDecoder(bytes.NewReader(patched))
+	dec.UseNumber()
+	if err := dec.Decode(&params); err != nil {
+		return nil, fmt.Errorf("patch instance %s params: result is not an object: %w", id, err)
+	}
+
+	return deployments.New(s.client).Create(ctx, id, deployments.CreateInput{
+		Action:  patch.Action,
+		Params:  params,
+		Message: patch.Message,
+	})
+}
+
+func conflict(id string, read, now time.Time) error {
+	return fmt.Errorf("patch instance %s params: read at updatedAt %s, now %s: %w",
+		id, read.Format(time.RFC3339Nano), now.Format(time.RFC3339Nano), ErrConflict)
+}
+
+// applyPatch returns params with patch applied, as JSON.
+func applyPatch(params map[string]any, patch Patch) ([]byte, error) {
+	if params == nil {
+		params = map[string]any{}
+	}
+	doc, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("encode current params: %w", err)
+	}
+	switch patch.Type {
+	case PatchMerge, "":
+		out, err := jsonpatch.MergePatch(doc, patch.Body)
+		if err != nil {
+			return nil, fmt.Errorf("apply merge patch: %w", err)
+		}
+		return out, nil
+	case PatchJSON:
+		ops, err := jsonpatch.DecodePatch(patch.Body)
+		if err != nil {
+			return nil, fmt.Errorf("decode JSON patch: %w", err)
+		}
+		out, err := ops.Apply(doc)
+		if err != nil {
+			return nil, fmt.Errorf("apply JSON patch: %w", err)
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("unknown patch type %q", patch.Type)
+}
+
+// validateParams checks params (JSON) against schema and returns one
+// FieldError per failing leaf. The error return is for a schema that
+// won't compile, not for invalid params.
+func validateParams(schema map[string]any, params []byte) ([]FieldError, error) {
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("encode params schema: %w", err)
+	}
+	schemaDoc, err := jsonschema.UnmarshalJSON(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("decode params schema: %w", err)
+	}
+	c := jsonschema.NewCompiler()
+	if err := c.AddResource("params.schema.json", schemaDoc); err != nil {
+		return nil, fmt.Errorf("load params schema: %w", err)
+	}
+	sch, err := c.Compile("params.schema.json")
+	if err != nil {
+		return nil, fmt.Errorf("compile params schema: %w", err)
+	}
+
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(params))
+	if err != nil {
+		return nil, fmt.Errorf("decode patched params: %w", err)
+	}
+	err = sch.Validate(doc)
+	var ve *jsonschema.ValidationError
+	if !errors.As(err, &ve) {
+		return nil, err
+	}
+	var fields []FieldError
+	var walk func(*jsonschema.ValidationError)
+	walk = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) == 0 {
+			// A leaf's basic output is its own location and message,
+			// rendered in the library's default (English) printer.
+			out := e.BasicOutput()
+			fields = append(fields, FieldError{Path: out.InstanceLocation, Message: out.Error.String()})
+			return
+		}
+		for _, cause := range e.Causes {
+			walk(cause)
+		}
+	}
+	walk(ve)
+	return fields, nil
+}
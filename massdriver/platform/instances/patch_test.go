@@ -0,0 +1,133 @@
+package instances_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/deployments"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/instances"
+)
+
+var patchSchema = map[string]any{
+	"type":     "object",
+	"required": []any{"size"},
+	"properties": map[string]any{
+		"size":     map[string]any{"type": "string", "enum": []any{"small", "large"}},
+		"replicas": map[string]any{"type": "integer", "minimum": 1},
+		"tags":     map[string]any{"type": "object"},
+	},
+	"additionalProperties": false,
+}
+
+func instanceAt(updatedAt string) gqltest.Response {
+	return gqltest.RespondWithData(map[string]any{
+		"instance": map[string]any{
+			"id":           "ecomm-prod-database",
+			"params":       map[string]any{"size": "small", "replicas": 1, "tags": map[string]any{"team": "data"}},
+			"paramsSchema": patchSchema,
+			"updatedAt":    updatedAt,
+		},
+	})
+}
+
+func TestPatchParams_MergePatch(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		instanceAt("2026-01-15T10:00:00Z"),
+		gqltest.RespondWithData(map[string]any{
+			"createDeployment": map[string]any{
+				"result":     map[string]any{"id": "dep-new", "status": "PENDING", "action": "PROVISION"},
+				"successful": true,
+			},
+		}),
+	)
+
+	dep, err := newService(gqlClient).PatchParams(t.Context(), "ecomm-prod-database", instances.Patch{
+		Body:        []byte(`{"size":"large","tags":{"team":null}}`),
+		Action:      deployments.ActionProvision,
+		IfUpdatedAt: time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("PatchParams: %v", err)
+	}
+	if dep.ID != "dep-new" {
+		t.Errorf("deployment = %+v, want dep-new", dep)
+	}
+
+	input, _ := gqlClient.Requests()[1].Variables["input"].(map[string]any)
+	if input["action"] != "PROVISION" {
+		t.Errorf("input.action = %v, want PROVISION", input["action"])
+	}
+	params := input["params"]
+	if s, ok := params.(string); ok {
+		if err := json.Unmarshal([]byte(s), &params); err != nil {
+			t.Fatalf("decode params: %v", err)
+		}
+	}
+	got, _ := json.Marshal(params)
+	if string(got) != `{"replicas":1,"size":"large","tags":{}}` {
+		t.Errorf("params = %s", got)
+	}
+}
+
+func TestPatchParams_JSONPatchValidationErrors(t *testing.T) {
+	gqlClient := gqltest.NewClient(instanceAt("2026-01-15T10:00:00Z"))
+
+	_, err := newService(gqlClient).PatchParams(t.Context(), "ecomm-prod-database", instances.Patch{
+		Type:   instances.PatchJSON,
+		Action: deployments.ActionProvision,
+		Body: []byte(`[
+			{"op":"remove","path":"/size"},
+			{"op":"replace","path":"/replicas","value":0},
+			{"op":"add","path":"/sise","value":"large"}
+		]`),
+	})
+	var verr *instances.ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("err = %v, want *ValidationError", err)
+	}
+	paths := map[string]bool{}
+	for _, f := range verr.Fields {
+		paths[f.Path] = true
+		if f.Message == "" {
+			t.Errorf("field %q has no message", f.Path)
+		}
+	}
+	if len(verr.Fields) != 3 || !paths[""] || !paths["/replicas"] {
+		t.Errorf("Fields = %+v, want missing size and unknown sise at the root, plus /replicas", verr.Fields)
+	}
+	if n := len(gqlClient.Requests()); n != 1 {
+		t.Errorf("made %d requests, want only the read", n)
+	}
+}
+
+// TestPatchParams_RequiresAction confirms the caller picks the
+// deployment action; nothing is read or written without one.
+func TestPatchParams_RequiresAction(t *testing.T) {
+	gqlClient := gqltest.NewClient()
+
+	_, err := newService(gqlClient).PatchParams(t.Context(), "ecomm-prod-database", instances.Patch{
+		Body: []byte(`{"replicas":3}`),
+	})
+	if err == nil {
+		t.Fatal("PatchParams without an action succeeded")
+	}
+	if n := len(gqlClient.Requests()); n != 0 {
+		t.Errorf("made %d requests, want none", n)
+	}
+}
+
+func TestPatchParams_StaleIfUpdatedAt(t *testing.T) {
+	gqlClient := gqltest.NewClient(instanceAt("2026-01-15T10:05:00Z"))
+
+	_, err := newService(gqlClient).PatchParams(t.Context(), "ecomm-prod-database", instances.Patch{
+		Body:        []byte(`{"replicas":3}`),
+		Action:      deployments.ActionProvision,
+		IfUpdatedAt: time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC),
+	})
+	if !errors.Is(err, instances.ErrConflict) {
+		t.Fatalf("err = %v, want ErrConflict", err)
+	}
+}
@@ -0,0 +1,83 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/decode"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/gen"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// RemoteReference is a per-instance connection-slot override — alias of
+// [types.RemoteReference].
+type RemoteReference = types.RemoteReference
+
+// RemoteReferences returns the remote-reference overrides on an instance,
+// sorted by field. Slots wired by a blueprint link or an environment
+// default aren't included.
+func (s *Service) RemoteReferences(ctx context.Context, instanceID string) ([]RemoteReference, error) {
+	resp, err := gen.GetInstanceRemoteReferences(ctx, s.client.GQLv2, s.client.Config.OrganizationID, instanceID)
+	if err != nil {
+		return nil, gql.ClassifyError(fmt.Errorf("get instance %s remote references: %w", instanceID, err))
+	}
+	if resp.Instance.Id == "" {
+		return nil, fmt.Errorf("get instance %s remote references: %w", instanceID, gql.ErrNotFound)
+	}
+	var out []RemoteReference
+	for _, dep := range resp.Instance.Dependencies {
+		src, ok := dep.Source.(*gen.GetInstanceRemoteReferencesInstanceDependenciesInstanceDependencySourceRemoteReference)
+		if !ok {
+			continue
+		}
+		ref, rerr := toRemoteReference(src)
+		if rerr != nil {
+			return nil, rerr
+		}
+		out = append(out, *ref)
+	}
+	return out, nil
+}
+
+// SetRemoteReference points an instance's connection slot field at
+// resourceID — a UUID for an imported resource or "instance.field" for a
+// provisioned one — overriding its blueprint link or environment default.
+// The instance must not be PROVISIONED or FAILED.
+func (s *Service) SetRemoteReference(ctx context.Context, instanceID, field, resourceID string) (*RemoteReference, error) {
+	resp, err := gen.SetRemoteReference(ctx, s.client.GQLv2, s.client.Config.OrganizationID, instanceID, resourceID, gen.SetRemoteReferenceInput{
+		Field: field,
+	})
+	if err != nil {
+		return nil, gql.ClassifyError(fmt.Errorf("set instance %s remote reference %s: %w", instanceID, field, err))
+	}
+	if err := gql.CheckMutation("set remote reference", resp.SetRemoteReference.Successful, resp.SetRemoteReference.Messages); err != nil {
+		return nil, err
+	}
+	return toRemoteReference(resp.SetRemoteReference.Result)
+}
+
+// RemoveRemoteReference removes the override on an instance's connection
+// slot field; the slot reverts to its blueprint link or environment
+// default at the next deploy. The instance must not be PROVISIONED or
+// FAILED.
+func (s *Service) RemoveRemoteReference(ctx context.Context, instanceID, field string) (*RemoteReference, error) {
+	resp, err := gen.RemoveRemoteReference(ctx, s.client.GQLv2, s.client.Config.OrganizationID, instanceID, gen.RemoveRemoteReferenceInput{
+		Field: field,
+	})
+	if err != nil {
+		return nil, gql.ClassifyError(fmt.Errorf("remove instance %s remote reference %s: %w", instanceID, field, err))
+	}
+	if err := gql.CheckMutation("remove remote reference", resp.RemoveRemoteReference.Successful, resp.RemoveRemoteReference.Messages); err != nil {
+		return nil, err
+	}
+	return toRemoteReference(resp.RemoveRemoteReference.Result)
+}
+
+func toRemoteReference(v any) (*RemoteReference, error) {
+	ref := RemoteReference{}
+	if err := decode.Decode(v, &ref); err != nil {
+		return nil, fmt.Errorf("decode remote reference: %w", err)
+	}
+	return &ref, nil
+}
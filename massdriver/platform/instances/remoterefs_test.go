@@ -0,0 +1,59 @@
+package instances_test
+
+import (
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
+)
+
+func TestRemoteReferences(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		gqltest.RespondWithData(map[string]any{
+			"instance": map[string]any{
+				"id": "ecomm-prod-database",
+				"dependencies": []map[string]any{
+					{"field": "network", "source": map[string]any{"__typename": "EnvironmentDefault", "id": "def-1"}},
+					{"field": "vpc", "source": map[string]any{
+						"__typename": "RemoteReference",
+						"id":         "ref-1",
+						"field":      "vpc",
+						"resource":   map[string]any{"id": "shared-vpc", "name": "Shared VPC"},
+					}},
+				},
+			},
+		}),
+	)
+
+	got, err := newService(gqlClient).RemoteReferences(t.Context(), "ecomm-prod-database")
+	if err != nil {
+		t.Fatalf("RemoteReferences: %v", err)
+	}
+	// Only the RemoteReference source counts; defaults and connections don't.
+	if len(got) != 1 || got[0].Field != "vpc" || got[0].Resource == nil || got[0].Resource.ID != "shared-vpc" {
+		t.Errorf("RemoteReferences = %+v, want the vpc override", got)
+	}
+}
+
+func TestSetRemoteReference(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		gqltest.RespondWithData(map[string]any{
+			"setRemoteReference": map[string]any{
+				"result":     map[string]any{"id": "ref-1", "field": "vpc", "resource": map[string]any{"id": "shared-vpc"}},
+				"successful": true,
+			},
+		}),
+	)
+
+	got, err := newService(gqlClient).SetRemoteReference(t.Context(), "ecomm-prod-database", "vpc", "shared-vpc")
+	if err != nil {
+		t.Fatalf("SetRemoteReference: %v", err)
+	}
+	if got.ID != "ref-1" {
+		t.Errorf("ID = %q, want ref-1", got.ID)
+	}
+	vars := gqlClient.Requests()[0].Variables
+	input, _ := vars["input"].(map[string]any)
+	if vars["instanceId"] != "ecomm-prod-database" || vars["resourceId"] != "shared-vpc" || input["field"] != "vpc" {
+		t.Errorf("variables = %v", vars)
+	}
+}
@@ -0,0 +1,297 @@
+package instances
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/decode"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/fingerprint"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/gen"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// SecretField is a secret declared by an instance's bundle — alias of
+// [types.InstanceSecretField].
+type SecretField = types.InstanceSecretField
+
+// SecretFields lists the secrets an instance's bundle declares, sorted by
+// name, with the fingerprint of each stored value.
+func (s *Service) SecretFields(ctx context.Context, instanceID string) ([]SecretField, error) {
+	resp, err := gen.GetInstanceSecretFields(ctx, s.client.GQLv2, s.client.Config.OrganizationID, instanceID)
+	if err != nil {
+		return nil, gql.ClassifyError(fmt.Errorf("get instance %s secret fields: %w", instanceID, err))
+	}
+	if resp.Instance.Id == "" {
+		return nil, fmt.Errorf("get instance %s secret fields: %w", instanceID, gql.ErrNotFound)
+	}
+	fields := make([]SecretField, 0, len(resp.Instance.SecretFields))
+	for _, f := range resp.Instance.SecretFields {
+		field := SecretField{}
+		if err := decode.Decode(f, &field); err != nil {
+			return nil, fmt.Errorf("decode instance secret field: %w", err)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// SecretSource supplies the desired secret values for [Service.SyncSecrets].
+// Build one with [SecretsFromMap], [SecretsFromDotenv], [SecretsFromJSON],
+// or [SecretsFromFile].
+type SecretSource func() (map[string]string, error)
+
+// SecretsFromMap uses m as-is.
+func SecretsFromMap(m map[string]string) SecretSource {
+	return func() (map[string]string, error) { return m, nil }
+}
+
+// SecretsFromDotenv reads KEY=VALUE lines. Blank lines, # comments, and a
+// leading "export " are ignored. Double-quoted values honor \n, \t, \",
+// and \\ escapes; single-quoted values are literal; unquoted values end at
+// " #". Parse errors name the line number, never its content.
+func SecretsFromDotenv(r io.Reader) SecretSource {
+	return func() (map[string]string, error) { return parseDotenv(r) }
+}
+
+// SecretsFromJSON reads a JSON object. String values are used as-is; any
+// other value is stored as its JSON encoding.
+func SecretsFromJSON(r io.Reader) SecretSource {
+	return func() (map[string]string, error) {
+		var raw map[string]json.RawMessage
+		if err := json.NewDecoder(r).Decode(&raw); err != nil {
+			// The decoder's message can quote input; keep only its kind.
+			var syn *json.SyntaxError
+			if errors.As(err, &syn) {
+				return nil, fmt.Errorf("read JSON secrets: invalid JSON at offset %d", syn.Offset)
+			}
+			return nil, errors.New("read JSON secrets: want an object of name to value")
+		}
+		m := make(map[string]string, len(raw))
+		for k, v := range raw {
+			var str string
+			if json.Unmarshal(v, &str) == nil {
+				m[k] = str
+				continue
+			}
+			m[k] = string(v)
+		}
+		return m, nil
+	}
+}
+
+// SecretsFromFile reads path as JSON when it ends in .json and as dotenv
+// otherwise.
+func SecretsFromFile(path string) SecretSource {
+	return func() (map[string]string, error) {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		if strings.EqualFold(filepath.Ext(path), ".json") {
+			return SecretsFromJSON(f)()
+		}
+		return SecretsFromDotenv(f)()
+	}
+}
+
+// SecretChange is what [Service.SyncSecrets] did, or would do, to one
+// secret.
+type SecretChange string
+
+const (
+	// SecretAdded: the source has a value and none was stored.
+	SecretAdded SecretChange = "added"
+	// SecretUpdated: the stored fingerprint differs from the source value.
+	SecretUpdated SecretChange = "updated"
+	// SecretUnchanged: the stored fingerprint matches; nothing is sent.
+	SecretUnchanged SecretChange = "unchanged"
+	// SecretRemoved: stored but absent from the source, with
+	// [SyncSecretsOptions].Prune set.
+	SecretRemoved SecretChange = "removed"
+	// SecretUndeclared: in the source but not declared by the bundle.
+	// Skipped — the bundle has nowhere to put it.
+	SecretUndeclared SecretChange = "undeclared"
+	// SecretMissing: required by the bundle, absent from the source, and
+	// not stored. The next deployment will fail until it is set.
+	SecretMissing SecretChange = "missing"
+)
+
+// SecretDiff is one line of a [SecretSyncReport]. It never carries a value.
+type SecretDiff struct {
+	Name     string
+	Change   SecretChange
+	Required bool
+	// Err is the failure setting or removing this secret, if any.
+	Err error
+}
+
+// SecretSyncReport is the outcome of [Service.SyncSecrets], one entry per
+// secret, sorted by name.
+type SecretSyncReport struct {
+	InstanceID string
+	DryRun     bool
+	Diffs      []SecretDiff
+}
+
+// Changed reports whether the sync set or removed anything (or, on a dry
+// run, would have).
+func (r *SecretSyncReport) Changed() bool {
+	return slices.ContainsFunc(r.Diffs, func(d SecretDiff) bool {
+		return d.Change == SecretAdded || d.Change == SecretUpdated || d.Change == SecretRemoved
+	})
+}
+
+// String renders the report as a diff: "+" added, "~" updated, "-"
+// removed, "?" undeclared, "!" missing. Unchanged secrets are omitted.
+// Only names appear — values are never printed.
+func (r *SecretSyncReport) String() string {
+	var sb strings.Builder
+	for _, d := range r.Diffs {
+		var mark string
+		switch d.Change {
+		case SecretAdded:
+			mark = "+"
+		case SecretUpdated:
+			mark = "~"
+		case SecretRemoved:
+			mark = "-"
+		case SecretUndeclared:
+			mark = "?"
+		case SecretMissing:
+			mark = "!"
+		case SecretUnchanged:
+			continue
+		}
+		fmt.Fprintf(&sb, "%s %s", mark, d.Name)
+		if d.Err != nil {
+			fmt.Fprintf(&sb, " (failed: %v)", d.Err)
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// SyncSecretsOptions controls [Service.SyncSecrets].
+type SyncSecretsOptions struct {
+	// Prune removes stored secrets the source doesn't mention.
+	Prune bool
+	// DryRun computes the report without changing anything.
+	DryRun bool
+}
+
+// SyncSecrets makes an instance's stored secrets match source. Each
+// declared secret's stored SHA-256 fingerprint is compared with the source
+// value's, so unchanged values are never re-sent; changed and new ones are
+// set, and with opts.Prune stored ones absent from the source are removed.
+// Source names the bundle doesn't declare are reported and skipped.
+//
+// Every secret is attempted even if one fails; the report records each
+// outcome and the returned error joins the failures. Values never appear
+// in the report or in errors.
+func (s *Service) SyncSecrets(ctx context.Context, instanceID string, source SecretSource, opts SyncSecretsOptions) (*SecretSyncReport, error) {
+	desired, err := source()
+	if err != nil {
+		return nil, fmt.Errorf("sync instance %s secrets: %w", instanceID, err)
+	}
+	fields, err := s.SecretFields(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SecretSyncReport{InstanceID: instanceID, DryRun: opts.DryRun}
+	declared := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		declared[f.Name] = true
+		d := SecretDiff{Name: f.Name, Required: f.Required}
+		value, ok := desired[f.Name]
+		switch {
+		case ok && f.SHA256 == "":
+			d.Change = SecretAdded
+		case ok && !fingerprint.Matches(f.SHA256, value):
+			d.Change = SecretUpdated
+		case ok:
+			d.Change = SecretUnchanged
+		case f.SHA256 != "" && opts.Prune:
+			d.Change = SecretRemoved
+		case f.SHA256 == "" && f.Required:
+			d.Change = SecretMissing
+		default:
+			continue
+		}
+		report.Diffs = append(report.Diffs, d)
+	}
+	for name := range desired {
+		if !declared[name] {
+			report.Diffs = append(report.Diffs, SecretDiff{Name: name, Change: SecretUndeclared})
+		}
+	}
+	slices.SortFunc(report.Diffs, func(a, b SecretDiff) int { return strings.Compare(a.Name, b.Name) })
+
+	if opts.DryRun {
+		return report, nil
+	}
+	var errs []error
+	for i := range report.Diffs {
+		d := &report.Diffs[i]
+		switch d.Change {
+		case SecretAdded, SecretUpdated:
+			_, d.Err = s.SetSecret(ctx, instanceID, d.Name, desired[d.Name])
+		case SecretRemoved:
+			_, d.Err = s.RemoveSecret(ctx, instanceID, d.Name)
+		case SecretUnchanged, SecretUndeclared, SecretMissing:
+		}
+		if d.Err != nil {
+			errs = append(errs, d.Err)
+		}
+	}
+	return report, errors.Join(errs...)
+}
+
+func parseDotenv(r io.Reader) (map[string]string, error) {
+	m := map[string]string{}
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		key, value, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" || strings.ContainsAny(key, " \t") {
+			return nil, fmt.Errorf("read dotenv secrets: line %d: want KEY=VALUE", n)
+		}
+		value = strings.TrimSpace(value)
+		switch {
+		case len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"':
+			unq, err := strconv.Unquote(value)
+			if err != nil {
+				return nil, fmt.Errorf("read dotenv secrets: line %d: bad double-quoted value", n)
+			}
+			value = unq
+		case len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'':
+			value = value[1 : len(value)-1]
+		default:
+			if i := strings.Index(value, " #"); i >= 0 {
+				value = strings.TrimSpace(value[:i])
+			}
+		}
+		m[key] = value
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read dotenv secrets: %w", err)
+	}
+	return m, nil
+}
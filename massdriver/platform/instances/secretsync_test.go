@@ -0,0 +1,124 @@
+package instances_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/fingerprint"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/instances"
+)
+
+func secretFields() gqltest.Response {
+	return gqltest.RespondWithData(map[string]any{
+		"instance": map[string]any{
+			"id": "ecomm-prod-api",
+			"secretFields": []map[string]any{
+				{"name": "API_KEY", "required": true, "sha256": fingerprint.Of("same")},
+				{"name": "DB_PASSWORD", "required": true, "sha256": fingerprint.Of("old")},
+				{"name": "LICENSE", "required": true},
+				{"name": "SENTRY_DSN", "required": false},
+				{"name": "WEBHOOK_TOKEN", "required": false, "sha256": fingerprint.Of("gone")},
+			},
+		},
+	})
+}
+
+func setSecretOK(name string) gqltest.Response {
+	return gqltest.RespondWithData(map[string]any{
+		"setInstanceSecret": map[string]any{"result": map[string]any{"name": name}, "successful": true},
+	})
+}
+
+func TestSyncSecrets(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		secretFields(),
+		setSecretOK("DB_PASSWORD"),
+		setSecretOK("SENTRY_DSN"),
+		gqltest.RespondWithData(map[string]any{
+			"removeInstanceSecret": map[string]any{"result": map[string]any{"name": "WEBHOOK_TOKEN"}, "successful": true},
+		}),
+	)
+
+	dotenv := `# prod secrets
+export API_KEY=same
+DB_PASSWORD="new\tvalue"
+SENTRY_DSN='https://k@sentry.example.com/1'
+EXTRA=unused # not declared
+`
+	report, err := newService(gqlClient).SyncSecrets(t.Context(), "ecomm-prod-api",
+		instances.SecretsFromDotenv(strings.NewReader(dotenv)),
+		instances.SyncSecretsOptions{Prune: true})
+	if err != nil {
+		t.Fatalf("SyncSecrets: %v", err)
+	}
+
+	want := "~ DB_PASSWORD\n? EXTRA\n! LICENSE\n+ SENTRY_DSN\n- WEBHOOK_TOKEN\n"
+	if got := report.String(); got != want {
+		t.Errorf("report =\n%s\nwant\n%s", got, want)
+	}
+	if !report.Changed() {
+		t.Error("Changed() = false, want true")
+	}
+
+	reqs := gqlClient.Requests()
+	if len(reqs) != 4 {
+		t.Fatalf("made %d requests, want fields + 2 sets + 1 remove", len(reqs))
+	}
+	input, _ := reqs[1].Variables["input"].(map[string]any)
+	if input["name"] != "DB_PASSWORD" || input["value"] != "new\tvalue" {
+		t.Errorf("first set = %v", input)
+	}
+	if reqs[3].Variables["name"] != "WEBHOOK_TOKEN" {
+		t.Errorf("remove name = %v, want WEBHOOK_TOKEN", reqs[3].Variables["name"])
+	}
+}
+
+func TestSyncSecrets_DryRunFromJSON(t *testing.T) {
+	gqlClient := gqltest.NewClient(secretFields())
+
+	report, err := newService(gqlClient).SyncSecrets(t.Context(), "ecomm-prod-api",
+		instances.SecretsFromJSON(strings.NewReader(`{"API_KEY":"changed","LICENSE":42}`)),
+		instances.SyncSecretsOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("SyncSecrets: %v", err)
+	}
+	if want := "~ API_KEY\n+ LICENSE\n"; report.String() != want {
+		t.Errorf("report =\n%s\nwant\n%s", report, want)
+	}
+	if n := len(gqlClient.Requests()); n != 1 {
+		t.Errorf("made %d requests on a dry run, want 1", n)
+	}
+}
+
+func TestSyncSecrets_FailureNeverLeaksValue(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		secretFields(),
+		gqltest.RespondWithData(map[string]any{
+			"setInstanceSecret": map[string]any{
+				"successful": false,
+				"messages":   []map[string]any{{"field": "value", "message": "too long"}},
+			},
+		}),
+	)
+
+	report, err := newService(gqlClient).SyncSecrets(t.Context(), "ecomm-prod-api",
+		instances.SecretsFromMap(map[string]string{"DB_PASSWORD": "hunter2"}),
+		instances.SyncSecretsOptions{})
+	if err == nil {
+		t.Fatal("SyncSecrets succeeded, want the set failure")
+	}
+	if strings.Contains(err.Error(), "hunter2") || strings.Contains(report.String(), "hunter2") {
+		t.Errorf("secret value leaked: %v / %s", err, report)
+	}
+	if report.Diffs[0].Err == nil {
+		t.Errorf("DB_PASSWORD diff has no Err")
+	}
+}
+
+func TestSecretsFromDotenv_ErrorOmitsLine(t *testing.T) {
+	_, err := instances.SecretsFromDotenv(strings.NewReader("OK=1\nsupersecretvalue\n"))()
+	if err == nil || strings.Contains(err.Error(), "supersecret") || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("err = %v, want a line-2 error without the content", err)
+	}
+}
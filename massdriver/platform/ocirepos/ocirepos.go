@@ -154,8 +154,8 @@ func (s *Service) Get(ctx context.Context, id string) (*OciRepo, error) {
 // on a failed page fetch, after which iteration stops.
 //
 // To buffer every match into a slice, wrap with [types.Collect].
-func (s *Service) Iter(ctx context.Context, input ListInput) iter.Seq2[OciRepo, error] {
-	return paging.Iter(ctx, input.After, s.page(input))
+func (s *Service) Iter(ctx context.Context, input ListInput, opts ...paging.Option) iter.Seq2[OciRepo, error] {
+	return paging.Iter(ctx, input.After, s.page(input), opts...)
 }
 
 // ListPage returns a single page of repositories matching input. input.PageSize
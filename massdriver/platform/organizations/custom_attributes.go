@@ -3,10 +3,13 @@ package organizations
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/scalars"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/decode"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/gen"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/paging"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
 )
 
@@ -31,6 +34,39 @@ const (
 	AttributeScopeRepo AttributeScope = "REPO"
 )
 
+// CustomAttributeSortField is the field a [Service.IterCustomAttributes]
+// result can be ordered by.
+type CustomAttributeSortField string
+
+const (
+	CustomAttributeSortByKey       CustomAttributeSortField = "KEY"
+	CustomAttributeSortByScope     CustomAttributeSortField = "SCOPE"
+	CustomAttributeSortByCreatedAt CustomAttributeSortField = "CREATED_AT"
+)
+
+// SortOrder is the direction of a sort.
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "ASC"
+	SortDesc SortOrder = "DESC"
+)
+
+// ListCustomAttributesInput controls a
+// [Service.IterCustomAttributes]/[Service.ListCustomAttributesPage] call.
+// The zero value lists every custom attribute, sorted by key ascending.
+type ListCustomAttributesInput struct {
+	// SortBy controls the sort field. Empty = KEY.
+	SortBy CustomAttributeSortField
+	// SortOrder controls sort direction. Empty = ASC.
+	SortOrder SortOrder
+
+	PageSize int
+	// After is the opaque cursor from a prior [types.Page].Next, selecting
+	// which page to start from. Empty starts at the first page.
+	After string
+}
+
 // CreateCustomAttributeInput is the input for [Service.CreateCustomAttribute].
 type CreateCustomAttributeInput struct {
 	// Key is 1-64 characters, identifier-like (starts with letter/underscore;
@@ -55,6 +91,70 @@ type UpdateCustomAttributeInput struct {
 	Values   []string
 }
 
+// IterCustomAttributes returns a lazy [iter.Seq2] over the organization's
+// custom attributes, fetching pages on demand. The yielded error is non-nil
+// exactly once, on a failed page fetch, after which iteration stops.
+// Listing requires the organization:manageCustomAttributes action; callers
+// without it get [gql.ErrForbidden] (wrapped).
+//
+// To buffer every attribute into a slice, wrap with [types.Collect].
+func (s *Service) IterCustomAttributes(ctx context.Context, input ListCustomAttributesInput, opts ...paging.Option) iter.Seq2[CustomAttribute, error] {
+	return paging.Iter(ctx, input.After, s.customAttributesPage(input), opts...)
+}
+
+// ListCustomAttributesPage returns a single page of custom attributes.
+// input.PageSize bounds the page and input.After (an opaque cursor from a
+// prior page's Next) selects which page.
+func (s *Service) ListCustomAttributesPage(ctx context.Context, input ListCustomAttributesInput) (types.Page[CustomAttribute], error) {
+	return s.customAttributesPage(input)(ctx, input.After)
+}
+
+// customAttributesPage builds the single-page fetcher shared by
+// IterCustomAttributes and ListCustomAttributesPage.
+func (s *Service) customAttributesPage(input ListCustomAttributesInput) paging.FetchFunc[CustomAttribute] {
+	sort := buildCustomAttributesSort(input)
+	limit := input.PageSize
+	return func(ctx context.Context, after string) (types.Page[CustomAttribute], error) {
+		resp, err := gen.ListCustomAttributes(ctx, s.client.GQLv2, s.client.Config.OrganizationID, sort, scalars.NewCursor(limit, after))
+		if err != nil {
+			return types.Page[CustomAttribute]{}, gql.ClassifyError(fmt.Errorf("list custom attributes: %w", err))
+		}
+		page := resp.Organization.CustomAttributes
+		items := make([]CustomAttribute, 0, len(page.Items))
+		for _, item := range page.Items {
+			a, aerr := toCustomAttribute(item)
+			if aerr != nil {
+				return types.Page[CustomAttribute]{}, aerr
+			}
+			items = append(items, *a)
+		}
+		return types.Page[CustomAttribute]{
+			Items:    items,
+			Next:     page.Cursor.Next,
+			Previous: page.Cursor.Previous,
+		}, nil
+	}
+}
+
+func buildCustomAttributesSort(input ListCustomAttributesInput) *gen.CustomAttributesSort {
+	if input.SortBy == "" && input.SortOrder == "" {
+		return nil
+	}
+	field := gen.CustomAttributesSortFieldKey
+	switch input.SortBy {
+	case CustomAttributeSortByScope:
+		field = gen.CustomAttributesSortFieldScope
+	case CustomAttributeSortByCreatedAt:
+		field = gen.CustomAttributesSortFieldCreatedAt
+	case CustomAttributeSortByKey:
+	}
+	order := gen.SortOrderAsc
+	if input.SortOrder == SortDesc {
+		order = gen.SortOrderDesc
+	}
+	return &gen.CustomAttributesSort{Field: field, Order: order}
+}
+
 // CreateCustomAttribute declares a new custom attribute for the
 // organization. Once declared, the attribute applies immediately to new
 // resources at its scope; existing resources are not retroactively
@@ -9,6 +9,7 @@ import (
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/client"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/organizations"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
 )
 
 func newService(gqlClient *gqltest.Client) *organizations.Service {
@@ -170,3 +171,37 @@ func TestDeleteCustomAttribute(t *testing.T) {
 		t.Errorf("Key = %q, want TEAM", got.Key)
 	}
 }
+
+func TestIterCustomAttributes(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		gqltest.RespondWithData(map[string]any{
+			"organization": map[string]any{"id": "ecomm-corp", "customAttributes": map[string]any{
+				"cursor": map[string]any{"next": "page-2"},
+				"items": []map[string]any{
+					{"id": "attr-1", "key": "TEAM", "scope": "PROJECT", "required": true, "values": []string{"data", "platform"}},
+				},
+			}},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"organization": map[string]any{"id": "ecomm-corp", "customAttributes": map[string]any{
+				"cursor": map[string]any{},
+				"items": []map[string]any{
+					{"id": "attr-2", "key": "TIER", "scope": "ENVIRONMENT", "values": []string{"1", "2"}},
+				},
+			}},
+		}),
+	)
+
+	got, err := types.Collect(newService(gqlClient).IterCustomAttributes(t.Context(), organizations.ListCustomAttributesInput{
+		SortBy: organizations.CustomAttributeSortByScope,
+	}))
+	if err != nil {
+		t.Fatalf("IterCustomAttributes: %v", err)
+	}
+	if len(got) != 2 || got[0].Key != "TEAM" || !got[0].Required || got[1].Scope != "ENVIRONMENT" || len(got[1].Values) != 2 {
+		t.Errorf("attributes = %+v", got)
+	}
+	if sort, _ := gqlClient.Requests()[0].Variables["sort"].(map[string]any); sort["field"] != "SCOPE" || sort["order"] != "ASC" {
+		t.Errorf("sort = %v, want SCOPE ASC", gqlClient.Requests()[0].Variables["sort"])
+	}
+}
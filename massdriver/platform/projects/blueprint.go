@@ -0,0 +1,387 @@
+package projects
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/jsonutil"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/components"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// Blueprint is a project's components and links in the portable form
+// [Service.ExportBlueprint] writes and [Service.ApplyBlueprint] reads.
+// Component IDs are local — "database", not "ecomm-database" — so one
+// file can seed several projects.
+//
+//	components:
+//	  - id: database
+//	    name: Primary Database
+//	    ociRepo: aws-aurora-postgres
+//	    attributes: {team: data}
+//	    position: {x: 0, y: 120}
+//	  - id: app
+//	    name: Storefront
+//	    ociRepo: aws-ecs-service
+//	links:
+//	  - from: database
+//	    fromField: authentication
+//	    to: app
+//	    toField: database
+type Blueprint struct {
+	Components []BlueprintComponent `yaml:"components"`
+	Links      []BlueprintLink      `yaml:"links,omitempty"`
+}
+
+// BlueprintComponent is one component of a [Blueprint].
+type BlueprintComponent struct {
+	// ID is the component's local ID, the last segment of its full ID.
+	ID          string `yaml:"id"`
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+	// OciRepo names the bundle repository. It can't change once the
+	// component exists.
+	OciRepo    string          `yaml:"ociRepo"`
+	Attributes map[string]any  `yaml:"attributes,omitempty"`
+	Position   *types.Position `yaml:"position,omitempty"`
+}
+
+// BlueprintLink is one link of a [Blueprint], between local component IDs.
+type BlueprintLink struct {
+	From      string `yaml:"from"`
+	FromField string `yaml:"fromField"`
+	To        string `yaml:"to"`
+	ToField   string `yaml:"toField"`
+	// FromVersion and ToVersion are the version constraints sent when the
+	// link is created. Empty = "latest". The API doesn't report them back,
+	// so they are never exported and never cause an update.
+	FromVersion string `yaml:"fromVersion,omitempty"`
+	ToVersion   string `yaml:"toVersion,omitempty"`
+}
+
+// key identifies a link independent of its server-side ID.
+func (l BlueprintLink) key() string {
+	return l.From + "." + l.FromField + " -> " + l.To + "." + l.ToField
+}
+
+// ParseBlueprint reads a YAML (or JSON) blueprint. Unknown keys are an
+// error.
+func ParseBlueprint(r io.Reader) (*Blueprint, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	var bp Blueprint
+	if err := dec.Decode(&bp); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("parse blueprint: %w", err)
+	}
+	seen := map[string]bool{}
+	for _, c := range bp.Components {
+		if c.ID == "" || c.OciRepo == "" {
+			return nil, fmt.Errorf("parse blueprint: component %q needs an id and an ociRepo", c.ID)
+		}
+		if seen[c.ID] {
+			return nil, fmt.Errorf("parse blueprint: component %q appears twice", c.ID)
+		}
+		seen[c.ID] = true
+	}
+	return &bp, nil
+}
+
+// Blueprint returns the project's blueprint, components and links sorted
+// by ID so the output is stable.
+func (s *Service) Blueprint(ctx context.Context, id string) (*Blueprint, error) {
+	p, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	bp := &Blueprint{}
+	for _, c := range p.Components {
+		bc := BlueprintComponent{
+			ID:          localID(p.ID, c.ID),
+			Name:        c.Name,
+			Description: c.Description,
+			Attributes:  c.Attributes,
+			Position:    c.Position,
+		}
+		if c.OciRepo != nil {
+			bc.OciRepo = c.OciRepo.Name
+		}
+		if len(bc.Attributes) == 0 {
+			bc.Attributes = nil
+		}
+		bp.Components = append(bp.Components, bc)
+	}
+	for _, l := range p.Links {
+		if l.FromComponent == nil || l.ToComponent == nil {
+			continue
+		}
+		bp.Links = append(bp.Links, BlueprintLink{
+			From:      localID(p.ID, l.FromComponent.ID),
+			FromField: l.FromField,
+			To:        localID(p.ID, l.ToComponent.ID),
+			ToField:   l.ToField,
+		})
+	}
+	slices.SortFunc(bp.Components, func(a, b BlueprintComponent) int { return strings.Compare(a.ID, b.ID) })
+	slices.SortFunc(bp.Links, func(a, b BlueprintLink) int { return strings.Compare(a.key(), b.key()) })
+	return bp, nil
+}
+
+// ExportBlueprint returns the project's [Blueprint] as YAML, ready to
+// commit. Exporting the same blueprint twice gives identical bytes.
+func (s *Service) ExportBlueprint(ctx context.Context, id string) ([]byte, error) {
+	bp, err := s.Blueprint(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(bp); err != nil {
+		return nil, fmt.Errorf("export blueprint %s: %w", id, err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("export blueprint %s: %w", id, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// ApplyBlueprintOptions controls [Service.ApplyBlueprint].
+type ApplyBlueprintOptions struct {
+	// Prune removes components and links the blueprint doesn't list.
+	// Without it they are left alone. Removing a component removes its
+	// links and fails while it still has deployed instances.
+	Prune bool
+	// DryRun computes the changes without making them.
+	DryRun bool
+}
+
+// BlueprintOp is what [Service.ApplyBlueprint] does to one component or
+// link.
+type BlueprintOp string
+
+const (
+	BlueprintAdd    BlueprintOp = "add"
+	BlueprintUpdate BlueprintOp = "update"
+	BlueprintRemove BlueprintOp = "remove"
+)
+
+// BlueprintChange is one entry of a [BlueprintChanges].
+type BlueprintChange struct {
+	Op BlueprintOp
+	// Component is the local component ID; empty for a link.
+	Component string
+	// Link is "from.field -> to.field"; empty for a component.
+	Link string
+	// Fields lists what an update changes ("name", "attributes", …).
+	Fields []string
+}
+
+// BlueprintChanges is the result of [Service.ApplyBlueprint], in the order
+// the changes are made.
+type BlueprintChanges struct {
+	DryRun  bool
+	Changes []BlueprintChange
+}
+
+// String renders the changes one per line ("+ component app", "~
+// component database (attributes)", "- link a.x -> b.y") for review.
+func (c *BlueprintChanges) String() string {
+	if len(c.Changes) == 0 {
+		return "No changes.\n"
+	}
+	var sb strings.Builder
+	for _, ch := range c.Changes {
+		mark := map[BlueprintOp]string{BlueprintAdd: "+", BlueprintUpdate: "~", BlueprintRemove: "-"}[ch.Op]
+		if ch.Component != "" {
+			fmt.Fprintf(&sb, "%s component %s", mark, ch.Component)
+		} else {
+			fmt.Fprintf(&sb, "%s link %s", mark, ch.Link)
+		}
+		if len(ch.Fields) > 0 {
+			fmt.Fprintf(&sb, " (%s)", strings.Join(ch.Fields, ", "))
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// ApplyBlueprint reconciles the project's live blueprint to bp: missing
+// components are added, differing names, descriptions, and attributes are
+// updated, missing links are created, and with opts.Prune anything not in
+// bp is removed. Changes are made in dependency order — components, then
+// link removals, link additions, and component removals last.
+//
+// A component whose ociRepo differs from the live one is an error; the
+// bundle behind a component is immutable. A component without a position
+// keeps its live one.
+//
+// Apply stops at the first failed mutation and returns the changes made so
+// far alongside the error.
+func (s *Service) ApplyBlueprint(ctx context.Context, id string, bp *Blueprint, opts ApplyBlueprintOptions) (*BlueprintChanges, error) {
+	p, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	live := map[string]types.Component{}
+	for _, c := range p.Components {
+		live[localID(p.ID, c.ID)] = c
+	}
+	liveLinks := map[string]types.Link{}
+	for _, l := range p.Links {
+		if l.FromComponent == nil || l.ToComponent == nil {
+			continue
+		}
+		k := BlueprintLink{From: localID(p.ID, l.FromComponent.ID), FromField: l.FromField, To: localID(p.ID, l.ToComponent.ID), ToField: l.ToField}.key()
+		liveLinks[k] = l
+	}
+
+	type step struct {
+		change BlueprintChange
+		run    func() error
+	}
+	var adds, updates, unlinks, links, removes []step
+	comps := s.components
+
+	wanted := map[string]bool{}
+	for _, bc := range sortedComponents(bp.Components) {
+		wanted[bc.ID] = true
+		cur, ok := live[bc.ID]
+		if !ok {
+			adds = append(adds, step{BlueprintChange{Op: BlueprintAdd, Component: bc.ID}, func() error {
+				_, err := comps.Add(ctx, p.ID, components.AddInput{
+					OciRepoName: bc.OciRepo,
+					ID:          bc.ID,
+					Name:        bc.Name,
+					Description: bc.Description,
+					Attributes:  bc.Attributes,
+				})
+				if err != nil || bc.Position == nil {
+					return err
+				}
+				_, err = comps.SetPosition(ctx, p.ID+"-"+bc.ID, *bc.Position)
+				return err
+			}})
+			continue
+		}
+		if cur.OciRepo != nil && cur.OciRepo.Name != bc.OciRepo {
+			return nil, fmt.Errorf("apply blueprint %s: component %s: ociRepo is immutable (live %s, blueprint %s)", p.ID, bc.ID, cur.OciRepo.Name, bc.OciRepo)
+		}
+		var fields []string
+		if cur.Name != bc.Name {
+			fields = append(fields, "name")
+		}
+		if cur.Description != bc.Description {
+			fields = append(fields, "description")
+		}
+		if !jsonutil.Equal(cur.Attributes, bc.Attributes) {
+			fields = append(fields, "attributes")
+		}
+		moved := bc.Position != nil && (cur.Position == nil || *cur.Position != *bc.Position)
+		if len(fields) > 0 || moved {
+			edited := len(fields) > 0
+			if moved {
+				fields = append(fields, "position")
+			}
+			updates = append(updates, step{BlueprintChange{Op: BlueprintUpdate, Component: bc.ID, Fields: fields}, func() error {
+				if edited {
+					if _, err := comps.Update(ctx, cur.ID, components.UpdateInput{
+						Name:        bc.Name,
+						Description: bc.Description,
+						Attributes:  bc.Attributes,
+					}); err != nil {
+						return err
+					}
+				}
+				if !moved {
+					return nil
+				}
+				_, err := comps.SetPosition(ctx, cur.ID, *bc.Position)
+				return err
+			}})
+		}
+	}
+
+	wantedLinks := map[string]bool{}
+	for _, bl := range bp.Links {
+		k := bl.key()
+		wantedLinks[k] = true
+		if _, ok := liveLinks[k]; ok {
+			continue
+		}
+		links = append(links, step{BlueprintChange{Op: BlueprintAdd, Link: k}, func() error {
+			_, err := comps.AddLink(ctx, components.AddLinkInput{
+				FromComponentID: p.ID + "-" + bl.From,
+				FromField:       bl.FromField,
+				FromVersion:     cmp.Or(bl.FromVersion, "latest"),
+				ToComponentID:   p.ID + "-" + bl.To,
+				ToField:         bl.ToField,
+				ToVersion:       cmp.Or(bl.ToVersion, "latest"),
+			})
+			return err
+		}})
+	}
+
+	if opts.Prune {
+		for _, k := range sortedKeys(liveLinks) {
+			l := liveLinks[k]
+			from, to := localID(p.ID, l.FromComponent.ID), localID(p.ID, l.ToComponent.ID)
+			// Removing either end removes the link with it.
+			if wantedLinks[k] || !wanted[from] || !wanted[to] {
+				continue
+			}
+			unlinks = append(unlinks, step{BlueprintChange{Op: BlueprintRemove, Link: k}, func() error {
+				_, err := comps.RemoveLink(ctx, l.ID)
+				return err
+			}})
+		}
+		for _, local := range sortedKeys(live) {
+			if wanted[local] {
+				continue
+			}
+			full := live[local].ID
+			removes = append(removes, step{BlueprintChange{Op: BlueprintRemove, Component: local}, func() error {
+				_, err := comps.Remove(ctx, full)
+				return err
+			}})
+		}
+	}
+
+	out := &BlueprintChanges{DryRun: opts.DryRun}
+	for _, st := range slices.Concat(adds, updates, unlinks, links, removes) {
+		if !opts.DryRun {
+			if err := st.run(); err != nil {
+				return out, err
+			}
+		}
+		out.Changes = append(out.Changes, st.change)
+	}
+	return out, nil
+}
+
+// localID strips the project prefix from a full component ID.
+func localID(projectID, id string) string {
+	return strings.TrimPrefix(id, projectID+"-")
+}
+
+func sortedComponents(cs []BlueprintComponent) []BlueprintComponent {
+	out := slices.Clone(cs)
+	slices.SortFunc(out, func(a, b BlueprintComponent) int { return strings.Compare(a.ID, b.ID) })
+	return out
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,198 @@
+package projects_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/projects"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// blueprintProject is a live project with two linked components and a
+// cache the blueprint below doesn't mention.
+func blueprintProject() gqltest.Response {
+	return gqltest.RespondWithData(map[string]any{
+		"project": map[string]any{
+			"id": "ecomm",
+			"components": []map[string]any{
+				{"id": "ecomm-app", "name": "Storefront", "ociRepo": map[string]any{"name": "aws-ecs-service"}},
+				{
+					"id": "ecomm-database", "name": "Database", "attributes": map[string]any{"team": "data"},
+					"position": map[string]any{"x": 0, "y": 120},
+					"ociRepo":  map[string]any{"name": "aws-aurora-postgres"},
+				},
+				{"id": "ecomm-cache", "name": "Cache", "ociRepo": map[string]any{"name": "aws-elasticache"}},
+			},
+			"links": []map[string]any{
+				{
+					"id": "link-1", "fromField": "authentication", "toField": "database",
+					"fromComponent": map[string]any{"id": "ecomm-database"}, "toComponent": map[string]any{"id": "ecomm-app"},
+				},
+				{
+					"id": "link-2", "fromField": "authentication", "toField": "cache",
+					"fromComponent": map[string]any{"id": "ecomm-cache"}, "toComponent": map[string]any{"id": "ecomm-app"},
+				},
+			},
+		},
+	})
+}
+
+func TestExportBlueprint(t *testing.T) {
+	gqlClient := gqltest.NewClient(blueprintProject())
+
+	got, err := newService(gqlClient).ExportBlueprint(t.Context(), "ecomm")
+	if err != nil {
+		t.Fatalf("ExportBlueprint: %v", err)
+	}
+	want := `components:
+  - id: app
+    name: Storefront
+    ociRepo: aws-ecs-service
+  - id: cache
+    name: Cache
+    ociRepo: aws-elasticache
+  - id: database
+    name: Database
+    ociRepo: aws-aurora-postgres
+    attributes:
+      team: data
+    position:
+      x: 0
+      "y": 120
+links:
+  - from: cache
+    fromField: authentication
+    to: app
+    toField: cache
+  - from: database
+    fromField: authentication
+    to: app
+    toField: database
+`
+	if string(got) != want {
+		t.Errorf("blueprint =\n%s\nwant\n%s", got, want)
+	}
+
+	bp, err := projects.ParseBlueprint(strings.NewReader(string(got)))
+	if err != nil {
+		t.Fatalf("ParseBlueprint: %v", err)
+	}
+	if len(bp.Components) != 3 || bp.Components[2].Position == nil || bp.Components[2].Position.Y != 120 {
+		t.Errorf("round trip = %+v", bp.Components)
+	}
+}
+
+const blueprintYAML = `
+components:
+  - id: app
+    name: Storefront
+    ociRepo: aws-ecs-service
+  - id: database
+    name: Database
+    ociRepo: aws-aurora-postgres
+    attributes: {team: platform}
+  - id: queue
+    name: Queue
+    ociRepo: aws-sqs
+links:
+  - from: database
+    fromField: authentication
+    to: app
+    toField: database
+  - from: queue
+    fromField: queue
+    to: app
+    toField: queue
+    toVersion: "~2"
+`
+
+func TestApplyBlueprint(t *testing.T) {
+	bp, err := projects.ParseBlueprint(strings.NewReader(blueprintYAML))
+	if err != nil {
+		t.Fatalf("ParseBlueprint: %v", err)
+	}
+
+	gqlClient := gqltest.NewClient(
+		blueprintProject(),
+		gqltest.RespondWithData(map[string]any{"addComponent": map[string]any{"result": map[string]any{"id": "ecomm-queue"}, "successful": true}}),
+		gqltest.RespondWithData(map[string]any{"updateComponent": map[string]any{"result": map[string]any{"id": "ecomm-database"}, "successful": true}}),
+		gqltest.RespondWithData(map[string]any{"linkComponents": map[string]any{"result": map[string]any{"id": "link-3"}, "successful": true}}),
+		gqltest.RespondWithData(map[string]any{"removeComponent": map[string]any{"result": map[string]any{"id": "ecomm-cache"}, "successful": true}}),
+	)
+
+	changes, err := newService(gqlClient).ApplyBlueprint(t.Context(), "ecomm", bp, projects.ApplyBlueprintOptions{Prune: true})
+	if err != nil {
+		t.Fatalf("ApplyBlueprint: %v", err)
+	}
+	// link-2 goes with the cache, so it isn't unlinked on its own.
+	want := `+ component queue
+~ component database (attributes)
++ link queue.queue -> app.queue
+- component cache
+`
+	if got := changes.String(); got != want {
+		t.Errorf("changes =\n%s\nwant\n%s", got, want)
+	}
+
+	reqs := gqlClient.Requests()
+	if reqs[1].Variables["projectId"] != "ecomm" || reqs[1].Variables["ociRepoName"] != "aws-sqs" {
+		t.Errorf("addComponent variables = %v", reqs[1].Variables)
+	}
+	link, _ := reqs[3].Variables["input"].(map[string]any)
+	if link["fromComponentId"] != "ecomm-queue" || link["fromVersion"] != "latest" || link["toVersion"] != "~2" {
+		t.Errorf("linkComponents input = %v", link)
+	}
+	if reqs[4].Variables["id"] != "ecomm-cache" {
+		t.Errorf("removeComponent id = %v, want ecomm-cache", reqs[4].Variables["id"])
+	}
+	if gqlClient.Pending() != 0 {
+		t.Errorf("%d responses unused", gqlClient.Pending())
+	}
+}
+
+func TestApplyBlueprint_DryRunAndImmutableRepo(t *testing.T) {
+	bp, err := projects.ParseBlueprint(strings.NewReader(blueprintYAML))
+	if err != nil {
+		t.Fatalf("ParseBlueprint: %v", err)
+	}
+
+	gqlClient := gqltest.NewClient(blueprintProject())
+	changes, err := newService(gqlClient).ApplyBlueprint(t.Context(), "ecomm", bp, projects.ApplyBlueprintOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("ApplyBlueprint: %v", err)
+	}
+	if len(changes.Changes) != 3 || len(gqlClient.Requests()) != 1 {
+		t.Errorf("dry run = %d changes, %d requests; want 3 changes and only the read", len(changes.Changes), len(gqlClient.Requests()))
+	}
+
+	bp.Components[0].OciRepo = "aws-lambda"
+	_, err = newService(gqltest.NewClient(blueprintProject())).ApplyBlueprint(t.Context(), "ecomm", bp, projects.ApplyBlueprintOptions{})
+	if err == nil || !strings.Contains(err.Error(), "immutable") {
+		t.Errorf("err = %v, want an immutable ociRepo error", err)
+	}
+}
+
+func TestApplyBlueprint_Positions(t *testing.T) {
+	bp := &projects.Blueprint{Components: []projects.BlueprintComponent{
+		{ID: "app", Name: "Storefront", OciRepo: "aws-ecs-service", Position: &types.Position{X: 400}},
+		{ID: "cache", Name: "Cache", OciRepo: "aws-elasticache"},
+		{ID: "database", Name: "Database", OciRepo: "aws-aurora-postgres", Attributes: map[string]any{"team": "data"}, Position: &types.Position{Y: 120}},
+	}}
+	gqlClient := gqltest.NewClient(
+		blueprintProject(),
+		gqltest.RespondWithData(map[string]any{"setComponentPosition": map[string]any{"result": map[string]any{"id": "ecomm-app"}, "successful": true}}),
+	)
+
+	changes, err := newService(gqlClient).ApplyBlueprint(t.Context(), "ecomm", bp, projects.ApplyBlueprintOptions{})
+	if err != nil {
+		t.Fatalf("ApplyBlueprint: %v", err)
+	}
+	if got := changes.String(); got != "~ component app (position)\n" {
+		t.Errorf("changes = %q, want only app moved", got)
+	}
+	reqs := gqlClient.Requests()
+	if len(reqs) != 2 || reqs[1].Variables["id"] != "ecomm-app" {
+		t.Errorf("requests = %+v, want a setComponentPosition for ecomm-app", reqs)
+	}
+}
@@ -4,6 +4,9 @@
 // blueprint (the architecture) and one or more environments (the actual
 // deployments). See https://docs.massdriver.cloud for the platform model.
 //
+// [Service.ExportBlueprint] and [Service.ApplyBlueprint] move a blueprint in
+// and out of a portable YAML [Blueprint].
+//
 // Construct a [*Service] with [New] passing the low-level client, or use the
 // pre-wired [massdriver.Client.Projects] field on the top-level SDK client.
 package projects
@@ -19,6 +22,7 @@ import (
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/decode"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/gen"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/paging"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/components"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
 )
 
@@ -30,15 +34,19 @@ type Project = types.Project
 // Service is the receiver for project operations. Construct with [New];
 // for the typical case you'll use the [massdriver.Client.Projects] field.
 type Service struct {
-	client *client.Client
+	client     *client.Client
+	components *components.Service
 }
 
-// New returns a [*Service] bound to the given low-level client.
+// New returns a [*Service] bound to the given low-level client. comps
+// makes the component and link changes [Service.ApplyBlueprint] plans.
 //
 // Most callers should use [massdriver.New] instead, which constructs the
 // low-level client and pre-wires every service. Use [New] only when you
 // need a single service in isolation or for tests with a custom client.
-func New(c *client.Client) *Service { return &Service{client: c} }
+func New(c *client.Client, comps *components.Service) *Service {
+	return &Service{client: c, components: comps}
+}
 
 // SortField is the field a [Service.Iter]/[Service.ListPage] result can be
 // ordered by.
@@ -127,8 +135,8 @@ func (s *Service) Get(ctx context.Context, id string) (*Project, error) {
 //
 // Each yielded [Project] has its Environments slice populated. To buffer every
 // match into a slice, wrap with [types.Collect].
-func (s *Service) Iter(ctx context.Context, input ListInput) iter.Seq2[Project, error] {
-	return paging.Iter(ctx, input.After, s.page(input))
+func (s *Service) Iter(ctx context.Context, input ListInput, opts ...paging.Option) iter.Seq2[Project, error] {
+	return paging.Iter(ctx, input.After, s.page(input), opts...)
 }
 
 // ListPage returns a single page of projects matching input. input.PageSize
@@ -9,6 +9,9 @@ import (
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/client"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/bundles"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/components"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/instances"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/projects"
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
 )
@@ -17,10 +20,11 @@ import (
 // preconfigured with an organization ID so the wrapper has something to
 // substitute into request variables.
 func newService(gqlClient *gqltest.Client) *projects.Service {
-	return projects.New(&client.Client{
+	c := &client.Client{
 		Config: config.Config{OrganizationID: "my-org"},
 		GQLv2:  gqlClient,
-	})
+	}
+	return projects.New(c, components.New(c, bundles.New(c), instances.New(c)))
 }
 
 func TestGet(t *testing.T) {
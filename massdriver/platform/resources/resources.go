@@ -157,8 +157,8 @@ func (s *Service) Get(ctx context.Context, id string) (*Resource, error) {
 //
 // Returned [Resource]s exclude the payload — call [Service.Get] for the full
 // record. To buffer every match into a slice, wrap with [types.Collect].
-func (s *Service) Iter(ctx context.Context, input ListInput) iter.Seq2[Resource, error] {
-	return paging.Iter(ctx, input.After, s.page(input))
+func (s *Service) Iter(ctx context.Context, input ListInput, opts ...paging.Option) iter.Seq2[Resource, error] {
+	return paging.Iter(ctx, input.After, s.page(input), opts...)
 }
 
 // ListPage returns a single page of resources matching input. input.PageSize
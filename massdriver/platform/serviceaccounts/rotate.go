@@ -0,0 +1,57 @@
+package serviceaccounts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/accesstokens"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/viewer"
+)
+
+// RotateKeyInput is the input for [Service.RotateKey].
+type RotateKeyInput struct {
+	// Key is the service account's current raw access token — the
+	// [Created.DefaultToken] or one minted since. Required: the API only
+	// issues and revokes a service account's tokens for the service
+	// account itself, so rotation authenticates with this key.
+	Key string
+	// Rotate configures the rotation as in [accesstokens.Service.Rotate].
+	// Its Sink is required; a nil Verify checks the replacement with
+	// viewer.Service.Get.
+	Rotate accesstokens.RotateInput
+}
+
+// RotateKey replaces service account id's key with a fresh one:
+// authenticated as the service account with input.Key, it confirms the
+// key belongs to id, finds the key's token by prefix, and runs
+// [accesstokens.Service.Rotate] on it — create, store, verify, revoke,
+// with the same rollback on failure.
+//
+// The caller's own credentials are not used, and no other token the
+// service account holds is touched.
+func (s *Service) RotateKey(ctx context.Context, id string, input RotateKeyInput) (*accesstokens.Rotated, error) {
+	if input.Key == "" {
+		return nil, fmt.Errorf("rotate service account %s key: the current key is required", id)
+	}
+	as := s.client.WithBearer(input.Key)
+
+	who, err := viewer.New(as).Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("rotate service account %s key: authenticate with key: %w", id, err)
+	}
+	if who.Kind != viewer.KindServiceAccount || who.ID != id {
+		return nil, fmt.Errorf("rotate service account %s key: key belongs to %s %s", id, who.Kind, who.ID)
+	}
+
+	tokens := accesstokens.New(as)
+	for t, err := range tokens.Iter(ctx, accesstokens.ListInput{Status: accesstokens.StatusActive}) {
+		if err != nil {
+			return nil, fmt.Errorf("rotate service account %s key: %w", id, err)
+		}
+		if t.Prefix != "" && strings.HasPrefix(input.Key, t.Prefix) {
+			return tokens.Rotate(ctx, t.ID, input.Rotate)
+		}
+	}
+	return nil, fmt.Errorf("rotate service account %s key: no active token matches the key", id)
+}
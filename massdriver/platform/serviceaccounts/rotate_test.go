@@ -0,0 +1,109 @@
+package serviceaccounts_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/config"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/client"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/accesstokens"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/serviceaccounts"
+)
+
+// TestRotateKey runs a key rotation against a local stand-in for the API
+// and confirms every step authenticates as the service account, not as
+// the caller.
+func TestRotateKey(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		seen []string // "operation auth" per request
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			OperationName string         `json:"operationName"`
+			Variables     map[string]any `json:"variables"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		mu.Lock()
+		seen = append(seen, req.OperationName+" "+r.Header.Get("Authorization"))
+		mu.Unlock()
+		var data map[string]any
+		switch req.OperationName {
+		case "GetViewer":
+			data = map[string]any{"viewer": map[string]any{"__typename": "ServiceAccountViewer", "id": "sa-1", "name": "ci-bot"}}
+		case "ListAccessTokens":
+			data = map[string]any{"accessTokens": map[string]any{"cursor": map[string]any{}, "items": []map[string]any{
+				{"id": "t-other", "name": "other", "prefix": "md_oth", "scopes": []string{"*"}},
+				{"id": "t-old", "name": "default", "prefix": "md_old", "scopes": []string{"*"}, "createdAt": "2026-01-01T00:00:00Z", "expiresAt": "2027-01-01T00:00:00Z"},
+			}}}
+		case "CreateAccessToken":
+			data = map[string]any{"createAccessToken": map[string]any{"successful": true, "result": map[string]any{
+				"id": "t-new", "name": "default", "token": "md_new_VALUE", "prefix": "md_new", "scopes": []string{"*"},
+			}}}
+		case "RevokeAccessToken":
+			data = map[string]any{"revokeAccessToken": map[string]any{"successful": true, "result": map[string]any{
+				"id": req.Variables["id"], "name": "default", "prefix": "md_old", "revokedAt": "2026-05-08T11:00:00Z",
+			}}}
+		default:
+			http.Error(w, "unexpected op "+req.OperationName, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+	t.Cleanup(srv.Close)
+	requests := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		out := seen
+		seen = nil
+		return out
+	}
+
+	caller := client.NewWithConfig(config.Config{
+		URL:            srv.URL,
+		OrganizationID: "my-org",
+		Credentials:    config.Credentials{Method: config.AuthPAT, AuthHeaderValue: "Bearer md_caller"},
+	}, client.DefaultTimeout)
+
+	var stored string
+	got, err := serviceaccounts.New(caller).RotateKey(t.Context(), "sa-1", serviceaccounts.RotateKeyInput{
+		Key: "md_old_VALUE",
+		Rotate: accesstokens.RotateInput{
+			Sink: accesstokens.SinkFunc(func(_ context.Context, tok *accesstokens.Created) error {
+				stored = tok.Token
+				return nil
+			}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("RotateKey: %v", err)
+	}
+	if got.New.ID != "t-new" || got.Old.ID != "t-old" || stored != "md_new_VALUE" {
+		t.Errorf("Rotated = %+v, stored %q; want t-old replaced by t-new", got, stored)
+	}
+
+	want := []string{
+		"GetViewer Bearer md_old_VALUE",
+		"ListAccessTokens Bearer md_old_VALUE",
+		"ListAccessTokens Bearer md_old_VALUE",
+		"CreateAccessToken Bearer md_old_VALUE",
+		"GetViewer Bearer md_new_VALUE",
+		"RevokeAccessToken Bearer md_old_VALUE",
+	}
+	if got := requests(); !slices.Equal(got, want) {
+		t.Errorf("requests = %q, want %q", got, want)
+	}
+
+	// A key that authenticates as someone else is refused before anything
+	// is created.
+	_, err = serviceaccounts.New(caller).RotateKey(t.Context(), "sa-2", serviceaccounts.RotateKeyInput{Key: "md_old_VALUE"})
+	if got := requests(); err == nil || len(got) != 1 {
+		t.Errorf("err = %v after %q, want a refusal after the viewer check", err, got)
+	}
+}
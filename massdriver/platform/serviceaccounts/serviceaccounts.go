@@ -6,7 +6,8 @@
 // when one is created, the server issues a default access token alongside
 // it (returned exactly once via [Created.DefaultToken]). Subsequent
 // tokens for the same service account are issued via the accesstokens
-// package after authenticating as that service account.
+// package after authenticating as that service account;
+// [Service.RotateKey] does exactly that to swap a key for a fresh one.
 //
 // Service accounts gain permissions by being added to groups. The
 // group-membership operations live in platform/groups
@@ -138,8 +139,8 @@ func (s *Service) Get(ctx context.Context, id string) (*ServiceAccount, error) {
 // exactly once, on a failed page fetch, after which iteration stops.
 //
 // To buffer every match into a slice, wrap with [types.Collect].
-func (s *Service) Iter(ctx context.Context, input ListInput) iter.Seq2[ServiceAccount, error] {
-	return paging.Iter(ctx, input.After, s.page(input))
+func (s *Service) Iter(ctx context.Context, input ListInput, opts ...paging.Option) iter.Seq2[ServiceAccount, error] {
+	return paging.Iter(ctx, input.After, s.page(input), opts...)
 }
 
 // ListPage returns a single page of service accounts matching input.
@@ -0,0 +1,53 @@
+package types
+
+// DeploymentComparison is a side-by-side diff of two deployments' bundle
+// version and snapshotted params, as returned by the
+// `compareDeployments` query. Runtime state, logs, and produced artifacts
+// are out of scope.
+type DeploymentComparison struct {
+	Source  Deployment        `json:"source" mapstructure:"source"`
+	Target  Deployment        `json:"target" mapstructure:"target"`
+	Version VersionComparison `json:"version" mapstructure:"version"`
+	// Params is a flat, leaf-level diff: maps and arrays are walked to the
+	// bottom, one entry per leaf path on either side.
+	Params []ParamComparison `json:"params,omitempty" mapstructure:"params"`
+}
+
+// Changed returns the param leaves that differ between the two sides.
+func (c DeploymentComparison) Changed() []ParamComparison {
+	var out []ParamComparison
+	for _, p := range c.Params {
+		if !p.Equal {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// VersionComparison compares one version string between two sides. Either
+// side is empty when it has no version to report.
+type VersionComparison struct {
+	Source string `json:"source,omitempty" mapstructure:"source"`
+	Target string `json:"target,omitempty" mapstructure:"target"`
+	Equal  bool   `json:"equal" mapstructure:"equal"`
+}
+
+// ParamComparison is one leaf of a params diff.
+type ParamComparison struct {
+	// Path is the jq-style path to the leaf, e.g. ".database.port" or
+	// ".containers[0].image".
+	Path   string     `json:"path" mapstructure:"path"`
+	Source ParamValue `json:"source" mapstructure:"source"`
+	Target ParamValue `json:"target" mapstructure:"target"`
+	// Equal is true when both sides have the same presence and value.
+	Equal bool `json:"equal" mapstructure:"equal"`
+}
+
+// ParamValue is one side of a [ParamComparison].
+type ParamValue struct {
+	// Present reports whether the path exists on this side; Value is
+	// empty both when it doesn't and when the leaf is JSON null.
+	Present bool `json:"present" mapstructure:"present"`
+	// Value is the display-ready string form of the leaf.
+	Value string `json:"value,omitempty" mapstructure:"value"`
+}
@@ -17,9 +17,17 @@ type Component struct {
 	Name        string         `json:"name" mapstructure:"name"`
 	Description string         `json:"description,omitempty" mapstructure:"description"`
 	Attributes  map[string]any `json:"attributes,omitempty" mapstructure:"attributes,omitempty"`
+	Position    *Position      `json:"position,omitempty" mapstructure:"position,omitempty"`
 	CreatedAt   time.Time      `json:"createdAt,omitzero" mapstructure:"createdAt"`
 	UpdatedAt   time.Time      `json:"updatedAt,omitzero" mapstructure:"updatedAt"`
 	OciRepo     *OciRepo       `json:"ociRepo,omitempty" mapstructure:"ociRepo,omitempty"`
 	Project     *Project       `json:"project,omitempty" mapstructure:"project,omitempty"`
 	Instances   []Instance     `json:"instances,omitempty" mapstructure:"instances,omitempty"`
 }
+
+// Position is a component's place on the blueprint canvas, in pixels from
+// the origin. Nil on a [Component] that has never been placed.
+type Position struct {
+	X int `json:"x" mapstructure:"x"`
+	Y int `json:"y" mapstructure:"y"`
+}
@@ -1,11 +1,22 @@
 package types
 
+import "time"
+
 // Connection is the runtime wiring between two deployed instances within an
 // [Environment] — the realization of a [Link] from the project blueprint.
 //
-// Skeleton — fuller shape will land alongside the platform/instances package.
+// FromInstance and ToInstance are populated (slim — id/name) when the
+// underlying GraphQL query selected them, as environments.Graph and
+// environments.IterConnections do. Link is the blueprint link the
+// connection realizes (slim — id and fields); it is nil when not selected
+// or when the link has since been removed.
 type Connection struct {
-	ID        string `json:"id" mapstructure:"id"`
-	FromField string `json:"fromField" mapstructure:"fromField"`
-	ToField   string `json:"toField" mapstructure:"toField"`
+	ID           string    `json:"id" mapstructure:"id"`
+	FromField    string    `json:"fromField" mapstructure:"fromField"`
+	ToField      string    `json:"toField" mapstructure:"toField"`
+	CreatedAt    time.Time `json:"createdAt,omitzero" mapstructure:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt,omitzero" mapstructure:"updatedAt"`
+	FromInstance *Instance `json:"fromInstance,omitempty" mapstructure:"fromInstance,omitempty"`
+	ToInstance   *Instance `json:"toInstance,omitempty" mapstructure:"toInstance,omitempty"`
+	Link         *Link     `json:"link,omitempty" mapstructure:"link,omitempty"`
 }
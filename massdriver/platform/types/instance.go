@@ -18,6 +18,7 @@ type Instance struct {
 	Name             string         `json:"name" mapstructure:"name"`
 	Status           string         `json:"status" mapstructure:"status"`
 	Version          string         `json:"version" mapstructure:"version"`
+	ReleaseStrategy  string         `json:"releaseStrategy,omitempty" mapstructure:"releaseStrategy"`
 	ResolvedVersion  string         `json:"resolvedVersion,omitempty" mapstructure:"resolvedVersion"`
 	DeployedVersion  string         `json:"deployedVersion,omitempty" mapstructure:"deployedVersion"`
 	AvailableUpgrade string         `json:"availableUpgrade,omitempty" mapstructure:"availableUpgrade"`
@@ -51,3 +52,13 @@ type InstanceSecret struct {
 	CreatedAt time.Time `json:"createdAt,omitzero" mapstructure:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt,omitzero" mapstructure:"updatedAt"`
 }
+
+// InstanceSecretField is a secret an [Instance]'s bundle declares. SHA256
+// is the fingerprint of the stored value, or empty when none is set.
+type InstanceSecretField struct {
+	Name        string `json:"name" mapstructure:"name"`
+	Required    bool   `json:"required" mapstructure:"required"`
+	Title       string `json:"title,omitempty" mapstructure:"title"`
+	Description string `json:"description,omitempty" mapstructure:"description"`
+	SHA256      string `json:"sha256,omitempty" mapstructure:"sha256"`
+}
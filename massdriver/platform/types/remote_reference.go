@@ -0,0 +1,18 @@
+package types
+
+import "time"
+
+// RemoteReference is a per-instance override of one connection slot: the
+// instance's Field is wired to a Resource from another project (or an
+// imported resource) instead of its blueprint [Link] or environment
+// default.
+//
+// Resource is slim (id/name); call platform/resources.Get for the full
+// payload.
+type RemoteReference struct {
+	ID        string    `json:"id" mapstructure:"id"`
+	Field     string    `json:"field" mapstructure:"field"`
+	Resource  *Resource `json:"resource,omitempty" mapstructure:"resource,omitempty"`
+	CreatedAt time.Time `json:"createdAt,omitzero" mapstructure:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt,omitzero" mapstructure:"updatedAt"`
+}
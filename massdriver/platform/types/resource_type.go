@@ -3,11 +3,17 @@ package types
 // ResourceType is the artifact-definition contract a [Resource] conforms to
 // — the schema describing what fields the resource carries.
 //
-// Skeleton — fuller shape (icon, schema, connection orientation, etc.) will
-// land alongside the platform/resourcetypes package, when that package is
+// ConnectionOrientation is "LINK" when instances receive the type through a
+// link drawn on the canvas and "ENVIRONMENT_DEFAULT" when an environment
+// default supplies it; empty when the query didn't select it.
+//
+// Skeleton — fuller shape (schema, import instructions, etc.) will land
+// alongside the platform/resourcetypes package, when that package is
 // designed.
 type ResourceType struct {
 	ID   string `json:"id" mapstructure:"id"`
 	Name string `json:"name" mapstructure:"name"`
 	Icon string `json:"icon,omitempty" mapstructure:"icon,omitempty"`
+
+	ConnectionOrientation string `json:"connectionOrientation,omitempty" mapstructure:"connectionOrientation,omitempty"`
 }
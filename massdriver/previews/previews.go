@@ -0,0 +1,260 @@
+// Package previews manages short-lived preview environments — one per pull
+// request, say — forked from a long-lived parent and torn down once their
+// time-to-live passes.
+//
+//	m := previews.New(c.Environments, previews.Options{CopySecrets: true})
+//	p, err := m.Create(ctx, "ecomm-staging", "pr-1234", 72*time.Hour)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(p.Environment.ID, "expires", p.ExpiresAt)
+//
+//	// On a schedule:
+//	report, err := m.Sweep(ctx)
+//	if err != nil {
+//	    return err // listing failed or ctx ended
+//	}
+//	return report.Err() // nil unless some previews failed to tear down
+//
+// # How previews are marked
+//
+// A preview is an ordinary environment carrying two attributes of its own:
+// [AttributePreview] set to "true" and [AttributeExpiresAt] set to an
+// RFC 3339 timestamp. Nothing else distinguishes it, so a preview can be
+// extended by updating its expiry attribute, and an environment created some
+// other way becomes a preview by adding both.
+package previews
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/bulk"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/cache"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/environments"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// Attribute keys set on every preview environment.
+const (
+	// AttributePreview is "true" on a preview environment.
+	AttributePreview = "preview"
+	// AttributeExpiresAt is when the preview becomes eligible for
+	// [Manager.Sweep], as an RFC 3339 timestamp in UTC.
+	AttributeExpiresAt = "preview_expires_at"
+)
+
+// maxIDLength is the longest environment ID the API accepts.
+const maxIDLength = 20
+
+// Options configures a [Manager]. The zero value forks without copying
+// secrets, remote references, or defaults and really tears previews down.
+type Options struct {
+	// CopySecrets, CopyRemoteReferences and CopyEnvironmentDefaults are
+	// passed to Environments.Fork by [Manager.Create].
+	CopySecrets             bool
+	CopyRemoteReferences    bool
+	CopyEnvironmentDefaults bool
+	// Attributes are extra attributes set on every preview alongside
+	// [AttributePreview] and [AttributeExpiresAt].
+	Attributes map[string]any
+	// Wait configures the decommission wait in [Manager.Sweep].
+	Wait environments.WaitOptions
+	// DryRun makes [Manager.Sweep] report the expired previews without
+	// decommissioning or deleting them.
+	DryRun bool
+}
+
+// Preview is a preview environment and its expiry.
+type Preview struct {
+	Environment types.Environment
+	ExpiresAt   time.Time
+}
+
+// Manager creates and sweeps preview environments. Construct with [New].
+type Manager struct {
+	environments *environments.Service
+	opts         Options
+}
+
+// New returns a [*Manager] using the given service — typically
+// c.Environments from the top-level client.
+func New(envs *environments.Service, opts Options) *Manager {
+	return &Manager{environments: envs, opts: opts}
+}
+
+// Create forks parentID into a preview environment named name that expires
+// ttl from now. The environment ID is name lowercased with everything but
+// letters and digits dropped, cut to 20 characters ("PR-1234" → "pr1234").
+//
+// Like Environments.Fork, Create converges: creating the same preview again
+// re-applies the copy options. Forking onto an existing preview returns it
+// unchanged, so Create then updates its expiry attribute (and the
+// description naming it) with Environments.Update, pushing the expiry out
+// to ttl from now. If the ID belongs to an existing environment that isn't
+// a preview, Create returns an error instead of tagging it as one, which
+// would put it in reach of [Manager.Sweep].
+func (m *Manager) Create(ctx context.Context, parentID, name string, ttl time.Duration) (*Preview, error) {
+	id := previewID(name)
+	if id == "" {
+		return nil, fmt.Errorf("create preview %q: name has no letters or digits", name)
+	}
+	if ttl <= 0 {
+		return nil, fmt.Errorf("create preview %q: ttl must be positive, got %s", name, ttl)
+	}
+	expiresAt := time.Now().Add(ttl).UTC().Truncate(time.Second)
+
+	attrs := make(map[string]any, len(m.opts.Attributes)+2)
+	maps.Copy(attrs, m.opts.Attributes)
+	attrs[AttributePreview] = "true"
+	attrs[AttributeExpiresAt] = expiresAt.Format(time.RFC3339)
+	description := fmt.Sprintf("Preview of %s, expires %s.", parentID, expiresAt.Format(time.RFC3339))
+
+	env, err := m.environments.Fork(ctx, parentID, environments.ForkInput{
+		ID:                      id,
+		Name:                    name,
+		Description:             description,
+		Attributes:              attrs,
+		CopySecrets:             m.opts.CopySecrets,
+		CopyRemoteReferences:    m.opts.CopyRemoteReferences,
+		CopyEnvironmentDefaults: m.opts.CopyEnvironmentDefaults,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// A fresh fork echoes the attributes it was given.
+	if env.Attributes[AttributePreview] != "true" {
+		return nil, fmt.Errorf("create preview %q: environment %s already exists and isn't a preview", name, env.ID)
+	}
+	// A fresh fork carries the new expiry; an existing preview comes back
+	// with its old one. Within a minute counts as current, which also
+	// tolerates a fork echoing the attribute re-encoded.
+	if stored, ok := storedExpiry(env); ok && stored.Sub(expiresAt).Abs() < time.Minute {
+		expiresAt = stored
+	} else {
+		merged := maps.Clone(env.Attributes)
+		if merged == nil {
+			merged = make(map[string]any, len(attrs))
+		}
+		maps.Copy(merged, attrs)
+		if env, err = m.environments.Update(ctx, env.ID, environments.UpdateInput{
+			Name:        cmp.Or(env.Name, name),
+			Description: description,
+			Attributes:  merged,
+		}); err != nil {
+			return nil, fmt.Errorf("create preview %q: extend existing preview: %w", name, err)
+		}
+	}
+	return &Preview{Environment: *env, ExpiresAt: expiresAt}, nil
+}
+
+// SweepReport is the outcome of [Manager.Sweep].
+type SweepReport struct {
+	DryRun bool
+	// Expired holds every preview past its expiry, in name order (Sweep
+	// lists environments sorted by name).
+	Expired []Preview
+	// Deleted holds the IDs of the expired previews that were
+	// decommissioned and deleted. Empty for a dry run.
+	Deleted []string
+	// Failed holds the expired previews whose teardown failed, and
+	// previews whose expiry attribute couldn't be parsed.
+	Failed bulk.Failures[Preview]
+}
+
+// Err summarizes the failures as one error, or returns nil when there are
+// none. It wraps each preview's error, so [errors.Is] and [errors.As] (for
+// an [*environments.WaveError], say) still work.
+func (r *SweepReport) Err() error {
+	return r.Failed.Err("previews", len(r.Deleted)+len(r.Failed))
+}
+
+// Sweep finds previews whose expiry has passed and, one at a time,
+// decommissions each (waiting for the wave to finish) and deletes it.
+// A failed teardown is recorded in the report and the sweep moves on; a
+// preview whose decommission failed is left in place for the next sweep.
+//
+// It returns an error only when listing environments fails or ctx ends —
+// per-preview failures are in the report, which is returned (as far as it
+// got) in every case. With [Options.DryRun] it only lists.
+//
+// Only environments that set both attributes themselves count: a project
+// tagged preview=true doesn't make its other environments previews.
+func (m *Manager) Sweep(ctx context.Context) (*SweepReport, error) {
+	report := &SweepReport{DryRun: m.opts.DryRun}
+	now := time.Now()
+	// Deletion decisions read live expiries, never cached ones.
+	for env, err := range m.environments.Iter(cache.Bypass(ctx), environments.ListInput{
+		Attributes: []types.AttributeFilter{{Key: AttributePreview, Eq: "true"}},
+		SortBy:     environments.SortByName,
+		SortOrder:  environments.SortAsc,
+	}) {
+		if err != nil {
+			return report, err
+		}
+		raw, ok := env.Attributes[AttributeExpiresAt]
+		if !ok || env.Attributes[AttributePreview] != "true" {
+			continue
+		}
+		p := Preview{Environment: env}
+		s, _ := raw.(string)
+		expiresAt, perr := time.Parse(time.RFC3339, s)
+		if perr != nil {
+			report.Failed.Add(p, fmt.Errorf("preview %s: invalid %s attribute %v: %w", env.ID, AttributeExpiresAt, raw, perr))
+			continue
+		}
+		p.ExpiresAt = expiresAt
+		if expiresAt.After(now) {
+			continue
+		}
+		report.Expired = append(report.Expired, p)
+	}
+	if m.opts.DryRun {
+		return report, nil
+	}
+
+	for _, p := range report.Expired {
+		if err := m.teardown(ctx, p.Environment.ID); err != nil {
+			if ctx.Err() != nil {
+				return report, ctx.Err()
+			}
+			report.Failed.Add(p, fmt.Errorf("preview %s: %w", p.Environment.ID, err))
+			continue
+		}
+		report.Deleted = append(report.Deleted, p.Environment.ID)
+	}
+	return report, nil
+}
+
+func (m *Manager) teardown(ctx context.Context, id string) error {
+	if _, err := m.environments.DecommissionAndWait(ctx, id, m.opts.Wait); err != nil {
+		return err
+	}
+	_, err := m.environments.Delete(ctx, id)
+	return err
+}
+
+// storedExpiry returns the expiry recorded on env, if it has a valid one.
+func storedExpiry(env *types.Environment) (time.Time, bool) {
+	s, _ := env.Attributes[AttributeExpiresAt].(string)
+	t, err := time.Parse(time.RFC3339, s)
+	return t, err == nil
+}
+
+// previewID turns a display name into an environment ID.
+func previewID(name string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+		}
+		if sb.Len() == maxIDLength {
+			break
+		}
+	}
+	return sb.String()
+}
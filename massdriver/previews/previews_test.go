@@ -0,0 +1,212 @@
+package previews_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/previews"
+)
+
+func newManager(t *testing.T, gqlClient *gqltest.Client, opts previews.Options) *previews.Manager {
+	t.Helper()
+	c := gqltest.NewSDKClient(t, gqlClient)
+	return previews.New(c.Environments, opts)
+}
+
+func preview(id, expiresAt string) map[string]any {
+	return map[string]any{"id": id, "attributes": map[string]any{
+		previews.AttributePreview: "true", previews.AttributeExpiresAt: expiresAt,
+	}}
+}
+
+func listPreviews() gqltest.Response {
+	past := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	future := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	return gqltest.RespondWithData(map[string]any{
+		"environments": map[string]any{"cursor": map[string]any{}, "items": []map[string]any{
+			preview("ecomm-pr1", past),
+			preview("ecomm-pr2", future),
+			preview("ecomm-pr3", past),
+			preview("ecomm-pr4", "next tuesday"),
+			// Inherits preview=true from its project but isn't one.
+			{"id": "ecomm-staging", "attributes": map[string]any{"team": "data"}},
+		}},
+	})
+}
+
+func TestCreate(t *testing.T) {
+	// A fresh fork comes back with the expiry it was given.
+	gqlClient := gqltest.NewClient(
+		gqltest.RespondWithData(map[string]any{
+			"forkEnvironment": map[string]any{"result": preview("ecomm-pr1234", time.Now().Add(2*time.Hour).UTC().Format(time.RFC3339)), "successful": true},
+		}),
+	)
+
+	before := time.Now()
+	p, err := newManager(t, gqlClient, previews.Options{
+		CopySecrets: true,
+		Attributes:  map[string]any{"team": "data"},
+	}).Create(t.Context(), "ecomm-staging", "PR-1234", 2*time.Hour)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if p.Environment.ID != "ecomm-pr1234" {
+		t.Errorf("ID = %q, want ecomm-pr1234", p.Environment.ID)
+	}
+	if d := p.ExpiresAt.Sub(before); d < 2*time.Hour-time.Second || d > 2*time.Hour+time.Second {
+		t.Errorf("ExpiresAt = %v, want about 2h from now", p.ExpiresAt)
+	}
+
+	vars := gqlClient.Requests()[0].Variables
+	input, _ := vars["input"].(map[string]any)
+	if vars["parentId"] != "ecomm-staging" || input["id"] != "pr1234" || input["name"] != "PR-1234" || input["copySecrets"] != true {
+		t.Errorf("fork variables = %v", vars)
+	}
+	// The Map scalar travels as an encoded JSON string.
+	attrs, _ := input["attributes"].(string)
+	for _, want := range []string{`"preview":"true"`, `"team":"data"`, `"preview_expires_at":"` + p.ExpiresAt.Format(time.RFC3339) + `"`} {
+		if !strings.Contains(attrs, want) {
+			t.Errorf("attributes = %s, want %s", attrs, want)
+		}
+	}
+
+	if _, err := newManager(t, gqltest.NewClient(), previews.Options{}).Create(t.Context(), "ecomm-staging", "--", time.Hour); err == nil {
+		t.Error("Create with an empty ID succeeded")
+	}
+	if gqlClient.Pending() != 0 || len(gqlClient.Requests()) != 1 {
+		t.Errorf("requests = %d, want only the fork", len(gqlClient.Requests()))
+	}
+}
+
+func TestCreate_ExtendsExisting(t *testing.T) {
+	existing := preview("ecomm-pr1234", time.Now().Add(-time.Hour).UTC().Format(time.RFC3339))
+	existing["name"] = "PR-1234"
+	existing["attributes"].(map[string]any)["owner"] = "ana"
+	gqlClient := gqltest.NewClient(
+		// Forking onto an existing preview returns it unchanged.
+		gqltest.RespondWithData(map[string]any{
+			"forkEnvironment": map[string]any{"result": existing, "successful": true},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"updateEnvironment": map[string]any{"result": map[string]any{"id": "ecomm-pr1234", "name": "PR-1234"}, "successful": true},
+		}),
+	)
+
+	before := time.Now()
+	p, err := newManager(t, gqlClient, previews.Options{}).Create(t.Context(), "ecomm-staging", "PR-1234", 2*time.Hour)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if d := p.ExpiresAt.Sub(before); d < 2*time.Hour-time.Second || d > 2*time.Hour+time.Second {
+		t.Errorf("ExpiresAt = %v, want about 2h from now", p.ExpiresAt)
+	}
+
+	reqs := gqlClient.Requests()
+	if len(reqs) != 2 || reqs[1].OpName != "UpdateEnvironment" || reqs[1].Variables["id"] != "ecomm-pr1234" {
+		t.Fatalf("requests = %+v, want the fork then an update of ecomm-pr1234", reqs)
+	}
+	input, _ := reqs[1].Variables["input"].(map[string]any)
+	attrs, _ := input["attributes"].(string)
+	for _, want := range []string{`"owner":"ana"`, `"preview":"true"`, `"preview_expires_at":"` + p.ExpiresAt.Format(time.RFC3339) + `"`} {
+		if !strings.Contains(attrs, want) {
+			t.Errorf("attributes = %s, want %s", attrs, want)
+		}
+	}
+	if input["name"] != "PR-1234" || !strings.Contains(input["description"].(string), p.ExpiresAt.Format(time.RFC3339)) {
+		t.Errorf("update input = %v", input)
+	}
+}
+
+func TestCreate_RefusesNonPreview(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		// The ID is taken by an environment that was never a preview.
+		gqltest.RespondWithData(map[string]any{
+			"forkEnvironment": map[string]any{"result": map[string]any{
+				"id": "ecomm-pr1234", "name": "PR-1234", "attributes": map[string]any{"team": "data"},
+			}, "successful": true},
+		}),
+	)
+
+	_, err := newManager(t, gqlClient, previews.Options{}).Create(t.Context(), "ecomm-staging", "PR-1234", 2*time.Hour)
+	if err == nil || !strings.Contains(err.Error(), "isn't a preview") {
+		t.Fatalf("err = %v, want a refusal to adopt ecomm-pr1234", err)
+	}
+	if reqs := gqlClient.Requests(); len(reqs) != 1 {
+		t.Errorf("requests = %+v, want only the fork", reqs)
+	}
+}
+
+func TestSweep(t *testing.T) {
+	decommissioned := gqltest.RespondWithData(map[string]any{
+		"decommissionEnvironment": map[string]any{"result": map[string]any{"id": "x"}, "successful": true},
+	})
+	noInstances := gqltest.RespondWithData(map[string]any{
+		"instances": map[string]any{"cursor": map[string]any{}, "items": []map[string]any{}},
+	})
+	gqlClient := gqltest.NewClient(
+		listPreviews(),
+		// ecomm-pr1 tears down cleanly.
+		noInstances,
+		decommissioned,
+		gqltest.RespondWithData(map[string]any{
+			"deleteEnvironment": map[string]any{"result": map[string]any{"id": "ecomm-pr1"}, "successful": true},
+		}),
+		// ecomm-pr3 can't be deleted.
+		noInstances,
+		decommissioned,
+		gqltest.RespondWithData(map[string]any{
+			"deleteEnvironment": map[string]any{"successful": false, "messages": []map[string]any{
+				{"field": "id", "message": "environment has instances"},
+			}},
+		}),
+	)
+
+	report, err := newManager(t, gqlClient, previews.Options{}).Sweep(t.Context())
+	if err != nil {
+		t.Fatalf("Sweep: %v", err)
+	}
+	if len(report.Expired) != 2 || report.Expired[0].Environment.ID != "ecomm-pr1" || report.Expired[1].Environment.ID != "ecomm-pr3" {
+		t.Errorf("Expired = %+v, want ecomm-pr1, ecomm-pr3", report.Expired)
+	}
+	if len(report.Deleted) != 1 || report.Deleted[0] != "ecomm-pr1" {
+		t.Errorf("Deleted = %v, want [ecomm-pr1]", report.Deleted)
+	}
+	if len(report.Failed) != 2 || report.Failed[0].Item.Environment.ID != "ecomm-pr4" || report.Failed[1].Item.Environment.ID != "ecomm-pr3" {
+		t.Fatalf("Failed = %+v, want ecomm-pr4, ecomm-pr3", report.Failed)
+	}
+	if _, ok := gql.AsMutationFailedError(report.Err()); !ok {
+		t.Errorf("Err() = %v, want it to wrap the delete's MutationFailedError", report.Err())
+	}
+
+	if sort, _ := gqlClient.Requests()[0].Variables["sort"].(map[string]any); sort["field"] != "NAME" {
+		t.Errorf("sort = %v, want by name", gqlClient.Requests()[0].Variables["sort"])
+	}
+	filter, _ := gqlClient.Requests()[0].Variables["filter"].(map[string]any)
+	if attrs, _ := filter["attributes"].([]any); len(attrs) != 1 {
+		t.Errorf("filter.attributes = %v, want the preview attribute", filter["attributes"])
+	}
+	if gqlClient.Pending() != 0 {
+		t.Errorf("%d responses unused", gqlClient.Pending())
+	}
+}
+
+func TestSweep_DryRun(t *testing.T) {
+	gqlClient := gqltest.NewClient(listPreviews())
+
+	report, err := newManager(t, gqlClient, previews.Options{DryRun: true}).Sweep(t.Context())
+	if err != nil {
+		t.Fatalf("Sweep: %v", err)
+	}
+	if !report.DryRun || len(report.Expired) != 2 || len(report.Deleted) != 0 {
+		t.Errorf("report = %+v, want 2 expired and nothing deleted", report)
+	}
+	if err := report.Err(); err == nil {
+		t.Errorf("Err() = %v, want the unparseable expiry of ecomm-pr4", err)
+	}
+	if len(gqlClient.Requests()) != 1 {
+		t.Errorf("made %d requests, want just the list", len(gqlClient.Requests()))
+	}
+}
@@ -19,6 +19,7 @@
 package provisioning
 
 import (
+	"log/slog"
 	"time"
 
 	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/config"
@@ -49,6 +50,7 @@ type options struct {
 	baseURL    string
 	timeout    time.Duration
 	timeoutSet bool
+	logger     *slog.Logger
 }
 
 // WithBaseURL sets the Massdriver API base URL. Useful for self-hosted
@@ -64,6 +66,13 @@ func WithTimeout(d time.Duration) Option {
 	return func(o *options) { o.timeout = d; o.timeoutSet = true }
 }
 
+// WithLogger sends a debug-level log line for every REST call (method,
+// URL, status, duration) to l. Headers and bodies are never logged, so
+// the deployment token and resource payloads stay out of the logs.
+func WithLogger(l *slog.Logger) Option {
+	return func(o *options) { o.logger = l }
+}
+
 // NewClient constructs a provisioning client. Credentials are resolved
 // from MASSDRIVER_DEPLOYMENT_ID + MASSDRIVER_TOKEN, which the platform
 // injects into the provisioner container at deployment time. Returns
@@ -96,7 +105,7 @@ func NewClient(opts ...Option) (*Client, error) {
 	if o.timeoutSet {
 		timeout = o.timeout
 	}
-	c := client.NewWithConfig(cfg, timeout)
+	c := client.NewWithOptions(cfg, client.Options{Timeout: timeout, Logger: o.logger})
 
 	return &Client{
 		config:      pcfg,
@@ -0,0 +1,223 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/jsonutil"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/deployments"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/environments"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/instances"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// RestoreOptions configures [Restore].
+type RestoreOptions struct {
+	// Action is the deployment that carries restored params. Required;
+	// use [deployments.ActionPlan] to preview a restore before provisioning
+	// it.
+	Action deployments.Action
+	// Message is recorded on each deployment.
+	Message string
+	// SkipDefaults leaves the target's environment defaults alone.
+	SkipDefaults bool
+	// SkipRemoteReferences leaves the target's remote references alone.
+	SkipRemoteReferences bool
+}
+
+// RestoreReport is what [Restore] did.
+type RestoreReport struct {
+	Environment string
+	// Deployments are the deployments created to save params, one per
+	// instance whose params differed.
+	Deployments []types.Deployment
+	// Updated lists "<id> <what>" for every change made, in order
+	// ("ecomm-prod attributes", "ecomm-prod-db remote reference vpc").
+	Updated []string
+	// MissingSecrets maps instance ID to the secrets the snapshot had set
+	// but the target lacks. Set them with Instances.SetSecret.
+	MissingSecrets map[string][]string
+	// Skipped lists snapshot components with no instance in the target.
+	Skipped []string
+}
+
+// Restore makes environmentID's configuration match snap, changing only
+// what differs: environment attributes (merged, so attributes the
+// snapshot doesn't name are kept), defaults, and per instance its version,
+// remote references (overrides the snapshot lacks are removed), and params.
+// Params are sent with one deployment per instance (opts.Action).
+//
+// Remote references can only change on instances that aren't PROVISIONED
+// or FAILED; the API rejects the rest.
+//
+// On error Restore returns the report so far. Because it only touches
+// what differs from snap, running it again picks up where it stopped.
+func Restore(ctx context.Context, c *massdriver.Client, snap *Snapshot, environmentID string, opts RestoreOptions) (*RestoreReport, error) {
+	if opts.Action == "" {
+		return nil, fmt.Errorf("restore environment %s: an action is required (PROVISION or PLAN)", environmentID)
+	}
+	env, err := c.Environments.Get(ctx, environmentID)
+	if err != nil {
+		return nil, err
+	}
+	report := &RestoreReport{Environment: env.ID}
+
+	if attrs := mergeAttributes(env.Attributes, snap.Attributes); !jsonutil.Equal(env.Attributes, attrs) {
+		if _, err := c.Environments.Update(ctx, env.ID, environments.UpdateInput{
+			Name:        env.Name,
+			Description: env.Description,
+			Attributes:  attrs,
+		}); err != nil {
+			return report, err
+		}
+		report.Updated = append(report.Updated, env.ID+" attributes")
+	}
+
+	if !opts.SkipDefaults {
+		if err := restoreDefaults(ctx, c, env, snap.Defaults, report); err != nil {
+			return report, err
+		}
+	}
+
+	live := map[string]types.Instance{}
+	for inst, err := range c.Instances.Iter(ctx, instances.ListInput{EnvironmentID: env.ID}) {
+		if err != nil {
+			return report, err
+		}
+		live[componentID(env.ID, inst.ID)] = inst
+	}
+	for _, component := range slices.Sorted(maps.Keys(snap.Instances)) {
+		listed, ok := live[component]
+		if !ok {
+			report.Skipped = append(report.Skipped, component)
+			continue
+		}
+		if err := restoreInstance(ctx, c, listed.ID, snap.Instances[component], opts, report); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+func restoreDefaults(ctx context.Context, c *massdriver.Client, env *types.Environment, want map[string]string, report *RestoreReport) error {
+	current := map[string]types.EnvironmentDefault{}
+	for _, d := range env.Defaults {
+		if d.Resource.ResourceType != nil {
+			current[d.Resource.ResourceType.ID] = d
+		}
+	}
+	for _, typ := range slices.Sorted(maps.Keys(want)) {
+		resourceID := want[typ]
+		cur, ok := current[typ]
+		if ok && cur.Resource.ID == resourceID {
+			continue
+		}
+		if ok {
+			if _, err := c.Environments.RemoveDefault(ctx, cur.ID); err != nil {
+				return err
+			}
+		}
+		if _, err := c.Environments.SetDefault(ctx, env.ID, resourceID); err != nil {
+			return err
+		}
+		report.Updated = append(report.Updated, env.ID+" default "+typ)
+	}
+	return nil
+}
+
+func restoreInstance(ctx context.Context, c *massdriver.Client, id string, want Instance, opts RestoreOptions, report *RestoreReport) error {
+	inst, err := c.Instances.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	version := want.Version
+	if want.ReleaseStrategy == "DEVELOPMENT" && !strings.Contains(version, "+dev") {
+		version += "+dev"
+	}
+	if version != "" && version != inst.Version {
+		if _, err := c.Instances.Update(ctx, id, instances.UpdateInput{Version: version}); err != nil {
+			return err
+		}
+		report.Updated = append(report.Updated, id+" version")
+	}
+
+	if !opts.SkipRemoteReferences {
+		refs, err := c.Instances.RemoteReferences(ctx, id)
+		if err != nil {
+			return err
+		}
+		have := map[string]string{}
+		for _, r := range refs {
+			if r.Resource != nil {
+				have[r.Field] = r.Resource.ID
+			}
+		}
+		for _, field := range slices.Sorted(maps.Keys(have)) {
+			if _, keep := want.RemoteReferences[field]; keep {
+				continue
+			}
+			if _, err := c.Instances.RemoveRemoteReference(ctx, id, field); err != nil {
+				return err
+			}
+			report.Updated = append(report.Updated, id+" remote reference "+field)
+		}
+		for _, field := range slices.Sorted(maps.Keys(want.RemoteReferences)) {
+			if have[field] == want.RemoteReferences[field] {
+				continue
+			}
+			if _, err := c.Instances.SetRemoteReference(ctx, id, field, want.RemoteReferences[field]); err != nil {
+				return err
+			}
+			report.Updated = append(report.Updated, id+" remote reference "+field)
+		}
+	}
+
+	if !jsonutil.Equal(inst.Params, want.Params) {
+		dep, err := c.Deployments.Create(ctx, id, deployments.CreateInput{
+			Action:  opts.Action,
+			Params:  want.Params,
+			Message: opts.Message,
+		})
+		if err != nil {
+			return err
+		}
+		report.Deployments = append(report.Deployments, *dep)
+		report.Updated = append(report.Updated, id+" params")
+	}
+
+	if len(want.Secrets) > 0 {
+		fields, err := c.Instances.SecretFields(ctx, id)
+		if err != nil {
+			return err
+		}
+		set := map[string]bool{}
+		for _, f := range fields {
+			set[f.Name] = f.SHA256 != ""
+		}
+		for _, name := range want.Secrets {
+			if set[name] {
+				continue
+			}
+			if report.MissingSecrets == nil {
+				report.MissingSecrets = map[string][]string{}
+			}
+			report.MissingSecrets[id] = append(report.MissingSecrets[id], name)
+		}
+	}
+	return nil
+}
+
+// mergeAttributes overlays want onto have without modifying either.
+func mergeAttributes(have, want map[string]any) map[string]any {
+	out := maps.Clone(have)
+	if out == nil && len(want) > 0 {
+		out = map[string]any{}
+	}
+	maps.Copy(out, want)
+	return out
+}
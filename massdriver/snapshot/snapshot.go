@@ -0,0 +1,173 @@
+// Package snapshot captures an environment's configuration into a
+// versioned file and restores it into the same or another environment —
+// config backups and a "restore last known good" path that doesn't depend
+// on deployment history.
+//
+//	snap, err := snapshot.Take(ctx, c, "ecomm-prod")
+//	if err != nil {
+//	    return err
+//	}
+//	if err := snap.Write(f); err != nil {
+//	    return err
+//	}
+//	// Later, or elsewhere:
+//	report, err := snapshot.Restore(ctx, c, snap, "ecomm-prod", snapshot.RestoreOptions{
+//	    Action: deployments.ActionProvision,
+//	})
+//
+// # What's captured
+//
+// Environment attributes and defaults, and per instance its version
+// constraint and release strategy, params, remote references, and the
+// names of the secrets that are set. Secret values never leave the
+// platform: a restore reports secrets the target lacks rather than
+// setting them.
+//
+// Instances are keyed by short component ID, so a snapshot of ecomm-staging
+// restores into ecomm-prod instance by instance. Defaults and remote
+// references name specific resources; skip them with [RestoreOptions]
+// when those resources don't apply to the target.
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/instances"
+)
+
+// FormatVersion is the snapshot file format [Take] writes. [Read] rejects
+// newer formats.
+const FormatVersion = 1
+
+// Snapshot is one environment's configuration at a point in time.
+type Snapshot struct {
+	FormatVersion int       `json:"formatVersion"`
+	TakenAt       time.Time `json:"takenAt"`
+	// Environment is the ID of the environment the snapshot was taken from.
+	Environment string         `json:"environment"`
+	Attributes  map[string]any `json:"attributes,omitempty"`
+	// Defaults maps resource type ID to the default resource's ID.
+	Defaults map[string]string `json:"defaults,omitempty"`
+	// Instances is keyed by component ID.
+	Instances map[string]Instance `json:"instances,omitempty"`
+}
+
+// Instance is one instance's configuration in a [Snapshot].
+type Instance struct {
+	// Version is the version constraint ("~1.2", "latest+dev").
+	Version string `json:"version"`
+	// ReleaseStrategy is STABLE or DEVELOPMENT.
+	ReleaseStrategy string         `json:"releaseStrategy,omitempty"`
+	Params          map[string]any `json:"params,omitempty"`
+	// RemoteReferences maps connection field to the referenced resource ID.
+	RemoteReferences map[string]string `json:"remoteReferences,omitempty"`
+	// Secrets are the names of secrets that were set, sorted.
+	Secrets []string `json:"secrets,omitempty"`
+}
+
+// Take snapshots an environment. It reads the environment (Environments.Get)
+// and, for each of its instances, the full instance (Instances.Get), its
+// remote references, and its secret fields.
+func Take(ctx context.Context, c *massdriver.Client, environmentID string) (*Snapshot, error) {
+	env, err := c.Environments.Get(ctx, environmentID)
+	if err != nil {
+		return nil, err
+	}
+	snap := &Snapshot{
+		FormatVersion: FormatVersion,
+		TakenAt:       time.Now().UTC(),
+		Environment:   env.ID,
+		Attributes:    env.Attributes,
+	}
+	for _, d := range env.Defaults {
+		if d.Resource.ResourceType == nil {
+			continue
+		}
+		if snap.Defaults == nil {
+			snap.Defaults = map[string]string{}
+		}
+		snap.Defaults[d.Resource.ResourceType.ID] = d.Resource.ID
+	}
+
+	for listed, err := range c.Instances.Iter(ctx, instances.ListInput{EnvironmentID: env.ID}) {
+		if err != nil {
+			return nil, err
+		}
+		inst, err := c.Instances.Get(ctx, listed.ID)
+		if err != nil {
+			return nil, err
+		}
+		refs, err := c.Instances.RemoteReferences(ctx, inst.ID)
+		if err != nil {
+			return nil, err
+		}
+		fields, err := c.Instances.SecretFields(ctx, inst.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		si := Instance{
+			Version:         inst.Version,
+			ReleaseStrategy: inst.ReleaseStrategy,
+			Params:          inst.Params,
+		}
+		for _, r := range refs {
+			if r.Resource == nil {
+				continue
+			}
+			if si.RemoteReferences == nil {
+				si.RemoteReferences = map[string]string{}
+			}
+			si.RemoteReferences[r.Field] = r.Resource.ID
+		}
+		for _, f := range fields {
+			if f.SHA256 != "" {
+				si.Secrets = append(si.Secrets, f.Name)
+			}
+		}
+		slices.Sort(si.Secrets)
+
+		if snap.Instances == nil {
+			snap.Instances = map[string]Instance{}
+		}
+		snap.Instances[componentID(env.ID, inst.ID)] = si
+	}
+	return snap, nil
+}
+
+// Write encodes the snapshot as indented JSON. Map keys are sorted, so
+// snapshots of an unchanged environment differ only in TakenAt.
+func (s *Snapshot) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+// Read decodes a snapshot written by [Snapshot.Write]. Unknown fields are
+// an error, as is a FormatVersion newer than this package understands.
+func Read(r io.Reader) (*Snapshot, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	dec.UseNumber()
+	var snap Snapshot
+	if err := dec.Decode(&snap); err != nil {
+		return nil, fmt.Errorf("read snapshot: %w", err)
+	}
+	if snap.FormatVersion < 1 || snap.FormatVersion > FormatVersion {
+		return nil, fmt.Errorf("read snapshot: format version %d not supported (want 1..%d)", snap.FormatVersion, FormatVersion)
+	}
+	return &snap, nil
+}
+
+// componentID returns the short component ID of an instance — its ID
+// minus the environment prefix ("ecomm-prod-database" → "database").
+func componentID(envID, instanceID string) string {
+	return strings.TrimPrefix(instanceID, envID+"-")
+}
@@ -0,0 +1,142 @@
+package snapshot_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/deployments"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/snapshot"
+)
+
+func remoteRefs(instanceID string, refs map[string]string) gqltest.Response {
+	deps := []map[string]any{
+		{"field": "network", "source": map[string]any{"__typename": "EnvironmentDefault"}},
+	}
+	for field, resourceID := range refs {
+		deps = append(deps, map[string]any{"field": field, "source": map[string]any{
+			"__typename": "RemoteReference", "id": "ref-" + field, "field": field, "resource": map[string]any{"id": resourceID},
+		}})
+	}
+	return gqltest.RespondWithData(map[string]any{"instance": map[string]any{"id": instanceID, "dependencies": deps}})
+}
+
+func TestTakeAndRestore(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		gqltest.RespondWithData(map[string]any{
+			"environment": map[string]any{
+				"id": "ecomm-staging", "attributes": map[string]any{"team": "data"},
+				"defaults": map[string]any{"items": []map[string]any{
+					{"id": "def-1", "resource": map[string]any{"id": "net-staging", "resourceType": map[string]any{"id": "aws-vpc"}}},
+				}},
+			},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"instances": map[string]any{"cursor": map[string]any{}, "items": []map[string]any{{"id": "ecomm-staging-db"}}},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"instance": map[string]any{"id": "ecomm-staging-db", "version": "~1.2", "releaseStrategy": "DEVELOPMENT", "params": map[string]any{"size": 2}},
+		}),
+		remoteRefs("ecomm-staging-db", map[string]string{"vpc": "shared-vpc"}),
+		gqltest.RespondWithData(map[string]any{"instance": map[string]any{"id": "ecomm-staging-db", "secretFields": []map[string]any{
+			{"name": "DB_PASSWORD", "sha256": "abc"},
+			{"name": "API_KEY"},
+		}}}),
+	)
+	snap, err := snapshot.Take(t.Context(), gqltest.NewSDKClient(t, gqlClient), "ecomm-staging")
+	if err != nil {
+		t.Fatalf("Take: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := snap.Write(&buf); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if strings.Contains(buf.String(), "API_KEY") {
+		t.Error("snapshot lists a secret that isn't set")
+	}
+	snap, err = snapshot.Read(&buf)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	db := snap.Instances["db"]
+	if db.Version != "~1.2" || db.RemoteReferences["vpc"] != "shared-vpc" || len(db.Secrets) != 1 || snap.Defaults["aws-vpc"] != "net-staging" {
+		t.Fatalf("snapshot = %+v", snap)
+	}
+	snap.Instances["cache"] = snapshot.Instance{Version: "~3"}
+
+	gqlClient = gqltest.NewClient(
+		gqltest.RespondWithData(map[string]any{
+			"environment": map[string]any{"id": "ecomm-prod", "name": "Production", "attributes": map[string]any{"team": "platform", "tier": "1"}},
+		}),
+		gqltest.RespondWithSuccess("updateEnvironment", "x"),
+		gqltest.RespondWithData(map[string]any{
+			"instances": map[string]any{"cursor": map[string]any{}, "items": []map[string]any{{"id": "ecomm-prod-db"}, {"id": "ecomm-prod-app"}}},
+		}),
+		gqltest.RespondWithData(map[string]any{
+			"instance": map[string]any{"id": "ecomm-prod-db", "version": "~1.1", "params": map[string]any{"size": 1}},
+		}),
+		gqltest.RespondWithSuccess("updateInstance", "x"),
+		remoteRefs("ecomm-prod-db", map[string]string{"dns": "prod-zone"}),
+		gqltest.RespondWithData(map[string]any{"removeRemoteReference": map[string]any{"result": map[string]any{"field": "dns"}, "successful": true}}),
+		gqltest.RespondWithData(map[string]any{"setRemoteReference": map[string]any{"result": map[string]any{"field": "vpc"}, "successful": true}}),
+		gqltest.RespondWithData(map[string]any{
+			"createDeployment": map[string]any{"result": map[string]any{"id": "dep-1", "status": "PENDING"}, "successful": true},
+		}),
+		gqltest.RespondWithData(map[string]any{"instance": map[string]any{"id": "ecomm-prod-db", "secretFields": []map[string]any{
+			{"name": "DB_PASSWORD"},
+		}}}),
+	)
+	report, err := snapshot.Restore(t.Context(), gqltest.NewSDKClient(t, gqlClient), snap, "ecomm-prod", snapshot.RestoreOptions{Action: deployments.ActionProvision, SkipDefaults: true})
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+
+	wantUpdated := []string{
+		"ecomm-prod attributes",
+		"ecomm-prod-db version",
+		"ecomm-prod-db remote reference dns",
+		"ecomm-prod-db remote reference vpc",
+		"ecomm-prod-db params",
+	}
+	if strings.Join(report.Updated, "\n") != strings.Join(wantUpdated, "\n") {
+		t.Errorf("Updated = %q, want %q", report.Updated, wantUpdated)
+	}
+	if len(report.Skipped) != 1 || report.Skipped[0] != "cache" {
+		t.Errorf("Skipped = %v, want [cache]", report.Skipped)
+	}
+	if got := report.MissingSecrets["ecomm-prod-db"]; len(got) != 1 || got[0] != "DB_PASSWORD" {
+		t.Errorf("MissingSecrets = %v", report.MissingSecrets)
+	}
+
+	reqs := gqlClient.Requests()
+	// The Map scalar travels as an encoded JSON string.
+	if attrs, _ := reqs[1].Variables["input"].(map[string]any)["attributes"]; attrs != `{"team":"data","tier":"1"}` {
+		t.Errorf("updateEnvironment attributes = %v, want team overlaid and tier kept", attrs)
+	}
+	if version, _ := reqs[4].Variables["input"].(map[string]any)["version"]; version != "~1.2+dev" {
+		t.Errorf("updateInstance version = %v, want ~1.2+dev", version)
+	}
+	if action, _ := reqs[8].Variables["input"].(map[string]any)["action"]; action != "PROVISION" {
+		t.Errorf("createDeployment action = %v, want PROVISION", action)
+	}
+	if gqlClient.Pending() != 0 {
+		t.Errorf("%d responses unused", gqlClient.Pending())
+	}
+}
+
+func TestRestore_RequiresAction(t *testing.T) {
+	gqlClient := gqltest.NewClient()
+	_, err := snapshot.Restore(t.Context(), gqltest.NewSDKClient(t, gqlClient), &snapshot.Snapshot{}, "ecomm-prod", snapshot.RestoreOptions{})
+	if err == nil || len(gqlClient.Requests()) != 0 {
+		t.Errorf("err = %v after %d requests, want an error before any request", err, len(gqlClient.Requests()))
+	}
+}
+
+func TestRead_RejectsNewerFormat(t *testing.T) {
+	_, err := snapshot.Read(strings.NewReader(`{"formatVersion": 2, "environment": "ecomm-prod"}`))
+	if err == nil || !strings.Contains(err.Error(), "format version 2") {
+		t.Errorf("err = %v, want a format version error", err)
+	}
+}
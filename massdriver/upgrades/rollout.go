@@ -0,0 +1,311 @@
+package upgrades
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/cache"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/deployments"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/instances"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/streaming"
+)
+
+// DefaultPollInterval is how often a rollout re-reads a deployment when
+// events are unavailable or quiet.
+const DefaultPollInterval = 10 * time.Second
+
+// DefaultBatchSizes is the widening schedule used when
+// [RolloutOptions].BatchSizes is empty.
+var DefaultBatchSizes = []int{1, 5, 25}
+
+// RolloutOptions configures [Upgrader.Rollout]. The zero value rolls out
+// with [DefaultBatchSizes] and no canary stage.
+type RolloutOptions struct {
+	// CanaryEnvironments are environment IDs upgraded first, as their own
+	// stage. The rest of the plan starts only once every canary succeeds.
+	CanaryEnvironments []string
+	// BatchSizes is the number of instances upgraded concurrently in each
+	// successive batch of a stage; the last size repeats. {1, 5, 20}
+	// upgrades one instance, then five, then twenty at a time.
+	BatchSizes []int
+	// PollInterval bounds how long a finished deployment can go unnoticed.
+	// Events make completion immediate with PAT credentials; polling is
+	// the only source otherwise. Zero selects [DefaultPollInterval].
+	PollInterval time.Duration
+	// Message is recorded on each deployment. Empty uses
+	// "Upgrade <bundle> from <from> to <to>".
+	Message string
+	// DryRun returns the batches as planned without changing anything.
+	DryRun bool
+	// OnStep is called as each instance finishes (or, on a dry run, as it
+	// is planned). Called from the rollout goroutine; keep it quick.
+	OnStep func(Step)
+}
+
+// Outcome is what happened to one instance in a rollout.
+type Outcome string
+
+const (
+	OutcomeUpgraded Outcome = "upgraded"
+	OutcomeFailed   Outcome = "failed"
+	// OutcomeSkipped: the rollout halted before reaching the instance.
+	OutcomeSkipped Outcome = "skipped"
+	// OutcomePlanned: a dry run.
+	OutcomePlanned Outcome = "planned"
+)
+
+// Step is one instance's part in a rollout.
+type Step struct {
+	Lagging
+	// Batch is the 1-based batch number across the whole rollout.
+	Batch   int
+	Canary  bool
+	Outcome Outcome
+	// Deployment is the upgrade deployment, in its final state; nil if
+	// none was created.
+	Deployment *types.Deployment
+	// Err explains an OutcomeFailed step.
+	Err error
+}
+
+// Report is the result of [Upgrader.Rollout]: every instance in the plan,
+// in rollout order.
+type Report struct {
+	Steps []Step
+	// Halted is set when a failure stopped the rollout early.
+	Halted bool
+}
+
+// Count returns the number of steps with outcome o.
+func (r *Report) Count(o Outcome) int {
+	n := 0
+	for _, s := range r.Steps {
+		if s.Outcome == o {
+			n++
+		}
+	}
+	return n
+}
+
+// HaltError is returned by [Upgrader.Rollout] when a batch had failures.
+// Later batches were not started.
+type HaltError struct {
+	Batch  int
+	Failed []Step
+}
+
+func (e *HaltError) Error() string {
+	ids := make([]string, len(e.Failed))
+	for i, s := range e.Failed {
+		ids[i] = s.Instance.ID
+	}
+	return fmt.Sprintf("upgrade rollout halted at batch %d: %d failed (%s)", e.Batch, len(e.Failed), strings.Join(ids, ", "))
+}
+
+// Unwrap returns each failed step's error.
+func (e *HaltError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failed))
+	for _, s := range e.Failed {
+		errs = append(errs, s.Err)
+	}
+	return errs
+}
+
+// Rollout upgrades every instance in plan: canary environments first, then
+// the rest, each stage in batches sized by opts.BatchSizes. Within a batch
+// instances upgrade concurrently; the next batch starts once every
+// deployment in this one has finished. If any fails, the rollout halts,
+// the remaining instances are reported as skipped, and the error is a
+// [*HaltError].
+//
+// Upgrading an instance creates a PROVISION deployment with the
+// instance's current params and waits for it, via deploymentEvents when
+// the credentials allow streaming and by polling otherwise. When the
+// instance's version constraint doesn't already resolve to the target,
+// Rollout pins it to the exact target for that deployment and restores
+// the original constraint once it ends, whatever the outcome, so
+// constraints such as "~1.2" survive the rollout.
+//
+// The report is returned even when err is non-nil.
+func (u *Upgrader) Rollout(ctx context.Context, plan *Plan, opts RolloutOptions) (*Report, error) {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultPollInterval
+	}
+	sizes := opts.BatchSizes
+	if len(sizes) == 0 {
+		sizes = DefaultBatchSizes
+	}
+
+	var canary, rest []Lagging
+	for _, l := range plan.Lagging {
+		if slices.Contains(opts.CanaryEnvironments, environmentID(l.Instance)) {
+			canary = append(canary, l)
+		} else {
+			rest = append(rest, l)
+		}
+	}
+	var batches [][]Step
+	for _, stage := range []struct {
+		items  []Lagging
+		canary bool
+	}{{canary, true}, {rest, false}} {
+		for i, n := 0, 0; i < len(stage.items); n++ {
+			size := max(sizes[min(n, len(sizes)-1)], 1)
+			end := min(i+size, len(stage.items))
+			batch := make([]Step, 0, end-i)
+			for _, l := range stage.items[i:end] {
+				batch = append(batch, Step{Lagging: l, Batch: len(batches) + 1, Canary: stage.canary})
+			}
+			batches = append(batches, batch)
+			i = end
+		}
+	}
+
+	report := &Report{}
+	var halt error
+	for _, batch := range batches {
+		switch {
+		case halt != nil:
+			for i := range batch {
+				batch[i].Outcome = OutcomeSkipped
+			}
+		case opts.DryRun:
+			for i := range batch {
+				batch[i].Outcome = OutcomePlanned
+				if opts.OnStep != nil {
+					opts.OnStep(batch[i])
+				}
+			}
+		default:
+			halt = u.runBatch(ctx, batch, opts)
+			if halt != nil {
+				report.Halted = true
+			}
+		}
+		report.Steps = append(report.Steps, batch...)
+	}
+	if err := ctx.Err(); err != nil {
+		return report, err
+	}
+	return report, halt
+}
+
+// runBatch upgrades batch concurrently, filling in each step's outcome.
+func (u *Upgrader) runBatch(ctx context.Context, batch []Step, opts RolloutOptions) error {
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	for i := range batch {
+		wg.Add(1)
+		go func(s *Step) {
+			defer wg.Done()
+			s.Deployment, s.Err = u.upgrade(ctx, s.Lagging, opts)
+			if s.Err == nil && s.Deployment.Status != string(deployments.StatusCompleted) {
+				s.Err = fmt.Errorf("upgrade %s: deployment %s %s", s.Instance.ID, s.Deployment.ID, s.Deployment.Status)
+			}
+			s.Outcome = OutcomeUpgraded
+			if s.Err != nil {
+				s.Outcome = OutcomeFailed
+			}
+			if opts.OnStep != nil {
+				mu.Lock()
+				opts.OnStep(*s)
+				mu.Unlock()
+			}
+		}(&batch[i])
+	}
+	wg.Wait()
+
+	var failed []Step
+	for _, s := range batch {
+		if s.Outcome == OutcomeFailed {
+			failed = append(failed, s)
+		}
+	}
+	if len(failed) > 0 {
+		return &HaltError{Batch: batch[0].Batch, Failed: failed}
+	}
+	return nil
+}
+
+// upgrade moves one instance to l.To and waits for its deployment to end.
+// A constraint pinned to reach l.To is restored before upgrade returns.
+func (u *Upgrader) upgrade(ctx context.Context, l Lagging, opts RolloutOptions) (dep *types.Deployment, err error) {
+	inst, err := u.instances.Get(ctx, l.Instance.ID)
+	if err != nil {
+		return nil, err
+	}
+	if inst.ResolvedVersion != l.To {
+		if _, err := u.instances.Update(ctx, inst.ID, instances.UpdateInput{Version: l.To}); err != nil {
+			return nil, err
+		}
+		defer func() {
+			// Restore even if ctx was cancelled mid-wait.
+			_, restoreErr := u.instances.Update(context.WithoutCancel(ctx), inst.ID, instances.UpdateInput{Version: inst.Version})
+			if restoreErr != nil {
+				err = errors.Join(err, fmt.Errorf("restore version constraint %q: %w", inst.Version, restoreErr))
+			}
+		}()
+	}
+	msg := opts.Message
+	if msg == "" {
+		bundle := "bundle"
+		if inst.Bundle != nil && inst.Bundle.Name != "" {
+			bundle = inst.Bundle.Name
+		}
+		msg = fmt.Sprintf("Upgrade %s from %s to %s", bundle, l.From, l.To)
+	}
+	dep, err = u.deployments.Create(ctx, inst.ID, deployments.CreateInput{
+		Action:  deployments.ActionProvision,
+		Params:  inst.Params,
+		Message: msg,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.wait(ctx, dep, opts.PollInterval)
+}
+
+// wait returns dep once it reaches a terminal status. Events prompt an
+// immediate re-read; the ticker covers missed events and non-PAT
+// credentials.
+func (u *Upgrader) wait(ctx context.Context, dep *types.Deployment, interval time.Duration) (*types.Deployment, error) {
+	ctx, cancel := context.WithCancel(cache.Bypass(ctx))
+	defer cancel()
+	events, err := u.deployments.StreamEvents(ctx, dep.ID)
+	if err != nil && !errors.Is(err, streaming.ErrRequiresPAT) {
+		return nil, err
+	}
+	tick := time.NewTicker(interval)
+	defer tick.Stop()
+	for {
+		if deployments.IsTerminal(dep.Status) {
+			return dep, nil
+		}
+		select {
+		case <-ctx.Done():
+			return dep, ctx.Err()
+		case ev, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			if de, isDep := ev.(*types.DeploymentEvent); !isDep || !deployments.IsTerminal(de.Deployment.Status) {
+				continue
+			}
+		case <-tick.C:
+		}
+		next, err := u.deployments.Get(ctx, dep.ID)
+		if err != nil {
+			return dep, err
+		}
+		dep = next
+	}
+}
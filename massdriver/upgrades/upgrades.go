@@ -0,0 +1,131 @@
+// Package upgrades finds instances running behind their bundle's newest
+// version and rolls upgrades out across the fleet in stages: canary
+// environments first, then widening batches, halting at the first failed
+// deployment.
+//
+//	u := upgrades.New(c.Instances, c.Deployments)
+//	plan, err := u.Scan(ctx, instances.ListInput{OciRepoName: "aws-rds"})
+//	if err != nil {
+//	    return err
+//	}
+//	report, err := u.Rollout(ctx, plan, upgrades.RolloutOptions{
+//	    CanaryEnvironments: []string{"ecomm-staging"},
+//	    BatchSizes:         []int{1, 5, 20},
+//	})
+//	var halt *upgrades.HaltError
+//	if errors.As(err, &halt) {
+//	    log.Printf("halted: %v", halt) // report still lists every instance
+//	}
+//
+// # What counts as lagging
+//
+// An instance lags when its AvailableUpgrade is set — a newer version
+// satisfies its version constraint — or when its ResolvedVersion differs
+// from its DeployedVersion, meaning the next deployment would change the
+// version. Instances that have never been deployed are not lagging.
+package upgrades
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/deployments"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/instances"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/types"
+)
+
+// Reason is why an instance is in a [Plan].
+type Reason string
+
+const (
+	// ReasonUpgradeAvailable: a newer bundle version satisfies the
+	// instance's version constraint.
+	ReasonUpgradeAvailable Reason = "upgrade-available"
+	// ReasonUndeployed: the constraint already resolves to a newer
+	// version, but it hasn't been deployed.
+	ReasonUndeployed Reason = "undeployed"
+)
+
+// Lagging is one instance behind its target version.
+type Lagging struct {
+	Instance types.Instance
+	Reason   Reason
+	// From is the deployed version; To is the version the rollout
+	// deploys — AvailableUpgrade when set, else ResolvedVersion.
+	From string
+	To   string
+}
+
+// Plan is the result of [Upgrader.Scan]: lagging instances ordered by
+// environment, then name.
+type Plan struct {
+	Lagging []Lagging
+	// Scanned is the number of instances the filter matched.
+	Scanned int
+}
+
+// Upgrader scans and rolls out upgrades. Construct with [New].
+type Upgrader struct {
+	instances   *instances.Service
+	deployments *deployments.Service
+}
+
+// New returns an [*Upgrader] using the given services — typically
+// c.Instances and c.Deployments from the top-level client.
+func New(inst *instances.Service, deps *deployments.Service) *Upgrader {
+	return &Upgrader{instances: inst, deployments: deps}
+}
+
+// Scan lists the instances matching filter and returns those that lag.
+// Filter by bundle (OciRepoName, BundleID), project (ProjectID),
+// environment (EnvironmentID), or effective attributes (Attributes) —
+// environment attributes are inherited, so they match too.
+func (u *Upgrader) Scan(ctx context.Context, filter instances.ListInput) (*Plan, error) {
+	plan := &Plan{}
+	for inst, err := range u.instances.Iter(ctx, filter) {
+		if err != nil {
+			return nil, err
+		}
+		plan.Scanned++
+		if l, ok := lagging(inst); ok {
+			plan.Lagging = append(plan.Lagging, l)
+		}
+	}
+	slices.SortFunc(plan.Lagging, func(a, b Lagging) int {
+		return cmp.Or(
+			strings.Compare(environmentID(a.Instance), environmentID(b.Instance)),
+			strings.Compare(a.Instance.Name, b.Instance.Name),
+			strings.Compare(a.Instance.ID, b.Instance.ID),
+		)
+	})
+	return plan, nil
+}
+
+func lagging(inst types.Instance) (Lagging, bool) {
+	if inst.DeployedVersion == "" {
+		return Lagging{}, false
+	}
+	l := Lagging{Instance: inst, From: inst.DeployedVersion}
+	switch {
+	case inst.AvailableUpgrade != "" && inst.AvailableUpgrade != inst.DeployedVersion:
+		l.Reason, l.To = ReasonUpgradeAvailable, inst.AvailableUpgrade
+	case inst.ResolvedVersion != "" && inst.ResolvedVersion != inst.DeployedVersion:
+		l.Reason, l.To = ReasonUndeployed, inst.ResolvedVersion
+	default:
+		return Lagging{}, false
+	}
+	return l, true
+}
+
+func environmentID(inst types.Instance) string {
+	if inst.Environment != nil && inst.Environment.ID != "" {
+		return inst.Environment.ID
+	}
+	// Fall back to the slug: project-environment-component.
+	if parts := strings.SplitN(inst.ID, "-", 3); len(parts) == 3 {
+		return parts[0] + "-" + parts[1]
+	}
+	return ""
+}
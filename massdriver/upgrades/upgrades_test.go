@@ -0,0 +1,216 @@
+package upgrades_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/config"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/gql/gqltest"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/internal/client"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/deployments"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/platform/instances"
+	"github.com/massdriver-cloud/massdriver-sdk-go/massdriver/upgrades"
+)
+
+func newUpgrader(gqlClient *gqltest.Client) *upgrades.Upgrader {
+	c := &client.Client{Config: config.Config{OrganizationID: "my-org"}, GQLv2: gqlClient}
+	return upgrades.New(instances.New(c), deployments.New(c))
+}
+
+func fleet() gqltest.Response {
+	inst := func(id, env string, resolved, deployed, available any) map[string]any {
+		return map[string]any{
+			"id": id, "name": id, "status": "PROVISIONED",
+			"resolvedVersion": resolved, "deployedVersion": deployed, "availableUpgrade": available,
+			"environment": map[string]any{"id": env},
+		}
+	}
+	return gqltest.RespondWithData(map[string]any{
+		"instances": map[string]any{
+			"cursor": map[string]any{},
+			"items": []map[string]any{
+				inst("ecomm-prod-db", "ecomm-prod", "1.2.0", "1.2.0", "1.3.0"),
+				inst("ecomm-prod-cache", "ecomm-prod", "1.2.0", "1.2.0", nil),
+				inst("ecomm-staging-db", "ecomm-staging", "1.3.0", "1.2.0", nil),
+				inst("ecomm-dev-db", "ecomm-dev", "1.3.0", nil, nil),
+			},
+		},
+	})
+}
+
+func TestScan(t *testing.T) {
+	gqlClient := gqltest.NewClient(fleet())
+
+	plan, err := newUpgrader(gqlClient).Scan(t.Context(), instances.ListInput{OciRepoName: "aws-rds"})
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if plan.Scanned != 4 || len(plan.Lagging) != 2 {
+		t.Fatalf("scanned %d, lagging %+v; want 4 scanned, 2 lagging", plan.Scanned, plan.Lagging)
+	}
+	prod, staging := plan.Lagging[0], plan.Lagging[1]
+	if prod.Instance.ID != "ecomm-prod-db" || prod.Reason != upgrades.ReasonUpgradeAvailable || prod.To != "1.3.0" {
+		t.Errorf("Lagging[0] = %+v", prod)
+	}
+	if staging.Instance.ID != "ecomm-staging-db" || staging.Reason != upgrades.ReasonUndeployed || staging.From != "1.2.0" {
+		t.Errorf("Lagging[1] = %+v", staging)
+	}
+
+	filter, _ := gqlClient.Requests()[0].Variables["filter"].(map[string]any)
+	if filter["ociRepoName"] == nil {
+		t.Errorf("filter = %v, want ociRepoName set", filter)
+	}
+}
+
+// lag leaves the environment ref unset, so canary matching falls back to
+// the ID's slug.
+func lag(id string) upgrades.Lagging {
+	l := upgrades.Lagging{From: "1.2.0", To: "1.3.0", Reason: upgrades.ReasonUpgradeAvailable}
+	l.Instance.ID = id
+	return l
+}
+
+func TestRollout_DryRunBatches(t *testing.T) {
+	plan := &upgrades.Plan{}
+	for _, id := range []string{"ecomm-prod-a", "ecomm-prod-b", "ecomm-prod-c", "ecomm-prod-d", "ecomm-staging-a"} {
+		plan.Lagging = append(plan.Lagging, lag(id))
+	}
+
+	report, err := newUpgrader(gqltest.NewClient()).Rollout(t.Context(), plan, upgrades.RolloutOptions{
+		CanaryEnvironments: []string{"ecomm-staging"},
+		BatchSizes:         []int{1, 2},
+		DryRun:             true,
+	})
+	if err != nil {
+		t.Fatalf("Rollout: %v", err)
+	}
+	var got []string
+	for _, s := range report.Steps {
+		if s.Outcome != upgrades.OutcomePlanned {
+			t.Errorf("%s outcome = %s, want planned", s.Instance.ID, s.Outcome)
+		}
+		got = append(got, s.Instance.ID+"@"+string(rune('0'+s.Batch)))
+	}
+	want := []string{"ecomm-staging-a@1", "ecomm-prod-a@2", "ecomm-prod-b@3", "ecomm-prod-c@3", "ecomm-prod-d@4"}
+	if len(got) != len(want) {
+		t.Fatalf("steps = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("steps = %v, want %v", got, want)
+			break
+		}
+	}
+	if !report.Steps[0].Canary || report.Steps[1].Canary {
+		t.Errorf("canary flags = %v, %v; want true, false", report.Steps[0].Canary, report.Steps[1].Canary)
+	}
+}
+
+func instanceRead(id, resolved string) gqltest.Response {
+	return gqltest.RespondWithData(map[string]any{
+		"instance": map[string]any{
+			"id": id, "version": "~1.2", "resolvedVersion": resolved, "params": map[string]any{"size": "small"},
+			"bundle": map[string]any{"name": "aws-rds"},
+		},
+	})
+}
+
+func deploymentCreated(id string) gqltest.Response {
+	return gqltest.RespondWithData(map[string]any{
+		"createDeployment": map[string]any{
+			"result":     map[string]any{"id": id, "status": "PENDING", "action": "PROVISION"},
+			"successful": true,
+		},
+	})
+}
+
+func deploymentRead(id, status string) gqltest.Response {
+	return gqltest.RespondWithData(map[string]any{
+		"deployment": map[string]any{"id": id, "status": status, "action": "PROVISION"},
+	})
+}
+
+func TestRollout_HaltsOnFailure(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		// ecomm-prod-a: pinned to 1.3.0, deployed, then unpinned.
+		instanceRead("ecomm-prod-a", "1.2.0"),
+		gqltest.RespondWithSuccess("updateInstance", "ecomm-prod-a"),
+		deploymentCreated("dep-a"),
+		deploymentRead("dep-a", "RUNNING"),
+		deploymentRead("dep-a", "COMPLETED"),
+		gqltest.RespondWithSuccess("updateInstance", "ecomm-prod-a"),
+		// ecomm-prod-b: already resolves to 1.3.0; its deployment fails.
+		instanceRead("ecomm-prod-b", "1.3.0"),
+		deploymentCreated("dep-b"),
+		deploymentRead("dep-b", "FAILED"),
+	)
+	plan := &upgrades.Plan{Lagging: []upgrades.Lagging{lag("ecomm-prod-a"), lag("ecomm-prod-b"), lag("ecomm-prod-c")}}
+
+	var seen []string
+	report, err := newUpgrader(gqlClient).Rollout(t.Context(), plan, upgrades.RolloutOptions{
+		BatchSizes:   []int{1},
+		PollInterval: time.Millisecond,
+		OnStep:       func(s upgrades.Step) { seen = append(seen, s.Instance.ID) },
+	})
+	var halt *upgrades.HaltError
+	if !errors.As(err, &halt) || halt.Batch != 2 || len(halt.Failed) != 1 {
+		t.Fatalf("err = %v, want a halt at batch 2", err)
+	}
+	if !report.Halted || report.Count(upgrades.OutcomeUpgraded) != 1 || report.Count(upgrades.OutcomeSkipped) != 1 {
+		t.Errorf("report = %+v", report)
+	}
+	if d := report.Steps[1].Deployment; d == nil || d.Status != "FAILED" {
+		t.Errorf("failed step deployment = %+v", d)
+	}
+	if len(seen) != 2 {
+		t.Errorf("OnStep saw %v, want the two attempted instances", seen)
+	}
+
+	reqs := gqlClient.Requests()
+	input, _ := reqs[1].Variables["input"].(map[string]any)
+	if input["version"] != "1.3.0" {
+		t.Errorf("update input = %v, want version 1.3.0", input)
+	}
+	create, _ := reqs[2].Variables["input"].(map[string]any)
+	if create["action"] != "PROVISION" || create["message"] != "Upgrade aws-rds from 1.2.0 to 1.3.0" {
+		t.Errorf("create input = %v", create)
+	}
+	restore, _ := reqs[5].Variables["input"].(map[string]any)
+	if restore["version"] != "~1.2" {
+		t.Errorf("restore input = %v, want the original constraint ~1.2", restore)
+	}
+	if gqlClient.Pending() != 0 {
+		t.Errorf("%d responses unused", gqlClient.Pending())
+	}
+}
+
+func TestRollout_RestoresConstraintAfterFailure(t *testing.T) {
+	gqlClient := gqltest.NewClient(
+		instanceRead("ecomm-prod-a", "1.2.0"),
+		gqltest.RespondWithSuccess("updateInstance", "ecomm-prod-a"),
+		deploymentCreated("dep-a"),
+		deploymentRead("dep-a", "FAILED"),
+		gqltest.RespondWithSuccess("updateInstance", "ecomm-prod-a"),
+	)
+	plan := &upgrades.Plan{Lagging: []upgrades.Lagging{lag("ecomm-prod-a")}}
+
+	_, err := newUpgrader(gqlClient).Rollout(t.Context(), plan, upgrades.RolloutOptions{PollInterval: time.Millisecond})
+	var halt *upgrades.HaltError
+	if !errors.As(err, &halt) {
+		t.Fatalf("err = %v, want a halt", err)
+	}
+
+	var versions []any
+	for _, r := range gqlClient.Requests() {
+		if input, ok := r.Variables["input"].(map[string]any); ok && r.OpName == "UpdateInstance" {
+			versions = append(versions, input["version"])
+		}
+	}
+	if len(versions) != 2 || versions[0] != "1.3.0" || versions[1] != "~1.2" {
+		t.Errorf("updateInstance versions = %v, want [1.3.0 ~1.2]", versions)
+	}
+	if gqlClient.Pending() != 0 {
+		t.Errorf("%d responses unused", gqlClient.Pending())
+	}
+}